@@ -105,8 +105,10 @@ func (c *Config) Validate() error {
 			if item.Statement != "" || item.Condition == "" {
 				return fmt.Errorf("%q context requires a 'condition'", item.Context)
 			}
-			if _, err := parseRequestCondition(item.Condition); err != nil {
-				return err
+			if isRequestFieldCondition(item.Condition) {
+				if _, err := parseRequestCondition(item.Condition); err != nil {
+					return err
+				}
 			}
 		default:
 			return errors.New("invalid context: " + item.Context)
@@ -248,13 +248,27 @@ func TestValidateConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "request context with OTTL condition",
+			config: &Config{
+				Table: []RoutingTableItem{
+					{
+						Context:   "request",
+						Condition: `otelcol.client.metadata["attr"][0] == "acme"`,
+						Pipelines: []pipeline.ID{
+							pipeline.NewIDWithName(pipeline.SignalTraces, "otlp"),
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "request context with invalid condition",
 			config: &Config{
 				Table: []RoutingTableItem{
 					{
 						Context:   "request",
-						Condition: `attributes["attr"] == "acme"`,
+						Condition: `request[attr] == "acme"`,
 						Pipelines: []pipeline.ID{
 							pipeline.NewIDWithName(pipeline.SignalTraces, "otlp"),
 						},
@@ -67,7 +67,12 @@ func (c *logsConnector) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 		route := c.router.routeSlice[i]
 		switch route.statementContext {
 		case "request":
-			if route.requestCondition.matchRequest(ctx) {
+			isMatch, err := route.matchRequest(ctx)
+			// If error during condition evaluation consider it as not a match.
+			if err != nil {
+				errs = errors.Join(errs, err)
+			}
+			if isMatch {
 				switch route.action {
 				case Copy:
 					ld.CopyTo(matched)
@@ -434,6 +434,8 @@ func TestLogsConnectorDetailed(t *testing.T) {
 	idSinkD := pipeline.NewIDWithName(pipeline.SignalLogs, "default")
 
 	isAcme := `request["X-Tenant"] == "acme"`
+	isAcmeOTTL := `otelcol.client.metadata["X-Tenant"][0] == "acme"`
+	isAcmeGRPCOTTL := `otelcol.grpc.metadata["X-Tenant"][0] == "acme"`
 
 	isResourceA := `attributes["resourceName"] == "resourceA"`
 	isResourceB := `attributes["resourceName"] == "resourceB"`
@@ -555,6 +557,54 @@ func TestLogsConnectorDetailed(t *testing.T) {
 			expectSink1: plog.Logs{},
 			expectSinkD: plogutiltest.NewLogs("AB", "CD", "EF"),
 		},
+		{
+			name: "request/ottl_match_http_value",
+			cfg: testConfig(
+				withRoute("request", isAcmeOTTL, idSink0),
+				withDefault(idSinkD),
+			),
+			ctx:         withHTTPMetadata(t.Context(), map[string][]string{"X-Tenant": {"acme"}}),
+			input:       plogutiltest.NewLogs("AB", "CD", "EF"),
+			expectSink0: plogutiltest.NewLogs("AB", "CD", "EF"),
+			expectSink1: plog.Logs{},
+			expectSinkD: plog.Logs{},
+		},
+		{
+			name: "request/ottl_match_no_http_value",
+			cfg: testConfig(
+				withRoute("request", isAcmeOTTL, idSink0),
+				withDefault(idSinkD),
+			),
+			ctx:         withHTTPMetadata(t.Context(), map[string][]string{"X-Tenant": {"notacme"}}),
+			input:       plogutiltest.NewLogs("AB", "CD", "EF"),
+			expectSink0: plog.Logs{},
+			expectSink1: plog.Logs{},
+			expectSinkD: plogutiltest.NewLogs("AB", "CD", "EF"),
+		},
+		{
+			name: "request/ottl_match_grpc_value",
+			cfg: testConfig(
+				withRoute("request", isAcmeGRPCOTTL, idSink0),
+				withDefault(idSinkD),
+			),
+			ctx:         withGRPCMetadata(t.Context(), map[string]string{"X-Tenant": "acme"}),
+			input:       plogutiltest.NewLogs("AB", "CD", "EF"),
+			expectSink0: plogutiltest.NewLogs("AB", "CD", "EF"),
+			expectSink1: plog.Logs{},
+			expectSinkD: plog.Logs{},
+		},
+		{
+			name: "log/ottl_match_http_value",
+			cfg: testConfig(
+				withRoute("log", isAcmeOTTL+" and "+isLogE, idSink0),
+				withDefault(idSinkD),
+			),
+			ctx:         withHTTPMetadata(t.Context(), map[string][]string{"X-Tenant": {"acme"}}),
+			input:       plogutiltest.NewLogs("AB", "CD", "EF"),
+			expectSink0: plogutiltest.NewLogs("AB", "CD", "E"),
+			expectSink1: plog.Logs{},
+			expectSinkD: plogutiltest.NewLogs("AB", "CD", "F"),
+		},
 		{
 			name: "resource/all_match_first_only",
 			cfg: testConfig(
@@ -68,7 +68,12 @@ func (c *metricsConnector) ConsumeMetrics(ctx context.Context, md pmetric.Metric
 		route := c.router.routeSlice[i]
 		switch route.statementContext {
 		case "request":
-			if route.requestCondition.matchRequest(ctx) {
+			isMatch, err := route.matchRequest(ctx)
+			// If error during condition evaluation consider it as not a match.
+			if err != nil {
+				errs = errors.Join(errs, err)
+			}
+			if isMatch {
 				switch route.action {
 				case Copy:
 					md.CopyTo(matched)
@@ -18,6 +18,7 @@ import (
 // but it's not clear that anything more than a simple comparison is needed.  We can expand this grammar in the
 // future if needed. For now, it expects the condition to be in exactly the format:
 // 'request["<name>"] <comparator> <value>' where <comparator> is either '==' or '!='.
+// Conditions which do not start with 'request[' are OTTL conditions, parsed with the `otelcol` context.
 
 var (
 	requestFieldRegex = regexp.MustCompile(`request\[".*"\]`)
@@ -25,6 +26,12 @@ var (
 	comparatorRegex   = regexp.MustCompile(`==|!=`)
 )
 
+// isRequestFieldCondition returns true if the condition uses this grammar, rather than being an
+// OTTL condition using the paths of the `otelcol` context.
+func isRequestFieldCondition(condition string) bool {
+	return strings.HasPrefix(strings.TrimSpace(condition), "request[")
+}
+
 type requestCondition struct {
 	compareFunc   func(string) bool
 	attributeName string
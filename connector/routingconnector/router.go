@@ -4,6 +4,7 @@
 package routingconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector"
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -16,6 +17,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlotelcol"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 )
@@ -31,6 +33,7 @@ type consumerProvider[C any] func(...pipeline.ID) (C, error)
 // parameter C is expected to be one of: consumer.Traces, consumer.Metrics, or
 // consumer.Logs.
 type router[C any] struct {
+	requestParser    ottl.Parser[*ottlotelcol.TransformContext]
 	resourceParser   ottl.Parser[*ottlresource.TransformContext]
 	spanParser       ottl.Parser[*ottlspan.TransformContext]
 	metricParser     ottl.Parser[*ottlmetric.TransformContext]
@@ -73,6 +76,7 @@ func newRouter[C any](
 type routingItem[C any] struct {
 	consumer           C
 	requestCondition   *requestCondition
+	requestStatement   *ottl.Statement[*ottlotelcol.TransformContext]
 	resourceStatement  *ottl.Statement[*ottlresource.TransformContext]
 	spanStatement      *ottl.Statement[*ottlspan.TransformContext]
 	metricStatement    *ottl.Statement[*ottlmetric.TransformContext]
@@ -82,10 +86,26 @@ type routingItem[C any] struct {
 	action             Action
 }
 
+// matchRequest returns true if the request of the context matches the condition of a route
+// of the "request" context.
+func (r routingItem[C]) matchRequest(ctx context.Context) (bool, error) {
+	if r.requestCondition != nil {
+		return r.requestCondition.matchRequest(ctx), nil
+	}
+	tCtx := ottlotelcol.NewTransformContextPtr()
+	defer tCtx.Close()
+	_, isMatch, err := r.requestStatement.Execute(ctx, tCtx)
+	return isMatch, err
+}
+
 func (r *router[C]) buildParsers(table []RoutingTableItem, settings component.TelemetrySettings) error {
-	var buildResource, buildSpan, buildMetric, buildDataPoint, buildLog bool
+	var buildRequest, buildResource, buildSpan, buildMetric, buildDataPoint, buildLog bool
 	for _, item := range table {
 		switch item.Context {
+		case "request":
+			if !isRequestFieldCondition(item.Condition) {
+				buildRequest = true
+			}
 		case "", "resource":
 			buildResource = true
 		case "span":
@@ -100,6 +120,17 @@ func (r *router[C]) buildParsers(table []RoutingTableItem, settings component.Te
 	}
 
 	var errs error
+	if buildRequest {
+		parser, err := ottlotelcol.NewParser(
+			standardFunctions[*ottlotelcol.TransformContext](),
+			settings,
+		)
+		if err == nil {
+			r.requestParser = parser
+		} else {
+			errs = errors.Join(errs, err)
+		}
+	}
 	if buildResource {
 		parser, err := ottlresource.NewParser(
 			standardFunctions[*ottlresource.TransformContext](),
@@ -210,9 +241,17 @@ func (r *router[C]) registerRouteConsumers() (err error) {
 			route.statementContext = item.Context
 			switch item.Context {
 			case "request":
-				route.requestCondition, err = parseRequestCondition(item.Condition)
-				if err != nil {
-					return err
+				if isRequestFieldCondition(item.Condition) {
+					route.requestCondition, err = parseRequestCondition(item.Condition)
+					if err != nil {
+						return err
+					}
+				} else {
+					statement, err := r.requestParser.ParseStatement(item.Statement)
+					if err != nil {
+						return err
+					}
+					route.requestStatement = statement
 				}
 			case "", "resource":
 				statement, err := r.resourceParser.ParseStatement(item.Statement)
@@ -3,7 +3,17 @@
 
 package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
 
-import "github.com/klauspost/compress/zstd"
+import (
+	"bytes"
+	"io"
+
+	"github.com/klauspost/compress/flate"
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zlib"
+	"github.com/klauspost/compress/zstd"
+	"go.opentelemetry.io/collector/config/configcompression"
+)
 
 // compressFunc defines how to compress encoded telemetry data.
 type compressFunc func(src []byte) []byte
@@ -11,7 +21,12 @@ type compressFunc func(src []byte) []byte
 var encoder, _ = zstd.NewWriter(nil)
 
 var encoders = map[string]compressFunc{
-	compressionZSTD: zstdCompress,
+	compressionZSTD:                            zstdCompress,
+	string(configcompression.TypeGzip):         gzipCompress,
+	string(configcompression.TypeZlib):         zlibCompress,
+	string(configcompression.TypeDeflate):      deflateCompress,
+	string(configcompression.TypeSnappy):       snappyCompress,
+	string(configcompression.TypeSnappyFramed): snappyFramedCompress,
 }
 
 func buildCompressor(compression string) compressFunc {
@@ -26,6 +41,50 @@ func zstdCompress(src []byte) []byte {
 	return encoder.EncodeAll(src, make([]byte, 0, len(src)))
 }
 
+// gzipCompress compress a buffer into a single gzip member
+func gzipCompress(src []byte) []byte {
+	return streamCompress(src, func(w io.Writer) io.WriteCloser {
+		return gzip.NewWriter(w)
+	})
+}
+
+// zlibCompress compress a buffer into a single zlib stream
+func zlibCompress(src []byte) []byte {
+	return streamCompress(src, func(w io.Writer) io.WriteCloser {
+		return zlib.NewWriter(w)
+	})
+}
+
+// deflateCompress compress a buffer into a raw deflate stream
+func deflateCompress(src []byte) []byte {
+	return streamCompress(src, func(w io.Writer) io.WriteCloser {
+		fw, _ := flate.NewWriter(w, flate.DefaultCompression)
+		return fw
+	})
+}
+
+// snappyCompress compress a buffer with the snappy block format
+func snappyCompress(src []byte) []byte {
+	return s2.EncodeSnappy(nil, src)
+}
+
+// snappyFramedCompress compress a buffer with the snappy framing format
+func snappyFramedCompress(src []byte) []byte {
+	return streamCompress(src, func(w io.Writer) io.WriteCloser {
+		return s2.NewWriter(w, s2.WriterSnappyCompat(), s2.WriterConcurrency(1))
+	})
+}
+
+// streamCompress compress a buffer with a freshly created stream writer.
+// Writes to a bytes.Buffer cannot fail, so errors are not checked.
+func streamCompress(src []byte, newWriter func(w io.Writer) io.WriteCloser) []byte {
+	buf := bytes.NewBuffer(make([]byte, 0, len(src)/2))
+	w := newWriter(buf)
+	_, _ = w.Write(src)
+	_ = w.Close()
+	return buf.Bytes()
+}
+
 // noneCompress return src
 func noneCompress(src []byte) []byte {
 	return src
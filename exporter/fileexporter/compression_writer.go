@@ -4,23 +4,41 @@
 package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 
+	"github.com/klauspost/compress/flate"
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zlib"
 	"github.com/klauspost/compress/zstd"
+	"go.opentelemetry.io/collector/config/configcompression"
 )
 
 // defaultMaxFrameMegabytes mirrors timberjack's default MaxSize.
 const defaultMaxFrameMegabytes = 100
 
-// compressingWriter wraps an io.WriteCloser with zstd compression.
+// streamEncoder is the subset of the klauspost encoders used by
+// compressingWriter. zstd, gzip, zlib, flate and s2 writers all satisfy it.
+type streamEncoder interface {
+	io.WriteCloser
+	Flush() error
+	Reset(w io.Writer)
+}
+
+// compressingWriter wraps an io.WriteCloser with stream compression.
 //
-//   - rotation != nil: each Write() is compressed into one complete frame (via
-//     EncodeAll) and written atomically. timberjack rotates between Write calls
-//     but never splits one, so a streamed frame (header/blocks/CRC across several
-//     writes) could be split across files; writing whole frames keeps every
-//     rotated file a valid, zstd -d-decodable .zst.
+//   - rotation != nil: records are compressed into complete frames (a zstd
+//     frame, gzip member or snappy stream) and each frame is written atomically.
+//     With minFrameBytes > 0, records are buffered until at least minFrameBytes
+//     are pending (or flush()/Close() is called) so small records share a frame;
+//     otherwise each Write() becomes its own frame.
+//     timberjack rotates between Write calls but never splits one, so a
+//     streamed frame (header/blocks/CRC across several writes) could be split
+//     across files; writing whole frames keeps every rotated file decodable by
+//     the standard tools.
 //   - rotation == nil: a single stream stays open, finalized by flush()/Close()
 //     for a better ratio.
 //
@@ -29,27 +47,31 @@ type compressingWriter struct {
 	base          io.WriteCloser // underlying writer (file or timberjack)
 	compression   string
 	level         int
-	encoder       *zstd.Encoder
-	rotation      *Rotation // when non-nil, finalize a frame per Write()
-	maxFrameBytes int       // rotation mode: max bytes for a single frame
-	frame         []byte    // rotation mode: reusable EncodeAll output buffer
-	dirty         bool      // encoder has received data since last flush/creation
-	err           error     // sticky error state
+	encoder       streamEncoder
+	rotation      *Rotation    // when non-nil, finalize a frame per Write()
+	maxFrameBytes int          // rotation mode: max bytes for a single frame
+	minFrameBytes int          // rotation mode: uncompressed bytes to buffer before finalizing a frame
+	pending       []byte       // rotation mode: records awaiting the next frame
+	frame         []byte       // rotation mode: reusable frame output buffer
+	frameBuf      bytes.Buffer // rotation mode: streaming target for non-zstd encoders
+	dirty         bool         // encoder has received data since last flush/creation
+	err           error        // sticky error state
 }
 
-func newCompressingWriter(base io.WriteCloser, compression string, level int, rotation *Rotation) (*compressingWriter, error) {
+func newCompressingWriter(base io.WriteCloser, compression string, level int, rotation *Rotation, minFrameBytes int) (*compressingWriter, error) {
 	cw := &compressingWriter{
-		base:        base,
-		compression: compression,
-		level:       level,
-		rotation:    rotation,
+		base:          base,
+		compression:   compression,
+		level:         level,
+		rotation:      rotation,
+		minFrameBytes: minFrameBytes,
 	}
 
-	// Rotation mode uses EncodeAll only, so the encoder needs no streaming target.
-	var target io.Writer
-	if rotation == nil {
-		target = base
-	} else {
+	// Rotation mode encodes into frameBuf (or uses EncodeAll for zstd), so the
+	// encoder never streams into base.
+	var target io.Writer = base
+	if rotation != nil {
+		target = &cw.frameBuf
 		maxMB := rotation.MaxMegabytes
 		if maxMB <= 0 {
 			maxMB = defaultMaxFrameMegabytes
@@ -66,18 +88,39 @@ func newCompressingWriter(base io.WriteCloser, compression string, level int, ro
 	return cw, nil
 }
 
-func (c *compressingWriter) newEncoder(w io.Writer) (*zstd.Encoder, error) {
-	switch c.compression {
-	case compressionZSTD:
+func (c *compressingWriter) newEncoder(w io.Writer) (streamEncoder, error) {
+	switch configcompression.Type(c.compression) {
+	case configcompression.TypeZstd:
+		if c.rotation != nil {
+			// EncodeAll only; the encoder needs no streaming target.
+			w = nil
+		}
 		return zstd.NewWriter(w,
 			zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(c.level)),
 			zstd.WithEncoderConcurrency(1),
 		)
+	case configcompression.TypeGzip:
+		return gzip.NewWriterLevel(w, flateLevel(c.level))
+	case configcompression.TypeZlib:
+		return zlib.NewWriterLevel(w, flateLevel(c.level))
+	case configcompression.TypeDeflate:
+		return flate.NewWriter(w, flateLevel(c.level))
+	case configcompression.TypeSnappyFramed:
+		return s2.NewWriter(w, s2.WriterSnappyCompat(), s2.WriterConcurrency(1)), nil
 	default:
 		return nil, fmt.Errorf("unsupported compression: %s", c.compression)
 	}
 }
 
+// flateLevel maps a configcompression level to a flate level. The zero value
+// means "not set" and selects the default level rather than no compression.
+func flateLevel(level int) int {
+	if level == 0 {
+		return flate.DefaultCompression
+	}
+	return level
+}
+
 func (c *compressingWriter) Write(p []byte) (int, error) {
 	if c.err != nil {
 		return 0, c.err
@@ -94,7 +137,49 @@ func (c *compressingWriter) Write(p []byte) (int, error) {
 		return n, nil
 	}
 
-	return c.writeFrame(p)
+	if c.minFrameBytes <= 0 {
+		return c.writeFrame(p)
+	}
+
+	c.pending = append(c.pending, p...)
+	if len(c.pending) >= c.minFrameBytes {
+		if err := c.writePending(); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// writePending compresses the buffered records into a frame. Rotation only.
+func (c *compressingWriter) writePending() error {
+	if c.err != nil {
+		return c.err
+	}
+	if len(c.pending) == 0 {
+		return nil
+	}
+	_, err := c.writeFrame(c.pending)
+	c.pending = c.pending[:0]
+	return err
+}
+
+// encodeFrame compresses p into one complete, independently decodable frame
+// stored in c.frame.
+func (c *compressingWriter) encodeFrame(p []byte) error {
+	if zenc, ok := c.encoder.(*zstd.Encoder); ok {
+		c.frame = zenc.EncodeAll(p, c.frame[:0])
+		return nil
+	}
+	c.frameBuf.Reset()
+	c.encoder.Reset(&c.frameBuf)
+	if _, err := c.encoder.Write(p); err != nil {
+		return err
+	}
+	if err := c.encoder.Close(); err != nil {
+		return err
+	}
+	c.frame = append(c.frame[:0], c.frameBuf.Bytes()...)
+	return nil
 }
 
 // writeFrame compresses p into one complete frame and writes it in a single
@@ -102,7 +187,10 @@ func (c *compressingWriter) Write(p []byte) (int, error) {
 // (which the writer would reject) is split into in-bounds chunks; the
 // decompressed frames concatenate back to p.
 func (c *compressingWriter) writeFrame(p []byte) (int, error) {
-	c.frame = c.encoder.EncodeAll(p, c.frame[:0])
+	if err := c.encodeFrame(p); err != nil {
+		c.err = err
+		return 0, err
+	}
 	if c.maxFrameBytes > 0 && len(c.frame) > c.maxFrameBytes {
 		return c.writeChunkedFrames(p)
 	}
@@ -114,7 +202,7 @@ func (c *compressingWriter) writeFrame(p []byte) (int, error) {
 }
 
 // writeChunkedFrames splits an oversized record into chunks that each compress
-// below maxFrameBytes (with headroom for zstd overhead so even incompressible
+// below maxFrameBytes (with headroom for codec overhead so even incompressible
 // data fits) and writes each as its own frame.
 func (c *compressingWriter) writeChunkedFrames(p []byte) (int, error) {
 	chunkSize := c.maxFrameBytes - c.maxFrameBytes/100 - 4096
@@ -125,7 +213,10 @@ func (c *compressingWriter) writeChunkedFrames(p []byte) (int, error) {
 	written := 0
 	for len(p) > 0 {
 		n := min(chunkSize, len(p))
-		c.frame = c.encoder.EncodeAll(p[:n], c.frame[:0])
+		if err := c.encodeFrame(p[:n]); err != nil {
+			c.err = err
+			return written, err
+		}
 		if _, err := c.base.Write(c.frame); err != nil {
 			c.err = err
 			return written, err
@@ -150,17 +241,41 @@ func (c *compressingWriter) closeAndResetEncoder() error {
 // Close finalizes the compression stream and closes the underlying writer.
 func (c *compressingWriter) Close() error {
 	// Non-rotation: Close() finalizes the open frame into base. Rotation: the
-	// encoder has no stream (EncodeAll only), so this just releases resources.
-	encoderErr := c.encoder.Close()
+	// buffered records are written as a final frame; only the zstd encoder
+	// holds resources to release.
+	var encoderErr error
+	if c.rotation == nil {
+		encoderErr = c.encoder.Close()
+	} else {
+		if c.err == nil {
+			encoderErr = c.writePending()
+		}
+		if zenc, ok := c.encoder.(*zstd.Encoder); ok {
+			encoderErr = errors.Join(encoderErr, zenc.Close())
+		}
+	}
 	baseErr := c.base.Close()
 	return errors.Join(encoderErr, baseErr)
 }
 
-// flush finalizes the current frame if dirty. In rotation mode dirty is never
-// set, so this is a no-op.
+// flush makes buffered data durable if dirty. zstd finalizes the current frame
+// (standard tools decode concatenated frames). zlib and deflate do not support
+// concatenated streams, so the remaining codecs emit a sync flush and keep a
+// single stream open. In rotation mode the buffered records are written as a
+// frame.
 func (c *compressingWriter) flush() error {
+	if c.rotation != nil {
+		return c.writePending()
+	}
 	if !c.dirty {
 		return nil
 	}
-	return c.closeAndResetEncoder()
+	if configcompression.Type(c.compression) == configcompression.TypeZstd {
+		return c.closeAndResetEncoder()
+	}
+	if err := c.encoder.Flush(); err != nil {
+		return err
+	}
+	c.dirty = false
+	return nil
 }
@@ -10,15 +10,21 @@ import (
 	"io"
 	"testing"
 
+	"github.com/klauspost/compress/flate"
+	"github.com/klauspost/compress/gzip"
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zlib"
 	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/configcompression"
 )
 
 // rotatingWriteCloser models timberjack: it never splits a single Write across
 // files and rotates before a write that would exceed max.
 type rotatingWriteCloser struct {
-	max   int
-	files []*bytes.Buffer
+	max    int
+	files  []*bytes.Buffer
+	writes int
 }
 
 func (r *rotatingWriteCloser) cur() *bytes.Buffer {
@@ -35,6 +41,7 @@ func (r *rotatingWriteCloser) Write(p []byte) (int, error) {
 	if r.cur().Len()+len(p) > r.max {
 		r.files = append(r.files, &bytes.Buffer{})
 	}
+	r.writes++
 	return r.cur().Write(p)
 }
 
@@ -45,7 +52,7 @@ func (*rotatingWriteCloser) Close() error { return nil }
 func TestCompressingWriter_RotationFrameIntegrity(t *testing.T) {
 	base := &rotatingWriteCloser{max: 70}
 
-	cw, err := newCompressingWriter(base, compressionZSTD, 3, &Rotation{MaxMegabytes: 1})
+	cw, err := newCompressingWriter(base, compressionZSTD, 3, &Rotation{MaxMegabytes: 1}, 0)
 	require.NoError(t, err)
 
 	var records []string
@@ -82,7 +89,7 @@ func TestCompressingWriter_RotationOversizedRecord(t *testing.T) {
 	const maxBytes = 1 << 20 // 1 MiB, matches Rotation{MaxMegabytes: 1}
 	base := &rotatingWriteCloser{max: maxBytes}
 
-	cw, err := newCompressingWriter(base, compressionZSTD, 3, &Rotation{MaxMegabytes: 1})
+	cw, err := newCompressingWriter(base, compressionZSTD, 3, &Rotation{MaxMegabytes: 1}, 0)
 	require.NoError(t, err)
 
 	// Incompressible payload several times larger than the limit.
@@ -110,11 +117,44 @@ func TestCompressingWriter_RotationOversizedRecord(t *testing.T) {
 	require.Equal(t, record, reassembled.Bytes())
 }
 
+// TestCompressingWriter_RotationMinFrameBytes: records are batched into a frame
+// once minFrameBytes are pending, and flush() writes whatever is left.
+func TestCompressingWriter_RotationMinFrameBytes(t *testing.T) {
+	base := &rotatingWriteCloser{max: 1 << 20}
+
+	cw, err := newCompressingWriter(base, compressionZSTD, 3, &Rotation{MaxMegabytes: 1}, 64)
+	require.NoError(t, err)
+
+	var want bytes.Buffer
+	for i := range 5 {
+		record := fmt.Sprintf("record-%03d-payload\n", i)
+		want.WriteString(record)
+		_, werr := cw.Write([]byte(record))
+		require.NoError(t, werr)
+	}
+	// 5 records of 19 bytes: the first 4 share a frame, the last is pending.
+	require.Equal(t, 1, base.writes)
+
+	require.NoError(t, cw.flush())
+	require.Equal(t, 2, base.writes)
+
+	require.NoError(t, cw.flush())
+	require.Equal(t, 2, base.writes, "flush with nothing pending must not write")
+	require.NoError(t, cw.Close())
+
+	dec, err := zstd.NewReader(bytes.NewReader(base.cur().Bytes()))
+	require.NoError(t, err)
+	defer dec.Close()
+	out, err := io.ReadAll(dec)
+	require.NoError(t, err)
+	require.Equal(t, want.String(), string(out))
+}
+
 func TestCompressingWriter_Zstd(t *testing.T) {
 	var buf bytes.Buffer
 	base := &nopWriteCloser{&buf}
 
-	cw, err := newCompressingWriter(base, compressionZSTD, 3, nil)
+	cw, err := newCompressingWriter(base, compressionZSTD, 3, nil, 0)
 	require.NoError(t, err)
 
 	testData := []byte("hello world from zstd compression")
@@ -141,7 +181,7 @@ func TestCompressingWriter_MultipleWrites_Zstd(t *testing.T) {
 	var buf bytes.Buffer
 	base := &nopWriteCloser{&buf}
 
-	cw, err := newCompressingWriter(base, compressionZSTD, 0, nil)
+	cw, err := newCompressingWriter(base, compressionZSTD, 0, nil, 0)
 	require.NoError(t, err)
 
 	messages := []string{
@@ -169,11 +209,83 @@ func TestCompressingWriter_MultipleWrites_Zstd(t *testing.T) {
 	require.Equal(t, expected, string(decompressed))
 }
 
+func newTestDecompressor(t *testing.T, compression string, r io.Reader) io.Reader {
+	t.Helper()
+	switch configcompression.Type(compression) {
+	case configcompression.TypeGzip:
+		gr, err := gzip.NewReader(r)
+		require.NoError(t, err)
+		return gr
+	case configcompression.TypeZlib:
+		zr, err := zlib.NewReader(r)
+		require.NoError(t, err)
+		return zr
+	case configcompression.TypeDeflate:
+		return flate.NewReader(r)
+	case configcompression.TypeSnappyFramed:
+		return s2.NewReader(r)
+	default:
+		dec, err := zstd.NewReader(r)
+		require.NoError(t, err)
+		return dec
+	}
+}
+
+func TestCompressingWriter_StreamCodecs(t *testing.T) {
+	for _, compression := range []string{"gzip", "zlib", "deflate", "x-snappy-framed"} {
+		t.Run(compression, func(t *testing.T) {
+			var buf bytes.Buffer
+			base := &nopWriteCloser{&buf}
+
+			cw, err := newCompressingWriter(base, compression, 0, nil, 0)
+			require.NoError(t, err)
+
+			_, err = cw.Write([]byte("first message\n"))
+			require.NoError(t, err)
+			// flush must keep the output a single decodable stream.
+			require.NoError(t, cw.flush())
+			_, err = cw.Write([]byte("second message\n"))
+			require.NoError(t, err)
+			require.NoError(t, cw.Close())
+
+			decompressed, err := io.ReadAll(newTestDecompressor(t, compression, &buf))
+			require.NoError(t, err)
+			require.Equal(t, "first message\nsecond message\n", string(decompressed))
+		})
+	}
+}
+
+func TestCompressingWriter_RotationFrameIntegrity_Gzip(t *testing.T) {
+	base := &rotatingWriteCloser{max: 100}
+
+	cw, err := newCompressingWriter(base, "gzip", 0, &Rotation{MaxMegabytes: 1}, 0)
+	require.NoError(t, err)
+
+	var want bytes.Buffer
+	for i := range 8 {
+		record := fmt.Sprintf("record-%03d-payload\n", i)
+		want.WriteString(record)
+		_, werr := cw.Write([]byte(record))
+		require.NoError(t, werr)
+	}
+	require.NoError(t, cw.Close())
+
+	require.Greater(t, len(base.files), 1, "test must actually rotate to be meaningful")
+
+	var reassembled bytes.Buffer
+	for i, f := range base.files {
+		out, rerr := io.ReadAll(newTestDecompressor(t, "gzip", bytes.NewReader(f.Bytes())))
+		require.NoErrorf(t, rerr, "file %d is not independently decodable", i)
+		reassembled.Write(out)
+	}
+	require.Equal(t, want.String(), reassembled.String())
+}
+
 func TestCompressingWriter_UnsupportedCompression(t *testing.T) {
 	var buf bytes.Buffer
 	base := &nopWriteCloser{&buf}
 
-	_, err := newCompressingWriter(base, "snappy", 0, nil)
+	_, err := newCompressingWriter(base, "snappy", 0, nil, 0)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "unsupported compression")
 }
@@ -182,7 +294,7 @@ func TestCompressingWriter_Flush(t *testing.T) {
 	var buf bytes.Buffer
 	base := &nopWriteCloser{&buf}
 
-	cw, err := newCompressingWriter(base, compressionZSTD, 0, nil)
+	cw, err := newCompressingWriter(base, compressionZSTD, 0, nil, 0)
 	require.NoError(t, err)
 
 	testData := []byte("data to flush")
@@ -14,6 +14,8 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configcompression"
 	"go.opentelemetry.io/collector/confmap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter/internal/metadata"
 )
 
 const (
@@ -25,6 +27,8 @@ var (
 	errInvalidOctal          = errors.New("directory_permissions value must be a valid octal representation")
 	errInvalidPermissionBits = errors.New("directory_permissions contain invalid bits for file access")
 	errDirPermsRequireCreate = errors.New("directory_permissions requires create_directory to be true")
+	errNativeSnappy          = errors.New("snappy compression is not supported with native compression, use x-snappy-framed instead")
+	errNativeRotationStream  = errors.New("zlib and deflate compression are not supported with rotation when native compression is enabled")
 )
 
 // Config defines configuration for file exporter.
@@ -32,6 +36,11 @@ type Config struct {
 	// Path of the file to write to. Path is relative to current directory.
 	Path string `mapstructure:"path"`
 
+	// AdditionalPaths are extra files that receive a copy of everything written
+	// to Path, e.g. a local file plus a network mount. Each destination is
+	// written independently: a failing destination does not stop the others.
+	AdditionalPaths []string `mapstructure:"additional_paths"`
+
 	// Mode defines whether the exporter should append to the file.
 	// Options:
 	// - false[default]:  truncates the file
@@ -52,7 +61,7 @@ type Config struct {
 	Encoding *component.ID `mapstructure:"encoding"`
 
 	// Compression Codec used to export telemetry data
-	// Supported compression algorithms:`zstd`
+	// Supported compression algorithms:`zstd`, `gzip`, `zlib`, `deflate`, `snappy`, `x-snappy-framed`
 	Compression string `mapstructure:"compression"`
 
 	// CompressionParams defines compression parameters.
@@ -61,8 +70,17 @@ type Config struct {
 	//   - SpeedDefault: 3
 	//   - SpeedBetterCompression: 6
 	//   - SpeedBestCompression: 11
+	// For gzip, zlib and deflate levels -1 to 9 are supported, 0 selects the default level.
+	// snappy and x-snappy-framed do not support levels.
 	CompressionParams configcompression.CompressionParams `mapstructure:"compression_params"`
 
+	// MinFrameBytes is the number of uncompressed bytes buffered before a
+	// compressed frame is finalized when native compression and rotation are
+	// enabled. Batching several records into one frame improves the compression
+	// ratio. Buffered records are still written on every flush. The default is 0,
+	// which writes one frame per record.
+	MinFrameBytes int `mapstructure:"min_frame_bytes"`
+
 	// FlushInterval is the duration between flushes.
 	// See time.ParseDuration for valid values.
 	FlushInterval time.Duration `mapstructure:"flush_interval"`
@@ -70,6 +88,9 @@ type Config struct {
 	// GroupBy enables writing to separate files based on a resource attribute.
 	GroupBy *GroupBy `mapstructure:"group_by"`
 
+	// Header configures a header record written at the start of every file.
+	Header *Header `mapstructure:"header"`
+
 	// CreateDirectory specifies that the parent directory of the output file should be created automatically on start.
 	CreateDirectory bool `mapstructure:"create_directory"`
 	// DirectoryPermissions specifies permissions used when creating directories (minus process umask).
@@ -116,6 +137,19 @@ type GroupBy struct {
 	MaxOpenFiles int `mapstructure:"max_open_files"`
 }
 
+// Header describes the header record written at the start of every file and
+// after every rotation. It records the collector version, format, encoding and
+// compression, so readers can decode a file without knowing the exporter
+// configuration.
+type Header struct {
+	// Enables the header. Default is false.
+	Enabled bool `mapstructure:"enabled"`
+
+	// SchemaURL is the OpenTelemetry schema URL of the exported data, recorded
+	// in the header. Optional.
+	SchemaURL string `mapstructure:"schema_url"`
+}
+
 var _ component.Config = (*Config)(nil)
 
 // Validate checks if the exporter configuration is valid
@@ -123,26 +157,46 @@ func (cfg *Config) Validate() error {
 	if cfg.Path == "" {
 		return errors.New("path must be non-empty")
 	}
+	for _, p := range cfg.AdditionalPaths {
+		if p == "" {
+			return errors.New("additional_paths must not contain empty paths")
+		}
+		if p == cfg.Path {
+			return errors.New("additional_paths must not contain path")
+		}
+	}
 	if cfg.Append && cfg.Rotation != nil {
 		return errors.New("append and rotation enabled at the same time is not supported")
 	}
 	if cfg.FormatType != formatTypeJSON && cfg.FormatType != formatTypeProto {
 		return errors.New("format type is not supported")
 	}
-	if cfg.Compression != "" && cfg.Compression != compressionZSTD {
-		return errors.New("compression is not supported")
-	}
 	if cfg.Compression != "" {
+		if _, ok := encoders[cfg.Compression]; !ok {
+			return errors.New("compression is not supported")
+		}
 		ct := configcompression.Type(cfg.Compression)
 		if err := ct.ValidateParams(cfg.CompressionParams); err != nil {
 			return fmt.Errorf("invalid compression_params: %w", err)
 		}
+		if metadata.ExporterFileNativeCompressionFeatureGate.IsEnabled() {
+			if err := validateNativeCompression(ct, cfg.Rotation); err != nil {
+				return err
+			}
+		}
+	}
+	if cfg.MinFrameBytes < 0 {
+		return errors.New("min_frame_bytes must not be negative")
 	}
 	if cfg.FlushInterval < 0 {
 		return errors.New("flush_interval must be larger than zero")
 	}
 
 	if cfg.GroupBy != nil && cfg.GroupBy.Enabled {
+		if len(cfg.AdditionalPaths) > 0 {
+			return errors.New("additional_paths is not supported when group_by is enabled")
+		}
+
 		pathParts := strings.Split(cfg.Path, "*")
 		if len(pathParts) != 2 {
 			return errors.New("path must contain exactly one * when group_by is enabled")
@@ -181,6 +235,23 @@ func (cfg *Config) Validate() error {
 	return nil
 }
 
+// validateNativeCompression rejects codecs that cannot produce a standard,
+// decodable file when the compression stream is written natively.
+func validateNativeCompression(ct configcompression.Type, rotation *Rotation) error {
+	switch ct {
+	case configcompression.TypeSnappy:
+		// The snappy block format has no stream framing.
+		return errNativeSnappy
+	case configcompression.TypeZlib, configcompression.TypeDeflate:
+		// Rotation writes one complete stream per record and zlib/deflate
+		// decoders stop after the first stream.
+		if rotation != nil {
+			return errNativeRotationStream
+		}
+	}
+	return nil
+}
+
 // Unmarshal a confmap.Conf into the config struct.
 func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
 	if componentParser == nil {
@@ -82,6 +82,55 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "gzip_with_level"),
+			expected: &Config{
+				Path:        "./filename",
+				FormatType:  formatTypeProto,
+				Compression: "gzip",
+				CompressionParams: configcompression.CompressionParams{
+					Level: 9,
+				},
+				FlushInterval: time.Second,
+				GroupBy: &GroupBy{
+					MaxOpenFiles:      defaultMaxOpenFiles,
+					ResourceAttribute: defaultResourceAttribute,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "additional_paths"),
+			expected: &Config{
+				Path:            "./local.json",
+				AdditionalPaths: []string{"/mnt/nfs/remote.json"},
+				FormatType:      formatTypeJSON,
+				FlushInterval:   time.Second,
+				GroupBy: &GroupBy{
+					MaxOpenFiles:      defaultMaxOpenFiles,
+					ResourceAttribute: defaultResourceAttribute,
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "additional_paths_empty"),
+			errorMessage: "additional_paths must not contain empty paths",
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "header"),
+			expected: &Config{
+				Path:          "./foo",
+				FormatType:    formatTypeJSON,
+				FlushInterval: time.Second,
+				Header: &Header{
+					Enabled:   true,
+					SchemaURL: "https://opentelemetry.io/schemas/1.26.0",
+				},
+				GroupBy: &GroupBy{
+					MaxOpenFiles:      defaultMaxOpenFiles,
+					ResourceAttribute: defaultResourceAttribute,
+				},
+			},
+		},
 		{
 			id: component.NewIDWithName(metadata.Type, "rotation_with_default_settings"),
 			expected: &Config{
@@ -157,6 +206,10 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "min_frame_bytes_negative_value"),
+			errorMessage: "min_frame_bytes must not be negative",
+		},
 		{
 			id:           component.NewIDWithName(metadata.Type, "flush_interval_negative_value"),
 			errorMessage: "flush_interval must be larger than zero",
@@ -199,6 +252,10 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(metadata.Type, "group_by_invalid_path2"),
 			errorMessage: "path must not start with * when group_by is enabled",
 		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "group_by_additional_paths"),
+			errorMessage: "additional_paths is not supported when group_by is enabled",
+		},
 		{
 			id:           component.NewIDWithName(metadata.Type, "group_by_empty_resource_attribute"),
 			errorMessage: "resource_attribute must not be empty when group_by is enabled",
@@ -237,3 +294,34 @@ func TestDirectoryPermissionsWithoutCreateDirectory(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "directory_permissions requires create_directory")
 }
+
+func TestValidateNativeCompression(t *testing.T) {
+	tests := []struct {
+		name        string
+		compression string
+		rotation    *Rotation
+		native      bool
+		expectedErr error
+	}{
+		{name: "gzip with rotation", compression: "gzip", rotation: &Rotation{}, native: true},
+		{name: "zlib without rotation", compression: "zlib", native: true},
+		{name: "zlib with rotation", compression: "zlib", rotation: &Rotation{}, native: true, expectedErr: errNativeRotationStream},
+		{name: "deflate with rotation", compression: "deflate", rotation: &Rotation{}, native: true, expectedErr: errNativeRotationStream},
+		{name: "deflate with rotation legacy", compression: "deflate", rotation: &Rotation{}},
+		{name: "snappy framed", compression: "x-snappy-framed", rotation: &Rotation{}, native: true},
+		{name: "snappy", compression: "snappy", native: true, expectedErr: errNativeSnappy},
+		{name: "snappy legacy", compression: "snappy"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setNativeCompressionFeatureGate(t, tt.native)
+			cfg := &Config{
+				Path:        "./foo",
+				FormatType:  formatTypeProto,
+				Compression: tt.compression,
+				Rotation:    tt.rotation,
+			}
+			assert.ErrorIs(t, cfg.Validate(), tt.expectedErr)
+		})
+	}
+}
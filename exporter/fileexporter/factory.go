@@ -7,7 +7,6 @@ import (
 	"context"
 	"io"
 	"os"
-	"time"
 
 	"github.com/DeRuina/timberjack"
 	"go.opentelemetry.io/collector/component"
@@ -77,7 +76,7 @@ func createTracesExporter(
 	set exporter.Settings,
 	cfg component.Config,
 ) (exporter.Traces, error) {
-	fe := getOrCreateFileExporter(cfg, set.Logger)
+	fe := getOrCreateFileExporter(cfg, set)
 	return exporterhelper.NewTraces(
 		ctx,
 		set,
@@ -94,7 +93,7 @@ func createMetricsExporter(
 	set exporter.Settings,
 	cfg component.Config,
 ) (exporter.Metrics, error) {
-	fe := getOrCreateFileExporter(cfg, set.Logger)
+	fe := getOrCreateFileExporter(cfg, set)
 	return exporterhelper.NewMetrics(
 		ctx,
 		set,
@@ -111,7 +110,7 @@ func createLogsExporter(
 	set exporter.Settings,
 	cfg component.Config,
 ) (exporter.Logs, error) {
-	fe := getOrCreateFileExporter(cfg, set.Logger)
+	fe := getOrCreateFileExporter(cfg, set)
 	return exporterhelper.NewLogs(
 		ctx,
 		set,
@@ -128,7 +127,7 @@ func createProfilesExporter(
 	set exporter.Settings,
 	cfg component.Config,
 ) (xexporter.Profiles, error) {
-	fe := getOrCreateFileExporter(cfg, set.Logger)
+	fe := getOrCreateFileExporter(cfg, set)
 	return xexporterhelper.NewProfiles(
 		ctx,
 		set,
@@ -144,76 +143,137 @@ func createProfilesExporter(
 // or returns the already cached one. Caching is required because the factory is asked trace and
 // metric receivers separately when it gets CreateTraces() and CreateMetrics()
 // but they must not create separate objects, they must use one Exporter object per configuration.
-func getOrCreateFileExporter(cfg component.Config, logger *zap.Logger) FileExporter {
+func getOrCreateFileExporter(cfg component.Config, set exporter.Settings) FileExporter {
 	conf := cfg.(*Config)
 	fe := exporters.GetOrAdd(cfg, func() component.Component {
-		return newFileExporter(conf, logger)
+		return newFileExporter(conf, set.Logger, set.BuildInfo)
 	})
 
 	c := fe.Unwrap()
 	return c.(FileExporter)
 }
 
-func newFileExporter(conf *Config, logger *zap.Logger) FileExporter {
+func newFileExporter(conf *Config, logger *zap.Logger, buildInfo component.BuildInfo) FileExporter {
 	if conf.GroupBy == nil || !conf.GroupBy.Enabled {
 		return &fileExporter{
-			conf: conf,
+			conf:      conf,
+			logger:    logger,
+			buildInfo: buildInfo,
 		}
 	}
 
 	return &groupingFileExporter{
-		conf:   conf,
-		logger: logger,
+		conf:      conf,
+		logger:    logger,
+		buildInfo: buildInfo,
 	}
 }
 
-func newFileWriter(path string, shouldAppend bool, rotation *Rotation, flushInterval time.Duration, export exportFunc, compression string, compressionLevel int) (*fileWriter, error) {
-	var baseWriter io.WriteCloser
+// newFileWriter creates a writer for path using the output settings of conf.
+// When header is set, it is written as the first record of every file.
+func newFileWriter(path string, conf *Config, export exportFunc, header []byte, logger *zap.Logger) (*fileWriter, error) {
 	var wc io.WriteCloser
 
-	if rotation == nil {
-		fileFlags := os.O_RDWR | os.O_CREATE
-		if shouldAppend {
-			fileFlags |= os.O_APPEND
-		} else {
-			fileFlags |= os.O_TRUNC
-		}
-		f, err := os.OpenFile(path, fileFlags, 0o644)
+	compression := conf.Compression
+	nativeCompression := compression != "" && metadata.ExporterFileNativeCompressionFeatureGate.IsEnabled()
+	level := int(conf.CompressionParams.Level)
+
+	// With rotation, the header is injected below the compression stream on
+	// every rotation, so it has to be framed and compressed up front.
+	var rotationHeader []byte
+	if header != nil && conf.Rotation != nil {
+		var err error
+		rotationHeader, err = frameHeader(header, export, nativeCompression, compression, level, conf.Rotation)
 		if err != nil {
 			return nil, err
 		}
-		baseWriter = f
-	} else {
-		baseWriter = &timberjack.Logger{
-			Filename:    path,
-			MaxSize:     rotation.MaxMegabytes,
-			MaxAge:      rotation.MaxDays,
-			MaxBackups:  rotation.MaxBackups,
-			LocalTime:   rotation.LocalTime,
-			Compression: "none", // ensure compression is handled by the collector
+	}
+
+	baseWriter, err := openBaseWriter(path, conf.Append, conf.Rotation, rotationHeader)
+	if err != nil {
+		return nil, err
+	}
+	if len(conf.AdditionalPaths) > 0 {
+		destinations := []*destination{{path: path, writer: baseWriter}}
+		for _, p := range conf.AdditionalPaths {
+			w, openErr := openBaseWriter(p, conf.Append, conf.Rotation, rotationHeader)
+			if openErr != nil {
+				for _, d := range destinations {
+					d.writer.Close()
+				}
+				return nil, openErr
+			}
+			destinations = append(destinations, &destination{path: p, writer: w})
 		}
+		baseWriter = newFanOutWriter(destinations, logger)
 	}
 
 	switch {
-	case compression != "" && metadata.ExporterFileNativeCompressionFeatureGate.IsEnabled():
-		var err error
-		wc, err = newCompressingWriter(baseWriter, compression, compressionLevel, rotation)
+	case nativeCompression:
+		var cw *compressingWriter
+		cw, err = newCompressingWriter(baseWriter, compression, level, conf.Rotation, conf.MinFrameBytes)
 		if err != nil {
 			baseWriter.Close()
 			return nil, err
 		}
-	case rotation == nil:
+		// Leave room for the header so a full-size frame still fits a fresh file.
+		if cw.maxFrameBytes > len(rotationHeader) {
+			cw.maxFrameBytes -= len(rotationHeader)
+		}
+		wc = cw
+	case conf.Rotation == nil:
 		wc = newBufferedWriteCloser(baseWriter)
 	default:
 		wc = baseWriter
 	}
 
-	return &fileWriter{
+	w := &fileWriter{
 		path:          path,
 		file:          wc,
 		exporter:      export,
-		flushInterval: flushInterval,
-	}, nil
+		flushInterval: conf.FlushInterval,
+	}
+
+	// Without rotation the header goes through the regular write path, unless
+	// records are appended to a file that already has content.
+	if header != nil && conf.Rotation == nil && (!conf.Append || isEmptyFile(path)) {
+		if err = w.export(header); err != nil {
+			wc.Close()
+			return nil, err
+		}
+	}
+
+	return w, nil
+}
+
+// openBaseWriter opens the file at path, or a rotating logger for it when
+// rotation is set. A non-nil header is written at the start of every rotated file.
+func openBaseWriter(path string, shouldAppend bool, rotation *Rotation, header []byte) (io.WriteCloser, error) {
+	if rotation == nil {
+		fileFlags := os.O_RDWR | os.O_CREATE
+		if shouldAppend {
+			fileFlags |= os.O_APPEND
+		} else {
+			fileFlags |= os.O_TRUNC
+		}
+		f, err := os.OpenFile(path, fileFlags, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		return f, nil
+	}
+	logger := &timberjack.Logger{
+		Filename:    path,
+		MaxSize:     rotation.MaxMegabytes,
+		MaxAge:      rotation.MaxDays,
+		MaxBackups:  rotation.MaxBackups,
+		LocalTime:   rotation.LocalTime,
+		Compression: "none", // ensure compression is handled by the collector
+	}
+	if header == nil {
+		return logger, nil
+	}
+	return newHeaderWriter(logger, path, rotation, header), nil
 }
 
 // This is the map of already created File exporters for particular configurations.
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter/internal/metadata"
 )
@@ -155,6 +156,22 @@ func TestNewFileWriter(t *testing.T) {
 				assert.True(t, ok)
 			},
 		},
+		{
+			name: "additional paths",
+			args: args{
+				cfg: &Config{
+					Path:            tempFileName(t),
+					AdditionalPaths: []string{tempFileName(t)},
+				},
+			},
+			validate: func(t *testing.T, writer *fileWriter) {
+				bw, ok := writer.file.(*bufferedWriteCloser)
+				require.True(t, ok)
+				fw, ok := bw.wrapped.(*fanOutWriter)
+				require.True(t, ok)
+				assert.Len(t, fw.destinations, 2)
+			},
+		},
 		{
 			name: "rotation file",
 			args: args{
@@ -196,7 +213,7 @@ func TestNewFileWriter(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := newFileWriter(tt.args.cfg.Path, tt.args.cfg.Append, tt.args.cfg.Rotation, tt.args.cfg.FlushInterval, nil, "", 0)
+			got, err := newFileWriter(tt.args.cfg.Path, tt.args.cfg, nil, nil, zap.NewNop())
 			defer func() {
 				assert.NoError(t, got.file.Close())
 			}()
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"go.uber.org/zap"
+)
+
+// destination is one output of a fanOutWriter.
+type destination struct {
+	path   string
+	writer io.WriteCloser
+	// dropped reports whether a write to this destination failed. It is then
+	// closed and never written to again.
+	dropped bool
+}
+
+// fanOutWriter writes every frame to all destinations. Destinations fail
+// independently: a write succeeds as long as at least one destination accepted
+// it, so a broken remote mount does not stall the local copy.
+//
+// A destination is dropped after its first failed write: the write may have been
+// partial, and appending to the file afterwards would leave a hole in the middle of
+// a record or of a compressed stream, making the rest of the file unreadable.
+//
+// Not thread-safe; callers serialize via fileWriter.mutex.
+type fanOutWriter struct {
+	destinations []*destination
+	logger       *zap.Logger
+}
+
+var _ io.WriteCloser = (*fanOutWriter)(nil)
+
+func newFanOutWriter(destinations []*destination, logger *zap.Logger) *fanOutWriter {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &fanOutWriter{
+		destinations: destinations,
+		logger:       logger,
+	}
+}
+
+func (f *fanOutWriter) Write(p []byte) (int, error) {
+	var errs error
+	written := false
+	for _, d := range f.destinations {
+		if d.dropped {
+			continue
+		}
+		n, err := d.writer.Write(p)
+		if err == nil && n < len(p) {
+			err = io.ErrShortWrite
+		}
+		if err != nil {
+			f.logger.Error("Failed to write to destination, dropping it", zap.String("path", d.path), zap.Error(err))
+			d.dropped = true
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", d.path, err), d.writer.Close())
+			continue
+		}
+		written = true
+	}
+	if !written {
+		if errs == nil {
+			errs = errors.New("all destinations were dropped after failed writes")
+		}
+		return 0, errs
+	}
+	return len(p), nil
+}
+
+func (f *fanOutWriter) Close() error {
+	var errs error
+	for _, d := range f.destinations {
+		if !d.dropped {
+			errs = errors.Join(errs, d.writer.Close())
+		}
+	}
+	return errs
+}
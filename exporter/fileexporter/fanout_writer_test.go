@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// failingWriteCloser fails every write while fail is set.
+type failingWriteCloser struct {
+	bytes.Buffer
+	fail   bool
+	closed bool
+}
+
+func (f *failingWriteCloser) Write(p []byte) (int, error) {
+	if f.fail {
+		return 0, errors.New("disk unavailable")
+	}
+	return f.Buffer.Write(p)
+}
+
+func (f *failingWriteCloser) Close() error {
+	f.closed = true
+	return nil
+}
+
+func TestFanOutWriter(t *testing.T) {
+	local := &failingWriteCloser{}
+	remote := &failingWriteCloser{}
+	core, logs := observer.New(zap.InfoLevel)
+	fw := newFanOutWriter([]*destination{
+		{path: "local", writer: local},
+		{path: "remote", writer: remote},
+	}, zap.New(core))
+
+	n, err := fw.Write([]byte("a"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	// A failing destination does not fail the write, and is dropped.
+	remote.fail = true
+	_, err = fw.Write([]byte("b"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, logs.FilterMessage("Failed to write to destination, dropping it").Len())
+	assert.True(t, remote.closed)
+
+	// A dropped destination is not written to anymore, even once it could be.
+	remote.fail = false
+	_, err = fw.Write([]byte("c"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "abc", local.String())
+	assert.Equal(t, "a", remote.String())
+
+	// The write fails once every destination was dropped.
+	local.fail = true
+	n, err = fw.Write([]byte("d"))
+	assert.ErrorContains(t, err, "local: disk unavailable")
+	assert.Zero(t, n)
+	assert.True(t, local.closed)
+
+	local.fail = false
+	_, err = fw.Write([]byte("e"))
+	assert.EqualError(t, err, "all destinations were dropped after failed writes")
+	assert.Equal(t, "abc", local.String())
+
+	require.NoError(t, fw.Close())
+}
+
+func TestFanOutWriter_Close(t *testing.T) {
+	local := &failingWriteCloser{}
+	remote := &failingWriteCloser{}
+	fw := newFanOutWriter([]*destination{
+		{path: "local", writer: local},
+		{path: "remote", writer: remote},
+	}, nil)
+
+	_, err := fw.Write([]byte("a"))
+	require.NoError(t, err)
+	require.NoError(t, fw.Close())
+	assert.True(t, local.closed)
+	assert.True(t, remote.closed)
+}
@@ -13,11 +13,14 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/pprofile"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
 )
 
 // fileExporter is the implementation of file exporter that writes telemetry data to a file
 type fileExporter struct {
 	conf       *Config
+	logger     *zap.Logger
+	buildInfo  component.BuildInfo
 	marshaller *marshaller
 	writer     *fileWriter
 }
@@ -63,20 +66,21 @@ func (e *fileExporter) Start(_ context.Context, host component.Host) error {
 	}
 	export := buildExportFunc(e.conf)
 
-	// Optionally ensure the output directory exists.
+	// Optionally ensure the output directories exist.
 	if e.conf.CreateDirectory {
-		dir := filepath.Dir(e.conf.Path)
 		perm := os.FileMode(0o755)
 		if e.conf.directoryPermissionsParsed != 0 {
 			perm = os.FileMode(e.conf.directoryPermissionsParsed)
 		}
-		err = os.MkdirAll(dir, perm)
-		if err != nil {
-			return err
+		for _, path := range append([]string{e.conf.Path}, e.conf.AdditionalPaths...) {
+			err = os.MkdirAll(filepath.Dir(path), perm)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
-	e.writer, err = newFileWriter(e.conf.Path, e.conf.Append, e.conf.Rotation, e.conf.FlushInterval, export, e.conf.Compression, int(e.conf.CompressionParams.Level))
+	e.writer, err = newFileWriter(e.conf.Path, e.conf, export, e.headerRecord(), e.logger)
 	if err != nil {
 		return err
 	}
@@ -84,6 +88,14 @@ func (e *fileExporter) Start(_ context.Context, host component.Host) error {
 	return nil
 }
 
+// headerRecord returns the marshaled file header, or nil when it is disabled.
+func (e *fileExporter) headerRecord() []byte {
+	if e.conf.Header == nil || !e.conf.Header.Enabled {
+		return nil
+	}
+	return e.marshaller.compressor(newFileHeader(e.conf, e.buildInfo).record())
+}
+
 // Shutdown stops the exporter and is invoked during shutdown.
 // It stops the flush ticker if set.
 func (e *fileExporter) Shutdown(context.Context) error {
@@ -19,6 +19,7 @@ import (
 	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/exporter/exportertest"
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -130,7 +131,7 @@ func TestFileTracesExporter(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			conf := tt.args.conf
-			feI := newFileExporter(conf, zap.NewNop())
+			feI := newFileExporter(conf, zap.NewNop(), component.BuildInfo{})
 			require.IsType(t, &fileExporter{}, feI)
 			fe := feI.(*fileExporter)
 
@@ -795,7 +796,7 @@ func TestFlushing(t *testing.T) {
 	// Wrap the buffer with the buffered writer closer that implements flush() method.
 	bwc := newBufferedWriteCloser(buf)
 	// Create a file exporter with flushing enabled.
-	feI := newFileExporter(cfg, zap.NewNop())
+	feI := newFileExporter(cfg, zap.NewNop(), component.BuildInfo{})
 	assert.IsType(t, &fileExporter{}, feI)
 	fe := feI.(*fileExporter)
 
@@ -811,7 +812,7 @@ func TestFlushing(t *testing.T) {
 	}
 	export := buildExportFunc(fe.conf)
 	var err error
-	fe.writer, err = newFileWriter(fe.conf.Path, fe.conf.Append, fe.conf.Rotation, fe.conf.FlushInterval, export, fe.conf.Compression, int(fe.conf.CompressionParams.Level))
+	fe.writer, err = newFileWriter(fe.conf.Path, fe.conf, export, nil, zap.NewNop())
 	assert.NoError(t, err)
 	err = fe.writer.file.Close()
 	assert.NoError(t, err)
@@ -850,7 +851,7 @@ func TestAppend(t *testing.T) {
 	// Wrap the buffer with the buffered writer closer that implements flush() method.
 	bwc := newBufferedWriteCloser(buf)
 	// Create a file exporter with flushing enabled.
-	feI := newFileExporter(cfg, zap.NewNop())
+	feI := newFileExporter(cfg, zap.NewNop(), component.BuildInfo{})
 	assert.IsType(t, &fileExporter{}, feI)
 	fe := feI.(*fileExporter)
 
@@ -866,7 +867,7 @@ func TestAppend(t *testing.T) {
 	}
 	export := buildExportFunc(fe.conf)
 	var err error
-	fe.writer, err = newFileWriter(fe.conf.Path, fe.conf.Append, fe.conf.Rotation, fe.conf.FlushInterval, export, fe.conf.Compression, int(fe.conf.CompressionParams.Level))
+	fe.writer, err = newFileWriter(fe.conf.Path, fe.conf, export, nil, zap.NewNop())
 	assert.NoError(t, err)
 	err = fe.writer.file.Close()
 	assert.NoError(t, err)
@@ -892,7 +893,7 @@ func TestAppend(t *testing.T) {
 	assert.NoError(t, fe.Shutdown(ctx))
 
 	// Restart the exporter
-	fe.writer, err = newFileWriter(fe.conf.Path, fe.conf.Append, fe.conf.Rotation, fe.conf.FlushInterval, export, fe.conf.Compression, int(fe.conf.CompressionParams.Level))
+	fe.writer, err = newFileWriter(fe.conf.Path, fe.conf, export, nil, zap.NewNop())
 	assert.NoError(t, err)
 	err = fe.writer.file.Close()
 	assert.NoError(t, err)
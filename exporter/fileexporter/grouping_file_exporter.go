@@ -27,6 +27,7 @@ import (
 type groupingFileExporter struct {
 	conf          *Config
 	logger        *zap.Logger
+	buildInfo     component.BuildInfo
 	marshaller    *marshaller
 	pathPrefix    string
 	pathSuffix    string
@@ -305,8 +306,12 @@ func (e *groupingFileExporter) Start(_ context.Context, host component.Host) err
 	e.attribute = e.conf.GroupBy.ResourceAttribute
 	e.pathSuffix = filepath.ToSlash(pathParts[1])
 	e.maxOpenFiles = e.conf.GroupBy.MaxOpenFiles
+	var header []byte
+	if e.conf.Header != nil && e.conf.Header.Enabled {
+		header = e.marshaller.compressor(newFileHeader(e.conf, e.buildInfo).record())
+	}
 	e.newFileWriter = func(path string) (*fileWriter, error) {
-		return newFileWriter(path, e.conf.Append, e.conf.Rotation, e.conf.FlushInterval, export, e.conf.Compression, int(e.conf.CompressionParams.Level))
+		return newFileWriter(path, e.conf, export, header, e.logger)
 	}
 
 	writers, err := simplelru.NewLRU(e.conf.GroupBy.MaxOpenFiles, e.onEvict)
@@ -14,6 +14,7 @@ import (
 	"github.com/DeRuina/timberjack"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -152,7 +153,7 @@ func TestGroupingFileTracesExporter(t *testing.T) {
 			tmpDir := t.TempDir()
 			conf.Path = tmpDir + "/*.log"
 			zapCore, logs := observer.New(zap.DebugLevel)
-			feI := newFileExporter(conf, zap.New(zapCore))
+			feI := newFileExporter(conf, zap.New(zapCore), component.BuildInfo{})
 			require.IsType(t, &groupingFileExporter{}, feI)
 			gfe := feI.(*groupingFileExporter)
 
@@ -228,7 +229,7 @@ func TestGroupingFileLogsExporter(t *testing.T) {
 			tmpDir := t.TempDir()
 			conf.Path = tmpDir + "/*.log"
 			zapCore, logs := observer.New(zap.DebugLevel)
-			feI := newFileExporter(conf, zap.New(zapCore))
+			feI := newFileExporter(conf, zap.New(zapCore), component.BuildInfo{})
 			require.IsType(t, &groupingFileExporter{}, feI)
 			gfe := feI.(*groupingFileExporter)
 
@@ -306,7 +307,7 @@ func TestGroupingFileMetricsExporter(t *testing.T) {
 			conf.Path = tmpDir + "/*.log"
 
 			zapCore, logs := observer.New(zap.DebugLevel)
-			feI := newFileExporter(conf, zap.New(zapCore))
+			feI := newFileExporter(conf, zap.New(zapCore), component.BuildInfo{})
 			require.IsType(t, &groupingFileExporter{}, feI)
 			gfe := feI.(*groupingFileExporter)
 
@@ -487,7 +488,7 @@ func BenchmarkExporters(b *testing.B) {
 		logs = append(logs, ld)
 	}
 	for _, tc := range tests {
-		fe := newFileExporter(tc.conf, zap.NewNop())
+		fe := newFileExporter(tc.conf, zap.NewNop(), component.BuildInfo{})
 
 		// remove marshaling time from the benchmark
 		tm := &testMarshaller{content: bytes.Repeat([]byte{'a'}, 512)}
@@ -545,7 +546,7 @@ func TestGroupingFileExporterWithRotation(t *testing.T) {
 	}
 
 	zapCore, _ := observer.New(zap.DebugLevel)
-	feI := newFileExporter(conf, zap.New(zapCore))
+	feI := newFileExporter(conf, zap.New(zapCore), component.BuildInfo{})
 	require.IsType(t, &groupingFileExporter{}, feI)
 	gfe := feI.(*groupingFileExporter)
 
@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+
+	"github.com/DeRuina/timberjack"
+	"go.opentelemetry.io/collector/component"
+)
+
+// headerKey is the single top-level key of the header record, so readers can
+// tell it apart from OTLP records.
+const headerKey = "otelcol_file_header"
+
+// fileHeader describes how the records following it are encoded, so readers
+// can select a decoder without out-of-band configuration.
+type fileHeader struct {
+	CollectorVersion string `json:"collector_version"`
+	Format           string `json:"format"`
+	Encoding         string `json:"encoding,omitempty"`
+	Compression      string `json:"compression,omitempty"`
+	SchemaURL        string `json:"schema_url,omitempty"`
+}
+
+func newFileHeader(conf *Config, buildInfo component.BuildInfo) fileHeader {
+	h := fileHeader{
+		CollectorVersion: buildInfo.Version,
+		Format:           conf.FormatType,
+		Compression:      conf.Compression,
+		SchemaURL:        conf.Header.SchemaURL,
+	}
+	if conf.Encoding != nil {
+		h.Encoding = conf.Encoding.String()
+	}
+	return h
+}
+
+// record returns the header encoded as a single JSON object.
+func (h fileHeader) record() []byte {
+	buf, _ := json.Marshal(map[string]fileHeader{headerKey: h})
+	return buf
+}
+
+// headerBuffer is an in-memory io.WriteCloser used to pre-encode the header.
+type headerBuffer struct {
+	bytes.Buffer
+}
+
+func (*headerBuffer) Close() error { return nil }
+
+// frameHeader encodes the header record exactly as the write path would:
+// framed by export and, with native compression, as one complete frame.
+func frameHeader(header []byte, export exportFunc, nativeCompression bool, compression string, level int, rotation *Rotation) ([]byte, error) {
+	framed := &headerBuffer{}
+	if err := export(&fileWriter{file: framed}, header); err != nil {
+		return nil, err
+	}
+	if !nativeCompression {
+		return framed.Bytes(), nil
+	}
+
+	compressed := &headerBuffer{}
+	cw, err := newCompressingWriter(compressed, compression, level, rotation, 0)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = cw.Write(framed.Bytes()); err != nil {
+		return nil, err
+	}
+	if err = cw.Close(); err != nil {
+		return nil, err
+	}
+	return compressed.Bytes(), nil
+}
+
+// headerWriter writes a pre-encoded header at the start of every file of a
+// rotating logger. timberjack has no rotation hook, so headerWriter tracks the
+// file size itself and rotates right before timberjack would.
+//
+// Not thread-safe; callers serialize via fileWriter.mutex.
+type headerWriter struct {
+	logger   *timberjack.Logger
+	header   []byte
+	maxBytes int64
+	// size is the number of bytes in the current file, or -1 until the first
+	// write when the file already existed with content.
+	size int64
+}
+
+func newHeaderWriter(logger *timberjack.Logger, path string, rotation *Rotation, header []byte) *headerWriter {
+	maxMB := rotation.MaxMegabytes
+	if maxMB <= 0 {
+		maxMB = defaultMaxFrameMegabytes
+	}
+	w := &headerWriter{
+		logger:   logger,
+		header:   header,
+		maxBytes: int64(maxMB) * 1024 * 1024,
+	}
+	if !isEmptyFile(path) {
+		// timberjack would append to the existing file, which has no header
+		// at its start as far as we know, so start a new one.
+		w.size = -1
+	}
+	return w
+}
+
+func (w *headerWriter) Write(p []byte) (int, error) {
+	if w.size != 0 && (w.size < 0 || w.size+int64(len(p)) > w.maxBytes) {
+		if err := w.logger.Rotate(); err != nil {
+			return 0, err
+		}
+		w.size = 0
+	}
+	if w.size == 0 {
+		n, err := w.logger.Write(w.header)
+		w.size += int64(n)
+		if err != nil {
+			return 0, err
+		}
+	}
+	n, err := w.logger.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+func (w *headerWriter) Close() error {
+	return w.logger.Close()
+}
+
+// isEmptyFile reports whether path does not exist or has no content.
+func isEmptyFile(path string) bool {
+	fi, err := os.Stat(path)
+	return err != nil || fi.Size() == 0
+}
@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DeRuina/timberjack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
+)
+
+func TestFileHeader(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "telemetry.json")
+	conf := &Config{
+		Path:       path,
+		FormatType: formatTypeJSON,
+		Header: &Header{
+			Enabled:   true,
+			SchemaURL: "https://opentelemetry.io/schemas/1.26.0",
+		},
+	}
+	fe := newFileExporter(conf, zap.NewNop(), component.BuildInfo{Version: "1.2.3"}).(*fileExporter)
+
+	require.NoError(t, fe.Start(t.Context(), componenttest.NewNopHost()))
+	require.NoError(t, fe.consumeTraces(t.Context(), testdata.GenerateTracesTwoSpansSameResource()))
+	require.NoError(t, fe.Shutdown(t.Context()))
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1<<20)
+
+	require.True(t, scanner.Scan())
+	var got map[string]fileHeader
+	require.NoError(t, json.Unmarshal(scanner.Bytes(), &got))
+	assert.Equal(t, fileHeader{
+		CollectorVersion: "1.2.3",
+		Format:           formatTypeJSON,
+		SchemaURL:        "https://opentelemetry.io/schemas/1.26.0",
+	}, got[headerKey])
+
+	require.True(t, scanner.Scan())
+	assert.Contains(t, scanner.Text(), "resourceSpans")
+}
+
+func TestHeaderWriter_Rotation(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "telemetry.log")
+	// An existing file without a header must not be appended to.
+	require.NoError(t, os.WriteFile(path, []byte("old\n"), 0o600))
+
+	logger := &timberjack.Logger{Filename: path, MaxSize: 1}
+	w := newHeaderWriter(logger, path, &Rotation{MaxMegabytes: 1}, []byte("header\n"))
+	w.maxBytes = 30
+
+	for range 4 {
+		_, err := w.Write([]byte("0123456789\n"))
+		require.NoError(t, err)
+		// Keep backup file names, which have millisecond precision, unique.
+		time.Sleep(2 * time.Millisecond)
+	}
+	require.NoError(t, w.Close())
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	// The pre-existing file plus 2 files with 2 records each.
+	require.Len(t, entries, 3)
+
+	var withHeader int
+	for _, entry := range entries {
+		content, rerr := os.ReadFile(filepath.Join(dir, entry.Name()))
+		require.NoError(t, rerr)
+		if string(content) == "old\n" {
+			continue
+		}
+		assert.True(t, strings.HasPrefix(string(content), "header\n"), "file %s must start with the header", entry.Name())
+		assert.Len(t, content, 7+2*11)
+		withHeader++
+	}
+	assert.Equal(t, 2, withHeader)
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/configcompression"
 	"go.opentelemetry.io/collector/featuregate"
@@ -182,7 +183,7 @@ func TestLegacyCompression_WhenFeatureGateDisabled(t *testing.T) {
 		Compression: compressionZSTD,
 	}
 
-	feI := newFileExporter(conf, zap.NewNop())
+	feI := newFileExporter(conf, zap.NewNop(), component.BuildInfo{})
 	require.IsType(t, &fileExporter{}, feI)
 	fe := feI.(*fileExporter)
 
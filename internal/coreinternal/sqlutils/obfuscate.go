@@ -0,0 +1,242 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlutils // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/sqlutils"
+
+import (
+	"errors"
+	"strings"
+)
+
+const placeholder = '?'
+
+var (
+	errUnterminatedString  = errors.New("unterminated string literal")
+	errUnterminatedComment = errors.New("unterminated comment")
+)
+
+// ObfuscateQuery replaces the literals of a SQL query with "?", so it does not contain
+// sensitive values, and normalizes the query so that queries only differing by their
+// literals have the same result:
+//   - string, dollar-quoted, numeric, hexadecimal and binary literals are replaced with "?";
+//   - lists of literals, e.g. `IN (1, 2, 3)`, are collapsed to `(?)`;
+//   - comments are removed and consecutive whitespaces are replaced with a single space.
+//
+// Identifiers, including quoted ones, keywords and bind parameters such as `$1` or `:name` are kept.
+// An error is returned if the query has an unterminated string literal or comment, as it
+// cannot be safely obfuscated.
+func ObfuscateQuery(query string) (string, error) {
+	var b strings.Builder
+	b.Grow(len(query))
+	space := false
+	write := func(s string) {
+		// Whitespaces are not kept after an opening parenthesis, nor before a closing one or a comma.
+		if space && b.Len() > 0 && lastByte(&b) != '(' && s[0] != ')' && s[0] != ',' {
+			b.WriteByte(' ')
+		}
+		space = false
+		b.WriteString(s)
+	}
+
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case isSpace(c):
+			space = true
+			i++
+		case c == '-' && peek(query, i+1) == '-':
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				end = len(query) - i
+			}
+			space = true
+			i += end
+		case c == '/' && peek(query, i+1) == '*':
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				return "", errUnterminatedComment
+			}
+			space = true
+			i += end + 4
+		case c == '\'':
+			end, err := stringEnd(query, i)
+			if err != nil {
+				return "", err
+			}
+			write(string(placeholder))
+			i = end
+		case c == '"' || c == '`':
+			// Quoted identifiers are kept.
+			end := strings.IndexByte(query[i+1:], c)
+			if end < 0 {
+				return "", errUnterminatedString
+			}
+			write(query[i : i+end+2])
+			i += end + 2
+		case c == '$':
+			end, isLiteral, err := dollarEnd(query, i)
+			if err != nil {
+				return "", err
+			}
+			if isLiteral {
+				write(string(placeholder))
+			} else {
+				write(query[i:end])
+			}
+			i = end
+		case isDigit(c) || (c == '.' && isDigit(peek(query, i+1))):
+			end := numberEnd(query, i)
+			write(string(placeholder))
+			i = end
+		case isIdentifierChar(c):
+			end := i
+			for end < len(query) && isIdentifierChar(query[end]) {
+				end++
+			}
+			// Prefixed string literals, e.g. N'unicode', E'escaped', X'1F' or B'01'.
+			if end-i == 1 && peek(query, end) == '\'' && strings.IndexByte("nNeExXbB", c) >= 0 {
+				strEnd, err := stringEnd(query, end)
+				if err != nil {
+					return "", err
+				}
+				write(string(placeholder))
+				i = strEnd
+				continue
+			}
+			write(query[i:end])
+			i = end
+		default:
+			write(query[i : i+1])
+			i++
+		}
+	}
+	return collapseLists(b.String()), nil
+}
+
+// stringEnd returns the index following the string literal starting with a quote at i.
+// Quotes are escaped by doubling them or with a backslash.
+func stringEnd(query string, i int) (int, error) {
+	for j := i + 1; j < len(query); j++ {
+		switch query[j] {
+		case '\\':
+			j++
+		case '\'':
+			if peek(query, j+1) == '\'' {
+				j++
+				continue
+			}
+			return j + 1, nil
+		}
+	}
+	return 0, errUnterminatedString
+}
+
+// dollarEnd returns the index following the token starting with a "$" at i, and whether
+// it is a dollar-quoted string literal, e.g. `$$text$$` or `$tag$text$tag$`, rather than
+// a bind parameter like `$1`.
+func dollarEnd(query string, i int) (int, bool, error) {
+	j := i + 1
+	if isDigit(peek(query, j)) {
+		for j < len(query) && isDigit(query[j]) {
+			j++
+		}
+		return j, false, nil
+	}
+	for j < len(query) && isIdentifierChar(query[j]) {
+		j++
+	}
+	if peek(query, j) != '$' {
+		return j, false, nil
+	}
+	tag := query[i : j+1]
+	end := strings.Index(query[j+1:], tag)
+	if end < 0 {
+		return 0, false, errUnterminatedString
+	}
+	return j + 1 + end + len(tag), true, nil
+}
+
+// numberEnd returns the index following the numeric literal starting at i,
+// including decimals, exponents and hexadecimal or binary prefixes.
+func numberEnd(query string, i int) int {
+	j := i
+	for j < len(query) {
+		c := query[j]
+		switch {
+		case isIdentifierChar(c) || c == '.':
+			j++
+		case (c == '+' || c == '-') && (query[j-1] == 'e' || query[j-1] == 'E') && !strings.HasPrefix(strings.ToLower(query[i:j]), "0x"):
+			j++
+		default:
+			return j
+		}
+	}
+	return j
+}
+
+// collapseLists replaces lists of placeholders, e.g. `(?, ?, ?)`, with `(?)`.
+func collapseLists(query string) string {
+	if !strings.Contains(query, "?,") {
+		return query
+	}
+	var b strings.Builder
+	b.Grow(len(query))
+	for i := 0; i < len(query); i++ {
+		if query[i] == '(' {
+			if end, ok := placeholderListEnd(query, i+1); ok {
+				b.WriteString("(?)")
+				i = end
+				continue
+			}
+		}
+		b.WriteByte(query[i])
+	}
+	return b.String()
+}
+
+// placeholderListEnd returns the index of the closing parenthesis of a list of
+// placeholders starting at i, and false if the parenthesis contains anything else.
+func placeholderListEnd(query string, i int) (int, bool) {
+	expectPlaceholder := true
+	for j := i; j < len(query); j++ {
+		switch c := query[j]; {
+		case c == ' ':
+		case c == placeholder && expectPlaceholder:
+			expectPlaceholder = false
+		case c == ',' && !expectPlaceholder:
+			expectPlaceholder = true
+		case c == ')' && !expectPlaceholder:
+			return j, true
+		default:
+			return 0, false
+		}
+	}
+	return 0, false
+}
+
+func lastByte(b *strings.Builder) byte {
+	s := b.String()
+	if s == "" {
+		return 0
+	}
+	return s[len(s)-1]
+}
+
+func peek(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+	return 0
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f' || c == '\v'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || c == '@' || c == '#' || isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObfuscateQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{
+			name:     "string and number literals",
+			query:    "SELECT * FROM users WHERE name = 'alice' AND age > 30",
+			expected: "SELECT * FROM users WHERE name = ? AND age > ?",
+		},
+		{
+			name:     "escaped quotes",
+			query:    `SELECT * FROM users WHERE name = 'O''Brien' OR name = 'it\'s'`,
+			expected: "SELECT * FROM users WHERE name = ? OR name = ?",
+		},
+		{
+			name:     "decimals, exponents, hexadecimal and negative numbers",
+			query:    "SELECT 1.5, .5, 1e-10, 2E+3, 0x1F, -42",
+			expected: "SELECT ?, ?, ?, ?, ?, -?",
+		},
+		{
+			name:     "prefixed strings",
+			query:    "SELECT N'name', E'line\\n', X'1F', b'01'",
+			expected: "SELECT ?, ?, ?, ?",
+		},
+		{
+			name:     "IN list",
+			query:    "SELECT * FROM orders WHERE id IN ( 1, 2,3 ) AND status IN ('new', 'paid')",
+			expected: "SELECT * FROM orders WHERE id IN (?) AND status IN (?)",
+		},
+		{
+			name:     "insert values",
+			query:    "INSERT INTO users (id, name) VALUES (1, 'alice')",
+			expected: "INSERT INTO users (id, name) VALUES (?)",
+		},
+		{
+			name:     "function arguments are kept",
+			query:    "SELECT COALESCE(name, 'unknown') FROM users",
+			expected: "SELECT COALESCE(name, ?) FROM users",
+		},
+		{
+			name:     "comments and whitespaces",
+			query:    "SELECT id -- the id\n  FROM /* secret: 'x' */ users\n\tWHERE id = 1",
+			expected: "SELECT id FROM users WHERE id = ?",
+		},
+		{
+			name:     "identifiers with digits and quoted identifiers",
+			query:    `SELECT "col 1", ` + "`table2`.id" + ` FROM table2 WHERE "user"."name" = 'bob'`,
+			expected: `SELECT "col 1", ` + "`table2`.id" + ` FROM table2 WHERE "user"."name" = ?`,
+		},
+		{
+			name:     "bind parameters",
+			query:    "SELECT * FROM users WHERE id = $1 AND name = :name AND age = ? AND org = @org",
+			expected: "SELECT * FROM users WHERE id = $1 AND name = :name AND age = ? AND org = @org",
+		},
+		{
+			name:     "dollar-quoted strings",
+			query:    "SELECT $$secret$$, $tag$it's a $$ secret$tag$",
+			expected: "SELECT ?, ?",
+		},
+		{
+			name:     "casts",
+			query:    "SELECT '2024-01-01'::date, id::text FROM events",
+			expected: "SELECT ?::date, id::text FROM events",
+		},
+		{
+			name:     "unicode identifiers",
+			query:    "SELECT prénom FROM clients WHERE ville = 'Paris'",
+			expected: "SELECT prénom FROM clients WHERE ville = ?",
+		},
+		{
+			name:     "empty query",
+			query:    "",
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ObfuscateQuery(tt.query)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestObfuscateQuery_error(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected error
+	}{
+		{
+			name:     "unterminated string",
+			query:    "SELECT * FROM users WHERE name = 'alice",
+			expected: errUnterminatedString,
+		},
+		{
+			name:     "unterminated quoted identifier",
+			query:    `SELECT "name FROM users`,
+			expected: errUnterminatedString,
+		},
+		{
+			name:     "unterminated dollar-quoted string",
+			query:    "SELECT $tag$secret",
+			expected: errUnterminatedString,
+		},
+		{
+			name:     "unterminated comment",
+			query:    "SELECT 1 /* comment",
+			expected: errUnterminatedComment,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ObfuscateQuery(tt.query)
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
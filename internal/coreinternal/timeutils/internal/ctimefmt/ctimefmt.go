@@ -114,6 +114,85 @@ func Format(format string, t time.Time) (string, error) {
 	return t.Format(native), nil
 }
 
+// localizedExpansions are the directives which contain localized names, along with the
+// directives they are equivalent to, so that their names can be localized.
+var localizedExpansions = map[string]string{
+	"%c": "%a %b %d %H:%M:%S %Y",
+	"%r": "%I:%M:%S %P",
+}
+
+// FormatLocalized returns a textual representation of the time value formatted according
+// to ctime-like format string, like Format, with the names of the months, of the week days
+// and of the periods of the day translated to the language, a BCP 47 language tag.
+// The names which are not translated in the language are kept in English.
+func FormatLocalized(format string, t time.Time, language string) (string, error) {
+	locale, err := lunes.NewDefaultLocale(language)
+	if err != nil {
+		return "", err
+	}
+	format = ctimeRegexp.ReplaceAllStringFunc(format, func(directive string) string {
+		if expansion, ok := localizedExpansions[directive]; ok {
+			return expansion
+		}
+		return directive
+	})
+
+	// The localized names are written as is, since they could contain Go layout elements,
+	// and the text between them is formatted as usual.
+	var sb strings.Builder
+	last := 0
+	for _, index := range ctimeRegexp.FindAllStringIndex(format, -1) {
+		name, ok := localizedName(format[index[0]:index[1]], t, locale)
+		if !ok {
+			continue
+		}
+		native, err := toNative(format[last:index[0]])
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(t.Format(native))
+		sb.WriteString(name)
+		last = index[1]
+	}
+	native, err := toNative(format[last:])
+	if err != nil {
+		return "", err
+	}
+	sb.WriteString(t.Format(native))
+	return sb.String(), nil
+}
+
+// localizedName returns the name of the time value for the directive in the locale, and
+// false if the directive is not a name or if the locale does not translate it.
+func localizedName(directive string, t time.Time, locale lunes.Locale) (string, bool) {
+	var names []string
+	var i int
+	switch directive {
+	case "%a":
+		names, i = locale.ShortDayNames(), int(t.Weekday())
+	case "%A":
+		names, i = locale.LongDayNames(), int(t.Weekday())
+	case "%b", "%h":
+		names, i = locale.ShortMonthNames(), int(t.Month())-1
+	case "%B":
+		names, i = locale.LongMonthNames(), int(t.Month())-1
+	case "%p", "%P":
+		names = locale.DayPeriods()
+		if t.Hour() >= 12 {
+			i = 1
+		}
+	default:
+		return "", false
+	}
+	if i >= len(names) || names[i] == "" {
+		return "", false
+	}
+	if directive == "%P" {
+		return strings.ToLower(names[i]), true
+	}
+	return names[i], true
+}
+
 type ParseFunc func(layout string) (time.Time, error)
 
 // Parse parses a ctime-like formatted string (e.g. "%Y-%m-%d ...")
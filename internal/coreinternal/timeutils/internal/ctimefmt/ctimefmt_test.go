@@ -158,3 +158,55 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatLocalized(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		language string
+		expected string
+	}{
+		{
+			name:     "english",
+			format:   "%A %d %B %Y %I:%M %p",
+			language: "en",
+			expected: "Wednesday 02 January 2019 03:04 PM",
+		},
+		{
+			name:     "portuguese",
+			format:   "%A, %d de %B de %Y",
+			language: "pt-PT",
+			expected: "quarta-feira, 02 de janeiro de 2019",
+		},
+		{
+			name:     "german abbreviations",
+			format:   "%a %d %b %Y",
+			language: "de",
+			expected: "Mi. 02 Jan. 2019",
+		},
+		{
+			name:     "expanded directives",
+			format:   "%c",
+			language: "fr",
+			expected: "mer. janv. 02 15:04:05 2019",
+		},
+		{
+			name:     "escaped directive",
+			format:   "%%B %B",
+			language: "es",
+			expected: "%B enero",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := FormatLocalized(tt.format, dt1, tt.language)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, s)
+		})
+	}
+
+	_, err := FormatLocalized("%B", dt1, "xx-unknown")
+	assert.Error(t, err)
+	_, err = FormatLocalized("%B %!", dt1, "en")
+	assert.Error(t, err)
+}
@@ -22,6 +22,13 @@ func FormatStrptime(format string, t time.Time) (string, error) {
 	return strptime.Format(format, t)
 }
 
+// FormatLocalizedStrptime formats the time like FormatStrptime, with the names of the months,
+// of the week days and of the periods of the day translated to the language, which must be
+// a known CLDR locale.
+func FormatLocalizedStrptime(format string, t time.Time, language string) (string, error) {
+	return strptime.FormatLocalized(format, t, language)
+}
+
 type StrptimeParser struct {
 	format     string
 	lastLayout atomic.Pointer[string]
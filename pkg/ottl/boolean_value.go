@@ -6,6 +6,8 @@ package ottl // import "github.com/open-telemetry/opentelemetry-collector-contri
 import (
 	"context"
 	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
 )
 
 // boolExpr represents a condition in OTTL
@@ -17,6 +19,15 @@ type boolExpr[K any] interface {
 
 type literalBoolExpr[K any] = literalExpr[K, bool]
 
+// boolExprGetter is a Getter returning the result of a boolean expression.
+type boolExprGetter[K any] struct {
+	expr boolExpr[K]
+}
+
+func (g boolExprGetter[K]) Get(ctx context.Context, tCtx K) (any, error) {
+	return g.expr.Eval(ctx, tCtx)
+}
+
 func newAlwaysTrue[K any]() boolExpr[K] {
 	return newLiteralExpr[K](true)
 }
@@ -187,6 +198,152 @@ func (e *comparisonExpr[K]) Eval(ctx context.Context, tCtx K) (bool, error) {
 	return e.comparator.compare(a, b, e.op), nil
 }
 
+func (p *parseContext[K]) newValueTestExpr(test *valueTest) (boolExpr[K], error) {
+	left, err := p.newGetter(test.Left)
+	if err != nil {
+		return nil, err
+	}
+	if test.In != nil {
+		return p.newMembershipExpr(left, test.In)
+	}
+	return p.newRangeExpr(left, test.Between)
+}
+
+func (p *parseContext[K]) newMembershipExpr(left Getter[K], m *membership) (boolExpr[K], error) {
+	list, err := p.newGetter(m.List)
+	if err != nil {
+		return nil, err
+	}
+	expr := &membershipExpr[K]{left: left, list: list, comparator: NewValueComparator(), negated: m.Negation != nil}
+
+	listVal, ok := GetLiteralValue(list)
+	if !ok {
+		return expr, nil
+	}
+	if leftVal, ok := GetLiteralValue(left); ok {
+		found, err := expr.contains(leftVal, listVal)
+		if err != nil {
+			return nil, err
+		}
+		return newLiteralExpr[K](found != expr.negated), nil
+	}
+	// The elements of the literal lists of strings are looked up instead of being compared one by one.
+	if elements, ok := listVal.([]any); ok {
+		strs := make(map[string]struct{}, len(elements))
+		for _, element := range elements {
+			str, ok := element.(string)
+			if !ok {
+				return expr, nil
+			}
+			strs[str] = struct{}{}
+		}
+		expr.strings = strs
+		return expr, nil
+	}
+	if _, ok := listVal.(pcommon.Slice); !ok {
+		return nil, fmt.Errorf("the right side of the 'in' operator must be a list, got %T", listVal)
+	}
+	return expr, nil
+}
+
+type membershipExpr[K any] struct {
+	left, list Getter[K]
+	comparator ValueComparator
+	// strings holds the elements of the list if it is a literal list of strings.
+	strings map[string]struct{}
+	negated bool
+}
+
+func (*membershipExpr[K]) unexported() {}
+
+func (e *membershipExpr[K]) Eval(ctx context.Context, tCtx K) (bool, error) {
+	val, err := e.left.Get(ctx, tCtx)
+	if err != nil {
+		return false, err
+	}
+	if e.strings != nil {
+		// Values which aren't strings are never equal to strings.
+		str, ok := val.(string)
+		_, found := e.strings[str]
+		return (ok && found) != e.negated, nil
+	}
+	list, err := e.list.Get(ctx, tCtx)
+	if err != nil {
+		return false, err
+	}
+	found, err := e.contains(val, list)
+	if err != nil {
+		return false, err
+	}
+	return found != e.negated, nil
+}
+
+// contains returns true if the list has an element equal to the value.
+func (e *membershipExpr[K]) contains(val, list any) (bool, error) {
+	switch l := list.(type) {
+	case []any:
+		for _, element := range l {
+			if e.comparator.Equal(val, element) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case pcommon.Slice:
+		for _, element := range l.All() {
+			if e.comparator.Equal(val, element.AsRaw()) {
+				return true, nil
+			}
+		}
+		return false, nil
+	default:
+		return false, fmt.Errorf("the right side of the 'in' operator must be a list, got %T", list)
+	}
+}
+
+func (p *parseContext[K]) newRangeExpr(val Getter[K], r *valueRange) (boolExpr[K], error) {
+	low, err := p.newGetter(r.Low)
+	if err != nil {
+		return nil, err
+	}
+	high, err := p.newGetter(r.High)
+	if err != nil {
+		return nil, err
+	}
+	expr := &rangeExpr[K]{val: val, low: low, high: high, comparator: NewValueComparator(), negated: r.Negation != nil}
+
+	if _, ok := GetLiteralValue(val); ok && isLiteralGetter(low) && isLiteralGetter(high) {
+		result, _ := expr.Eval(context.Background(), *new(K))
+		return newLiteralExpr[K](result), nil
+	}
+	return expr, nil
+}
+
+// rangeExpr tests whether a value is between the low and high values, inclusively.
+type rangeExpr[K any] struct {
+	val, low, high Getter[K]
+	comparator     ValueComparator
+	negated        bool
+}
+
+func (*rangeExpr[K]) unexported() {}
+
+func (e *rangeExpr[K]) Eval(ctx context.Context, tCtx K) (bool, error) {
+	val, err := e.val.Get(ctx, tCtx)
+	if err != nil {
+		return false, err
+	}
+	low, err := e.low.Get(ctx, tCtx)
+	if err != nil {
+		return false, err
+	}
+	high, err := e.high.Get(ctx, tCtx)
+	if err != nil {
+		return false, err
+	}
+	inRange := e.comparator.GreaterEqual(val, low) && e.comparator.LessEqual(val, high)
+	return inRange != e.negated, nil
+}
+
 func (p *parseContext[K]) newBoolExpr(expr *booleanExpression) (boolExpr[K], error) {
 	if expr == nil {
 		return newAlwaysTrue[K](), nil
@@ -235,6 +392,11 @@ func (p *parseContext[K]) newBooleanValueEvaluator(value *booleanValue) (boolExp
 	var boolExpr boolExpr[K]
 	var err error
 	switch {
+	case value.Test != nil:
+		boolExpr, err = p.newValueTestExpr(value.Test)
+		if err != nil {
+			return nil, err
+		}
 	case value.Comparison != nil:
 		boolExpr, err = p.newComparisonExpr(value.Comparison)
 		if err != nil {
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
 )
@@ -627,6 +628,64 @@ func Test_newBooleanExpressionEvaluator(t *testing.T) {
 	}
 }
 
+func Test_newBooleanExpressionEvaluator_constantConverter(t *testing.T) {
+	functions := defaultFunctionsForTests()
+	functions["True"] = NewFactory("True", nil, func(FunctionContext, Arguments) (ExprFunc[any], error) {
+		return True()
+	}, WithPureFunction[any]())
+
+	p, _ := NewParser(
+		functions,
+		testParsePath[any],
+		componenttest.NewNopTelemetrySettings(),
+	)
+
+	tests := []struct {
+		name    string
+		input   string
+		want    bool
+		literal bool
+	}{
+		{
+			name:    "pure converter",
+			input:   `True()`,
+			want:    true,
+			literal: true,
+		},
+		{
+			name:    "negated pure converter",
+			input:   `not True() and name == "foo"`,
+			want:    false,
+			literal: true,
+		},
+		{
+			name:    "pure converter short-circuits or",
+			input:   `name == "bar" or True()`,
+			want:    true,
+			literal: true,
+		},
+		{
+			name:    "pure converter is removed from and",
+			input:   `True() and name == "foo"`,
+			want:    true,
+			literal: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := conditionParser().ParseString("", tt.input)
+			require.NoError(t, err)
+			evaluator, err := p.newParseContext().newBoolExpr(parsed)
+			require.NoError(t, err)
+			_, isLiteral := evaluator.(*literalBoolExpr[any])
+			assert.Equal(t, tt.literal, isLiteral)
+			result, err := evaluator.Eval(t.Context(), "foo")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
 func Test_newBooleanExpressionEvaluator_invalid(t *testing.T) {
 	functions := map[string]Factory[any]{"Hello": createFactory("Hello", &struct{}{}, hello)}
 
@@ -665,3 +724,156 @@ func Test_newBooleanExpressionEvaluator_invalid(t *testing.T) {
 		})
 	}
 }
+
+func Test_newBooleanExpressionEvaluator_membershipAndRange(t *testing.T) {
+	p, _ := NewParser(
+		defaultFunctionsForTests(),
+		testParsePath[any],
+		componenttest.NewNopTelemetrySettings(),
+	)
+
+	tests := []struct {
+		name    string
+		input   string
+		tCtx    any
+		want    bool
+		literal bool
+	}{
+		{
+			name:  "in string list",
+			input: `name in ["foo", "bar"]`,
+			tCtx:  "bar",
+			want:  true,
+		},
+		{
+			name:  "in string list without the value",
+			input: `name in ["foo", "bar"]`,
+			tCtx:  "baz",
+			want:  false,
+		},
+		{
+			name:  "in string list with another type",
+			input: `name in ["1", "2"]`,
+			tCtx:  int64(1),
+			want:  false,
+		},
+		{
+			name:  "not in string list",
+			input: `name not in ["foo", "bar"]`,
+			tCtx:  "baz",
+			want:  true,
+		},
+		{
+			name:  "in numeric list",
+			input: `name in [1, 2.5, nil]`,
+			tCtx:  2.5,
+			want:  true,
+		},
+		{
+			name:  "in numeric list compares ints and floats",
+			input: `name in [1.0, 2.0]`,
+			tCtx:  int64(2),
+			want:  true,
+		},
+		{
+			name:  "in slice path",
+			input: `"foo" in name`,
+			tCtx: func() pcommon.Slice {
+				s := pcommon.NewSlice()
+				s.AppendEmpty().SetStr("bar")
+				s.AppendEmpty().SetStr("foo")
+				return s
+			}(),
+			want: true,
+		},
+		{
+			name:  "in list path",
+			input: `"foo" in name`,
+			tCtx:  []any{"bar"},
+			want:  false,
+		},
+		{
+			name:    "in literal list",
+			input:   `"foo" in ["foo", "bar"]`,
+			want:    true,
+			literal: true,
+		},
+		{
+			name:    "not in literal list",
+			input:   `1 not in [1, 2]`,
+			want:    false,
+			literal: true,
+		},
+		{
+			name:  "in list combined with other conditions",
+			input: `name in ["foo"] and name != "bar" or name in ["baz"]`,
+			tCtx:  "baz",
+			want:  true,
+		},
+		{
+			name:  "between",
+			input: `name between 1 and 5`,
+			tCtx:  int64(5),
+			want:  true,
+		},
+		{
+			name:  "between floats",
+			input: `name between 1.5 and 2.5`,
+			tCtx:  int64(1),
+			want:  false,
+		},
+		{
+			name:  "not between",
+			input: `name not between 1 and 5`,
+			tCtx:  int64(6),
+			want:  true,
+		},
+		{
+			name:  "between of another type",
+			input: `name between 1 and 5`,
+			tCtx:  "3",
+			want:  false,
+		},
+		{
+			name:  "between followed by and",
+			input: `name between 1 and 5 and name != 3`,
+			tCtx:  int64(3),
+			want:  false,
+		},
+		{
+			name:    "between literals",
+			input:   `3 between 1 + 1 and 5`,
+			want:    true,
+			literal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := conditionParser().ParseString("", tt.input)
+			require.NoError(t, err)
+			evaluator, err := p.newParseContext().newBoolExpr(parsed)
+			require.NoError(t, err)
+			_, isLiteral := evaluator.(*literalBoolExpr[any])
+			assert.Equal(t, tt.literal, isLiteral)
+			result, err := evaluator.Eval(t.Context(), tt.tCtx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
+func Test_newBooleanExpressionEvaluator_membership_notAList(t *testing.T) {
+	p, _ := NewParser(
+		defaultFunctionsForTests(),
+		testParsePath[any],
+		componenttest.NewNopTelemetrySettings(),
+	)
+
+	_, err := p.ParseCondition(`name in "foo"`)
+	assert.ErrorContains(t, err, `the right side of the 'in' operator must be a list, got string`)
+
+	condition, err := p.ParseCondition(`"foo" in name`)
+	require.NoError(t, err)
+	_, err = condition.Eval(t.Context(), "foo")
+	assert.ErrorContains(t, err, `the right side of the 'in' operator must be a list, got string`)
+}
@@ -0,0 +1,214 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	containerlist "container/list"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// CacheConfig configures a Cache.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type CacheConfig struct {
+	// TTL is the duration after which an entry expires once it was last set.
+	// Entries never expire if it is zero.
+	TTL time.Duration `mapstructure:"ttl"`
+	// MaxEntries is the maximum number of entries of the cache. Once it is
+	// reached, the least recently used entry is evicted when a new one is set.
+	// The number of entries is not limited if it is zero.
+	MaxEntries int `mapstructure:"max_entries"`
+}
+
+// Validate checks that the configuration is valid.
+func (c CacheConfig) Validate() error {
+	var errs error
+	if c.TTL < 0 {
+		errs = errors.Join(errs, errors.New("ttl cannot be negative"))
+	}
+	if c.MaxEntries < 0 {
+		errs = errors.Join(errs, errors.New("max_entries cannot be negative"))
+	}
+	return errs
+}
+
+// Cache is a cache of values that can be shared by TransformContexts, so the values
+// set by statements are available to the statements executed on other TransformContexts,
+// including the ones of other batches. It is safe for concurrent use.
+//
+// The values are copied when they are read or set, so they cannot be modified
+// outside the cache.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type Cache struct {
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*containerlist.Element
+	// lru holds the entries ordered from the most to the least recently used.
+	lru *containerlist.List
+}
+
+type cacheEntry struct {
+	key       string
+	value     pcommon.Value
+	expiresAt time.Time
+}
+
+// NewCache creates a Cache with the given configuration.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func NewCache(cfg CacheConfig) *Cache {
+	return &Cache{
+		ttl:        cfg.TTL,
+		maxEntries: cfg.MaxEntries,
+		now:        time.Now,
+		entries:    map[string]*containerlist.Element{},
+		lru:        containerlist.New(),
+	}
+}
+
+// Get returns a copy of the value of the key, and false if the cache has no value
+// for it or if it expired.
+func (c *Cache) Get(key string) (pcommon.Value, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.getElement(key, c.now())
+	if !ok {
+		return pcommon.Value{}, false
+	}
+	c.lru.MoveToFront(elem)
+	val := pcommon.NewValueEmpty()
+	elem.Value.(*cacheEntry).value.CopyTo(val)
+	return val, true
+}
+
+// Update sets the value of the key to the value modified by update. The value
+// passed to update is a copy of the current value of the key, or an empty value
+// if the cache has no value for it. The value of the key is not modified if
+// update returns an error.
+func (c *Cache) Update(key string, update func(pcommon.Value) error) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	val := pcommon.NewValueEmpty()
+	elem, ok := c.getElement(key, now)
+	if ok {
+		elem.Value.(*cacheEntry).value.CopyTo(val)
+	}
+	if err := update(val); err != nil {
+		return err
+	}
+	c.set(key, val, now)
+	return nil
+}
+
+// Delete removes the value of the key.
+func (c *Cache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+}
+
+// Len returns the number of entries of the cache, including the expired
+// entries which were not removed yet.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// AsMap returns a copy of the entries of the cache which did not expire.
+func (c *Cache) AsMap() pcommon.Map {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	m := pcommon.NewMap()
+	m.EnsureCapacity(c.lru.Len())
+	for elem := c.lru.Front(); elem != nil; {
+		next := elem.Next()
+		entry := elem.Value.(*cacheEntry)
+		if c.expired(entry, now) {
+			c.remove(elem)
+		} else {
+			entry.value.CopyTo(m.PutEmpty(entry.key))
+		}
+		elem = next
+	}
+	return m
+}
+
+// Replace replaces the entries of the cache with the ones of the map. The entries
+// whose value did not change keep their expiration time.
+func (c *Cache) Replace(m pcommon.Map) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	for key, elem := range c.entries {
+		if _, ok := m.Get(key); !ok {
+			c.remove(elem)
+		}
+	}
+	for key, val := range m.All() {
+		if elem, ok := c.getElement(key, now); ok && elem.Value.(*cacheEntry).value.Equal(val) {
+			continue
+		}
+		copied := pcommon.NewValueEmpty()
+		val.CopyTo(copied)
+		c.set(key, copied, now)
+	}
+}
+
+// getElement returns the element of the key, removing it if it expired.
+func (c *Cache) getElement(key string, now time.Time) (*containerlist.Element, bool) {
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if c.expired(elem.Value.(*cacheEntry), now) {
+		c.remove(elem)
+		return nil, false
+	}
+	return elem, true
+}
+
+func (c *Cache) set(key string, val pcommon.Value, now time.Time) {
+	var expiresAt time.Time
+	if c.ttl > 0 {
+		expiresAt = now.Add(c.ttl)
+	}
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*cacheEntry)
+		entry.value = val
+		entry.expiresAt = expiresAt
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, value: val, expiresAt: expiresAt})
+	for c.maxEntries > 0 && c.lru.Len() > c.maxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+func (c *Cache) remove(elem *containerlist.Element) {
+	delete(c.entries, elem.Value.(*cacheEntry).key)
+	c.lru.Remove(elem)
+}
+
+func (*Cache) expired(entry *cacheEntry, now time.Time) bool {
+	return !entry.expiresAt.IsZero() && !now.Before(entry.expiresAt)
+}
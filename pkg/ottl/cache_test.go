@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func newTestCache(cfg CacheConfig) (*Cache, *time.Time) {
+	now := time.Unix(1_700_000_000, 0)
+	c := NewCache(cfg)
+	c.now = func() time.Time {
+		return now
+	}
+	return c, &now
+}
+
+func setCacheStr(t *testing.T, c *Cache, key, val string) {
+	require.NoError(t, c.Update(key, func(v pcommon.Value) error {
+		v.SetStr(val)
+		return nil
+	}))
+}
+
+func Test_CacheConfig_Validate(t *testing.T) {
+	assert.NoError(t, CacheConfig{TTL: time.Minute, MaxEntries: 10}.Validate())
+	assert.EqualError(t, CacheConfig{TTL: -time.Minute, MaxEntries: -1}.Validate(), "ttl cannot be negative\nmax_entries cannot be negative")
+}
+
+func Test_Cache_GetUpdate(t *testing.T) {
+	c, _ := newTestCache(CacheConfig{})
+
+	_, ok := c.Get("key")
+	assert.False(t, ok)
+
+	require.NoError(t, c.Update("key", func(v pcommon.Value) error {
+		assert.Equal(t, pcommon.ValueTypeEmpty, v.Type())
+		v.SetEmptyMap().PutStr("nested", "value")
+		return nil
+	}))
+
+	val, ok := c.Get("key")
+	require.True(t, ok)
+	assert.Equal(t, map[string]any{"nested": "value"}, val.Map().AsRaw())
+
+	// The values are copied
+	val.Map().PutStr("nested", "changed")
+	val, ok = c.Get("key")
+	require.True(t, ok)
+	assert.Equal(t, map[string]any{"nested": "value"}, val.Map().AsRaw())
+
+	// The value is not modified if the update fails
+	err := c.Update("key", func(v pcommon.Value) error {
+		v.Map().PutStr("nested", "changed")
+		return errors.New("failed")
+	})
+	assert.EqualError(t, err, "failed")
+	val, ok = c.Get("key")
+	require.True(t, ok)
+	assert.Equal(t, map[string]any{"nested": "value"}, val.Map().AsRaw())
+
+	c.Delete("key")
+	_, ok = c.Get("key")
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.Len())
+}
+
+func Test_Cache_TTL(t *testing.T) {
+	c, now := newTestCache(CacheConfig{TTL: time.Minute})
+
+	setCacheStr(t, c, "key1", "value1")
+	*now = now.Add(30 * time.Second)
+	setCacheStr(t, c, "key2", "value2")
+
+	// Reading an entry does not extend its ttl
+	val, ok := c.Get("key1")
+	require.True(t, ok)
+	assert.Equal(t, "value1", val.Str())
+
+	*now = now.Add(30 * time.Second)
+	_, ok = c.Get("key1")
+	assert.False(t, ok)
+	assert.Equal(t, map[string]any{"key2": "value2"}, c.AsMap().AsRaw())
+
+	// Setting an entry extends its ttl
+	*now = now.Add(20 * time.Second)
+	setCacheStr(t, c, "key2", "value2")
+	*now = now.Add(50 * time.Second)
+	val, ok = c.Get("key2")
+	require.True(t, ok)
+	assert.Equal(t, "value2", val.Str())
+}
+
+func Test_Cache_MaxEntries(t *testing.T) {
+	c, _ := newTestCache(CacheConfig{MaxEntries: 2})
+
+	setCacheStr(t, c, "key1", "value1")
+	setCacheStr(t, c, "key2", "value2")
+	// key1 is now the most recently used entry
+	_, ok := c.Get("key1")
+	require.True(t, ok)
+
+	setCacheStr(t, c, "key3", "value3")
+	assert.Equal(t, 2, c.Len())
+	assert.Equal(t, map[string]any{"key1": "value1", "key3": "value3"}, c.AsMap().AsRaw())
+}
+
+func Test_Cache_Replace(t *testing.T) {
+	c, now := newTestCache(CacheConfig{TTL: time.Minute})
+
+	setCacheStr(t, c, "key1", "value1")
+	setCacheStr(t, c, "key2", "value2")
+	*now = now.Add(30 * time.Second)
+
+	m := c.AsMap()
+	m.Remove("key2")
+	m.PutStr("key3", "value3")
+	c.Replace(m)
+	assert.Equal(t, map[string]any{"key1": "value1", "key3": "value3"}, c.AsMap().AsRaw())
+
+	// key1 did not change and kept its expiration time
+	*now = now.Add(30 * time.Second)
+	assert.Equal(t, map[string]any{"key3": "value3"}, c.AsMap().AsRaw())
+}
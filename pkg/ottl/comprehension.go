@@ -0,0 +1,241 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/ottlcommon"
+)
+
+// comprehension iterates over the elements of a list or map, binding them to local identifiers.
+// With a single identifier, it is bound to the elements of lists and to the keys of maps.
+// With two identifiers, they are bound to the index and element of lists, and to the key and
+// value of maps.
+type comprehension[K any] struct {
+	identifiers []LocalIdentifierDecl
+	source      Getter[K]
+	condition   boolExpr[K]
+}
+
+func (p *parseContext[K]) newComprehension(loop *comprehensionLoop, build func() error) (*comprehension[K], error) {
+	source, err := p.newGetter(loop.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers := make([]LocalIdentifierDecl, len(loop.Identifiers))
+	frame := make(localScopeFrame, len(loop.Identifiers))
+	for i, identifier := range loop.Identifiers {
+		if !identifier.IsBlank() {
+			if _, exists := frame[identifier.Name()]; exists {
+				return nil, fmt.Errorf("duplicate local identifier %q", identifier.Name())
+			}
+			frame[identifier.Name()] = struct{}{}
+		}
+		identifiers[i] = &identifier
+	}
+
+	c := &comprehension[K]{
+		identifiers: identifiers,
+		source:      source,
+	}
+	err = p.withLocalScope(frame, func() error {
+		if err := build(); err != nil {
+			return err
+		}
+		if loop.Condition != nil {
+			condition, err := p.newBoolExpr(loop.Condition)
+			if err != nil {
+				return err
+			}
+			c.condition = condition
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// forEach calls fn for each element of the source matching the condition, with a context
+// holding the bindings of the element.
+func (c *comprehension[K]) forEach(ctx context.Context, tCtx K, fn func(context.Context) error) error {
+	source, err := c.source.Get(ctx, tCtx)
+	if err != nil {
+		return err
+	}
+
+	activation := &localActivation{bindings: make(map[string]any, len(c.identifiers))}
+	ctx = pushLocalActivation(ctx, activation)
+	return iterateComprehensionSource(source, func(key, val any) error {
+		c.bind(activation, key, val)
+		if c.condition != nil {
+			matches, err := c.condition.Eval(ctx, tCtx)
+			if err != nil {
+				return err
+			}
+			if !matches {
+				return nil
+			}
+		}
+		return fn(ctx)
+	})
+}
+
+func (c *comprehension[K]) bind(activation *localActivation, key, val any) {
+	if len(c.identifiers) == 1 {
+		if identifier := c.identifiers[0]; !identifier.IsBlank() {
+			// Only the keys of maps are strings, the keys of lists are their indexes.
+			if _, isMapKey := key.(string); isMapKey {
+				activation.bindings[identifier.Name()] = key
+			} else {
+				activation.bindings[identifier.Name()] = val
+			}
+		}
+		return
+	}
+	if !c.identifiers[0].IsBlank() {
+		activation.bindings[c.identifiers[0].Name()] = key
+	}
+	if !c.identifiers[1].IsBlank() {
+		activation.bindings[c.identifiers[1].Name()] = val
+	}
+}
+
+// iterateComprehensionSource calls fn with the index and element of each element of lists,
+// and with the key and value of each entry of maps. Nil sources have no elements.
+func iterateComprehensionSource(source any, fn func(key, val any) error) error {
+	switch s := source.(type) {
+	case nil:
+		return nil
+	case pcommon.Slice:
+		for i, v := range s.All() {
+			if err := fn(int64(i), ottlcommon.GetValue(v)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case pcommon.Map:
+		for k, v := range s.All() {
+			if err := fn(k, ottlcommon.GetValue(v)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case map[string]any:
+		// The keys are sorted so the results do not depend on the map iteration order.
+		for _, k := range slices.Sorted(maps.Keys(s)) {
+			if err := fn(k, s[k]); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []any:
+		return iterateSlice(s, fn)
+	case []string:
+		return iterateSlice(s, fn)
+	case []int64:
+		return iterateSlice(s, fn)
+	case []float64:
+		return iterateSlice(s, fn)
+	case []bool:
+		return iterateSlice(s, fn)
+	default:
+		return fmt.Errorf("comprehensions can only iterate over lists and maps, got %T", source)
+	}
+}
+
+func iterateSlice[T any](s []T, fn func(key, val any) error) error {
+	for i, v := range s {
+		if err := fn(int64(i), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type listComprehensionGetter[K any] struct {
+	comprehension *comprehension[K]
+	value         Getter[K]
+}
+
+func (p *parseContext[K]) newListComprehensionGetter(l *listComprehension) (Getter[K], error) {
+	g := &listComprehensionGetter[K]{}
+	c, err := p.newComprehension(&l.Loop, func() error {
+		value, err := p.newGetter(l.Value)
+		g.value = value
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	g.comprehension = c
+	return g, nil
+}
+
+func (g *listComprehensionGetter[K]) Get(ctx context.Context, tCtx K) (any, error) {
+	result := []any{}
+	err := g.comprehension.forEach(ctx, tCtx, func(ctx context.Context) error {
+		val, err := g.value.Get(ctx, tCtx)
+		if err != nil {
+			return err
+		}
+		result = append(result, val)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+type mapComprehensionGetter[K any] struct {
+	comprehension *comprehension[K]
+	key           StringGetter[K]
+	value         Getter[K]
+}
+
+func (p *parseContext[K]) newMapComprehensionGetter(m *mapComprehension) (Getter[K], error) {
+	g := &mapComprehensionGetter[K]{}
+	c, err := p.newComprehension(&m.Loop, func() error {
+		key, err := p.newGetter(m.Key)
+		if err != nil {
+			return err
+		}
+		g.key = StandardStringGetter[K]{Getter: key.Get}
+		g.value, err = p.newGetter(m.Value)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	g.comprehension = c
+	return g, nil
+}
+
+func (g *mapComprehensionGetter[K]) Get(ctx context.Context, tCtx K) (any, error) {
+	result := pcommon.NewMap()
+	err := g.comprehension.forEach(ctx, tCtx, func(ctx context.Context) error {
+		key, err := g.key.Get(ctx, tCtx)
+		if err != nil {
+			return fmt.Errorf("invalid map comprehension key: %w", err)
+		}
+		val, err := g.value.Get(ctx, tCtx)
+		if err != nil {
+			return err
+		}
+		return putMapValue(result, key, val)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
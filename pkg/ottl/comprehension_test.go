@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_comprehension(t *testing.T) {
+	attributes := pcommon.NewMap()
+	require.NoError(t, attributes.FromRaw(map[string]any{
+		"b": int64(2),
+		"a": int64(1),
+	}))
+	events := pcommon.NewSlice()
+	require.NoError(t, events.FromRaw([]any{
+		map[string]any{"name": "checkout", "tags": []any{"x", "y"}},
+		map[string]any{"name": "cart", "tags": []any{"z"}},
+	}))
+
+	tests := []struct {
+		name       string
+		expression string
+		tCtx       any
+		expected   func() any
+	}{
+		{
+			name:       "list elements",
+			expression: `[x for x in attributes]`,
+			tCtx:       []any{"foo", int64(1), nil},
+			expected: func() any {
+				return []any{"foo", int64(1), nil}
+			},
+		},
+		{
+			name:       "list indexes and elements",
+			expression: `[i for i, x in attributes where x != "skip"]`,
+			tCtx:       []string{"foo", "skip", "bar"},
+			expected: func() any {
+				return []any{int64(0), int64(2)}
+			},
+		},
+		{
+			name:       "pcommon slice",
+			expression: `[x["name"] for x in attributes]`,
+			tCtx:       events,
+			expected: func() any {
+				return []any{"checkout", "cart"}
+			},
+		},
+		{
+			name:       "map keys",
+			expression: `[k for k in attributes]`,
+			tCtx:       map[string]any{"b": 2, "a": 1, "c": 3},
+			expected: func() any {
+				return []any{"a", "b", "c"}
+			},
+		},
+		{
+			name:       "map values with blank identifier",
+			expression: `[v for _, v in attributes where v > 1]`,
+			tCtx:       attributes,
+			expected: func() any {
+				return []any{int64(2)}
+			},
+		},
+		{
+			name:       "nested comprehensions",
+			expression: `[[x["name"] for _ in x["tags"]] for x in attributes]`,
+			tCtx:       events,
+			expected: func() any {
+				return []any{[]any{"checkout", "checkout"}, []any{"cart"}}
+			},
+		},
+		{
+			name:       "nil source",
+			expression: `[x for x in attributes]`,
+			tCtx:       nil,
+			expected: func() any {
+				return []any{}
+			},
+		},
+		{
+			name:       "map from list",
+			expression: `{x["name"]: x["tags"] for x in attributes}`,
+			tCtx:       events,
+			expected: func() any {
+				// The keys are inserted in the order of the list.
+				m := pcommon.NewMap()
+				require.NoError(t, m.PutEmptySlice("checkout").FromRaw([]any{"x", "y"}))
+				require.NoError(t, m.PutEmptySlice("cart").FromRaw([]any{"z"}))
+				return m
+			},
+		},
+		{
+			name:       "empty map",
+			expression: `{k: v for k, v in attributes where v > 2}`,
+			tCtx:       attributes,
+			expected: func() any {
+				return pcommon.NewMap()
+			},
+		},
+	}
+
+	p, _ := NewParser(
+		CreateFactoryMap[any](),
+		testParsePath[any],
+		componenttest.NewNopTelemetrySettings(),
+		WithEnumParser[any](testParseEnum),
+	)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := p.ParseValueExpression(tt.expression)
+			require.NoError(t, err)
+
+			v, err := parsed.Eval(t.Context(), tt.tCtx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected(), v)
+		})
+	}
+}
+
+func Test_comprehension_error(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		tCtx       any
+		wantErr    string
+	}{
+		{
+			name:       "not iterable",
+			expression: `[x for x in attributes]`,
+			tCtx:       "foo",
+			wantErr:    "comprehensions can only iterate over lists and maps, got string",
+		},
+		{
+			name:       "map key not a string",
+			expression: `{i: x for i, x in attributes}`,
+			tCtx:       []any{"foo"},
+			wantErr:    "invalid map comprehension key",
+		},
+	}
+
+	p, _ := NewParser(
+		CreateFactoryMap[any](),
+		testParsePath[any],
+		componenttest.NewNopTelemetrySettings(),
+		WithEnumParser[any](testParseEnum),
+	)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := p.ParseValueExpression(tt.expression)
+			require.NoError(t, err)
+
+			_, err = parsed.Eval(t.Context(), tt.tCtx)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_comprehension_parseError(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		wantErr    string
+	}{
+		{
+			name:       "duplicate identifier",
+			expression: `[x for x, x in attributes]`,
+			wantErr:    `duplicate local identifier "x"`,
+		},
+		{
+			name:       "identifier used outside the comprehension",
+			expression: `[[x for x in attributes], x]`,
+			wantErr:    "bad path x",
+		},
+		{
+			name:       "identifier used in the source",
+			expression: `[x for x in x]`,
+			wantErr:    "bad path x",
+		},
+	}
+
+	p, _ := NewParser(
+		CreateFactoryMap[any](),
+		testParsePath[any],
+		componenttest.NewNopTelemetrySettings(),
+		WithEnumParser[any](testParseEnum),
+	)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := p.ParseValueExpression(tt.expression)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+const (
+	scopeName = "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+	conditionEvaluationsMetric = "otelcol.ottl.condition.evaluations"
+)
+
+// conditionResult is the result of the evaluation of a condition of a ConditionSequence.
+type conditionResult int
+
+const (
+	conditionMatch conditionResult = iota
+	conditionNoMatch
+	conditionError
+	conditionResults
+)
+
+func (r conditionResult) String() string {
+	switch r {
+	case conditionMatch:
+		return "match"
+	case conditionNoMatch:
+		return "no_match"
+	default:
+		return "error"
+	}
+}
+
+// conditionStatistics counts the evaluations of the conditions of a ConditionSequence.
+type conditionStatistics struct {
+	evaluations metric.Int64Counter
+	// options are the options recording the attributes of each condition, indexed by the
+	// index of the condition, then by its result and whether it short-circuited the sequence.
+	options [][conditionResults][2]metric.AddOption
+}
+
+func newConditionStatistics[K any](conditions []*Condition[K], telemetrySettings component.TelemetrySettings) (*conditionStatistics, error) {
+	evaluations, err := telemetrySettings.MeterProvider.Meter(scopeName).Int64Counter(
+		conditionEvaluationsMetric,
+		metric.WithDescription("Number of evaluations of the conditions of the OTTL condition sequences, by condition and result."),
+		metric.WithUnit("{evaluation}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	// The attribute sets are built once, so recording an evaluation does not allocate.
+	options := make([][conditionResults][2]metric.AddOption, len(conditions))
+	for i, condition := range conditions {
+		for result := range conditionResults {
+			for _, shortCircuit := range []bool{false, true} {
+				options[i][result][boolIndex(shortCircuit)] = metric.WithAttributeSet(attribute.NewSet(
+					attribute.String("ottl.condition", condition.origText),
+					attribute.Int("ottl.condition.index", i),
+					attribute.String("ottl.condition.result", result.String()),
+					attribute.Bool("ottl.condition.short_circuit", shortCircuit),
+				))
+			}
+		}
+	}
+	return &conditionStatistics{evaluations: evaluations, options: options}, nil
+}
+
+// record counts an evaluation of the condition at the index. shortCircuit tells whether its
+// result ended the evaluation of the sequence before the evaluation of the next conditions.
+func (s *conditionStatistics) record(ctx context.Context, index int, result conditionResult, shortCircuit bool) {
+	if s == nil {
+		return
+	}
+	s.evaluations.Add(ctx, 1, s.options[index][result][boolIndex(shortCircuit)])
+}
+
+func boolIndex(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// WithConditionSequenceStatistics enables the counting of the evaluations of each condition of the
+// ConditionSequence, which are reported with the MeterProvider of its component.TelemetrySettings by
+// the otelcol.ottl.condition.evaluations counter. Its attributes are the text of the condition, its
+// index, its result (match, no_match or error), and whether the result short-circuited the sequence,
+// skipping the evaluation of the next conditions. They tell how often each condition matches and is
+// evaluated, to order the conditions so that the most decisive ones are evaluated first.
+//
+// Experimental: *NOTE* this option is subject to change or removal in the future.
+func WithConditionSequenceStatistics[K any]() ConditionSequenceOption[K] {
+	return func(c *ConditionSequence[K]) {
+		c.statistics = true
+	}
+}
+
+// initStatistics creates the statistics of the ConditionSequence, if enabled. The sequence is
+// still usable without statistics if the counter cannot be created.
+func (c *ConditionSequence[K]) initStatistics() {
+	if !c.statistics {
+		return
+	}
+	stats, err := newConditionStatistics(c.conditions, c.telemetrySettings)
+	if err != nil {
+		c.telemetrySettings.Logger.Warn("failed to create the statistics of the condition sequence", zap.Error(err))
+		return
+	}
+	c.stats = stats
+}
@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// conditionEvaluations returns the counts of the evaluations of the conditions, keyed by
+// "<index> <condition> <result> <short_circuit>".
+func conditionEvaluations(t *testing.T, tel *componenttest.Telemetry) map[string]int64 {
+	m, err := tel.GetMetric(conditionEvaluationsMetric)
+	require.NoError(t, err)
+	assert.Equal(t, "{evaluation}", m.Unit)
+	sum, ok := m.Data.(metricdata.Sum[int64])
+	require.True(t, ok)
+	counts := map[string]int64{}
+	for _, dp := range sum.DataPoints {
+		index, _ := dp.Attributes.Value("ottl.condition.index")
+		condition, _ := dp.Attributes.Value("ottl.condition")
+		result, _ := dp.Attributes.Value("ottl.condition.result")
+		shortCircuit, _ := dp.Attributes.Value("ottl.condition.short_circuit")
+		counts[fmt.Sprintf("%d %s %s %t", index.AsInt64(), condition.AsString(), result.AsString(), shortCircuit.AsBool())] = dp.Value
+	}
+	return counts
+}
+
+func newStatisticsTestConditions(exprs ...boolExpr[any]) []*Condition[any] {
+	conditions := make([]*Condition[any], 0, len(exprs))
+	for i, expr := range exprs {
+		conditions = append(conditions, &Condition[any]{condition: expr, origText: fmt.Sprintf("condition%d", i)})
+	}
+	return conditions
+}
+
+func Test_ConditionSequence_statistics(t *testing.T) {
+	tests := []struct {
+		name      string
+		exprs     []boolExpr[any]
+		logicOp   LogicOperation
+		errorMode ErrorMode
+		expected  map[string]int64
+	}{
+		{
+			name:      "OR short-circuits on match",
+			exprs:     []boolExpr[any]{newAlwaysFalse[any](), newAlwaysTrue[any](), newAlwaysTrue[any]()},
+			logicOp:   Or,
+			errorMode: PropagateError,
+			expected: map[string]int64{
+				"0 condition0 no_match false": 2,
+				"1 condition1 match true":     2,
+			},
+		},
+		{
+			name:      "OR without match",
+			exprs:     []boolExpr[any]{newAlwaysFalse[any](), newAlwaysFalse[any]()},
+			logicOp:   Or,
+			errorMode: PropagateError,
+			expected: map[string]int64{
+				"0 condition0 no_match false": 2,
+				"1 condition1 no_match false": 2,
+			},
+		},
+		{
+			name:      "AND short-circuits on no match",
+			exprs:     []boolExpr[any]{newAlwaysTrue[any](), newAlwaysFalse[any](), newAlwaysTrue[any]()},
+			logicOp:   And,
+			errorMode: PropagateError,
+			expected: map[string]int64{
+				"0 condition0 match true":     0,
+				"0 condition0 match false":    2,
+				"1 condition1 no_match true":  2,
+				"2 condition2 match false":    0,
+				"2 condition2 no_match false": 0,
+			},
+		},
+		{
+			name:      "AND matching the last condition",
+			exprs:     []boolExpr[any]{newAlwaysTrue[any](), newAlwaysTrue[any]()},
+			logicOp:   And,
+			errorMode: PropagateError,
+			expected: map[string]int64{
+				"0 condition0 match false": 2,
+				"1 condition1 match false": 2,
+			},
+		},
+		{
+			name:      "propagated error short-circuits",
+			exprs:     []boolExpr[any]{newErrExpr[any](errors.New("test")), newAlwaysTrue[any]()},
+			logicOp:   Or,
+			errorMode: PropagateError,
+			expected: map[string]int64{
+				"0 condition0 error true": 2,
+			},
+		},
+		{
+			name:      "ignored error",
+			exprs:     []boolExpr[any]{newErrExpr[any](errors.New("test")), newAlwaysTrue[any]()},
+			logicOp:   Or,
+			errorMode: SilentError,
+			expected: map[string]int64{
+				"0 condition0 error false": 2,
+				"1 condition1 match false": 2,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tel := componenttest.NewTelemetry()
+			t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) }) //nolint:usetesting
+
+			sequence := NewConditionSequence(
+				newStatisticsTestConditions(tt.exprs...),
+				tel.NewTelemetrySettings(),
+				WithLogicOperation[any](tt.logicOp),
+				WithConditionSequenceErrorMode[any](tt.errorMode),
+				WithConditionSequenceStatistics[any](),
+			)
+			for range 2 {
+				_, _ = sequence.Eval(t.Context(), nil)
+			}
+
+			counts := conditionEvaluations(t, tel)
+			for key, expected := range tt.expected {
+				assert.Equal(t, expected, counts[key], key)
+			}
+			var total, expectedTotal int64
+			for _, count := range counts {
+				total += count
+			}
+			for _, count := range tt.expected {
+				expectedTotal += count
+			}
+			assert.Equal(t, expectedTotal, total)
+		})
+	}
+}
+
+func Test_ConditionSequence_statisticsDisabled(t *testing.T) {
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) }) //nolint:usetesting
+
+	sequence := NewConditionSequence(newStatisticsTestConditions(newAlwaysTrue[any]()), tel.NewTelemetrySettings())
+	match, err := sequence.Eval(t.Context(), nil)
+	require.NoError(t, err)
+	assert.True(t, match)
+
+	_, err = tel.GetMetric(conditionEvaluationsMetric)
+	assert.Error(t, err)
+}
@@ -22,12 +22,21 @@ var defaultContextInferPriority = []string{
 	"metric",
 	"spanevent",
 	"span",
+	"trace",
 	"profile",
 	"scope",
 	"instrumentation_scope",
 	"resource",
 }
 
+// globalContexts are the contexts whose paths are available in all the other contexts, such as
+// the `otelcol` context. Unless they are candidates themselves, their paths do not hint at the
+// context of the statements, which is inferred from the other paths, or is the candidate with the
+// lowest priority if they only use global paths, so they are executed as few times as possible.
+var globalContexts = []string{
+	"otelcol",
+}
+
 // contextInferrer is an interface used to infer the OTTL context from statements.
 type contextInferrer interface {
 	// inferFromStatements returns the OTTL context inferred from the given statements.
@@ -45,6 +54,7 @@ type priorityContextInferrer struct {
 	telemetrySettings component.TelemetrySettings
 	contextPriority   map[string]int
 	contextCandidate  map[string]*priorityContextInferrerCandidate
+	pathNamespaces    map[string]struct{}
 }
 
 type priorityContextInferrerCandidate struct {
@@ -91,6 +101,14 @@ func withContextInferrerPriorities(priorities []string) priorityContextInferrerO
 	}
 }
 
+// withContextInferrerPathNamespaces sets the path namespaces registered with WithPathNamespace,
+// whose paths are available in all contexts and are inferred like the globalContexts ones.
+func withContextInferrerPathNamespaces(namespaces map[string]struct{}) priorityContextInferrerOption {
+	return func(c *priorityContextInferrer) {
+		c.pathNamespaces = namespaces
+	}
+}
+
 func (s *priorityContextInferrer) inferFromConditions(conditions []string) (inferredContext string, err error) {
 	return s.infer(nil, conditions, nil)
 }
@@ -148,9 +166,14 @@ func (s *priorityContextInferrer) inferFromHints(hints []priorityContextInferrer
 	requiredEnums := map[enumSymbol]struct{}{}
 
 	var inferredContextPriority int
+	hasGlobalPaths := false
 	for _, hint := range hints {
 		for _, p := range hint.paths {
 			candidate := p.Context
+			if s.isGlobalContext(candidate) {
+				hasGlobalPaths = true
+				continue
+			}
 			candidatePriority, ok := s.contextPriority[candidate]
 			if !ok {
 				candidatePriority = math.MaxInt
@@ -167,6 +190,9 @@ func (s *priorityContextInferrer) inferFromHints(hints []priorityContextInferrer
 			requiredEnums[enum] = struct{}{}
 		}
 	}
+	if inferredContext == "" && hasGlobalPaths {
+		inferredContext = s.lowestPriorityCandidate()
+	}
 	// No inferred context
 	if inferredContext == "" {
 		s.telemetrySettings.Logger.Debug("No OTTL context candidate found")
@@ -185,6 +211,30 @@ func (s *priorityContextInferrer) inferFromHints(hints []priorityContextInferrer
 	return "", err
 }
 
+// isGlobalContext returns true if the context is one of the globalContexts or a path namespace
+// registered with WithPathNamespace, and is not a candidate.
+func (s *priorityContextInferrer) isGlobalContext(context string) bool {
+	if _, isCandidate := s.contextCandidate[context]; isCandidate {
+		return false
+	}
+	_, isPathNamespace := s.pathNamespaces[context]
+	return isPathNamespace || slices.Contains(globalContexts, context)
+}
+
+// lowestPriorityCandidate returns the prioritized context candidate with the lowest priority,
+// or an empty string if none of the candidates is prioritized.
+func (s *priorityContextInferrer) lowestPriorityCandidate() string {
+	var lowest string
+	lowestPriority := -1
+	for context := range s.contextCandidate {
+		if priority, ok := s.contextPriority[context]; ok && priority > lowestPriority {
+			lowest = context
+			lowestPriority = priority
+		}
+	}
+	return lowest
+}
+
 // validateContextCandidate checks if the given context candidate has all required functions names
 // and enums symbols. The functions arity are not verified.
 func (s *priorityContextInferrer) validateContextCandidate(
@@ -288,15 +338,17 @@ func (*priorityContextInferrer) getConditionsHints(conditions []string) ([]prior
 // select a context in which the function/enum are supported.
 func (*priorityContextInferrer) getStatementsHints(statements []string) ([]priorityContextInferrerHints, error) {
 	hints := make([]priorityContextInferrerHints, 0, len(statements))
+	bindings := localScopeFrame{}
 	for _, statement := range statements {
 		parsed, err := parseStatement(statement)
 		if err != nil {
 			return nil, err
 		}
 		visitor := newGrammarContextInferrerVisitor()
-		parsed.Editor.accept(&visitor)
-		if parsed.WhereClause != nil {
-			parsed.WhereClause.accept(&visitor)
+		visitor.scopes.push(bindings)
+		parsed.accept(&visitor)
+		if parsed.Let != nil {
+			bindings[parsed.Let.Name] = struct{}{}
 		}
 		hints = append(hints, visitor)
 	}
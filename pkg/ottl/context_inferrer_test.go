@@ -39,12 +39,13 @@ func newDummyPriorityContextInferrerCandidate(hasFunctionName, hasEnumSymbol boo
 
 func Test_NewPriorityContextInferrer_InferStatements(t *testing.T) {
 	tests := []struct {
-		name       string
-		priority   []string
-		candidates map[string]*priorityContextInferrerCandidate
-		statements []string
-		expected   string
-		err        string
+		name           string
+		priority       []string
+		candidates     map[string]*priorityContextInferrerCandidate
+		pathNamespaces []string
+		statements     []string
+		expected       string
+		err            string
 	}{
 		{
 			name:     "with priority and statement context",
@@ -168,14 +169,60 @@ func Test_NewPriorityContextInferrer_InferStatements(t *testing.T) {
 			statements: []string{"set(span.foo, Lambda((spanevent) => spanevent.bar))"},
 			expected:   "span",
 		},
+		{
+			name:     "global context paths are not hints",
+			priority: []string{"log", "scope", "resource"},
+			candidates: map[string]*priorityContextInferrerCandidate{
+				"log":      defaultDummyPriorityContextInferrerCandidate,
+				"resource": defaultDummyPriorityContextInferrerCandidate,
+			},
+			statements: []string{`set(resource.attributes["tenant"], otelcol.client.metadata["x-tenant"])`},
+			expected:   "resource",
+		},
+		{
+			name:     "only global context paths",
+			priority: []string{"log", "scope", "resource"},
+			candidates: map[string]*priorityContextInferrerCandidate{
+				"log":   defaultDummyPriorityContextInferrerCandidate,
+				"scope": defaultDummyPriorityContextInferrerCandidate,
+			},
+			statements: []string{`set(otelcol.client.metadata["x-tenant"], "tenant")`},
+			expected:   "scope",
+		},
+		{
+			name:     "global context candidate",
+			priority: []string{"log", "resource"},
+			candidates: map[string]*priorityContextInferrerCandidate{
+				"otelcol":  defaultDummyPriorityContextInferrerCandidate,
+				"resource": defaultDummyPriorityContextInferrerCandidate,
+			},
+			statements: []string{`set(otelcol.client.metadata["x-tenant"], "tenant")`},
+			expected:   "otelcol",
+		},
+		{
+			name:     "path namespace paths are not hints",
+			priority: []string{"log", "scope", "resource"},
+			candidates: map[string]*priorityContextInferrerCandidate{
+				"log":      defaultDummyPriorityContextInferrerCandidate,
+				"resource": defaultDummyPriorityContextInferrerCandidate,
+			},
+			pathNamespaces: []string{"myvendor"},
+			statements:     []string{`set(resource.attributes["region"], myvendor.region)`},
+			expected:       "resource",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			pathNamespaces := map[string]struct{}{}
+			for _, namespace := range tt.pathNamespaces {
+				pathNamespaces[namespace] = struct{}{}
+			}
 			inferrer := newPriorityContextInferrer(
 				componenttest.NewNopTelemetrySettings(),
 				tt.candidates,
 				withContextInferrerPriorities(tt.priority),
+				withContextInferrerPathNamespaces(pathNamespaces),
 			)
 			inferredContext, err := inferrer.inferFromStatements(tt.statements)
 			if tt.err != "" {
@@ -473,6 +520,7 @@ func Test_NewPriorityContextInferrer_DefaultPriorityList(t *testing.T) {
 		"metric",
 		"spanevent",
 		"span",
+		"trace",
 		"profile",
 		"scope",
 		"instrumentation_scope",
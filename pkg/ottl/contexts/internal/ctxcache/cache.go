@@ -5,6 +5,7 @@ package ctxcache // import "github.com/open-telemetry/opentelemetry-collector-co
 
 import (
 	"context"
+	"fmt"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
@@ -16,33 +17,76 @@ const Name = "cache"
 
 type Getter[K any] func(K) pcommon.Map
 
-func PathExpressionParser[K any](cacheGetter Getter[K]) ottl.PathExpressionParser[K] {
+// SharedGetter returns the cache shared by the TransformContexts, or nil if the
+// TransformContext uses its own cache.
+type SharedGetter[K any] func(K) *ottl.Cache
+
+func PathExpressionParser[K any](cacheGetter Getter[K], sharedCacheGetter SharedGetter[K]) ottl.PathExpressionParser[K] {
 	return func(path ottl.Path[K]) (ottl.GetSetter[K], error) {
 		if path.Keys() == nil {
-			return accessCache(cacheGetter), nil
+			return accessCache(cacheGetter, sharedCacheGetter), nil
 		}
-		return accessCacheKey(cacheGetter, path.Keys()), nil
+		return accessCacheKey(cacheGetter, sharedCacheGetter, path.Keys()), nil
 	}
 }
 
-func accessCache[K any](cacheGetter Getter[K]) ottl.StandardGetSetter[K] {
+func accessCache[K any](cacheGetter Getter[K], sharedCacheGetter SharedGetter[K]) ottl.StandardGetSetter[K] {
 	return ottl.StandardGetSetter[K]{
 		Getter: func(_ context.Context, tCtx K) (any, error) {
+			if shared := sharedCacheGetter(tCtx); shared != nil {
+				return shared.AsMap(), nil
+			}
 			return cacheGetter(tCtx), nil
 		},
 		Setter: func(_ context.Context, tCtx K, val any) error {
+			if shared := sharedCacheGetter(tCtx); shared != nil {
+				m := pcommon.NewMap()
+				if err := ctxutil.SetMap(m, val); err != nil {
+					return err
+				}
+				shared.Replace(m)
+				return nil
+			}
 			return ctxutil.SetMap(cacheGetter(tCtx), val)
 		},
 	}
 }
 
-func accessCacheKey[K any](cacheGetter Getter[K], key []ottl.Key[K]) ottl.StandardGetSetter[K] {
+func accessCacheKey[K any](cacheGetter Getter[K], sharedCacheGetter SharedGetter[K], key []ottl.Key[K]) ottl.StandardGetSetter[K] {
 	return ottl.StandardGetSetter[K]{
 		Getter: func(ctx context.Context, tCtx K) (any, error) {
+			if shared := sharedCacheGetter(tCtx); shared != nil {
+				return getSharedCacheValue(ctx, tCtx, shared, key)
+			}
 			return ctxutil.GetMapValue(ctx, tCtx, cacheGetter(tCtx), key)
 		},
 		Setter: func(ctx context.Context, tCtx K, val any) error {
+			if shared := sharedCacheGetter(tCtx); shared != nil {
+				return setSharedCacheValue(ctx, tCtx, shared, key, val)
+			}
 			return ctxutil.SetMapValue(ctx, tCtx, cacheGetter(tCtx), key, val)
 		},
 	}
 }
+
+func getSharedCacheValue[K any](ctx context.Context, tCtx K, cache *ottl.Cache, keys []ottl.Key[K]) (any, error) {
+	name, err := ctxutil.GetMapKeyName(ctx, tCtx, keys[0])
+	if err != nil {
+		return nil, fmt.Errorf("cannot get cache value: %w", err)
+	}
+	val, ok := cache.Get(*name)
+	if !ok {
+		return nil, nil
+	}
+	return ctxutil.GetIndexableValue(ctx, tCtx, val, keys[1:])
+}
+
+func setSharedCacheValue[K any](ctx context.Context, tCtx K, cache *ottl.Cache, keys []ottl.Key[K], val any) error {
+	name, err := ctxutil.GetMapKeyName(ctx, tCtx, keys[0])
+	if err != nil {
+		return fmt.Errorf("cannot set cache value: %w", err)
+	}
+	return cache.Update(*name, func(current pcommon.Value) error {
+		return ctxutil.SetIndexableValue(ctx, tCtx, current, val, keys[1:])
+	})
+}
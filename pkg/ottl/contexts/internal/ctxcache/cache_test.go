@@ -24,6 +24,8 @@ func Test_PathExpressionParser(t *testing.T) {
 
 	parser := PathExpressionParser(func(tCtx testContext) pcommon.Map {
 		return tCtx.getCache()
+	}, func(testContext) *ottl.Cache {
+		return nil
 	})
 
 	t.Run("access entire cache", func(t *testing.T) {
@@ -187,6 +189,55 @@ func Test_PathExpressionParser(t *testing.T) {
 	})
 }
 
+func Test_PathExpressionParser_sharedCache(t *testing.T) {
+	ctx := newTestContext(pcommon.NewMap())
+	shared := ottl.NewCache(ottl.CacheConfig{})
+	parser := PathExpressionParser(func(tCtx testContext) pcommon.Map {
+		return tCtx.getCache()
+	}, func(testContext) *ottl.Cache {
+		return shared
+	})
+
+	keyPath := &pathtest.Path[testContext]{
+		N: "cache",
+		KeySlice: []ottl.Key[testContext]{
+			&pathtest.Key[testContext]{
+				S: ottltest.Strp("parent"),
+			},
+			&pathtest.Key[testContext]{
+				S: ottltest.Strp("nested_key"),
+			},
+		},
+	}
+	keyGetter, err := parser(keyPath)
+	require.NoError(t, err)
+
+	val, err := keyGetter.Get(t.Context(), ctx)
+	require.NoError(t, err)
+	assert.Nil(t, val)
+
+	require.NoError(t, keyGetter.Set(t.Context(), ctx, "nested_value"))
+	val, err = keyGetter.Get(t.Context(), ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "nested_value", val)
+
+	// The cache of the context is not used
+	assert.Equal(t, 0, ctx.cache.Len())
+
+	cacheGetter, err := parser(&pathtest.Path[testContext]{N: "cache"})
+	require.NoError(t, err)
+
+	val, err = cacheGetter.Get(t.Context(), ctx)
+	require.NoError(t, err)
+	require.IsType(t, pcommon.Map{}, val)
+	assert.Equal(t, map[string]any{"parent": map[string]any{"nested_key": "nested_value"}}, val.(pcommon.Map).AsRaw())
+
+	newCache := pcommon.NewMap()
+	newCache.PutStr("new_key", "new_value")
+	require.NoError(t, cacheGetter.Set(t.Context(), ctx, newCache))
+	assert.Equal(t, map[string]any{"new_key": "new_value"}, shared.AsMap().AsRaw())
+}
+
 type testContext struct {
 	cache pcommon.Map
 }
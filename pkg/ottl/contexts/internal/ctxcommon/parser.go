@@ -37,6 +37,7 @@ func PathExpressionParser[K any](
 	contextName string,
 	contextDocRef string,
 	cacheGetter ctxcache.Getter[K],
+	sharedCacheGetter ctxcache.SharedGetter[K],
 	contextParsers map[string]ottl.PathExpressionParser[K],
 ) ottl.PathExpressionParser[K] {
 	return func(path ottl.Path[K]) (ottl.GetSetter[K], error) {
@@ -63,7 +64,7 @@ func PathExpressionParser[K any](
 		// Allow cache access only on this context
 		if path.Name() == ctxcache.Name {
 			if pathContext == contextName {
-				return ctxcache.PathExpressionParser(cacheGetter)(path)
+				return ctxcache.PathExpressionParser(cacheGetter, sharedCacheGetter)(path)
 			}
 			return nil, ctxcache.NewError(contextName, pathContext, fullPath)
 		}
@@ -86,6 +86,9 @@ func TestPathExpressionParser(t *testing.T) {
 		contextName,
 		contextDocRef,
 		cacheGetter,
+		func(testContext) *ottl.Cache {
+			return nil
+		},
 		contextParsers,
 	)
 
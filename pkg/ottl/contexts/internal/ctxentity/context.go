@@ -0,0 +1,17 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ctxentity // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxentity"
+
+import "go.opentelemetry.io/collector/pdata/plog"
+
+const (
+	Name   = "entity"
+	DocRef = "https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/pkg/ottl/contexts/ottlentity"
+)
+
+// Context is implemented by the contexts giving access to an entity event,
+// which is carried by the attributes of a log record.
+type Context interface {
+	GetEntityEvent() plog.LogRecord
+}
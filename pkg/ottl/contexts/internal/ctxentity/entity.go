@@ -0,0 +1,214 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ctxentity // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxentity"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxerror"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxutil"
+)
+
+// The attributes of the log records representing entity events, as written by
+// the experimentalmetricmetadata package.
+const (
+	eventTypeAttribute  = "otel.entity.event.type"
+	idAttribute         = "otel.entity.id"
+	typeAttribute       = "otel.entity.type"
+	intervalAttribute   = "otel.entity.interval"
+	attributesAttribute = "otel.entity.attributes"
+
+	eventTypeState  = "entity_state"
+	eventTypeDelete = "entity_delete"
+)
+
+func PathGetSetter[K Context](path ottl.Path[K]) (ottl.GetSetter[K], error) {
+	if path == nil {
+		return nil, ctxerror.New("nil", "nil", Name, DocRef)
+	}
+	switch path.Name() {
+	case "type":
+		return accessType[K](), nil
+	case "id":
+		if path.Keys() == nil {
+			return accessMap[K](idAttribute), nil
+		}
+		return accessMapKey(idAttribute, path.Keys()), nil
+	case "attributes":
+		if path.Keys() == nil {
+			return accessMap[K](attributesAttribute), nil
+		}
+		return accessMapKey(attributesAttribute, path.Keys()), nil
+	case "event_type":
+		return accessEventType[K](), nil
+	case "interval":
+		return accessInterval[K](), nil
+	case "time_unix_nano":
+		return accessTimeUnixNano[K](), nil
+	case "time":
+		return accessTime[K](), nil
+	default:
+		return nil, ctxerror.New(path.Name(), path.String(), Name, DocRef)
+	}
+}
+
+// entityMap returns the map stored in the attribute of the entity event,
+// which is created if the event does not have it yet.
+func entityMap(event plog.LogRecord, attribute string) (pcommon.Map, error) {
+	val, ok := event.Attributes().Get(attribute)
+	if !ok {
+		return event.Attributes().PutEmptyMap(attribute), nil
+	}
+	if val.Type() != pcommon.ValueTypeMap {
+		return pcommon.Map{}, fmt.Errorf("the %q attribute of the entity event must be a map, but is a %s", attribute, val.Type())
+	}
+	return val.Map(), nil
+}
+
+func accessType[K Context]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			if val, ok := tCtx.GetEntityEvent().Attributes().Get(typeAttribute); ok {
+				return val.Str(), nil
+			}
+			return "", nil
+		},
+		Setter: func(_ context.Context, tCtx K, val any) error {
+			s, err := ctxutil.ExpectType[string](val)
+			if err != nil {
+				return err
+			}
+			tCtx.GetEntityEvent().Attributes().PutStr(typeAttribute, s)
+			return nil
+		},
+	}
+}
+
+func accessMap[K Context](attribute string) ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			return entityMap(tCtx.GetEntityEvent(), attribute)
+		},
+		Setter: func(_ context.Context, tCtx K, val any) error {
+			m, err := entityMap(tCtx.GetEntityEvent(), attribute)
+			if err != nil {
+				return err
+			}
+			return ctxutil.SetMap(m, val)
+		},
+	}
+}
+
+func accessMapKey[K Context](attribute string, keys []ottl.Key[K]) ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, tCtx K) (any, error) {
+			m, err := entityMap(tCtx.GetEntityEvent(), attribute)
+			if err != nil {
+				return nil, err
+			}
+			return ctxutil.GetMapValue[K](ctx, tCtx, m, keys)
+		},
+		Setter: func(ctx context.Context, tCtx K, val any) error {
+			m, err := entityMap(tCtx.GetEntityEvent(), attribute)
+			if err != nil {
+				return err
+			}
+			return ctxutil.SetMapValue[K](ctx, tCtx, m, keys, val)
+		},
+	}
+}
+
+func accessEventType[K Context]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			val, ok := tCtx.GetEntityEvent().Attributes().Get(eventTypeAttribute)
+			if !ok {
+				return EventTypeUnspecified, nil
+			}
+			switch val.Str() {
+			case eventTypeState:
+				return EventTypeState, nil
+			case eventTypeDelete:
+				return EventTypeDelete, nil
+			default:
+				return EventTypeUnspecified, nil
+			}
+		},
+		Setter: func(_ context.Context, tCtx K, val any) error {
+			i, err := ctxutil.ExpectType[int64](val)
+			if err != nil {
+				return err
+			}
+			attrs := tCtx.GetEntityEvent().Attributes()
+			switch i {
+			case EventTypeUnspecified:
+				attrs.Remove(eventTypeAttribute)
+			case EventTypeState:
+				attrs.PutStr(eventTypeAttribute, eventTypeState)
+			case EventTypeDelete:
+				attrs.PutStr(eventTypeAttribute, eventTypeDelete)
+			default:
+				return fmt.Errorf("invalid entity event type %d", i)
+			}
+			return nil
+		},
+	}
+}
+
+func accessInterval[K Context]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			if val, ok := tCtx.GetEntityEvent().Attributes().Get(intervalAttribute); ok {
+				return val.Int(), nil
+			}
+			return int64(0), nil
+		},
+		Setter: func(_ context.Context, tCtx K, val any) error {
+			i, err := ctxutil.ExpectType[int64](val)
+			if err != nil {
+				return err
+			}
+			tCtx.GetEntityEvent().Attributes().PutInt(intervalAttribute, i)
+			return nil
+		},
+	}
+}
+
+func accessTimeUnixNano[K Context]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			return tCtx.GetEntityEvent().Timestamp().AsTime().UnixNano(), nil
+		},
+		Setter: func(_ context.Context, tCtx K, val any) error {
+			i, err := ctxutil.ExpectType[int64](val)
+			if err != nil {
+				return err
+			}
+			tCtx.GetEntityEvent().SetTimestamp(pcommon.NewTimestampFromTime(time.Unix(0, i)))
+			return nil
+		},
+	}
+}
+
+func accessTime[K Context]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			return tCtx.GetEntityEvent().Timestamp().AsTime(), nil
+		},
+		Setter: func(_ context.Context, tCtx K, val any) error {
+			t, err := ctxutil.ExpectType[time.Time](val)
+			if err != nil {
+				return err
+			}
+			tCtx.GetEntityEvent().SetTimestamp(pcommon.NewTimestampFromTime(t))
+			return nil
+		},
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ctxentity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxentity"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/pathtest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
+)
+
+var (
+	time1 = time.Date(1970, 1, 1, 0, 0, 0, 100000000, time.UTC)
+	time2 = time.Date(1970, 1, 1, 0, 0, 0, 200000000, time.UTC)
+)
+
+func TestPathGetSetter(t *testing.T) {
+	refEvent := createEntityEvent()
+
+	newID := pcommon.NewMap()
+	newID.PutStr("k8s.pod.uid", "new-uid")
+
+	newAttrs := pcommon.NewMap()
+	newAttrs.PutStr("hello", "world")
+
+	tests := []struct {
+		name     string
+		path     ottl.Path[*testContext]
+		orig     any
+		newVal   any
+		modified func(event plog.LogRecord)
+	}{
+		{
+			name:   "type",
+			path:   &pathtest.Path[*testContext]{N: "type"},
+			orig:   "k8s.pod",
+			newVal: "k8s.node",
+			modified: func(event plog.LogRecord) {
+				event.Attributes().PutStr("otel.entity.type", "k8s.node")
+			},
+		},
+		{
+			name:   "id",
+			path:   &pathtest.Path[*testContext]{N: "id"},
+			orig:   getMap(refEvent, "otel.entity.id"),
+			newVal: newID,
+			modified: func(event plog.LogRecord) {
+				newID.CopyTo(getMap(event, "otel.entity.id"))
+			},
+		},
+		{
+			name: "id key",
+			path: &pathtest.Path[*testContext]{
+				N: "id",
+				KeySlice: []ottl.Key[*testContext]{
+					&pathtest.Key[*testContext]{S: ottltest.Strp("k8s.pod.uid")},
+				},
+			},
+			orig:   "uid",
+			newVal: "new-uid",
+			modified: func(event plog.LogRecord) {
+				getMap(event, "otel.entity.id").PutStr("k8s.pod.uid", "new-uid")
+			},
+		},
+		{
+			name:   "attributes",
+			path:   &pathtest.Path[*testContext]{N: "attributes"},
+			orig:   getMap(refEvent, "otel.entity.attributes"),
+			newVal: newAttrs,
+			modified: func(event plog.LogRecord) {
+				newAttrs.CopyTo(getMap(event, "otel.entity.attributes"))
+			},
+		},
+		{
+			name: "attributes key",
+			path: &pathtest.Path[*testContext]{
+				N: "attributes",
+				KeySlice: []ottl.Key[*testContext]{
+					&pathtest.Key[*testContext]{S: ottltest.Strp("k8s.pod.phase")},
+				},
+			},
+			orig:   "Running",
+			newVal: "Succeeded",
+			modified: func(event plog.LogRecord) {
+				getMap(event, "otel.entity.attributes").PutStr("k8s.pod.phase", "Succeeded")
+			},
+		},
+		{
+			name: "attributes new key",
+			path: &pathtest.Path[*testContext]{
+				N: "attributes",
+				KeySlice: []ottl.Key[*testContext]{
+					&pathtest.Key[*testContext]{S: ottltest.Strp("new")},
+				},
+			},
+			orig:   nil,
+			newVal: int64(1),
+			modified: func(event plog.LogRecord) {
+				getMap(event, "otel.entity.attributes").PutInt("new", 1)
+			},
+		},
+		{
+			name:   "event_type",
+			path:   &pathtest.Path[*testContext]{N: "event_type"},
+			orig:   ctxentity.EventTypeState,
+			newVal: ctxentity.EventTypeDelete,
+			modified: func(event plog.LogRecord) {
+				event.Attributes().PutStr("otel.entity.event.type", "entity_delete")
+			},
+		},
+		{
+			name:   "event_type unspecified",
+			path:   &pathtest.Path[*testContext]{N: "event_type"},
+			orig:   ctxentity.EventTypeState,
+			newVal: ctxentity.EventTypeUnspecified,
+			modified: func(event plog.LogRecord) {
+				event.Attributes().Remove("otel.entity.event.type")
+			},
+		},
+		{
+			name:   "interval",
+			path:   &pathtest.Path[*testContext]{N: "interval"},
+			orig:   int64(30000),
+			newVal: int64(60000),
+			modified: func(event plog.LogRecord) {
+				event.Attributes().PutInt("otel.entity.interval", 60000)
+			},
+		},
+		{
+			name:   "time_unix_nano",
+			path:   &pathtest.Path[*testContext]{N: "time_unix_nano"},
+			orig:   time1.UnixNano(),
+			newVal: time2.UnixNano(),
+			modified: func(event plog.LogRecord) {
+				event.SetTimestamp(pcommon.NewTimestampFromTime(time2))
+			},
+		},
+		{
+			name:   "time",
+			path:   &pathtest.Path[*testContext]{N: "time"},
+			orig:   time1,
+			newVal: time2,
+			modified: func(event plog.LogRecord) {
+				event.SetTimestamp(pcommon.NewTimestampFromTime(time2))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessor, err := ctxentity.PathGetSetter[*testContext](tt.path)
+			require.NoError(t, err)
+
+			event := createEntityEvent()
+			ctx := newTestContext(event)
+
+			got, err := accessor.Get(t.Context(), ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.orig, got)
+
+			err = accessor.Set(t.Context(), ctx, tt.newVal)
+			require.NoError(t, err)
+
+			// Verify that setting an invalid type returns an error
+			err = accessor.Set(t.Context(), ctx, struct{}{})
+			require.Error(t, err)
+
+			expectedEvent := createEntityEvent()
+			tt.modified(expectedEvent)
+
+			assert.Equal(t, expectedEvent, event)
+		})
+	}
+}
+
+func TestPathGetSetter_emptyEvent(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected any
+	}{
+		{path: "type", expected: ""},
+		{path: "event_type", expected: ctxentity.EventTypeUnspecified},
+		{path: "interval", expected: int64(0)},
+		{path: "id", expected: pcommon.NewMap()},
+		{path: "attributes", expected: pcommon.NewMap()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			accessor, err := ctxentity.PathGetSetter[*testContext](&pathtest.Path[*testContext]{N: tt.path})
+			require.NoError(t, err)
+
+			got, err := accessor.Get(t.Context(), newTestContext(plog.NewLogRecord()))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestPathGetSetter_errors(t *testing.T) {
+	event := plog.NewLogRecord()
+	event.Attributes().PutStr("otel.entity.id", "not a map")
+	ctx := newTestContext(event)
+
+	accessor, err := ctxentity.PathGetSetter[*testContext](&pathtest.Path[*testContext]{N: "id"})
+	require.NoError(t, err)
+	_, err = accessor.Get(t.Context(), ctx)
+	assert.EqualError(t, err, `the "otel.entity.id" attribute of the entity event must be a map, but is a Str`)
+
+	accessor, err = ctxentity.PathGetSetter[*testContext](&pathtest.Path[*testContext]{N: "event_type"})
+	require.NoError(t, err)
+	err = accessor.Set(t.Context(), ctx, int64(5))
+	assert.EqualError(t, err, "invalid entity event type 5")
+
+	_, err = ctxentity.PathGetSetter[*testContext](&pathtest.Path[*testContext]{N: "unknown"})
+	assert.Error(t, err)
+}
+
+func getMap(event plog.LogRecord, attribute string) pcommon.Map {
+	val, ok := event.Attributes().Get(attribute)
+	if !ok {
+		return event.Attributes().PutEmptyMap(attribute)
+	}
+	return val.Map()
+}
+
+func createEntityEvent() plog.LogRecord {
+	event := plog.NewLogRecord()
+	event.SetTimestamp(pcommon.NewTimestampFromTime(time1))
+	event.Attributes().PutStr("otel.entity.event.type", "entity_state")
+	event.Attributes().PutStr("otel.entity.type", "k8s.pod")
+	event.Attributes().PutInt("otel.entity.interval", 30000)
+	event.Attributes().PutEmptyMap("otel.entity.id").PutStr("k8s.pod.uid", "uid")
+	attrs := event.Attributes().PutEmptyMap("otel.entity.attributes")
+	attrs.PutStr("k8s.pod.name", "name")
+	attrs.PutStr("k8s.pod.phase", "Running")
+	return event
+}
+
+type testContext struct {
+	event plog.LogRecord
+}
+
+func (l *testContext) GetEntityEvent() plog.LogRecord {
+	return l.event
+}
+
+func newTestContext(event plog.LogRecord) *testContext {
+	return &testContext{event: event}
+}
@@ -0,0 +1,21 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ctxentity // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxentity"
+
+import (
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// The event types have the values of the experimentalmetricmetadata.EventType constants.
+const (
+	EventTypeUnspecified int64 = iota
+	EventTypeState
+	EventTypeDelete
+)
+
+var SymbolTable = map[ottl.EnumSymbol]ottl.Enum{
+	"ENTITY_EVENT_TYPE_UNSPECIFIED": ottl.Enum(EventTypeUnspecified),
+	"ENTITY_EVENT_TYPE_STATE":       ottl.Enum(EventTypeState),
+	"ENTITY_EVENT_TYPE_DELETE":      ottl.Enum(EventTypeDelete),
+}
@@ -29,6 +29,8 @@ func accessClient[K any](path ottl.Path[K]) (ottl.GetSetter[K], error) {
 		return accessClientAuth(nextPath)
 	case "metadata":
 		return accessClientMetadata(nextPath)
+	case "tls":
+		return accessClientTLS(nextPath)
 	default:
 		return nil, ctxerror.New(nextPath.Name(), nextPath.String(), Name, DocRef)
 	}
@@ -170,22 +172,17 @@ func accessClientAuthAttributesKey[K any](keys []ottl.Key[K]) ottl.StandardGetSe
 	}
 }
 
-func convertClientMetadataToMap(md client.Metadata) pcommon.Map {
-	mdMap := pcommon.NewMap()
-	for k := range md.Keys() {
-		convertStringArrToValueSlice(md.Get(k)).MoveTo(mdMap.PutEmpty(k))
-	}
-	return mdMap
-}
-
 func accessClientMetadataKeys[K any]() ottl.StandardGetSetter[K] {
 	return ottl.StandardGetSetter[K]{
 		Getter: func(ctx context.Context, _ K) (any, error) {
-			cl := client.FromContext(ctx)
-			return convertClientMetadataToMap(cl.Metadata), nil
+			return OutgoingMetadataFromContext(ctx).all(ctx), nil
 		},
-		Setter: func(_ context.Context, _ K, _ any) error {
-			return fmt.Errorf(readOnlyPathErrMsg, "otelcol.client.metadata")
+		Setter: func(ctx context.Context, _ K, val any) error {
+			out := OutgoingMetadataFromContext(ctx)
+			if out == nil {
+				return fmt.Errorf(noOutgoingMetadataErrMsg, "otelcol.client.metadata")
+			}
+			return out.setAll(val)
 		},
 	}
 }
@@ -201,15 +198,28 @@ func accessClientMetadataKey[K any](keys []ottl.Key[K]) ottl.StandardGetSetter[K
 			if err != nil {
 				return nil, fmt.Errorf("cannot get map value: %w", err)
 			}
-			cl := client.FromContext(ctx)
-			mdVal := cl.Metadata.Get(*key)
+			mdVal := OutgoingMetadataFromContext(ctx).get(ctx, *key)
 			if len(mdVal) == 0 {
 				return nil, nil
 			}
 			return getIndexableValueFromStringArr(ctx, tCtx, keys[1:], mdVal)
 		},
-		Setter: func(_ context.Context, _ K, _ any) error {
-			return fmt.Errorf(readOnlyPathErrMsg, "otelcol.client.metadata")
+		Setter: func(ctx context.Context, tCtx K, val any) error {
+			if len(keys) == 0 {
+				return errors.New("cannot set map value without keys")
+			}
+			if len(keys) > 1 {
+				return errors.New("cannot set a single value of a metadata key, set all its values instead")
+			}
+			out := OutgoingMetadataFromContext(ctx)
+			if out == nil {
+				return fmt.Errorf(noOutgoingMetadataErrMsg, "otelcol.client.metadata")
+			}
+			key, err := ctxutil.GetMapKeyName(ctx, tCtx, keys[0])
+			if err != nil {
+				return fmt.Errorf("cannot set map value: %w", err)
+			}
+			return out.set(*key, val)
 		},
 	}
 }
@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ctxotelcol // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxotelcol"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxerror"
+)
+
+type collectorInfoKey struct{}
+
+// CollectorInfo describes the collector processing the data.
+type CollectorInfo struct {
+	// Version is the version of the collector.
+	Version string
+	// Hostname is the name of the host running the collector. The name reported
+	// by the kernel is used if it is empty.
+	Hostname string
+}
+
+// NewCollectorInfoContext returns a context carrying the CollectorInfo.
+func NewCollectorInfoContext(ctx context.Context, info CollectorInfo) context.Context {
+	return context.WithValue(ctx, collectorInfoKey{}, info)
+}
+
+// CollectorInfoFromContext returns the CollectorInfo carried by the context.
+func CollectorInfoFromContext(ctx context.Context) CollectorInfo {
+	info, _ := ctx.Value(collectorInfoKey{}).(CollectorInfo)
+	return info
+}
+
+var osHostname = sync.OnceValue(func() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return ""
+	}
+	return hostname
+})
+
+func accessCollector[K any](path ottl.Path[K]) (ottl.GetSetter[K], error) {
+	nextPath := path.Next()
+	if nextPath == nil {
+		return nil, ctxerror.New(path.Name(), path.String(), Name, DocRef)
+	}
+	if nextPath.Keys() != nil || nextPath.Next() != nil {
+		return nil, ctxerror.New(nextPath.Name(), nextPath.String(), Name, DocRef)
+	}
+	switch nextPath.Name() {
+	case "version":
+		return accessCollectorInfoField[K]("otelcol.collector.version", func(info CollectorInfo) string {
+			return info.Version
+		}), nil
+	case "hostname":
+		return accessCollectorInfoField[K]("otelcol.collector.hostname", func(info CollectorInfo) string {
+			if info.Hostname == "" {
+				return osHostname()
+			}
+			return info.Hostname
+		}), nil
+	default:
+		return nil, ctxerror.New(nextPath.Name(), nextPath.String(), Name, DocRef)
+	}
+}
+
+func accessCollectorInfoField[K any](name string, field func(CollectorInfo) string) ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, _ K) (any, error) {
+			val := field(CollectorInfoFromContext(ctx))
+			if val == "" {
+				return nil, nil
+			}
+			return val, nil
+		},
+		Setter: func(_ context.Context, _ K, _ any) error {
+			return fmt.Errorf(readOnlyPathErrMsg, name)
+		},
+	}
+}
@@ -4,7 +4,8 @@
 package ctxotelcol // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxotelcol"
 
 const (
-	readOnlyPathErrMsg = "%q is read-only and cannot be modified"
-	Name               = "otelcol"
-	DocRef             = "https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/pkg/ottl/contexts/ottlotelcol"
+	readOnlyPathErrMsg       = "%q is read-only and cannot be modified"
+	noOutgoingMetadataErrMsg = "%q cannot be modified without an outgoing metadata carrier in the context"
+	Name                     = "otelcol"
+	DocRef                   = "https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/pkg/ottl/contexts/ottlotelcol"
 )
@@ -9,7 +9,9 @@ import (
 	"fmt"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxerror"
@@ -21,12 +23,29 @@ func accessGRPC[K any](path ottl.Path[K]) (ottl.GetSetter[K], error) {
 	if nextPath == nil {
 		return nil, ctxerror.New(path.Name(), path.String(), Name, DocRef)
 	}
+	if nextPath.Name() != "metadata" && (nextPath.Keys() != nil || nextPath.Next() != nil) {
+		return nil, ctxerror.New(nextPath.Name(), nextPath.String(), Name, DocRef)
+	}
 	switch nextPath.Name() {
 	case "metadata":
 		if nextPath.Keys() == nil {
 			return accessGRPCMetadataKeys[K](), nil
 		}
 		return accessGRPCMetadataKey[K](nextPath.Keys()), nil
+	case "method":
+		return accessGRPCMethod[K](), nil
+	case "authority":
+		return accessGRPCMetadataValue[K]("otelcol.grpc.authority", ":authority"), nil
+	case "content_type":
+		return accessGRPCMetadataValue[K]("otelcol.grpc.content_type", "content-type"), nil
+	case "compression":
+		return accessGRPCCompression[K](), nil
+	case "accepted_compressions":
+		return accessGRPCAcceptedCompressions[K](), nil
+	case "transport":
+		return accessGRPCTransport[K](), nil
+	case "local_addr":
+		return accessGRPCLocalAddr[K](), nil
 	default:
 		return nil, ctxerror.New(nextPath.Name(), nextPath.String(), Name, DocRef)
 	}
@@ -81,3 +100,110 @@ func accessGRPCMetadataKey[K any](keys []ottl.Key[K]) ottl.StandardGetSetter[K]
 		},
 	}
 }
+
+func accessGRPCMethod[K any]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, _ K) (any, error) {
+			method, ok := grpc.Method(ctx)
+			if !ok {
+				return nil, nil
+			}
+			return method, nil
+		},
+		Setter: func(_ context.Context, _ K, _ any) error {
+			return fmt.Errorf(readOnlyPathErrMsg, "otelcol.grpc.method")
+		},
+	}
+}
+
+// accessGRPCMetadataValue reads the first value of a key of the incoming gRPC metadata, which
+// also holds the ":authority" pseudo-header and the "content-type" header of the request.
+func accessGRPCMetadataValue[K any](name, key string) ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, _ K) (any, error) {
+			vals := metadata.ValueFromIncomingContext(ctx, key)
+			if len(vals) == 0 {
+				return nil, nil
+			}
+			return vals[0], nil
+		},
+		Setter: func(_ context.Context, _ K, _ any) error {
+			return fmt.Errorf(readOnlyPathErrMsg, name)
+		},
+	}
+}
+
+// The compression headers are reserved by gRPC and not part of the incoming metadata, so they are
+// read from the server transport stream of the request, which exposes them through these methods.
+type (
+	recvCompressStream interface {
+		RecvCompress() string
+	}
+	advertisedCompressorsStream interface {
+		ClientAdvertisedCompressors() []string
+	}
+)
+
+func accessGRPCCompression[K any]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, _ K) (any, error) {
+			stream, ok := grpc.ServerTransportStreamFromContext(ctx).(recvCompressStream)
+			if !ok {
+				return nil, nil
+			}
+			compression := stream.RecvCompress()
+			if compression == "" {
+				return nil, nil
+			}
+			return compression, nil
+		},
+		Setter: func(_ context.Context, _ K, _ any) error {
+			return fmt.Errorf(readOnlyPathErrMsg, "otelcol.grpc.compression")
+		},
+	}
+}
+
+func accessGRPCAcceptedCompressions[K any]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, _ K) (any, error) {
+			stream, ok := grpc.ServerTransportStreamFromContext(ctx).(advertisedCompressorsStream)
+			if !ok {
+				return nil, nil
+			}
+			return convertStringArrToValueSlice(stream.ClientAdvertisedCompressors()).Slice(), nil
+		},
+		Setter: func(_ context.Context, _ K, _ any) error {
+			return fmt.Errorf(readOnlyPathErrMsg, "otelcol.grpc.accepted_compressions")
+		},
+	}
+}
+
+func accessGRPCTransport[K any]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, _ K) (any, error) {
+			p, ok := peer.FromContext(ctx)
+			if !ok || p.Addr == nil {
+				return nil, nil
+			}
+			return p.Addr.Network(), nil
+		},
+		Setter: func(_ context.Context, _ K, _ any) error {
+			return fmt.Errorf(readOnlyPathErrMsg, "otelcol.grpc.transport")
+		},
+	}
+}
+
+func accessGRPCLocalAddr[K any]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, _ K) (any, error) {
+			p, ok := peer.FromContext(ctx)
+			if !ok || p.LocalAddr == nil {
+				return nil, nil
+			}
+			return p.LocalAddr.String(), nil
+		},
+		Setter: func(_ context.Context, _ K, _ any) error {
+			return fmt.Errorf(readOnlyPathErrMsg, "otelcol.grpc.local_addr")
+		},
+	}
+}
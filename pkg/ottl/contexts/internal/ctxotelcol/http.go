@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ctxotelcol // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxotelcol"
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"go.opentelemetry.io/collector/client"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxerror"
+)
+
+func accessHTTP[K any](path ottl.Path[K]) (ottl.GetSetter[K], error) {
+	nextPath := path.Next()
+	if nextPath == nil {
+		return nil, ctxerror.New(path.Name(), path.String(), Name, DocRef)
+	}
+	if nextPath.Keys() != nil || nextPath.Next() != nil {
+		return nil, ctxerror.New(nextPath.Name(), nextPath.String(), Name, DocRef)
+	}
+	switch nextPath.Name() {
+	case "user_agent":
+		return accessHTTPHeader[K]("otelcol.http.user_agent", "user-agent"), nil
+	case "content_encoding":
+		return accessHTTPHeader[K]("otelcol.http.content_encoding", "content-encoding"), nil
+	case "remote_port":
+		return accessHTTPRemotePort[K](), nil
+	default:
+		return nil, ctxerror.New(nextPath.Name(), nextPath.String(), Name, DocRef)
+	}
+}
+
+// accessHTTPHeader reads a request header from the client metadata, where it is
+// stored by HTTP receivers configured to include the request metadata.
+func accessHTTPHeader[K any](name, header string) ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, _ K) (any, error) {
+			vals := client.FromContext(ctx).Metadata.Get(header)
+			if len(vals) == 0 {
+				return nil, nil
+			}
+			return vals[0], nil
+		},
+		Setter: func(_ context.Context, _ K, _ any) error {
+			return fmt.Errorf(readOnlyPathErrMsg, name)
+		},
+	}
+}
+
+func accessHTTPRemotePort[K any]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, _ K) (any, error) {
+			port, ok := remotePort(client.FromContext(ctx).Addr)
+			if !ok {
+				return nil, nil
+			}
+			return port, nil
+		},
+		Setter: func(_ context.Context, _ K, _ any) error {
+			return fmt.Errorf(readOnlyPathErrMsg, "otelcol.http.remote_port")
+		},
+	}
+}
+
+func remotePort(addr net.Addr) (int64, bool) {
+	switch a := addr.(type) {
+	case nil:
+		return 0, false
+	case *net.TCPAddr:
+		return int64(a.Port), true
+	case *net.UDPAddr:
+		return int64(a.Port), true
+	}
+	_, port, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return 0, false
+	}
+	p, err := strconv.ParseInt(port, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return p, true
+}
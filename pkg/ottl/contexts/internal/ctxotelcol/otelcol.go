@@ -24,8 +24,12 @@ func PathGetSetter[K any](path ottl.Path[K]) (ottl.GetSetter[K], error) {
 	switch path.Name() {
 	case "client":
 		return accessClient[K](path)
+	case "collector":
+		return accessCollector[K](path)
 	case "grpc":
 		return accessGRPC[K](path)
+	case "http":
+		return accessHTTP[K](path)
 	default:
 		return nil, ctxerror.New(path.Name(), path.String(), Name, DocRef)
 	}
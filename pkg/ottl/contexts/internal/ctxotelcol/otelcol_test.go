@@ -4,15 +4,26 @@
 package ctxotelcol
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
 	"net"
+	"net/url"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/pathtest"
@@ -174,7 +185,7 @@ func TestContextClientMetadata(t *testing.T) {
 		assert.Equal(t, "value1", result)
 	})
 
-	t.Run("cannot set entire metadata", func(t *testing.T) {
+	t.Run("cannot set entire metadata without outgoing metadata", func(t *testing.T) {
 		path := &pathtest.Path[testContext]{
 			N: "client",
 			NextPath: &pathtest.Path[testContext]{
@@ -191,10 +202,10 @@ func TestContextClientMetadata(t *testing.T) {
 
 		err = getter.Set(ctx, testContext{}, newMetadata)
 		require.Error(t, err)
-		assert.Equal(t, `"otelcol.client.metadata" is read-only and cannot be modified`, err.Error())
+		assert.Equal(t, `"otelcol.client.metadata" cannot be modified without an outgoing metadata carrier in the context`, err.Error())
 	})
 
-	t.Run("cannot set specific metadata key", func(t *testing.T) {
+	t.Run("cannot set specific metadata key without outgoing metadata", func(t *testing.T) {
 		path := &pathtest.Path[testContext]{
 			N: "client",
 			NextPath: &pathtest.Path[testContext]{
@@ -212,7 +223,7 @@ func TestContextClientMetadata(t *testing.T) {
 
 		err = getter.Set(ctx, testContext{}, "new-value")
 		require.Error(t, err)
-		assert.Equal(t, `"otelcol.client.metadata" is read-only and cannot be modified`, err.Error())
+		assert.Equal(t, `"otelcol.client.metadata" cannot be modified without an outgoing metadata carrier in the context`, err.Error())
 	})
 
 	t.Run("error when accessing metadata key without keys", func(t *testing.T) {
@@ -246,6 +257,243 @@ func TestContextClientMetadata(t *testing.T) {
 	})
 }
 
+func TestContextClientMetadataOutgoing(t *testing.T) {
+	metadataPath := &pathtest.Path[testContext]{
+		N: "client",
+		NextPath: &pathtest.Path[testContext]{
+			N: "metadata",
+		},
+	}
+	keyPath := func(key string) *pathtest.Path[testContext] {
+		return &pathtest.Path[testContext]{
+			N: "client",
+			NextPath: &pathtest.Path[testContext]{
+				N: "metadata",
+				KeySlice: []ottl.Key[testContext]{
+					&pathtest.Key[testContext]{
+						S: ottltest.Strp(key),
+					},
+				},
+			},
+		}
+	}
+	newContext := func(t *testing.T) context.Context {
+		ctx := client.NewContext(t.Context(), client.Info{
+			Metadata: client.NewMetadata(map[string][]string{
+				"x-tenant-id":  {"incoming"},
+				"x-request-id": {"abc"},
+			}),
+		})
+		return NewOutgoingMetadataContext(ctx)
+	}
+	set := func(t *testing.T, ctx context.Context, path ottl.Path[testContext], val any) {
+		getSetter, err := PathGetSetter[testContext](path)
+		require.NoError(t, err)
+		require.NoError(t, getSetter.Set(ctx, testContext{}, val))
+	}
+	get := func(t *testing.T, ctx context.Context, path ottl.Path[testContext]) any {
+		getSetter, err := PathGetSetter[testContext](path)
+		require.NoError(t, err)
+		val, err := getSetter.Get(ctx, testContext{})
+		require.NoError(t, err)
+		return val
+	}
+
+	t.Run("set specific key", func(t *testing.T) {
+		ctx := newContext(t)
+		set(t, ctx, keyPath("x-tenant-id"), "tenant-a")
+		set(t, ctx, keyPath("x-scope"), []any{"a", "b"})
+
+		// The getters reflect the changes
+		assert.Equal(t, "tenant-a", get(t, ctx, &pathtest.Path[testContext]{
+			N: "client",
+			NextPath: &pathtest.Path[testContext]{
+				N: "metadata",
+				KeySlice: []ottl.Key[testContext]{
+					&pathtest.Key[testContext]{S: ottltest.Strp("x-tenant-id")},
+					&pathtest.Key[testContext]{I: ottltest.Intp(0)},
+				},
+			},
+		}))
+		all := get(t, ctx, metadataPath).(pcommon.Map)
+		assert.Equal(t, map[string]any{
+			"x-tenant-id":  []any{"tenant-a"},
+			"x-request-id": []any{"abc"},
+			"x-scope":      []any{"a", "b"},
+		}, all.AsRaw())
+
+		// The incoming metadata is unchanged until the changes are applied
+		assert.Equal(t, []string{"incoming"}, client.FromContext(ctx).Metadata.Get("x-tenant-id"))
+		applied := client.FromContext(ApplyOutgoingMetadata(ctx)).Metadata
+		assert.Equal(t, []string{"tenant-a"}, applied.Get("x-tenant-id"))
+		assert.Equal(t, []string{"abc"}, applied.Get("x-request-id"))
+		assert.Equal(t, []string{"a", "b"}, applied.Get("x-scope"))
+	})
+
+	t.Run("remove specific key", func(t *testing.T) {
+		ctx := newContext(t)
+		set(t, ctx, keyPath("x-request-id"), nil)
+
+		assert.Nil(t, get(t, ctx, keyPath("x-request-id")))
+		applied := client.FromContext(ApplyOutgoingMetadata(ctx)).Metadata
+		assert.Empty(t, applied.Get("x-request-id"))
+		assert.Equal(t, []string{"incoming"}, applied.Get("x-tenant-id"))
+	})
+
+	t.Run("set entire metadata", func(t *testing.T) {
+		ctx := newContext(t)
+		m := pcommon.NewMap()
+		m.PutStr("x-tenant-id", "tenant-b")
+		set(t, ctx, metadataPath, m)
+
+		assert.Nil(t, get(t, ctx, keyPath("x-request-id")))
+		applied := client.FromContext(ApplyOutgoingMetadata(ctx)).Metadata
+		assert.Equal(t, []string{"tenant-b"}, applied.Get("x-tenant-id"))
+		assert.Empty(t, applied.Get("x-request-id"))
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		ctx := newContext(t)
+		assert.Equal(t, ctx, ApplyOutgoingMetadata(ctx))
+		assert.Equal(t, t.Context(), ApplyOutgoingMetadata(t.Context()))
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		ctx := newContext(t)
+		getSetter, err := PathGetSetter[testContext](keyPath("x-tenant-id"))
+		require.NoError(t, err)
+		require.ErrorContains(t, getSetter.Set(ctx, testContext{}, int64(1)), `invalid value for metadata key "x-tenant-id": unsupported type: int64`)
+		require.ErrorContains(t, getSetter.Set(ctx, testContext{}, []any{"a", int64(1)}), "unsupported type in slice: int64")
+
+		getSetter, err = PathGetSetter[testContext](metadataPath)
+		require.NoError(t, err)
+		require.ErrorContains(t, getSetter.Set(ctx, testContext{}, "tenant"), "unsupported type provided for setting client metadata: string")
+	})
+
+	t.Run("cannot set indexed value", func(t *testing.T) {
+		ctx := newContext(t)
+		getSetter, err := PathGetSetter[testContext](&pathtest.Path[testContext]{
+			N: "client",
+			NextPath: &pathtest.Path[testContext]{
+				N: "metadata",
+				KeySlice: []ottl.Key[testContext]{
+					&pathtest.Key[testContext]{S: ottltest.Strp("x-tenant-id")},
+					&pathtest.Key[testContext]{I: ottltest.Intp(0)},
+				},
+			},
+		})
+		require.NoError(t, err)
+		require.ErrorContains(t, getSetter.Set(ctx, testContext{}, "tenant"), "cannot set a single value of a metadata key")
+	})
+}
+
+func TestContextClientTLS(t *testing.T) {
+	notAfter := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	uri, err := url.Parse("spiffe://example.org/tenant-a")
+	require.NoError(t, err)
+	cert := &x509.Certificate{
+		Subject:        pkix.Name{CommonName: "tenant-a", Organization: []string{"Example"}},
+		Issuer:         pkix.Name{CommonName: "Example CA"},
+		NotAfter:       notAfter,
+		DNSNames:       []string{"tenant-a.example.org"},
+		EmailAddresses: []string{"ops@example.org"},
+		IPAddresses:    []net.IP{net.ParseIP("10.0.0.1")},
+		URIs:           []*url.URL{uri},
+	}
+	ctx := peer.NewContext(t.Context(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}},
+		},
+	})
+	tlsPath := func(name string, keys ...ottl.Key[testContext]) *pathtest.Path[testContext] {
+		return &pathtest.Path[testContext]{
+			N: "client",
+			NextPath: &pathtest.Path[testContext]{
+				N: "tls",
+				NextPath: &pathtest.Path[testContext]{
+					N:        name,
+					KeySlice: keys,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		path     ottl.Path[testContext]
+		expected any
+		errName  string
+	}{
+		{
+			name:     "subject",
+			path:     tlsPath("subject"),
+			expected: "CN=tenant-a,O=Example",
+			errName:  "otelcol.client.tls.subject",
+		},
+		{
+			name:     "issuer",
+			path:     tlsPath("issuer"),
+			expected: "CN=Example CA",
+			errName:  "otelcol.client.tls.issuer",
+		},
+		{
+			name:     "not_after",
+			path:     tlsPath("not_after"),
+			expected: notAfter,
+			errName:  "otelcol.client.tls.not_after",
+		},
+		{
+			name: "san",
+			path: tlsPath("san"),
+			expected: func() pcommon.Slice {
+				s := pcommon.NewSlice()
+				require.NoError(t, s.FromRaw([]any{"tenant-a.example.org", "ops@example.org", "10.0.0.1", "spiffe://example.org/tenant-a"}))
+				return s
+			}(),
+			errName: "otelcol.client.tls.san",
+		},
+		{
+			name:     "san index",
+			path:     tlsPath("san", &pathtest.Key[testContext]{I: ottltest.Intp(3)}),
+			expected: "spiffe://example.org/tenant-a",
+			errName:  "otelcol.client.tls.san",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getSetter, err := PathGetSetter[testContext](tt.path)
+			require.NoError(t, err)
+
+			val, err := getSetter.Get(ctx, testContext{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, val)
+
+			// Without a client certificate, the paths are nil
+			val, err = getSetter.Get(t.Context(), testContext{})
+			require.NoError(t, err)
+			assert.Nil(t, val)
+			val, err = getSetter.Get(peer.NewContext(t.Context(), &peer.Peer{}), testContext{})
+			require.NoError(t, err)
+			assert.Nil(t, val)
+
+			err = getSetter.Set(ctx, testContext{}, "new-value")
+			require.Error(t, err)
+			assert.Equal(t, fmt.Sprintf("%q is read-only and cannot be modified", tt.errName), err.Error())
+		})
+	}
+
+	t.Run("invalid paths", func(t *testing.T) {
+		for _, path := range []*pathtest.Path[testContext]{
+			{N: "client", NextPath: &pathtest.Path[testContext]{N: "tls"}},
+			tlsPath("serial"),
+			tlsPath("subject", &pathtest.Key[testContext]{S: ottltest.Strp("CN")}),
+		} {
+			_, err := PathGetSetter[testContext](path)
+			assert.Error(t, err)
+		}
+	})
+}
+
 func TestContextClientAddr(t *testing.T) {
 	path := &pathtest.Path[testContext]{
 		N: "client",
@@ -377,6 +625,167 @@ func TestContextClientAuthAttributes_AllAndKey(t *testing.T) {
 	})
 }
 
+func TestContextCollectorInfo(t *testing.T) {
+	hostname, err := os.Hostname()
+	require.NoError(t, err)
+	newPath := func(first, second string) *pathtest.Path[testContext] {
+		return &pathtest.Path[testContext]{
+			N: first,
+			NextPath: &pathtest.Path[testContext]{
+				N: second,
+			},
+		}
+	}
+	ctx := NewCollectorInfoContext(t.Context(), CollectorInfo{
+		Version:  "v1.2.3",
+		Hostname: "collector-0",
+	})
+
+	tests := []struct {
+		name     string
+		path     ottl.Path[testContext]
+		expected any
+		empty    any
+		errName  string
+	}{
+		{
+			name:     "collector version",
+			path:     newPath("collector", "version"),
+			expected: "v1.2.3",
+			errName:  "otelcol.collector.version",
+		},
+		{
+			name:     "collector hostname",
+			path:     newPath("collector", "hostname"),
+			expected: "collector-0",
+			empty:    hostname,
+			errName:  "otelcol.collector.hostname",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getSetter, err := PathGetSetter[testContext](tt.path)
+			require.NoError(t, err)
+
+			val, err := getSetter.Get(ctx, testContext{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, val)
+
+			val, err = getSetter.Get(t.Context(), testContext{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.empty, val)
+
+			err = getSetter.Set(ctx, testContext{}, "new-value")
+			require.Error(t, err)
+			assert.Equal(t, fmt.Sprintf("%q is read-only and cannot be modified", tt.errName), err.Error())
+		})
+	}
+
+	t.Run("invalid paths", func(t *testing.T) {
+		for _, path := range []*pathtest.Path[testContext]{
+			{N: "collector"},
+			{N: "pipeline"},
+			newPath("collector", "name"),
+			newPath("pipeline", "id"),
+			{
+				N: "collector",
+				NextPath: &pathtest.Path[testContext]{
+					N:        "version",
+					KeySlice: []ottl.Key[testContext]{&pathtest.Key[testContext]{S: ottltest.Strp("key")}},
+				},
+			},
+		} {
+			_, err := PathGetSetter[testContext](path)
+			assert.Error(t, err)
+		}
+	})
+}
+
+func TestContextHTTP(t *testing.T) {
+	httpPath := func(name string) *pathtest.Path[testContext] {
+		return &pathtest.Path[testContext]{
+			N: "http",
+			NextPath: &pathtest.Path[testContext]{
+				N: name,
+			},
+		}
+	}
+	ctx := client.NewContext(t.Context(), client.Info{
+		Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 43512},
+		Metadata: client.NewMetadata(map[string][]string{
+			"User-Agent":       {"OTel-OTLP-Exporter-Go/1.30.0"},
+			"Content-Encoding": {"gzip"},
+		}),
+	})
+
+	tests := []struct {
+		name     string
+		path     ottl.Path[testContext]
+		expected any
+		errName  string
+	}{
+		{
+			name:     "user_agent",
+			path:     httpPath("user_agent"),
+			expected: "OTel-OTLP-Exporter-Go/1.30.0",
+			errName:  "otelcol.http.user_agent",
+		},
+		{
+			name:     "content_encoding",
+			path:     httpPath("content_encoding"),
+			expected: "gzip",
+			errName:  "otelcol.http.content_encoding",
+		},
+		{
+			name:     "remote_port",
+			path:     httpPath("remote_port"),
+			expected: int64(43512),
+			errName:  "otelcol.http.remote_port",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getSetter, err := PathGetSetter[testContext](tt.path)
+			require.NoError(t, err)
+
+			val, err := getSetter.Get(ctx, testContext{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, val)
+
+			val, err = getSetter.Get(t.Context(), testContext{})
+			require.NoError(t, err)
+			assert.Nil(t, val)
+
+			err = getSetter.Set(ctx, testContext{}, "new-value")
+			require.Error(t, err)
+			assert.Equal(t, fmt.Sprintf("%q is read-only and cannot be modified", tt.errName), err.Error())
+		})
+	}
+
+	t.Run("remote_port from address string", func(t *testing.T) {
+		getSetter, err := PathGetSetter[testContext](httpPath("remote_port"))
+		require.NoError(t, err)
+
+		val, err := getSetter.Get(client.NewContext(t.Context(), client.Info{Addr: testAddr{s: "[::1]:8080"}}), testContext{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(8080), val)
+
+		val, err = getSetter.Get(client.NewContext(t.Context(), client.Info{Addr: testAddr{s: "/tmp/otel.sock"}}), testContext{})
+		require.NoError(t, err)
+		assert.Nil(t, val)
+	})
+
+	t.Run("invalid paths", func(t *testing.T) {
+		for _, path := range []*pathtest.Path[testContext]{
+			{N: "http"},
+			httpPath("method"),
+		} {
+			_, err := PathGetSetter[testContext](path)
+			assert.Error(t, err)
+		}
+	})
+}
+
 func TestContextGrpcMetadata(t *testing.T) {
 	base := t.Context()
 	// include client context too, to ensure coexistence
@@ -477,6 +886,96 @@ func TestContextGrpcMetadata(t *testing.T) {
 	})
 }
 
+func TestContextGRPCDetails(t *testing.T) {
+	ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs(
+		":authority", "collector:4317",
+		"content-type", "application/grpc",
+	))
+	ctx = grpc.NewContextWithServerTransportStream(ctx, &testServerTransportStream{
+		method:      "/opentelemetry.proto.collector.logs.v1.LogsService/Export",
+		compression: "gzip",
+		compressors: []string{"gzip", "zstd"},
+	})
+	ctx = peer.NewContext(ctx, &peer.Peer{
+		Addr:      testAddr{s: "192.168.1.10:51234"},
+		LocalAddr: testAddr{s: "10.0.0.1:4317"},
+	})
+
+	tests := []struct {
+		name     string
+		expected any
+	}{
+		{
+			name:     "method",
+			expected: "/opentelemetry.proto.collector.logs.v1.LogsService/Export",
+		},
+		{
+			name:     "authority",
+			expected: "collector:4317",
+		},
+		{
+			name:     "content_type",
+			expected: "application/grpc",
+		},
+		{
+			name:     "compression",
+			expected: "gzip",
+		},
+		{
+			name:     "accepted_compressions",
+			expected: []any{"gzip", "zstd"},
+		},
+		{
+			name:     "transport",
+			expected: "tcp",
+		},
+		{
+			name:     "local_addr",
+			expected: "10.0.0.1:4317",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := &pathtest.Path[testContext]{
+				N: "grpc",
+				NextPath: &pathtest.Path[testContext]{
+					N: tt.name,
+				},
+			}
+			getter, err := PathGetSetter[testContext](path)
+			require.NoError(t, err)
+
+			val, err := getter.Get(ctx, testContext{})
+			require.NoError(t, err)
+			if sl, ok := val.(pcommon.Slice); ok {
+				val = sl.AsRaw()
+			}
+			assert.Equal(t, tt.expected, val)
+
+			val, err = getter.Get(t.Context(), testContext{})
+			require.NoError(t, err)
+			assert.Nil(t, val)
+
+			err = getter.Set(ctx, testContext{}, "x")
+			assert.EqualError(t, err, fmt.Sprintf("%q is read-only and cannot be modified", "otelcol.grpc."+tt.name))
+		})
+	}
+
+	t.Run("keys are not supported", func(t *testing.T) {
+		path := &pathtest.Path[testContext]{
+			N: "grpc",
+			NextPath: &pathtest.Path[testContext]{
+				N: "method",
+				KeySlice: []ottl.Key[testContext]{
+					&pathtest.Key[testContext]{S: ottltest.Strp("k")},
+				},
+			},
+		}
+		_, err := PathGetSetter[testContext](path)
+		assert.Error(t, err)
+	})
+}
+
 func Test_enableOTelColContextFeatureGate(t *testing.T) {
 	original := featureMetadata.OttlContextsEnableOTelColContextFeatureGate.IsEnabled()
 	defer func() {
@@ -514,3 +1013,16 @@ func (a testAuth) GetAttributeNames() []string {
 	}
 	return names
 }
+
+type testServerTransportStream struct {
+	method      string
+	compression string
+	compressors []string
+}
+
+func (s *testServerTransportStream) Method() string                        { return s.method }
+func (*testServerTransportStream) SetHeader(metadata.MD) error             { return nil }
+func (*testServerTransportStream) SendHeader(metadata.MD) error            { return nil }
+func (*testServerTransportStream) SetTrailer(metadata.MD) error            { return nil }
+func (s *testServerTransportStream) RecvCompress() string                  { return s.compression }
+func (s *testServerTransportStream) ClientAdvertisedCompressors() []string { return s.compressors }
@@ -0,0 +1,190 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ctxotelcol // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxotelcol"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+type outgoingMetadataKey struct{}
+
+// OutgoingMetadata collects the changes made to the client metadata by OTTL statements,
+// so they can be applied to the context passed to the next consumer.
+type OutgoingMetadata struct {
+	mu sync.Mutex
+	// replaced is set when the whole metadata is set, hiding the incoming metadata.
+	replaced bool
+	// values holds the metadata set by key. A nil value means the key was removed.
+	values map[string][]string
+}
+
+// NewOutgoingMetadataContext returns a context carrying an empty OutgoingMetadata.
+func NewOutgoingMetadataContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, outgoingMetadataKey{}, &OutgoingMetadata{values: map[string][]string{}})
+}
+
+// OutgoingMetadataFromContext returns the OutgoingMetadata carried by the context, or nil.
+func OutgoingMetadataFromContext(ctx context.Context) *OutgoingMetadata {
+	md, _ := ctx.Value(outgoingMetadataKey{}).(*OutgoingMetadata)
+	return md
+}
+
+// ApplyOutgoingMetadata returns a context whose client metadata includes the changes
+// collected by the OutgoingMetadata carried by ctx. The context is returned unchanged
+// if it carries no changes.
+func ApplyOutgoingMetadata(ctx context.Context) context.Context {
+	out := OutgoingMetadataFromContext(ctx)
+	if out == nil {
+		return ctx
+	}
+	out.mu.Lock()
+	defer out.mu.Unlock()
+	if !out.replaced && len(out.values) == 0 {
+		return ctx
+	}
+	info := client.FromContext(ctx)
+	merged := make(map[string][]string)
+	if !out.replaced {
+		for k := range info.Metadata.Keys() {
+			merged[k] = info.Metadata.Get(k)
+		}
+	}
+	for k, v := range out.values {
+		if v == nil {
+			delete(merged, k)
+			continue
+		}
+		merged[k] = v
+	}
+	info.Metadata = client.NewMetadata(merged)
+	return client.NewContext(ctx, info)
+}
+
+func (o *OutgoingMetadata) get(ctx context.Context, key string) []string {
+	if o != nil {
+		o.mu.Lock()
+		defer o.mu.Unlock()
+		if v, ok := o.values[key]; ok {
+			return v
+		}
+		if o.replaced {
+			return nil
+		}
+	}
+	return client.FromContext(ctx).Metadata.Get(key)
+}
+
+func (o *OutgoingMetadata) all(ctx context.Context) pcommon.Map {
+	mdMap := pcommon.NewMap()
+	if o == nil || !o.replaced {
+		md := client.FromContext(ctx).Metadata
+		for k := range md.Keys() {
+			convertStringArrToValueSlice(md.Get(k)).MoveTo(mdMap.PutEmpty(k))
+		}
+	}
+	if o == nil {
+		return mdMap
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for k, v := range o.values {
+		if v == nil {
+			mdMap.Remove(k)
+			continue
+		}
+		convertStringArrToValueSlice(v).MoveTo(mdMap.PutEmpty(k))
+	}
+	return mdMap
+}
+
+func (o *OutgoingMetadata) setAll(val any) error {
+	m, err := metadataMapFromValue(val)
+	if err != nil {
+		return err
+	}
+	values := make(map[string][]string, m.Len())
+	for k, v := range m.All() {
+		vals, err := metadataValues(v.AsRaw())
+		if err != nil {
+			return fmt.Errorf("invalid value for metadata key %q: %w", k, err)
+		}
+		if vals != nil {
+			values[k] = vals
+		}
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.replaced = true
+	o.values = values
+	return nil
+}
+
+func (o *OutgoingMetadata) set(key string, val any) error {
+	vals, err := metadataValues(val)
+	if err != nil {
+		return fmt.Errorf("invalid value for metadata key %q: %w", key, err)
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.values[key] = vals
+	return nil
+}
+
+func metadataMapFromValue(val any) (pcommon.Map, error) {
+	if val == nil {
+		return pcommon.NewMap(), nil
+	}
+	if md, ok := val.(client.Metadata); ok {
+		m := pcommon.NewMap()
+		for k := range md.Keys() {
+			convertStringArrToValueSlice(md.Get(k)).MoveTo(m.PutEmpty(k))
+		}
+		return m, nil
+	}
+	if m, ok := val.(pcommon.Map); ok {
+		return m, nil
+	}
+	if rm, ok := val.(map[string]any); ok {
+		m := pcommon.NewMap()
+		if err := m.FromRaw(rm); err != nil {
+			return pcommon.Map{}, err
+		}
+		return m, nil
+	}
+	return pcommon.Map{}, fmt.Errorf("unsupported type provided for setting client metadata: %T", val)
+}
+
+// metadataValues converts a value set by a statement into metadata values.
+// A nil value removes the key.
+func metadataValues(val any) ([]string, error) {
+	switch v := val.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case []string:
+		return append([]string{}, v...), nil
+	case pcommon.Value:
+		return metadataValues(v.AsRaw())
+	case pcommon.Slice:
+		return metadataValues(v.AsRaw())
+	case []any:
+		vals := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("unsupported type in slice: %T", item)
+			}
+			vals = append(vals, s)
+		}
+		return vals, nil
+	default:
+		return nil, fmt.Errorf("unsupported type: %T", val)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ctxotelcol // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxotelcol"
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxerror"
+)
+
+func accessClientTLS[K any](path ottl.Path[K]) (ottl.GetSetter[K], error) {
+	nextPath := path.Next()
+	if nextPath == nil {
+		return nil, ctxerror.New(path.Name(), path.String(), Name, DocRef)
+	}
+	if nextPath.Next() != nil {
+		return nil, ctxerror.New(nextPath.Next().Name(), nextPath.Next().String(), Name, DocRef)
+	}
+	if nextPath.Keys() != nil && nextPath.Name() != "san" {
+		return nil, ctxerror.New(nextPath.Name(), nextPath.String(), Name, DocRef)
+	}
+	switch nextPath.Name() {
+	case "subject":
+		return accessClientTLSCertificate[K]("otelcol.client.tls.subject", func(cert *x509.Certificate) any {
+			return cert.Subject.String()
+		}), nil
+	case "issuer":
+		return accessClientTLSCertificate[K]("otelcol.client.tls.issuer", func(cert *x509.Certificate) any {
+			return cert.Issuer.String()
+		}), nil
+	case "not_after":
+		return accessClientTLSCertificate[K]("otelcol.client.tls.not_after", func(cert *x509.Certificate) any {
+			return cert.NotAfter
+		}), nil
+	case "san":
+		keys := nextPath.Keys()
+		return ottl.StandardGetSetter[K]{
+			Getter: func(ctx context.Context, tCtx K) (any, error) {
+				cert := peerCertificate(ctx)
+				if cert == nil {
+					return nil, nil
+				}
+				sans := subjectAltNames(cert)
+				if len(keys) > 0 && len(sans) == 0 {
+					return nil, nil
+				}
+				return getIndexableValueFromStringArr(ctx, tCtx, keys, sans)
+			},
+			Setter: func(_ context.Context, _ K, _ any) error {
+				return fmt.Errorf(readOnlyPathErrMsg, "otelcol.client.tls.san")
+			},
+		}, nil
+	default:
+		return nil, ctxerror.New(nextPath.Name(), nextPath.String(), Name, DocRef)
+	}
+}
+
+func accessClientTLSCertificate[K any](name string, field func(*x509.Certificate) any) ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, _ K) (any, error) {
+			cert := peerCertificate(ctx)
+			if cert == nil {
+				return nil, nil
+			}
+			return field(cert), nil
+		},
+		Setter: func(_ context.Context, _ K, _ any) error {
+			return fmt.Errorf(readOnlyPathErrMsg, name)
+		},
+	}
+}
+
+// peerCertificate returns the certificate presented by the client, or nil if the
+// request was not received over mutual TLS. client.Info does not carry the TLS
+// state, so it is taken from the gRPC peer kept in the context.
+func peerCertificate(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return nil
+	}
+	return tlsInfo.State.PeerCertificates[0]
+}
+
+// subjectAltNames returns the DNS names, email addresses, IP addresses and URIs
+// of the certificate, in that order.
+func subjectAltNames(cert *x509.Certificate) []string {
+	sans := make([]string, 0, len(cert.DNSNames)+len(cert.EmailAddresses)+len(cert.IPAddresses)+len(cert.URIs))
+	sans = append(sans, cert.DNSNames...)
+	sans = append(sans, cert.EmailAddresses...)
+	for _, ip := range cert.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	for _, uri := range cert.URIs {
+		sans = append(sans, uri.String())
+	}
+	return sans
+}
@@ -0,0 +1,146 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ctxprofilesample // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxprofilesample"
+
+import (
+	"context"
+	"encoding/hex"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxcommon"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxerror"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxutil"
+)
+
+func accessLink[K Context](path ottl.Path[K]) (ottl.GetSetter[K], error) {
+	nextPath := path.Next()
+	if nextPath == nil {
+		return nil, ctxerror.New(path.Name(), path.String(), Name, DocRef)
+	}
+	stringPath := nextPath.Next()
+	if stringPath != nil && (stringPath.Name() != "string" || stringPath.Next() != nil) {
+		return nil, ctxerror.New(stringPath.Name(), stringPath.String(), Name, DocRef)
+	}
+	switch nextPath.Name() {
+	case "trace_id":
+		if stringPath != nil {
+			return accessLinkStringTraceID[K](), nil
+		}
+		return accessLinkTraceID[K](), nil
+	case "span_id":
+		if stringPath != nil {
+			return accessLinkStringSpanID[K](), nil
+		}
+		return accessLinkSpanID[K](), nil
+	default:
+		return nil, ctxerror.New(nextPath.Name(), nextPath.String(), Name, DocRef)
+	}
+}
+
+func accessLinkTraceID[K Context]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			return getLink(tCtx).TraceID(), nil
+		},
+		Setter: func(_ context.Context, tCtx K, val any) error {
+			id, err := ctxutil.ExpectType[pcommon.TraceID](val)
+			if err != nil {
+				return err
+			}
+			return updateLink(tCtx, func(link pprofile.Link) {
+				link.SetTraceID(id)
+			})
+		},
+	}
+}
+
+func accessLinkStringTraceID[K Context]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			id := getLink(tCtx).TraceID()
+			return hex.EncodeToString(id[:]), nil
+		},
+		Setter: func(_ context.Context, tCtx K, val any) error {
+			str, err := ctxutil.ExpectType[string](val)
+			if err != nil {
+				return err
+			}
+			id, err := ctxcommon.ParseTraceID(str)
+			if err != nil {
+				return err
+			}
+			return updateLink(tCtx, func(link pprofile.Link) {
+				link.SetTraceID(id)
+			})
+		},
+	}
+}
+
+func accessLinkSpanID[K Context]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			return getLink(tCtx).SpanID(), nil
+		},
+		Setter: func(_ context.Context, tCtx K, val any) error {
+			id, err := ctxutil.ExpectType[pcommon.SpanID](val)
+			if err != nil {
+				return err
+			}
+			return updateLink(tCtx, func(link pprofile.Link) {
+				link.SetSpanID(id)
+			})
+		},
+	}
+}
+
+func accessLinkStringSpanID[K Context]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			id := getLink(tCtx).SpanID()
+			return hex.EncodeToString(id[:]), nil
+		},
+		Setter: func(_ context.Context, tCtx K, val any) error {
+			str, err := ctxutil.ExpectType[string](val)
+			if err != nil {
+				return err
+			}
+			id, err := ctxcommon.ParseSpanID(str)
+			if err != nil {
+				return err
+			}
+			return updateLink(tCtx, func(link pprofile.Link) {
+				link.SetSpanID(id)
+			})
+		},
+	}
+}
+
+// getLink returns the link of the sample from the link table of the dictionary,
+// or an empty link if the sample has no valid link index.
+func getLink[K Context](tCtx K) pprofile.Link {
+	table := tCtx.GetProfilesDictionary().LinkTable()
+	idx := int(tCtx.GetProfileSample().LinkIndex())
+	if idx < 0 || idx >= table.Len() {
+		return pprofile.NewLink()
+	}
+	return table.At(idx)
+}
+
+// updateLink sets the link index of the sample to a link updated by the function. As the entries
+// of the link table are shared by the samples, the current link is not modified: the updated
+// link is added to the table, unless an equal link already exists.
+func updateLink[K Context](tCtx K, update func(pprofile.Link)) error {
+	link := pprofile.NewLink()
+	getLink(tCtx).CopyTo(link)
+	update(link)
+	idx, err := pprofile.SetLink(tCtx.GetProfilesDictionary().LinkTable(), link)
+	if err != nil {
+		return err
+	}
+	tCtx.GetProfileSample().SetLinkIndex(idx)
+	return nil
+}
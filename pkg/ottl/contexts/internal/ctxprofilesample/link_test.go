@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ctxprofilesample // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxprofilesample"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/pathtest"
+)
+
+var (
+	testTraceID = pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	testSpanID  = pcommon.SpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+)
+
+func newLinkPath(names ...string) *pathtest.Path[*profileSampleContext] {
+	path := &pathtest.Path[*profileSampleContext]{N: "link"}
+	current := path
+	for _, name := range names {
+		next := &pathtest.Path[*profileSampleContext]{N: name}
+		current.NextPath = next
+		current = next
+	}
+	return path
+}
+
+func newLinkDictionary() (pprofile.ProfilesDictionary, pprofile.Sample) {
+	dictionary := pprofile.NewProfilesDictionary()
+	dictionary.LinkTable().AppendEmpty()
+	link := dictionary.LinkTable().AppendEmpty()
+	link.SetTraceID(testTraceID)
+	link.SetSpanID(testSpanID)
+	sample := pprofile.NewSample()
+	sample.SetLinkIndex(1)
+	return dictionary, sample
+}
+
+func TestLinkPathGetSetter(t *testing.T) {
+	newTraceID := pcommon.TraceID([16]byte{16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1})
+	newSpanID := pcommon.SpanID([8]byte{8, 7, 6, 5, 4, 3, 2, 1})
+	tests := []struct {
+		name     string
+		path     ottl.Path[*profileSampleContext]
+		orig     any
+		newVal   any
+		expected func(pprofile.Link)
+	}{
+		{
+			name:   "trace_id",
+			path:   newLinkPath("trace_id"),
+			orig:   testTraceID,
+			newVal: newTraceID,
+			expected: func(link pprofile.Link) {
+				link.SetTraceID(newTraceID)
+				link.SetSpanID(testSpanID)
+			},
+		},
+		{
+			name:   "trace_id string",
+			path:   newLinkPath("trace_id", "string"),
+			orig:   "0102030405060708090a0b0c0d0e0f10",
+			newVal: "100f0e0d0c0b0a090807060504030201",
+			expected: func(link pprofile.Link) {
+				link.SetTraceID(newTraceID)
+				link.SetSpanID(testSpanID)
+			},
+		},
+		{
+			name:   "span_id",
+			path:   newLinkPath("span_id"),
+			orig:   testSpanID,
+			newVal: newSpanID,
+			expected: func(link pprofile.Link) {
+				link.SetTraceID(testTraceID)
+				link.SetSpanID(newSpanID)
+			},
+		},
+		{
+			name:   "span_id string",
+			path:   newLinkPath("span_id", "string"),
+			orig:   "0102030405060708",
+			newVal: "0807060504030201",
+			expected: func(link pprofile.Link) {
+				link.SetTraceID(testTraceID)
+				link.SetSpanID(newSpanID)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dictionary, sample := newLinkDictionary()
+			tCtx := newProfileSampleContext(sample, dictionary)
+
+			accessor, err := PathGetSetter(tt.path)
+			require.NoError(t, err)
+
+			got, err := accessor.Get(t.Context(), tCtx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.orig, got)
+
+			require.NoError(t, accessor.Set(t.Context(), tCtx, tt.newVal))
+			got, err = accessor.Get(t.Context(), tCtx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.newVal, got)
+
+			// The shared link is not modified, a new link is added to the table.
+			assert.Equal(t, testTraceID, dictionary.LinkTable().At(1).TraceID())
+			assert.Equal(t, int32(2), sample.LinkIndex())
+			expected := pprofile.NewLink()
+			tt.expected(expected)
+			assert.True(t, expected.Equal(dictionary.LinkTable().At(2)))
+
+			err = accessor.Set(t.Context(), tCtx, struct{}{})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestLinkPathGetSetter_reusesExistingLink(t *testing.T) {
+	dictionary, sample := newLinkDictionary()
+	sample.SetLinkIndex(0)
+	tCtx := newProfileSampleContext(sample, dictionary)
+
+	traceIDAccessor, err := PathGetSetter(newLinkPath("trace_id"))
+	require.NoError(t, err)
+	spanIDAccessor, err := PathGetSetter(newLinkPath("span_id"))
+	require.NoError(t, err)
+
+	require.NoError(t, traceIDAccessor.Set(t.Context(), tCtx, testTraceID))
+	require.NoError(t, spanIDAccessor.Set(t.Context(), tCtx, testSpanID))
+	assert.Equal(t, int32(1), sample.LinkIndex())
+	assert.Equal(t, 3, dictionary.LinkTable().Len())
+}
+
+func TestLinkPathGetSetter_invalidIndex(t *testing.T) {
+	sample := pprofile.NewSample()
+	sample.SetLinkIndex(10)
+	tCtx := newProfileSampleContext(sample, pprofile.NewProfilesDictionary())
+
+	accessor, err := PathGetSetter(newLinkPath("trace_id"))
+	require.NoError(t, err)
+	got, err := accessor.Get(t.Context(), tCtx)
+	require.NoError(t, err)
+	assert.Equal(t, pcommon.NewTraceIDEmpty(), got)
+}
+
+func TestLinkPathGetSetter_invalidPath(t *testing.T) {
+	for _, path := range []ottl.Path[*profileSampleContext]{
+		newLinkPath(),
+		newLinkPath("unknown"),
+		newLinkPath("trace_id", "unknown"),
+		newLinkPath("span_id", "string", "unknown"),
+	} {
+		_, err := PathGetSetter(path)
+		assert.Error(t, err)
+	}
+}
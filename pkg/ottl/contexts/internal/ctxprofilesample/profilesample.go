@@ -30,6 +30,16 @@ func PathGetSetter[K Context](path ottl.Path[K]) (ottl.GetSetter[K], error) {
 		return accessAttributeIndices[K](), nil
 	case "link_index":
 		return accessLinkIndex[K](), nil
+	case "link":
+		return accessLink(path)
+	case "stack_index":
+		return accessStackIndex[K](), nil
+	case "mapping_filenames":
+		return accessMappingFilenames[K](), nil
+	case "function_names":
+		return accessFunctionNames[K](), nil
+	case "function_filenames":
+		return accessFunctionFilenames[K](), nil
 	case "timestamps_unix_nano":
 		return accessTimestampsUnixNano[K](), nil
 	case "timestamps":
@@ -88,6 +98,25 @@ func accessLinkIndex[K Context]() ottl.StandardGetSetter[K] {
 	}
 }
 
+func accessStackIndex[K Context]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			return int64(tCtx.GetProfileSample().StackIndex()), nil
+		},
+		Setter: func(_ context.Context, tCtx K, val any) error {
+			v, err := ctxutil.ExpectType[int64](val)
+			if err != nil {
+				return err
+			}
+			if v >= math.MaxInt32 {
+				return errMaxValueExceed
+			}
+			tCtx.GetProfileSample().SetStackIndex(int32(v))
+			return nil
+		},
+	}
+}
+
 func accessTimestampsUnixNano[K Context]() ottl.StandardGetSetter[K] {
 	return ottl.StandardGetSetter[K]{
 		Getter: func(_ context.Context, tCtx K) (any, error) {
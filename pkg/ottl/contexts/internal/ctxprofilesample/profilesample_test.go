@@ -36,6 +36,10 @@ func TestPathGetSetter(t *testing.T) {
 			path: "link_index",
 			val:  int64(44),
 		},
+		{
+			path: "stack_index",
+			val:  int64(12),
+		},
 		{
 			path: "timestamps_unix_nano",
 			val:  []int64{tsNow.Unix(), 2, 3},
@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ctxprofilesample // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxprofilesample"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pprofile"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+const readOnlyPathErrMsg = "%q is read-only and cannot be modified"
+
+// accessMappingFilenames returns the file names of the mappings of the locations of the
+// sample's stack, with one entry per location.
+func accessMappingFilenames[K Context]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			dict := tCtx.GetProfilesDictionary()
+			filenames := []string{}
+			for _, location := range stackLocations(tCtx) {
+				filename := ""
+				if idx := int(location.MappingIndex()); idx >= 0 && idx < dict.MappingTable().Len() {
+					filename = stringAt(dict, dict.MappingTable().At(idx).FilenameStrindex())
+				}
+				filenames = append(filenames, filename)
+			}
+			return filenames, nil
+		},
+		Setter: func(context.Context, K, any) error {
+			return fmt.Errorf(readOnlyPathErrMsg, Name+".mapping_filenames")
+		},
+	}
+}
+
+// accessFunctionNames returns the names of the functions of the sample's stack,
+// with one entry per line of its locations, starting from the leaf.
+func accessFunctionNames[K Context]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			dict := tCtx.GetProfilesDictionary()
+			names := []string{}
+			for _, function := range stackFunctions(tCtx) {
+				names = append(names, stringAt(dict, function.NameStrindex()))
+			}
+			return names, nil
+		},
+		Setter: func(context.Context, K, any) error {
+			return fmt.Errorf(readOnlyPathErrMsg, Name+".function_names")
+		},
+	}
+}
+
+// accessFunctionFilenames returns the source file names of the functions of the sample's
+// stack, with one entry per line of its locations, starting from the leaf.
+func accessFunctionFilenames[K Context]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			dict := tCtx.GetProfilesDictionary()
+			filenames := []string{}
+			for _, function := range stackFunctions(tCtx) {
+				filenames = append(filenames, stringAt(dict, function.FilenameStrindex()))
+			}
+			return filenames, nil
+		},
+		Setter: func(context.Context, K, any) error {
+			return fmt.Errorf(readOnlyPathErrMsg, Name+".function_filenames")
+		},
+	}
+}
+
+// stackLocations returns the locations of the sample's stack, ignoring the invalid indices.
+func stackLocations[K Context](tCtx K) []pprofile.Location {
+	dict := tCtx.GetProfilesDictionary()
+	stackIdx := int(tCtx.GetProfileSample().StackIndex())
+	if stackIdx < 0 || stackIdx >= dict.StackTable().Len() {
+		return nil
+	}
+	var locations []pprofile.Location
+	for _, idx := range dict.StackTable().At(stackIdx).LocationIndices().All() {
+		if int(idx) >= 0 && int(idx) < dict.LocationTable().Len() {
+			locations = append(locations, dict.LocationTable().At(int(idx)))
+		}
+	}
+	return locations
+}
+
+// stackFunctions returns the functions of the lines of the sample's stack locations,
+// ignoring the invalid indices.
+func stackFunctions[K Context](tCtx K) []pprofile.Function {
+	dict := tCtx.GetProfilesDictionary()
+	var functions []pprofile.Function
+	for _, location := range stackLocations(tCtx) {
+		for _, line := range location.Lines().All() {
+			if idx := int(line.FunctionIndex()); idx >= 0 && idx < dict.FunctionTable().Len() {
+				functions = append(functions, dict.FunctionTable().At(idx))
+			}
+		}
+	}
+	return functions
+}
+
+func stringAt(dict pprofile.ProfilesDictionary, idx int32) string {
+	if int(idx) < 0 || int(idx) >= dict.StringTable().Len() {
+		return ""
+	}
+	return dict.StringTable().At(int(idx))
+}
@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ctxprofilesample // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxprofilesample"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/pathtest"
+)
+
+// newStackDictionary returns a dictionary with a stack of two locations: the first one
+// has an inlined function, and the second one has no mapping.
+func newStackDictionary() pprofile.ProfilesDictionary {
+	dictionary := pprofile.NewProfilesDictionary()
+	dictionary.StringTable().FromRaw([]string{"", "libc.so", "main", "main.go", "inlined", "inline.go", "unknown"})
+
+	dictionary.MappingTable().AppendEmpty()
+	dictionary.MappingTable().AppendEmpty().SetFilenameStrindex(1)
+
+	dictionary.FunctionTable().AppendEmpty()
+	mainFunction := dictionary.FunctionTable().AppendEmpty()
+	mainFunction.SetNameStrindex(2)
+	mainFunction.SetFilenameStrindex(3)
+	inlinedFunction := dictionary.FunctionTable().AppendEmpty()
+	inlinedFunction.SetNameStrindex(4)
+	inlinedFunction.SetFilenameStrindex(5)
+
+	dictionary.LocationTable().AppendEmpty()
+	first := dictionary.LocationTable().AppendEmpty()
+	first.SetMappingIndex(1)
+	first.Lines().AppendEmpty().SetFunctionIndex(2)
+	first.Lines().AppendEmpty().SetFunctionIndex(1)
+	second := dictionary.LocationTable().AppendEmpty()
+	second.SetMappingIndex(0)
+	second.Lines().AppendEmpty().SetFunctionIndex(1)
+
+	dictionary.StackTable().AppendEmpty()
+	dictionary.StackTable().AppendEmpty().LocationIndices().FromRaw([]int32{1, 2, 42})
+	return dictionary
+}
+
+func TestStackPathGetSetter(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected []string
+	}{
+		{
+			path:     "mapping_filenames",
+			expected: []string{"libc.so", ""},
+		},
+		{
+			path:     "function_names",
+			expected: []string{"inlined", "main", "main"},
+		},
+		{
+			path:     "function_filenames",
+			expected: []string{"inline.go", "main.go", "main.go"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			sample := pprofile.NewSample()
+			sample.SetStackIndex(1)
+			tCtx := newProfileSampleContext(sample, newStackDictionary())
+
+			accessor, err := PathGetSetter(&pathtest.Path[*profileSampleContext]{N: tt.path})
+			require.NoError(t, err)
+
+			got, err := accessor.Get(t.Context(), tCtx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+
+			sample.SetStackIndex(10)
+			got, err = accessor.Get(t.Context(), tCtx)
+			require.NoError(t, err)
+			assert.Equal(t, []string{}, got)
+
+			err = accessor.Set(t.Context(), tCtx, []string{})
+			assert.EqualError(t, err, `"profilesample.`+tt.path+`" is read-only and cannot be modified`)
+		})
+	}
+}
@@ -92,10 +92,16 @@ func PathGetSetter[K Context](path ottl.Path[K]) (ottl.GetSetter[K], error) {
 	case "dropped_attributes_count":
 		return accessSpanDroppedAttributesCount[K](), nil
 	case "events":
+		if keys := path.Keys(); keys != nil {
+			return accessEventsKey[K](keys), nil
+		}
 		return accessEvents[K](), nil
 	case "dropped_events_count":
 		return accessDroppedEventsCount[K](), nil
 	case "links":
+		if keys := path.Keys(); keys != nil {
+			return accessLinksKey[K](keys), nil
+		}
 		return accessLinks[K](), nil
 	case "dropped_links_count":
 		return accessDroppedLinksCount[K](), nil
@@ -505,6 +511,32 @@ func accessEvents[K Context]() ottl.StandardGetSetter[K] {
 	}
 }
 
+func accessEventsKey[K Context](keys []ottl.Key[K]) ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, tCtx K) (any, error) {
+			events := tCtx.GetSpan().Events()
+			idx, err := getElementIndex(ctx, tCtx, events.Len(), keys)
+			if err != nil {
+				return nil, err
+			}
+			return events.At(idx), nil
+		},
+		Setter: func(ctx context.Context, tCtx K, val any) error {
+			event, err := ctxutil.ExpectType[ptrace.SpanEvent](val)
+			if err != nil {
+				return err
+			}
+			events := tCtx.GetSpan().Events()
+			idx, err := getElementIndex(ctx, tCtx, events.Len(), keys)
+			if err != nil {
+				return err
+			}
+			event.CopyTo(events.At(idx))
+			return nil
+		},
+	}
+}
+
 func accessDroppedEventsCount[K Context]() ottl.StandardGetSetter[K] {
 	return ottl.StandardGetSetter[K]{
 		Getter: func(_ context.Context, tCtx K) (any, error) {
@@ -540,6 +572,41 @@ func accessLinks[K Context]() ottl.StandardGetSetter[K] {
 	}
 }
 
+func accessLinksKey[K Context](keys []ottl.Key[K]) ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, tCtx K) (any, error) {
+			links := tCtx.GetSpan().Links()
+			idx, err := getElementIndex(ctx, tCtx, links.Len(), keys)
+			if err != nil {
+				return nil, err
+			}
+			return links.At(idx), nil
+		},
+		Setter: func(ctx context.Context, tCtx K, val any) error {
+			link, err := ctxutil.ExpectType[ptrace.SpanLink](val)
+			if err != nil {
+				return err
+			}
+			links := tCtx.GetSpan().Links()
+			idx, err := getElementIndex(ctx, tCtx, links.Len(), keys)
+			if err != nil {
+				return err
+			}
+			link.CopyTo(links.At(idx))
+			return nil
+		},
+	}
+}
+
+// getElementIndex returns the index of the event or link selected by the keys,
+// which can't index the fields of the element.
+func getElementIndex[K any](ctx context.Context, tCtx K, sliceLen int, keys []ottl.Key[K]) (int, error) {
+	if len(keys) > 1 {
+		return 0, errors.New("cannot index the fields of a span event or link, only a single index is supported")
+	}
+	return ctxutil.GetSliceIndexFromKeys(ctx, tCtx, sliceLen, keys)
+}
+
 func accessDroppedLinksCount[K Context]() ottl.StandardGetSetter[K] {
 	return ottl.StandardGetSetter[K]{
 		Getter: func(_ context.Context, tCtx K) (any, error) {
@@ -516,6 +516,22 @@ func TestPathGetSetter(t *testing.T) {
 				newEvents.CopyTo(span.Events())
 			},
 		},
+		{
+			name: "events negative index",
+			path: &pathtest.Path[*testContext]{
+				N: "events",
+				KeySlice: []ottl.Key[*testContext]{
+					&pathtest.Key[*testContext]{
+						I: ottltest.Intp(-1),
+					},
+				},
+			},
+			orig:   refSpan.Events().At(0),
+			newVal: newEvents.At(0),
+			modified: func(span ptrace.Span) {
+				newEvents.At(0).CopyTo(span.Events().At(0))
+			},
+		},
 		{
 			name: "dropped_events_count",
 			path: &pathtest.Path[*testContext]{
@@ -541,6 +557,22 @@ func TestPathGetSetter(t *testing.T) {
 				newLinks.CopyTo(span.Links())
 			},
 		},
+		{
+			name: "links index",
+			path: &pathtest.Path[*testContext]{
+				N: "links",
+				KeySlice: []ottl.Key[*testContext]{
+					&pathtest.Key[*testContext]{
+						I: ottltest.Intp(0),
+					},
+				},
+			},
+			orig:   refSpan.Links().At(0),
+			newVal: newLinks.At(0),
+			modified: func(span ptrace.Span) {
+				newLinks.At(0).CopyTo(span.Links().At(0))
+			},
+		},
 		{
 			name: "dropped_links_count",
 			path: &pathtest.Path[*testContext]{
@@ -640,6 +672,64 @@ func TestPathGetSetter(t *testing.T) {
 	}
 }
 
+func TestPathGetSetter_eventsAndLinksKeysInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		path ottl.Path[*testContext]
+		val  any
+		err  string
+	}{
+		{
+			name: "index out of bounds",
+			path: &pathtest.Path[*testContext]{
+				N: "events",
+				KeySlice: []ottl.Key[*testContext]{
+					&pathtest.Key[*testContext]{I: ottltest.Intp(-2)},
+				},
+			},
+			val: ptrace.NewSpanEvent(),
+			err: "index -2 out of bounds",
+		},
+		{
+			name: "range",
+			path: &pathtest.Path[*testContext]{
+				N: "links",
+				KeySlice: []ottl.Key[*testContext]{
+					&pathtest.Key[*testContext]{R: &ottl.KeyRange{}},
+				},
+			},
+			val: ptrace.NewSpanLink(),
+			err: "range [:] is not supported, expected an integer index",
+		},
+		{
+			name: "multiple keys",
+			path: &pathtest.Path[*testContext]{
+				N: "events",
+				KeySlice: []ottl.Key[*testContext]{
+					&pathtest.Key[*testContext]{I: ottltest.Intp(0)},
+					&pathtest.Key[*testContext]{S: ottltest.Strp("name")},
+				},
+			},
+			val: ptrace.NewSpanEvent(),
+			err: "cannot index the fields of a span event or link, only a single index is supported",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessor, err := ctxspan.PathGetSetter[*testContext](tt.path)
+			require.NoError(t, err)
+
+			span := createTelemetry()
+
+			_, err = accessor.Get(t.Context(), newTestContext(span))
+			assert.EqualError(t, err, tt.err)
+
+			err = accessor.Set(t.Context(), newTestContext(span), tt.val)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
 func createTelemetry() ptrace.Span {
 	span := ptrace.NewSpan()
 	span.SetTraceID(traceID)
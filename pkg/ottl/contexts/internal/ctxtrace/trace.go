@@ -0,0 +1,247 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ctxtrace // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxtrace"
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxerror"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxutil"
+)
+
+func PathGetSetter[K Context](path ottl.Path[K]) (ottl.GetSetter[K], error) {
+	if path == nil {
+		return nil, ctxerror.New("nil", "nil", Name, DocRef)
+	}
+	switch path.Name() {
+	case "trace_id":
+		nextPath := path.Next()
+		if nextPath != nil {
+			if nextPath.Name() == "string" {
+				return accessStringTraceID[K](), nil
+			}
+			return nil, ctxerror.New(nextPath.Name(), nextPath.String(), Name, DocRef)
+		}
+		return accessTraceID[K](), nil
+	case "span_count":
+		return accessSpanCount[K](), nil
+	case "error_count":
+		return accessErrorCount[K](), nil
+	case "root_span_name":
+		return accessRootSpanName[K](), nil
+	case "start_time_unix_nano":
+		return accessStartTimeUnixNano[K](), nil
+	case "end_time_unix_nano":
+		return accessEndTimeUnixNano[K](), nil
+	case "start_time":
+		return accessStartTime[K](), nil
+	case "end_time":
+		return accessEndTime[K](), nil
+	case "spans":
+		keys := path.Keys()
+		if keys == nil {
+			return accessSpans[K](), nil
+		}
+		return accessSpansKey[K](keys), nil
+	case "span_attributes":
+		keys := path.Keys()
+		if keys == nil {
+			return nil, fmt.Errorf("path %q must be indexed with the key of the span attributes", path.String())
+		}
+		return accessSpanAttributesKey[K](keys), nil
+	default:
+		return nil, ctxerror.New(path.Name(), path.String(), Name, DocRef)
+	}
+}
+
+func readOnly[K Context](name string, getter func(tCtx K) any) ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			return getter(tCtx), nil
+		},
+		Setter: func(context.Context, K, any) error {
+			return fmt.Errorf(readOnlyPathErrMsg, name)
+		},
+	}
+}
+
+func accessTraceID[K Context]() ottl.StandardGetSetter[K] {
+	return readOnly("trace.trace_id", func(tCtx K) any {
+		return tCtx.GetTraceID()
+	})
+}
+
+func accessStringTraceID[K Context]() ottl.StandardGetSetter[K] {
+	return readOnly("trace.trace_id.string", func(tCtx K) any {
+		id := tCtx.GetTraceID()
+		return hex.EncodeToString(id[:])
+	})
+}
+
+func accessSpanCount[K Context]() ottl.StandardGetSetter[K] {
+	return readOnly("trace.span_count", func(tCtx K) any {
+		return int64(len(tCtx.GetSpans()))
+	})
+}
+
+func accessErrorCount[K Context]() ottl.StandardGetSetter[K] {
+	return readOnly("trace.error_count", func(tCtx K) any {
+		var count int64
+		for _, span := range tCtx.GetSpans() {
+			if span.Status().Code() == ptrace.StatusCodeError {
+				count++
+			}
+		}
+		return count
+	})
+}
+
+func accessRootSpanName[K Context]() ottl.StandardGetSetter[K] {
+	return readOnly("trace.root_span_name", func(tCtx K) any {
+		for _, span := range tCtx.GetSpans() {
+			if span.ParentSpanID().IsEmpty() {
+				return span.Name()
+			}
+		}
+		return nil
+	})
+}
+
+// startTimestamp returns the earliest start timestamp of the spans, and false if there are no spans.
+func startTimestamp(spans []ptrace.Span) (pcommon.Timestamp, bool) {
+	if len(spans) == 0 {
+		return 0, false
+	}
+	start := spans[0].StartTimestamp()
+	for _, span := range spans[1:] {
+		start = min(start, span.StartTimestamp())
+	}
+	return start, true
+}
+
+// endTimestamp returns the latest end timestamp of the spans, and false if there are no spans.
+func endTimestamp(spans []ptrace.Span) (pcommon.Timestamp, bool) {
+	if len(spans) == 0 {
+		return 0, false
+	}
+	end := spans[0].EndTimestamp()
+	for _, span := range spans[1:] {
+		end = max(end, span.EndTimestamp())
+	}
+	return end, true
+}
+
+func accessStartTimeUnixNano[K Context]() ottl.StandardGetSetter[K] {
+	return readOnly("trace.start_time_unix_nano", func(tCtx K) any {
+		if start, ok := startTimestamp(tCtx.GetSpans()); ok {
+			return start.AsTime().UnixNano()
+		}
+		return nil
+	})
+}
+
+func accessEndTimeUnixNano[K Context]() ottl.StandardGetSetter[K] {
+	return readOnly("trace.end_time_unix_nano", func(tCtx K) any {
+		if end, ok := endTimestamp(tCtx.GetSpans()); ok {
+			return end.AsTime().UnixNano()
+		}
+		return nil
+	})
+}
+
+func accessStartTime[K Context]() ottl.StandardGetSetter[K] {
+	return readOnly("trace.start_time", func(tCtx K) any {
+		if start, ok := startTimestamp(tCtx.GetSpans()); ok {
+			return start.AsTime()
+		}
+		return nil
+	})
+}
+
+func accessEndTime[K Context]() ottl.StandardGetSetter[K] {
+	return readOnly("trace.end_time", func(tCtx K) any {
+		if end, ok := endTimestamp(tCtx.GetSpans()); ok {
+			return end.AsTime()
+		}
+		return nil
+	})
+}
+
+// spansSlice returns a copy of the main fields of the spans, so they can be
+// iterated or aggregated by the statements.
+func spansSlice(spans []ptrace.Span) pcommon.Slice {
+	s := pcommon.NewSlice()
+	s.EnsureCapacity(len(spans))
+	for _, span := range spans {
+		m := s.AppendEmpty().SetEmptyMap()
+		m.PutStr("span_id", span.SpanID().String())
+		m.PutStr("parent_span_id", span.ParentSpanID().String())
+		m.PutStr("name", span.Name())
+		m.PutInt("kind", int64(span.Kind()))
+		m.PutInt("status_code", int64(span.Status().Code()))
+		m.PutStr("status_message", span.Status().Message())
+		m.PutInt("start_time_unix_nano", span.StartTimestamp().AsTime().UnixNano())
+		m.PutInt("end_time_unix_nano", span.EndTimestamp().AsTime().UnixNano())
+		span.Attributes().CopyTo(m.PutEmptyMap("attributes"))
+	}
+	return s
+}
+
+func accessSpans[K Context]() ottl.StandardGetSetter[K] {
+	return readOnly("trace.spans", func(tCtx K) any {
+		return spansSlice(tCtx.GetSpans())
+	})
+}
+
+func accessSpansKey[K Context](keys []ottl.Key[K]) ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, tCtx K) (any, error) {
+			return ctxutil.GetSliceValue[K](ctx, tCtx, spansSlice(tCtx.GetSpans()), keys)
+		},
+		Setter: func(context.Context, K, any) error {
+			return fmt.Errorf(readOnlyPathErrMsg, "trace.spans")
+		},
+	}
+}
+
+// accessSpanAttributesKey gets the attribute if all the spans have the same value for it,
+// and sets it on all the spans.
+func accessSpanAttributesKey[K Context](keys []ottl.Key[K]) ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, tCtx K) (any, error) {
+			key, err := ctxutil.GetMapKeyName(ctx, tCtx, keys[0])
+			if err != nil {
+				return nil, fmt.Errorf("cannot get span attributes value: %w", err)
+			}
+			spans := tCtx.GetSpans()
+			if len(spans) == 0 {
+				return nil, nil
+			}
+			common, ok := spans[0].Attributes().Get(*key)
+			if !ok {
+				return nil, nil
+			}
+			for _, span := range spans[1:] {
+				if val, ok := span.Attributes().Get(*key); !ok || !common.Equal(val) {
+					return nil, nil
+				}
+			}
+			return ctxutil.GetIndexableValue[K](ctx, tCtx, common, keys[1:])
+		},
+		Setter: func(ctx context.Context, tCtx K, val any) error {
+			for _, span := range tCtx.GetSpans() {
+				if err := ctxutil.SetMapValue[K](ctx, tCtx, span.Attributes(), keys, val); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,293 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ctxtrace_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxtrace"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/pathtest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
+)
+
+var traceID = pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+
+func TestPathGetSetter(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     ottl.Path[*testContext]
+		expected any
+	}{
+		{
+			name: "trace_id",
+			path: &pathtest.Path[*testContext]{
+				N: "trace_id",
+			},
+			expected: traceID,
+		},
+		{
+			name: "trace_id string",
+			path: &pathtest.Path[*testContext]{
+				N: "trace_id",
+				NextPath: &pathtest.Path[*testContext]{
+					N: "string",
+				},
+			},
+			expected: "0102030405060708090a0b0c0d0e0f10",
+		},
+		{
+			name: "span_count",
+			path: &pathtest.Path[*testContext]{
+				N: "span_count",
+			},
+			expected: int64(3),
+		},
+		{
+			name: "error_count",
+			path: &pathtest.Path[*testContext]{
+				N: "error_count",
+			},
+			expected: int64(2),
+		},
+		{
+			name: "root_span_name",
+			path: &pathtest.Path[*testContext]{
+				N: "root_span_name",
+			},
+			expected: "GET /checkout",
+		},
+		{
+			name: "start_time_unix_nano",
+			path: &pathtest.Path[*testContext]{
+				N: "start_time_unix_nano",
+			},
+			expected: int64(100),
+		},
+		{
+			name: "end_time_unix_nano",
+			path: &pathtest.Path[*testContext]{
+				N: "end_time_unix_nano",
+			},
+			expected: int64(500),
+		},
+		{
+			name: "start_time",
+			path: &pathtest.Path[*testContext]{
+				N: "start_time",
+			},
+			expected: time.Unix(0, 100).UTC(),
+		},
+		{
+			name: "end_time",
+			path: &pathtest.Path[*testContext]{
+				N: "end_time",
+			},
+			expected: time.Unix(0, 500).UTC(),
+		},
+		{
+			name: "spans name",
+			path: &pathtest.Path[*testContext]{
+				N: "spans",
+				KeySlice: []ottl.Key[*testContext]{
+					&pathtest.Key[*testContext]{
+						I: ottltest.Intp(1),
+					},
+					&pathtest.Key[*testContext]{
+						S: ottltest.Strp("name"),
+					},
+				},
+			},
+			expected: "SELECT orders",
+		},
+		{
+			name: "span_attributes shared by all spans",
+			path: &pathtest.Path[*testContext]{
+				N: "span_attributes",
+				KeySlice: []ottl.Key[*testContext]{
+					&pathtest.Key[*testContext]{
+						S: ottltest.Strp("tenant"),
+					},
+				},
+			},
+			expected: "acme",
+		},
+		{
+			name: "span_attributes with different values",
+			path: &pathtest.Path[*testContext]{
+				N: "span_attributes",
+				KeySlice: []ottl.Key[*testContext]{
+					&pathtest.Key[*testContext]{
+						S: ottltest.Strp("component"),
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "span_attributes missing on some spans",
+			path: &pathtest.Path[*testContext]{
+				N: "span_attributes",
+				KeySlice: []ottl.Key[*testContext]{
+					&pathtest.Key[*testContext]{
+						S: ottltest.Strp("db.system"),
+					},
+				},
+			},
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessor, err := ctxtrace.PathGetSetter(tt.path)
+			require.NoError(t, err)
+
+			got, err := accessor.Get(t.Context(), newTestContext())
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestPathGetSetter_spans(t *testing.T) {
+	accessor, err := ctxtrace.PathGetSetter(&pathtest.Path[*testContext]{N: "spans"})
+	require.NoError(t, err)
+
+	got, err := accessor.Get(t.Context(), newTestContext())
+	require.NoError(t, err)
+	require.IsType(t, pcommon.Slice{}, got)
+	spans := got.(pcommon.Slice)
+	require.Equal(t, 3, spans.Len())
+	assert.Equal(t, map[string]any{
+		"span_id":              "0000000000000002",
+		"parent_span_id":       "0000000000000001",
+		"name":                 "SELECT orders",
+		"kind":                 int64(ptrace.SpanKindClient),
+		"status_code":          int64(ptrace.StatusCodeError),
+		"status_message":       "timeout",
+		"start_time_unix_nano": int64(200),
+		"end_time_unix_nano":   int64(300),
+		"attributes": map[string]any{
+			"tenant":    "acme",
+			"component": "db",
+			"db.system": "postgresql",
+		},
+	}, spans.At(1).Map().AsRaw())
+}
+
+func TestPathGetSetter_setSpanAttributes(t *testing.T) {
+	tCtx := newTestContext()
+	accessor, err := ctxtrace.PathGetSetter(&pathtest.Path[*testContext]{
+		N: "span_attributes",
+		KeySlice: []ottl.Key[*testContext]{
+			&pathtest.Key[*testContext]{
+				S: ottltest.Strp("trace.error_count"),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, accessor.Set(t.Context(), tCtx, int64(2)))
+	for _, span := range tCtx.spans {
+		val, ok := span.Attributes().Get("trace.error_count")
+		require.True(t, ok)
+		assert.Equal(t, int64(2), val.Int())
+	}
+	got, err := accessor.Get(t.Context(), tCtx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), got)
+}
+
+func TestPathGetSetter_readOnly(t *testing.T) {
+	for _, name := range []string{"trace_id", "span_count", "error_count", "root_span_name", "start_time", "end_time", "spans"} {
+		t.Run(name, func(t *testing.T) {
+			accessor, err := ctxtrace.PathGetSetter(&pathtest.Path[*testContext]{N: name})
+			require.NoError(t, err)
+			err = accessor.Set(t.Context(), newTestContext(), nil)
+			assert.EqualError(t, err, `"trace.`+name+`" is read-only and cannot be modified`)
+		})
+	}
+}
+
+func TestPathGetSetter_errors(t *testing.T) {
+	_, err := ctxtrace.PathGetSetter(&pathtest.Path[*testContext]{N: "span_attributes", FullPath: "trace.span_attributes"})
+	assert.EqualError(t, err, `path "trace.span_attributes" must be indexed with the key of the span attributes`)
+
+	_, err = ctxtrace.PathGetSetter(&pathtest.Path[*testContext]{N: "name", FullPath: "trace.name"})
+	assert.ErrorContains(t, err, `segment "name" from path "trace.name" is not a valid path`)
+}
+
+func TestPathGetSetter_noRootSpan(t *testing.T) {
+	tCtx := newTestContext()
+	tCtx.spans = tCtx.spans[1:]
+	accessor, err := ctxtrace.PathGetSetter(&pathtest.Path[*testContext]{N: "root_span_name"})
+	require.NoError(t, err)
+
+	got, err := accessor.Get(t.Context(), tCtx)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+type testContext struct {
+	traceID pcommon.TraceID
+	spans   []ptrace.Span
+}
+
+func (tCtx *testContext) GetTraceID() pcommon.TraceID {
+	return tCtx.traceID
+}
+
+func (tCtx *testContext) GetSpans() []ptrace.Span {
+	return tCtx.spans
+}
+
+func newTestContext() *testContext {
+	spans := ptrace.NewSpanSlice()
+
+	root := spans.AppendEmpty()
+	root.SetTraceID(traceID)
+	root.SetSpanID(pcommon.SpanID([8]byte{0, 0, 0, 0, 0, 0, 0, 1}))
+	root.SetName("GET /checkout")
+	root.SetKind(ptrace.SpanKindServer)
+	root.SetStartTimestamp(100)
+	root.SetEndTimestamp(500)
+	root.Status().SetCode(ptrace.StatusCodeError)
+	root.Attributes().PutStr("tenant", "acme")
+	root.Attributes().PutStr("component", "http")
+
+	query := spans.AppendEmpty()
+	query.SetTraceID(traceID)
+	query.SetSpanID(pcommon.SpanID([8]byte{0, 0, 0, 0, 0, 0, 0, 2}))
+	query.SetParentSpanID(root.SpanID())
+	query.SetName("SELECT orders")
+	query.SetKind(ptrace.SpanKindClient)
+	query.SetStartTimestamp(200)
+	query.SetEndTimestamp(300)
+	query.Status().SetCode(ptrace.StatusCodeError)
+	query.Status().SetMessage("timeout")
+	query.Attributes().PutStr("tenant", "acme")
+	query.Attributes().PutStr("component", "db")
+	query.Attributes().PutStr("db.system", "postgresql")
+
+	render := spans.AppendEmpty()
+	render.SetTraceID(traceID)
+	render.SetSpanID(pcommon.SpanID([8]byte{0, 0, 0, 0, 0, 0, 0, 3}))
+	render.SetParentSpanID(root.SpanID())
+	render.SetName("render")
+	render.SetStartTimestamp(300)
+	render.SetEndTimestamp(400)
+	render.Attributes().PutStr("tenant", "acme")
+	render.Attributes().PutStr("component", "http")
+
+	return &testContext{
+		traceID: traceID,
+		spans:   []ptrace.Span{root, query, render},
+	}
+}
@@ -91,11 +91,11 @@ func Test_GetMapValue_Invalid(t *testing.T) {
 					G: getSetter,
 				},
 				&pathtest.Key[any]{
-					I: ottltest.Intp(-1),
+					I: ottltest.Intp(-10),
 					G: getSetter,
 				},
 			},
-			err: "index -1 out of bounds",
+			err: "index -10 out of bounds",
 		},
 		{
 			name: "invalid type",
@@ -223,11 +223,11 @@ func Test_SetMapValue_Invalid(t *testing.T) {
 					G: getSetter,
 				},
 				&pathtest.Key[any]{
-					I: ottltest.Intp(-1),
+					I: ottltest.Intp(-10),
 					G: getSetter,
 				},
 			},
-			err: "index -1 out of bounds",
+			err: "index -10 out of bounds",
 		},
 		{
 			name: "slice index too small",
@@ -12,6 +12,7 @@ import (
 	"golang.org/x/exp/constraints"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/ottlcommon"
 )
 
 const (
@@ -21,28 +22,40 @@ const (
 var (
 	errMissingSetKey = errors.New("cannot set slice value without key")
 	errMissingGetKey = errors.New("cannot get slice value without key")
+
+	errRangeNotLastKey = errors.New("cannot set a value within a range of a slice, the range must be the last key")
 )
 
+// GetSliceIndexFromKeys returns the index of the slice element selected by the first key,
+// negative indexes counting from the end of the slice.
 func GetSliceIndexFromKeys[K any](ctx context.Context, tCtx K, sliceLen int, keys []ottl.Key[K]) (int, error) {
-	i, err := keys[0].Int(ctx, tCtx)
+	r, err := ottl.GetKeyRange(ctx, tCtx, keys[0])
+	if err != nil {
+		return 0, err
+	}
+	if r != nil {
+		return 0, fmt.Errorf("range %v is not supported, expected an integer index", r)
+	}
+	i, err := getSliceIndex(ctx, tCtx, keys[0])
 	if err != nil {
 		return 0, err
 	}
+	return ottlcommon.SliceIndex(*i, sliceLen)
+}
+
+func getSliceIndex[K any](ctx context.Context, tCtx K, key ottl.Key[K]) (*int64, error) {
+	i, err := key.Int(ctx, tCtx)
+	if err != nil {
+		return nil, err
+	}
 	if i == nil {
-		resInt, err := FetchValueFromExpression[K, int64](ctx, tCtx, keys[0])
+		resInt, err := FetchValueFromExpression[K, int64](ctx, tCtx, key)
 		if err != nil {
-			return 0, fmt.Errorf("unable to resolve an integer index in slice: %w", err)
+			return nil, fmt.Errorf("unable to resolve an integer index in slice: %w", err)
 		}
 		i = resInt
 	}
-
-	idx := int(*i)
-
-	if idx < 0 || idx >= sliceLen {
-		return 0, fmt.Errorf("index %d out of bounds", idx)
-	}
-
-	return idx, nil
+	return i, nil
 }
 
 func GetSliceValue[K any](ctx context.Context, tCtx K, s pcommon.Slice, keys []ottl.Key[K]) (any, error) {
@@ -50,12 +63,24 @@ func GetSliceValue[K any](ctx context.Context, tCtx K, s pcommon.Slice, keys []o
 		return 0, errMissingGetKey
 	}
 
+	r, err := ottl.GetKeyRange(ctx, tCtx, keys[0])
+	if err != nil {
+		return nil, err
+	}
+	if r != nil {
+		elements, err := getSliceRange(s, r)
+		if err != nil {
+			return nil, err
+		}
+		return GetIndexableValue[K](ctx, tCtx, elements, keys[1:])
+	}
+
 	idx, err := GetSliceIndexFromKeys(ctx, tCtx, s.Len(), keys)
 	if err != nil {
 		return nil, err
 	}
 
-	return getIndexableValue[K](ctx, tCtx, s.At(idx), keys[1:])
+	return GetIndexableValue[K](ctx, tCtx, s.At(idx), keys[1:])
 }
 
 func SetSliceValue[K any](ctx context.Context, tCtx K, s pcommon.Slice, keys []ottl.Key[K], val any) error {
@@ -63,6 +88,17 @@ func SetSliceValue[K any](ctx context.Context, tCtx K, s pcommon.Slice, keys []o
 		return errMissingSetKey
 	}
 
+	r, err := ottl.GetKeyRange(ctx, tCtx, keys[0])
+	if err != nil {
+		return err
+	}
+	if r != nil {
+		if len(keys) > 1 {
+			return errRangeNotLastKey
+		}
+		return setSliceRange(s, r, val)
+	}
+
 	idx, err := GetSliceIndexFromKeys(ctx, tCtx, s.Len(), keys)
 	if err != nil {
 		return err
@@ -71,6 +107,48 @@ func SetSliceValue[K any](ctx context.Context, tCtx K, s pcommon.Slice, keys []o
 	return SetIndexableValue[K](ctx, tCtx, s.At(idx), val, keys[1:])
 }
 
+// getSliceRange returns a copy of the elements of the slice in the range.
+func getSliceRange(s pcommon.Slice, r *ottl.KeyRange) (pcommon.Value, error) {
+	start, end, err := r.Bounds(s.Len())
+	if err != nil {
+		return pcommon.Value{}, err
+	}
+	elements := pcommon.NewValueSlice()
+	elements.Slice().EnsureCapacity(end - start)
+	for i := start; i < end; i++ {
+		s.At(i).CopyTo(elements.Slice().AppendEmpty())
+	}
+	return elements, nil
+}
+
+// setSliceRange replaces the elements of the slice in the range with the elements of the value,
+// which must be a slice but may have a different length than the range.
+func setSliceRange(s pcommon.Slice, r *ottl.KeyRange, val any) error {
+	start, end, err := r.Bounds(s.Len())
+	if err != nil {
+		return err
+	}
+	elements := pcommon.NewValueEmpty()
+	if err = SetValue(elements, val); err != nil {
+		return err
+	}
+	if elements.Type() != pcommon.ValueTypeSlice {
+		return fmt.Errorf("cannot set range %v of a slice to a value of type %T, expected a slice", r, val)
+	}
+
+	result := pcommon.NewSlice()
+	result.EnsureCapacity(start + elements.Slice().Len() + s.Len() - end)
+	for i := range start {
+		s.At(i).CopyTo(result.AppendEmpty())
+	}
+	elements.Slice().MoveAndAppendTo(result)
+	for i := end; i < s.Len(); i++ {
+		s.At(i).CopyTo(result.AppendEmpty())
+	}
+	result.CopyTo(s)
+	return nil
+}
+
 // CommonTypedSlice is an interface for typed pdata slices, such as pcommon.StringSlice,
 // pcommon.Int64Slice, pcommon.Int32Slice, etc.
 type CommonTypedSlice[T any] interface {
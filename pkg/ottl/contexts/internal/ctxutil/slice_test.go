@@ -70,11 +70,11 @@ func Test_GetSliceValue_Invalid(t *testing.T) {
 			name: "index too small",
 			keys: []ottl.Key[any]{
 				&pathtest.Key[any]{
-					I: ottltest.Intp(-1),
+					I: ottltest.Intp(-10),
 					G: getSetter,
 				},
 			},
-			err: "index -1 out of bounds",
+			err: "index -10 out of bounds",
 		},
 		{
 			name: "invalid type",
@@ -157,11 +157,11 @@ func Test_SetSliceValue_Invalid(t *testing.T) {
 			name: "index too small",
 			keys: []ottl.Key[any]{
 				&pathtest.Key[any]{
-					I: ottltest.Intp(-1),
+					I: ottltest.Intp(-10),
 					G: getSetter,
 				},
 			},
-			err: "index -1 out of bounds",
+			err: "index -10 out of bounds",
 		},
 		{
 			name: "invalid type",
@@ -247,11 +247,11 @@ func Test_GetCommonTypedSliceValue_Invalid(t *testing.T) {
 			name: "index too small",
 			keys: []ottl.Key[any]{
 				&pathtest.Key[any]{
-					I: ottltest.Intp(-1),
+					I: ottltest.Intp(-10),
 					G: getSetter,
 				},
 			},
-			err: "index -1 out of bounds",
+			err: "index -10 out of bounds",
 		},
 		{
 			name: "invalid key type",
@@ -335,11 +335,11 @@ func Test_SetCommonTypedSliceValue_Invalid(t *testing.T) {
 			name: "index too small",
 			keys: []ottl.Key[any]{
 				&pathtest.Key[any]{
-					I: ottltest.Intp(-1),
+					I: ottltest.Intp(-10),
 					G: getSetter,
 				},
 			},
-			err: "index -1 out of bounds",
+			err: "index -10 out of bounds",
 		},
 		{
 			name: "invalid key type",
@@ -515,11 +515,11 @@ func Test_SetCommonIntSliceValue_Invalid(t *testing.T) {
 			name: "index too small",
 			keys: []ottl.Key[any]{
 				&pathtest.Key[any]{
-					I: ottltest.Intp(-1),
+					I: ottltest.Intp(-10),
 					G: getSetter,
 				},
 			},
-			err: "index -1 out of bounds",
+			err: "index -10 out of bounds",
 		},
 		{
 			name: "invalid key type",
@@ -649,3 +649,201 @@ func Test_GetCommonIntSliceValues(t *testing.T) {
 	st.FromRaw([]int32{1, 2, 3})
 	assert.Equal(t, []int64{1, 2, 3}, ctxutil.GetCommonIntSliceValues[int32](st))
 }
+
+func Test_GetSliceValue_NegativeIndex(t *testing.T) {
+	s := pcommon.NewSlice()
+	require.NoError(t, s.FromRaw([]any{"a", "b", "c"}))
+
+	value, err := ctxutil.GetSliceValue[any](t.Context(), nil, s, []ottl.Key[any]{
+		&pathtest.Key[any]{
+			I: ottltest.Intp(-1),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "c", value)
+}
+
+func Test_GetSliceValue_Range(t *testing.T) {
+	tests := []struct {
+		name     string
+		keys     []ottl.Key[any]
+		expected any
+		err      string
+	}{
+		{
+			name: "range",
+			keys: []ottl.Key[any]{
+				&pathtest.Key[any]{R: &ottl.KeyRange{Start: ottltest.Intp(1), End: ottltest.Intp(3)}},
+			},
+			expected: []any{"b", "c"},
+		},
+		{
+			name: "omitted bounds",
+			keys: []ottl.Key[any]{
+				&pathtest.Key[any]{R: &ottl.KeyRange{}},
+			},
+			expected: []any{"a", "b", "c", "d"},
+		},
+		{
+			name: "negative bounds",
+			keys: []ottl.Key[any]{
+				&pathtest.Key[any]{R: &ottl.KeyRange{Start: ottltest.Intp(-2)}},
+			},
+			expected: []any{"c", "d"},
+		},
+		{
+			name: "index in range",
+			keys: []ottl.Key[any]{
+				&pathtest.Key[any]{R: &ottl.KeyRange{End: ottltest.Intp(-1)}},
+				&pathtest.Key[any]{I: ottltest.Intp(-1)},
+			},
+			expected: "c",
+		},
+		{
+			name: "out of bounds",
+			keys: []ottl.Key[any]{
+				&pathtest.Key[any]{R: &ottl.KeyRange{Start: ottltest.Intp(3), End: ottltest.Intp(5)}},
+			},
+			err: "range [3:5] out of bounds for slice of length 4",
+		},
+		{
+			name: "start after end",
+			keys: []ottl.Key[any]{
+				&pathtest.Key[any]{R: &ottl.KeyRange{Start: ottltest.Intp(-1), End: ottltest.Intp(1)}},
+			},
+			err: "range [-1:1] out of bounds for slice of length 4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := pcommon.NewSlice()
+			require.NoError(t, s.FromRaw([]any{"a", "b", "c", "d"}))
+
+			value, err := ctxutil.GetSliceValue[any](t.Context(), nil, s, tt.keys)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			if sl, ok := value.(pcommon.Slice); ok {
+				value = sl.AsRaw()
+			}
+			assert.Equal(t, tt.expected, value)
+			assert.Equal(t, []any{"a", "b", "c", "d"}, s.AsRaw())
+		})
+	}
+}
+
+func Test_SetSliceValue_NegativeIndex(t *testing.T) {
+	s := pcommon.NewSlice()
+	require.NoError(t, s.FromRaw([]any{"a", "b", "c"}))
+
+	err := ctxutil.SetSliceValue[any](t.Context(), nil, s, []ottl.Key[any]{
+		&pathtest.Key[any]{I: ottltest.Intp(-2)},
+	}, "value")
+	require.NoError(t, err)
+	assert.Equal(t, []any{"a", "value", "c"}, s.AsRaw())
+}
+
+func Test_SetSliceValue_Range(t *testing.T) {
+	tests := []struct {
+		name     string
+		keys     []ottl.Key[any]
+		val      any
+		expected []any
+		err      string
+	}{
+		{
+			name: "same length",
+			keys: []ottl.Key[any]{
+				&pathtest.Key[any]{R: &ottl.KeyRange{Start: ottltest.Intp(1), End: ottltest.Intp(3)}},
+			},
+			val:      []any{"x", "y"},
+			expected: []any{"a", "x", "y", "d"},
+		},
+		{
+			name: "fewer elements",
+			keys: []ottl.Key[any]{
+				&pathtest.Key[any]{R: &ottl.KeyRange{Start: ottltest.Intp(1), End: ottltest.Intp(3)}},
+			},
+			val:      []string{"x"},
+			expected: []any{"a", "x", "d"},
+		},
+		{
+			name: "more elements",
+			keys: []ottl.Key[any]{
+				&pathtest.Key[any]{R: &ottl.KeyRange{Start: ottltest.Intp(-1)}},
+			},
+			val:      []int64{1, 2},
+			expected: []any{"a", "b", "c", int64(1), int64(2)},
+		},
+		{
+			name: "insert",
+			keys: []ottl.Key[any]{
+				&pathtest.Key[any]{R: &ottl.KeyRange{Start: ottltest.Intp(0), End: ottltest.Intp(0)}},
+			},
+			val:      []any{"x"},
+			expected: []any{"x", "a", "b", "c", "d"},
+		},
+		{
+			name: "remove",
+			keys: []ottl.Key[any]{
+				&pathtest.Key[any]{R: &ottl.KeyRange{End: ottltest.Intp(2)}},
+			},
+			val:      []any{},
+			expected: []any{"c", "d"},
+		},
+		{
+			name: "not a slice",
+			keys: []ottl.Key[any]{
+				&pathtest.Key[any]{R: &ottl.KeyRange{}},
+			},
+			val: "x",
+			err: "cannot set range [:] of a slice to a value of type string, expected a slice",
+		},
+		{
+			name: "out of bounds",
+			keys: []ottl.Key[any]{
+				&pathtest.Key[any]{R: &ottl.KeyRange{Start: ottltest.Intp(-5)}},
+			},
+			val: []any{"x"},
+			err: "range [-5:] out of bounds for slice of length 4",
+		},
+		{
+			name: "range not last key",
+			keys: []ottl.Key[any]{
+				&pathtest.Key[any]{R: &ottl.KeyRange{}},
+				&pathtest.Key[any]{I: ottltest.Intp(0)},
+			},
+			val: "x",
+			err: "cannot set a value within a range of a slice, the range must be the last key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := pcommon.NewSlice()
+			require.NoError(t, s.FromRaw([]any{"a", "b", "c", "d"}))
+
+			err := ctxutil.SetSliceValue[any](t.Context(), nil, s, tt.keys, tt.val)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				assert.Equal(t, []any{"a", "b", "c", "d"}, s.AsRaw())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, s.AsRaw())
+		})
+	}
+}
+
+func Test_GetCommonTypedSliceValue_Range(t *testing.T) {
+	s := pcommon.NewStringSlice()
+	s.Append("one", "two", "three")
+
+	_, err := ctxutil.GetCommonTypedSliceValue[any, string](t.Context(), nil, s, []ottl.Key[any]{
+		&pathtest.Key[any]{R: &ottl.KeyRange{Start: ottltest.Intp(1)}},
+	})
+	assert.EqualError(t, err, "range [1:] is not supported, expected an integer index")
+}
@@ -85,7 +85,7 @@ func SetValue(value pcommon.Value, val any) error {
 	return err
 }
 
-func getIndexableValue[K any](ctx context.Context, tCtx K, value pcommon.Value, keys []ottl.Key[K]) (any, error) {
+func GetIndexableValue[K any](ctx context.Context, tCtx K, value pcommon.Value, keys []ottl.Key[K]) (any, error) {
 	val := value
 	var ok bool
 	for index := range keys {
@@ -100,21 +100,26 @@ func getIndexableValue[K any](ctx context.Context, tCtx K, value pcommon.Value,
 				return nil, nil
 			}
 		case pcommon.ValueTypeSlice:
-			i, err := keys[index].Int(ctx, tCtx)
+			r, err := ottl.GetKeyRange(ctx, tCtx, keys[index])
 			if err != nil {
 				return nil, err
 			}
-			if i == nil {
-				resInt, err := FetchValueFromExpression[K, int64](ctx, tCtx, keys[index])
+			if r != nil {
+				val, err = getSliceRange(val.Slice(), r)
 				if err != nil {
-					return nil, fmt.Errorf("unable to resolve an integer index in slice: %w", err)
+					return nil, err
 				}
-				i = resInt
+				continue
 			}
-			if int(*i) >= val.Slice().Len() || int(*i) < 0 {
-				return nil, fmt.Errorf("index %v out of bounds", *i)
+			i, err := getSliceIndex(ctx, tCtx, keys[index])
+			if err != nil {
+				return nil, err
+			}
+			idx, err := ottlcommon.SliceIndex(*i, val.Slice().Len())
+			if err != nil {
+				return nil, err
 			}
-			val = val.Slice().At(int(*i))
+			val = val.Slice().At(idx)
 		default:
 			return nil, fmt.Errorf("type %v does not support string indexing", val.Type())
 		}
@@ -137,22 +142,36 @@ func SetIndexableValue[K any](ctx context.Context, tCtx K, currentValue pcommon.
 				currentValue = potentialValue
 			}
 		case pcommon.ValueTypeSlice:
-			i, err := keys[index].Int(ctx, tCtx)
+			r, err := ottl.GetKeyRange(ctx, tCtx, keys[index])
 			if err != nil {
 				return err
 			}
-			if i == nil {
-				resInt, err := FetchValueFromExpression[K, int64](ctx, tCtx, keys[index])
-				if err != nil {
-					return fmt.Errorf("unable to resolve an integer index in slice: %w", err)
+			if r != nil {
+				if index < len(keys)-1 {
+					return errRangeNotLastKey
 				}
-				i = resInt
+				return setSliceRange(currentValue.Slice(), r, val)
+			}
+			i, err := getSliceIndex(ctx, tCtx, keys[index])
+			if err != nil {
+				return err
 			}
-			if int(*i) >= currentValue.Slice().Len() || int(*i) < 0 {
-				return fmt.Errorf("index %v out of bounds", *i)
+			idx, err := ottlcommon.SliceIndex(*i, currentValue.Slice().Len())
+			if err != nil {
+				return err
 			}
-			currentValue = currentValue.Slice().At(int(*i))
+			currentValue = currentValue.Slice().At(idx)
 		case pcommon.ValueTypeEmpty:
+			r, err := ottl.GetKeyRange(ctx, tCtx, keys[index])
+			if err != nil {
+				return err
+			}
+			if r != nil {
+				if index < len(keys)-1 {
+					return errRangeNotLastKey
+				}
+				return setSliceRange(currentValue.SetEmptySlice(), r, val)
+			}
 			s, err := keys[index].String(ctx, tCtx)
 			if err != nil {
 				return err
@@ -165,6 +184,9 @@ func SetIndexableValue[K any](ctx context.Context, tCtx K, currentValue pcommon.
 			case s != nil:
 				currentValue = currentValue.SetEmptyMap().PutEmpty(*s)
 			case i != nil:
+				if *i < 0 {
+					return fmt.Errorf("index %v out of bounds", *i)
+				}
 				currentValue.SetEmptySlice()
 				for k := 0; k < int(*i); k++ {
 					currentValue.Slice().AppendEmpty()
@@ -175,6 +197,9 @@ func SetIndexableValue[K any](ctx context.Context, tCtx K, currentValue pcommon.
 				resInt, errInt := FetchValueFromExpression[K, int64](ctx, tCtx, keys[index])
 				switch {
 				case errInt == nil:
+					if *resInt < 0 {
+						return fmt.Errorf("index %v out of bounds", *resInt)
+					}
 					currentValue.SetEmptySlice()
 					for k := 0; k < int(*resInt); k++ {
 						currentValue.Slice().AppendEmpty()
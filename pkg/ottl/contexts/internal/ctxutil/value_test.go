@@ -7,11 +7,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxutil"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/pathtest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
 )
 
 func Test_SetIndexableValue_InvalidValue(t *testing.T) {
@@ -34,3 +36,53 @@ func Test_SetValue_UnsupportedType(t *testing.T) {
 	err := ctxutil.SetValue(value, struct{}{})
 	assert.EqualError(t, err, "unsupported type struct {} for set operation; current value type is Str")
 }
+
+func Test_GetIndexableValue_Range(t *testing.T) {
+	value := pcommon.NewValueMap()
+	require.NoError(t, value.Map().FromRaw(map[string]any{"list": []any{"a", "b", "c"}}))
+
+	val, err := ctxutil.GetIndexableValue[any](t.Context(), nil, value, []ottl.Key[any]{
+		&pathtest.Key[any]{S: ottltest.Strp("list")},
+		&pathtest.Key[any]{R: &ottl.KeyRange{Start: ottltest.Intp(-2)}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []any{"b", "c"}, val.(pcommon.Slice).AsRaw())
+
+	val, err = ctxutil.GetIndexableValue[any](t.Context(), nil, value, []ottl.Key[any]{
+		&pathtest.Key[any]{S: ottltest.Strp("list")},
+		&pathtest.Key[any]{I: ottltest.Intp(-3)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "a", val)
+}
+
+func Test_SetIndexableValue_Range(t *testing.T) {
+	value := pcommon.NewValueMap()
+	require.NoError(t, value.Map().FromRaw(map[string]any{"list": []any{"a", "b", "c"}}))
+
+	err := ctxutil.SetIndexableValue[any](t.Context(), nil, value, []any{"x"}, []ottl.Key[any]{
+		&pathtest.Key[any]{S: ottltest.Strp("list")},
+		&pathtest.Key[any]{R: &ottl.KeyRange{Start: ottltest.Intp(1)}},
+	})
+	require.NoError(t, err)
+	err = ctxutil.SetIndexableValue[any](t.Context(), nil, value, []any{"y"}, []ottl.Key[any]{
+		&pathtest.Key[any]{S: ottltest.Strp("new")},
+		&pathtest.Key[any]{R: &ottl.KeyRange{}},
+	})
+	require.NoError(t, err)
+	err = ctxutil.SetIndexableValue[any](t.Context(), nil, value, "z", []ottl.Key[any]{
+		&pathtest.Key[any]{S: ottltest.Strp("list")},
+		&pathtest.Key[any]{I: ottltest.Intp(-2)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"list": []any{"z", "x"},
+		"new":  []any{"y"},
+	}, value.Map().AsRaw())
+
+	err = ctxutil.SetIndexableValue[any](t.Context(), nil, value, "z", []ottl.Key[any]{
+		&pathtest.Key[any]{S: ottltest.Strp("missing")},
+		&pathtest.Key[any]{I: ottltest.Intp(-1)},
+	})
+	assert.EqualError(t, err, "index -1 out of bounds")
+}
@@ -50,6 +50,7 @@ var _ ottl.Key[any] = &Key[any]{}
 type Key[K any] struct {
 	S *string
 	I *int64
+	R *ottl.KeyRange
 	G ottl.Getter[K]
 }
 
@@ -64,3 +65,7 @@ func (k *Key[K]) Int(_ context.Context, _ K) (*int64, error) {
 func (k *Key[K]) ExpressionGetter(_ context.Context, _ K) (ottl.Getter[K], error) {
 	return k.G, nil
 }
+
+func (k *Key[K]) Range(_ context.Context, _ K) (*ottl.KeyRange, error) {
+	return k.R, nil
+}
@@ -48,6 +48,7 @@ type TransformContext struct {
 	metric          pmetric.Metric
 	dataPoint       any
 	cache           pcommon.Map
+	sharedCache     *ottl.Cache
 }
 
 // MarshalLogObject serializes the TransformContext into a zapcore.ObjectEncoder for logging.
@@ -84,9 +85,22 @@ func (tCtx *TransformContext) Close() {
 	tCtx.metric = pmetric.Metric{}
 	tCtx.dataPoint = nil
 	tCtx.cache.Clear()
+	tCtx.sharedCache = nil
 	tcPool.Put(tCtx)
 }
 
+// WithCache sets a cache shared by the TransformContexts, which is used by the cache path
+// instead of the cache of the TransformContext. The values set by the statements are then
+// available to the statements executed on other TransformContexts, until they expire or are
+// evicted from the cache.
+//
+// Experimental: *NOTE* this option is subject to change or removal in the future.
+func WithCache(cache *ottl.Cache) TransformContextOption {
+	return func(p *TransformContext) {
+		p.sharedCache = cache
+	}
+}
+
 // GetDataPoint returns the datapoint from the TransformContext.
 func (tCtx *TransformContext) GetDataPoint() any {
 	return tCtx.dataPoint
@@ -167,6 +181,13 @@ func WithConditionSequenceErrorMode(errorMode ottl.ErrorMode) ConditionSequenceO
 	}
 }
 
+// WithConditionSequenceStatistics enables the statistics of the evaluations of the conditions of a condition sequence.
+func WithConditionSequenceStatistics() ConditionSequenceOption {
+	return func(c *ottl.ConditionSequence[*TransformContext]) {
+		ottl.WithConditionSequenceStatistics[*TransformContext]()(c)
+	}
+}
+
 // NewConditionSequence creates a new condition sequence with the provided conditions and options.
 func NewConditionSequence(conditions []*ottl.Condition[*TransformContext], telemetrySettings component.TelemetrySettings, options ...ConditionSequenceOption) ottl.ConditionSequence[*TransformContext] {
 	c := ottl.NewConditionSequence(conditions, telemetrySettings)
@@ -205,11 +226,16 @@ func getCache(tCtx *TransformContext) pcommon.Map {
 	return tCtx.cache
 }
 
+func getSharedCache(tCtx *TransformContext) *ottl.Cache {
+	return tCtx.sharedCache
+}
+
 func pathExpressionParser(cacheGetter ctxcache.Getter[*TransformContext]) ottl.PathExpressionParser[*TransformContext] {
 	return ctxcommon.PathExpressionParser(
 		ctxdatapoint.Name,
 		ctxdatapoint.DocRef,
 		cacheGetter,
+		getSharedCache,
 		map[string]ottl.PathExpressionParser[*TransformContext]{
 			ctxresource.Name:    ctxresource.PathGetSetter[*TransformContext],
 			ctxscope.Name:       ctxscope.PathGetSetter[*TransformContext],
@@ -0,0 +1,255 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlentity // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlentity"
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxcache"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxcommon"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxentity"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxotelcol"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxresource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxscope"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/logging"
+)
+
+// entityEventsScopeAttribute is the attribute set by the experimentalmetricmetadata package
+// on the instrumentation scope of the logs carrying entity events.
+const entityEventsScopeAttribute = "otel.entity.event_as_log"
+
+var tcPool = sync.Pool{
+	New: func() any {
+		return &TransformContext{cache: pcommon.NewMap()}
+	},
+}
+
+// ContextName is the name of the context for entity events.
+// Experimental: *NOTE* this constant is subject to change or removal in the future.
+const ContextName = ctxentity.Name
+
+var (
+	_ ctxentity.Context       = (*TransformContext)(nil)
+	_ ctxresource.Context     = (*TransformContext)(nil)
+	_ ctxscope.Context        = (*TransformContext)(nil)
+	_ zapcore.ObjectMarshaler = (*TransformContext)(nil)
+)
+
+// TransformContext represents an entity event and its associated hierarchy.
+// Entity events are sent as log records, under an instrumentation scope
+// for which IsEntityEventsScope returns true.
+type TransformContext struct {
+	resourceLogs plog.ResourceLogs
+	scopeLogs    plog.ScopeLogs
+	entityEvent  plog.LogRecord
+	cache        pcommon.Map
+	sharedCache  *ottl.Cache
+}
+
+type entityEvent plog.LogRecord
+
+// MarshalLogObject serializes the entity event into a zapcore.ObjectEncoder for logging.
+func (e entityEvent) MarshalLogObject(encoder zapcore.ObjectEncoder) error {
+	lr := plog.LogRecord(e)
+	encoder.AddUint64("time_unix_nano", uint64(lr.Timestamp()))
+	return encoder.AddObject("attributes", logging.Map(lr.Attributes()))
+}
+
+// MarshalLogObject serializes the TransformContext into a zapcore.ObjectEncoder for logging.
+func (tCtx *TransformContext) MarshalLogObject(encoder zapcore.ObjectEncoder) error {
+	err := encoder.AddObject("resource", logging.Resource(tCtx.GetResource()))
+	err = errors.Join(err, encoder.AddObject("scope", logging.InstrumentationScope(tCtx.GetInstrumentationScope())))
+	err = errors.Join(err, encoder.AddObject("entity_event", entityEvent(tCtx.entityEvent)))
+	err = errors.Join(err, encoder.AddObject("cache", logging.Map(tCtx.cache)))
+	return err
+}
+
+// TransformContextOption represents an option for configuring a TransformContext.
+type TransformContextOption func(*TransformContext)
+
+// NewTransformContextPtr returns a new TransformContext with the provided parameters from a pool of contexts.
+// Caller must call TransformContext.Close on the returned TransformContext.
+func NewTransformContextPtr(resourceLogs plog.ResourceLogs, scopeLogs plog.ScopeLogs, entityEvent plog.LogRecord, options ...TransformContextOption) *TransformContext {
+	tCtx := tcPool.Get().(*TransformContext)
+	tCtx.resourceLogs = resourceLogs
+	tCtx.scopeLogs = scopeLogs
+	tCtx.entityEvent = entityEvent
+	for _, opt := range options {
+		opt(tCtx)
+	}
+	return tCtx
+}
+
+// Close the current TransformContext.
+// After this function returns this instance cannot be used.
+func (tCtx *TransformContext) Close() {
+	tCtx.resourceLogs = plog.ResourceLogs{}
+	tCtx.scopeLogs = plog.ScopeLogs{}
+	tCtx.entityEvent = plog.LogRecord{}
+	tCtx.cache.Clear()
+	tCtx.sharedCache = nil
+	tcPool.Put(tCtx)
+}
+
+// WithCache sets a cache shared by the TransformContexts, which is used by the cache path
+// instead of the cache of the TransformContext. The values set by the statements are then
+// available to the statements executed on other TransformContexts, until they expire or are
+// evicted from the cache.
+//
+// Experimental: *NOTE* this option is subject to change or removal in the future.
+func WithCache(cache *ottl.Cache) TransformContextOption {
+	return func(p *TransformContext) {
+		p.sharedCache = cache
+	}
+}
+
+// IsEntityEventsScope returns true if the log records of the scope are entity events,
+// so they should be processed with this context rather than the log context.
+func IsEntityEventsScope(scope pcommon.InstrumentationScope) bool {
+	val, ok := scope.Attributes().Get(entityEventsScopeAttribute)
+	return ok && val.Type() == pcommon.ValueTypeBool && val.Bool()
+}
+
+// GetEntityEvent returns the log record carrying the entity event from the TransformContext.
+func (tCtx *TransformContext) GetEntityEvent() plog.LogRecord {
+	return tCtx.entityEvent
+}
+
+// GetInstrumentationScope returns the instrumentation scope from the TransformContext.
+func (tCtx *TransformContext) GetInstrumentationScope() pcommon.InstrumentationScope {
+	return tCtx.scopeLogs.Scope()
+}
+
+// GetResource returns the resource from the TransformContext.
+func (tCtx *TransformContext) GetResource() pcommon.Resource {
+	return tCtx.resourceLogs.Resource()
+}
+
+// GetScopeSchemaURLItem returns the scope schema URL item from the TransformContext.
+func (tCtx *TransformContext) GetScopeSchemaURLItem() ctxcommon.SchemaURLItem {
+	return tCtx.scopeLogs
+}
+
+// GetResourceSchemaURLItem returns the resource schema URL item from the TransformContext.
+func (tCtx *TransformContext) GetResourceSchemaURLItem() ctxcommon.SchemaURLItem {
+	return tCtx.resourceLogs
+}
+
+// EnablePathContextNames enables the support for path's context names on statements.
+// When this option is configured, all statement's paths must have a valid context prefix,
+// otherwise an error is reported.
+//
+// Experimental: *NOTE* this option is subject to change or removal in the future.
+func EnablePathContextNames() ottl.Option[*TransformContext] {
+	return func(p *ottl.Parser[*TransformContext]) {
+		ottl.WithPathContextNames[*TransformContext]([]string{
+			ctxentity.Name,
+			ctxscope.LegacyName,
+			ctxscope.Name,
+			ctxresource.Name,
+			ctxotelcol.Name,
+		})(p)
+	}
+}
+
+// StatementSequenceOption represents an option for configuring a statement sequence.
+type StatementSequenceOption func(*ottl.StatementSequence[*TransformContext])
+
+// WithStatementSequenceErrorMode sets the error mode for a statement sequence.
+func WithStatementSequenceErrorMode(errorMode ottl.ErrorMode) StatementSequenceOption {
+	return func(s *ottl.StatementSequence[*TransformContext]) {
+		ottl.WithStatementSequenceErrorMode[*TransformContext](errorMode)(s)
+	}
+}
+
+// NewStatementSequence creates a new statement sequence with the provided statements and options.
+func NewStatementSequence(statements []*ottl.Statement[*TransformContext], telemetrySettings component.TelemetrySettings, options ...StatementSequenceOption) ottl.StatementSequence[*TransformContext] {
+	s := ottl.NewStatementSequence(statements, telemetrySettings)
+	for _, op := range options {
+		op(&s)
+	}
+	return s
+}
+
+// ConditionSequenceOption represents an option for configuring a condition sequence.
+type ConditionSequenceOption func(*ottl.ConditionSequence[*TransformContext])
+
+// WithConditionSequenceErrorMode sets the error mode for a condition sequence.
+func WithConditionSequenceErrorMode(errorMode ottl.ErrorMode) ConditionSequenceOption {
+	return func(c *ottl.ConditionSequence[*TransformContext]) {
+		ottl.WithConditionSequenceErrorMode[*TransformContext](errorMode)(c)
+	}
+}
+
+// WithConditionSequenceStatistics enables the statistics of the evaluations of the conditions of a condition sequence.
+func WithConditionSequenceStatistics() ConditionSequenceOption {
+	return func(c *ottl.ConditionSequence[*TransformContext]) {
+		ottl.WithConditionSequenceStatistics[*TransformContext]()(c)
+	}
+}
+
+// NewConditionSequence creates a new condition sequence with the provided conditions and options.
+func NewConditionSequence(conditions []*ottl.Condition[*TransformContext], telemetrySettings component.TelemetrySettings, options ...ConditionSequenceOption) ottl.ConditionSequence[*TransformContext] {
+	c := ottl.NewConditionSequence(conditions, telemetrySettings)
+	for _, op := range options {
+		op(&c)
+	}
+	return c
+}
+
+// NewParser creates a new entity event parser with the provided functions and options.
+func NewParser(
+	functions map[string]ottl.Factory[*TransformContext],
+	telemetrySettings component.TelemetrySettings,
+	options ...ottl.Option[*TransformContext],
+) (ottl.Parser[*TransformContext], error) {
+	return ctxcommon.NewParser(
+		functions,
+		telemetrySettings,
+		pathExpressionParser(getCache),
+		parseEnum,
+		options...,
+	)
+}
+
+func parseEnum(val *ottl.EnumSymbol) (*ottl.Enum, error) {
+	if val != nil {
+		if enum, ok := ctxentity.SymbolTable[*val]; ok {
+			return &enum, nil
+		}
+		return nil, fmt.Errorf("enum symbol, %s, not found", *val)
+	}
+	return nil, errors.New("enum symbol not provided")
+}
+
+func getCache(tCtx *TransformContext) pcommon.Map {
+	return tCtx.cache
+}
+
+func getSharedCache(tCtx *TransformContext) *ottl.Cache {
+	return tCtx.sharedCache
+}
+
+func pathExpressionParser(cacheGetter ctxcache.Getter[*TransformContext]) ottl.PathExpressionParser[*TransformContext] {
+	return ctxcommon.PathExpressionParser(
+		ctxentity.Name,
+		ctxentity.DocRef,
+		cacheGetter,
+		getSharedCache,
+		map[string]ottl.PathExpressionParser[*TransformContext]{
+			ctxresource.Name:    ctxresource.PathGetSetter[*TransformContext],
+			ctxscope.Name:       ctxscope.PathGetSetter[*TransformContext],
+			ctxscope.LegacyName: ctxscope.PathGetSetter[*TransformContext],
+			ctxentity.Name:      ctxentity.PathGetSetter[*TransformContext],
+			ctxotelcol.Name:     ctxotelcol.PathGetSetter[*TransformContext],
+		})
+}
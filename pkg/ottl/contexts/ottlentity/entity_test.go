@@ -0,0 +1,238 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlentity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxentity"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/pathtest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
+)
+
+func Test_newPathGetSetter(t *testing.T) {
+	newCache := pcommon.NewMap()
+	newCache.PutStr("temp", "value")
+
+	tests := []struct {
+		name     string
+		path     ottl.Path[*TransformContext]
+		orig     any
+		newVal   any
+		modified func(event plog.LogRecord, cache pcommon.Map)
+	}{
+		{
+			name:   "cache",
+			path:   &pathtest.Path[*TransformContext]{N: "cache"},
+			orig:   pcommon.NewMap(),
+			newVal: newCache,
+			modified: func(_ plog.LogRecord, cache pcommon.Map) {
+				newCache.CopyTo(cache)
+			},
+		},
+		{
+			name: "cache access",
+			path: &pathtest.Path[*TransformContext]{
+				N: "cache",
+				KeySlice: []ottl.Key[*TransformContext]{
+					&pathtest.Key[*TransformContext]{S: ottltest.Strp("temp")},
+				},
+			},
+			orig:   nil,
+			newVal: "new value",
+			modified: func(_ plog.LogRecord, cache pcommon.Map) {
+				cache.PutStr("temp", "new value")
+			},
+		},
+		{
+			name:   "type",
+			path:   &pathtest.Path[*TransformContext]{N: "type"},
+			orig:   "k8s.pod",
+			newVal: "k8s.node",
+			modified: func(event plog.LogRecord, _ pcommon.Map) {
+				event.Attributes().PutStr("otel.entity.type", "k8s.node")
+			},
+		},
+		{
+			name: "id key with context",
+			path: &pathtest.Path[*TransformContext]{
+				C: "entity",
+				N: "id",
+				KeySlice: []ottl.Key[*TransformContext]{
+					&pathtest.Key[*TransformContext]{S: ottltest.Strp("k8s.pod.uid")},
+				},
+			},
+			orig:   "uid",
+			newVal: "new-uid",
+			modified: func(event plog.LogRecord, _ pcommon.Map) {
+				id, _ := event.Attributes().Get("otel.entity.id")
+				id.Map().PutStr("k8s.pod.uid", "new-uid")
+			},
+		},
+		{
+			name:   "event_type",
+			path:   &pathtest.Path[*TransformContext]{N: "event_type"},
+			orig:   ctxentity.EventTypeState,
+			newVal: ctxentity.EventTypeDelete,
+			modified: func(event plog.LogRecord, _ pcommon.Map) {
+				event.Attributes().PutStr("otel.entity.event.type", "entity_delete")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessor, err := pathExpressionParser(getCache)(tt.path)
+			require.NoError(t, err)
+
+			rLogs, sLogs, event := createTelemetry()
+			tCtx := NewTransformContextPtr(rLogs, sLogs, event)
+			defer tCtx.Close()
+
+			got, err := accessor.Get(t.Context(), tCtx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.orig, got)
+
+			err = accessor.Set(t.Context(), tCtx, tt.newVal)
+			require.NoError(t, err)
+
+			_, _, expectedEvent := createTelemetry()
+			expectedCache := pcommon.NewMap()
+			tt.modified(expectedEvent, expectedCache)
+
+			assert.Equal(t, expectedEvent, event)
+			assert.Equal(t, expectedCache, tCtx.cache)
+		})
+	}
+}
+
+func Test_newPathGetSetter_higherContextPath(t *testing.T) {
+	rLogs, sLogs, event := createTelemetry()
+	tCtx := NewTransformContextPtr(rLogs, sLogs, event)
+	defer tCtx.Close()
+
+	tests := []struct {
+		name     string
+		path     ottl.Path[*TransformContext]
+		expected any
+	}{
+		{
+			name: "resource with context",
+			path: &pathtest.Path[*TransformContext]{C: "resource", N: "attributes", KeySlice: []ottl.Key[*TransformContext]{
+				&pathtest.Key[*TransformContext]{S: ottltest.Strp("k8s.cluster.name")},
+			}},
+			expected: "cluster",
+		},
+		{
+			name:     "scope with context",
+			path:     &pathtest.Path[*TransformContext]{C: "scope", N: "name"},
+			expected: "k8sclusterreceiver",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessor, err := pathExpressionParser(getCache)(tt.path)
+			require.NoError(t, err)
+
+			got, err := accessor.Get(t.Context(), tCtx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func Test_Parser(t *testing.T) {
+	rLogs, sLogs, event := createTelemetry()
+
+	p, err := NewParser(ottl.CreateFactoryMap[*TransformContext](), componenttest.NewNopTelemetrySettings(), EnablePathContextNames())
+	require.NoError(t, err)
+
+	tCtx := NewTransformContextPtr(rLogs, sLogs, event)
+	defer tCtx.Close()
+
+	condition, err := p.ParseCondition(`entity.type == "k8s.pod" and entity.event_type == ENTITY_EVENT_TYPE_STATE and entity.attributes["k8s.pod.phase"] == "Running"`)
+	require.NoError(t, err)
+	matches, err := condition.Eval(t.Context(), tCtx)
+	require.NoError(t, err)
+	assert.True(t, matches)
+}
+
+func Test_IsEntityEventsScope(t *testing.T) {
+	_, sLogs, _ := createTelemetry()
+	assert.True(t, IsEntityEventsScope(sLogs.Scope()))
+
+	scope := pcommon.NewInstrumentationScope()
+	assert.False(t, IsEntityEventsScope(scope))
+	scope.Attributes().PutStr("otel.entity.event_as_log", "true")
+	assert.False(t, IsEntityEventsScope(scope))
+}
+
+func Test_WithCache(t *testing.T) {
+	shared := ottl.NewCache(ottl.CacheConfig{})
+	accessor, err := pathExpressionParser(getCache)(&pathtest.Path[*TransformContext]{
+		N: "cache",
+		KeySlice: []ottl.Key[*TransformContext]{
+			&pathtest.Key[*TransformContext]{S: ottltest.Strp("key")},
+		},
+	})
+	require.NoError(t, err)
+
+	rLogs, sLogs, event := createTelemetry()
+	tCtx := NewTransformContextPtr(rLogs, sLogs, event, WithCache(shared))
+	require.NoError(t, accessor.Set(t.Context(), tCtx, "value"))
+	assert.Equal(t, 0, tCtx.cache.Len())
+	tCtx.Close()
+
+	tCtx = NewTransformContextPtr(rLogs, sLogs, event, WithCache(shared))
+	defer tCtx.Close()
+	got, err := accessor.Get(t.Context(), tCtx)
+	require.NoError(t, err)
+	assert.Equal(t, "value", got)
+}
+
+func Test_ParseEnum(t *testing.T) {
+	tests := []struct {
+		name string
+		want ottl.Enum
+	}{
+		{name: "ENTITY_EVENT_TYPE_UNSPECIFIED", want: ottl.Enum(ctxentity.EventTypeUnspecified)},
+		{name: "ENTITY_EVENT_TYPE_STATE", want: ottl.Enum(ctxentity.EventTypeState)},
+		{name: "ENTITY_EVENT_TYPE_DELETE", want: ottl.Enum(ctxentity.EventTypeDelete)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := parseEnum((*ottl.EnumSymbol)(ottltest.Strp(tt.name)))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, *actual)
+		})
+	}
+
+	_, err := parseEnum((*ottl.EnumSymbol)(ottltest.Strp("not an enum")))
+	assert.Error(t, err)
+	_, err = parseEnum(nil)
+	assert.Error(t, err)
+}
+
+func createTelemetry() (plog.ResourceLogs, plog.ScopeLogs, plog.LogRecord) {
+	rLogs := plog.NewResourceLogs()
+	rLogs.Resource().Attributes().PutStr("k8s.cluster.name", "cluster")
+
+	sLogs := rLogs.ScopeLogs().AppendEmpty()
+	sLogs.Scope().SetName("k8sclusterreceiver")
+	sLogs.Scope().Attributes().PutBool("otel.entity.event_as_log", true)
+
+	event := sLogs.LogRecords().AppendEmpty()
+	event.Attributes().PutStr("otel.entity.event.type", "entity_state")
+	event.Attributes().PutStr("otel.entity.type", "k8s.pod")
+	event.Attributes().PutEmptyMap("otel.entity.id").PutStr("k8s.pod.uid", "uid")
+	event.Attributes().PutEmptyMap("otel.entity.attributes").PutStr("k8s.pod.phase", "Running")
+
+	return rLogs, sLogs, event
+}
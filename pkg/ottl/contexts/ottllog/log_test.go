@@ -4,13 +4,16 @@
 package ottllog
 
 import (
+	"context"
 	"encoding/hex"
+	"fmt"
 	"slices"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 
@@ -704,6 +707,66 @@ func Test_newPathGetSetter_higherContextPath(t *testing.T) {
 	}
 }
 
+func Test_newPathGetSetter_schemaURLAndDroppedCounts(t *testing.T) {
+	rl := plog.NewResourceLogs()
+	sl := rl.ScopeLogs().AppendEmpty()
+	rl.SetSchemaUrl("https://opentelemetry.io/schemas/1.25.0")
+	rl.Resource().SetDroppedAttributesCount(1)
+	sl.SetSchemaUrl("https://opentelemetry.io/schemas/1.24.0")
+	sl.Scope().SetDroppedAttributesCount(2)
+	tCtx := NewTransformContextPtr(rl, sl, sl.LogRecords().AppendEmpty())
+	defer tCtx.Close()
+	tests := []struct {
+		name     string
+		path     ottl.Path[*TransformContext]
+		orig     any
+		newVal   any
+		modified func() any
+	}{
+		{
+			name:     "resource schema_url",
+			path:     &pathtest.Path[*TransformContext]{C: "resource", N: "schema_url"},
+			orig:     "https://opentelemetry.io/schemas/1.25.0",
+			newVal:   "https://opentelemetry.io/schemas/1.26.0",
+			modified: func() any { return rl.SchemaUrl() },
+		},
+		{
+			name:     "resource dropped_attributes_count",
+			path:     &pathtest.Path[*TransformContext]{C: "resource", N: "dropped_attributes_count"},
+			orig:     int64(1),
+			newVal:   int64(0),
+			modified: func() any { return int64(rl.Resource().DroppedAttributesCount()) },
+		},
+		{
+			name:     "instrumentation_scope schema_url",
+			path:     &pathtest.Path[*TransformContext]{C: "instrumentation_scope", N: "schema_url"},
+			orig:     "https://opentelemetry.io/schemas/1.24.0",
+			newVal:   "https://opentelemetry.io/schemas/1.26.0",
+			modified: func() any { return sl.SchemaUrl() },
+		},
+		{
+			name:     "instrumentation_scope dropped_attributes_count",
+			path:     &pathtest.Path[*TransformContext]{N: "instrumentation_scope", NextPath: &pathtest.Path[*TransformContext]{N: "dropped_attributes_count"}},
+			orig:     int64(2),
+			newVal:   int64(0),
+			modified: func() any { return int64(sl.Scope().DroppedAttributesCount()) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessor, err := pathExpressionParser(getCache)(tt.path)
+			require.NoError(t, err)
+
+			got, err := accessor.Get(t.Context(), tCtx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.orig, got)
+
+			require.NoError(t, accessor.Set(t.Context(), tCtx, tt.newVal))
+			assert.Equal(t, tt.newVal, tt.modified())
+		})
+	}
+}
+
 func createTelemetry(bodyType string) (plog.ResourceLogs, plog.ScopeLogs, plog.LogRecord) {
 	rLogs := plog.NewResourceLogs()
 
@@ -772,6 +835,39 @@ func createTelemetry(bodyType string) (plog.ResourceLogs, plog.ScopeLogs, plog.L
 	return rLogs, rLogs.ScopeLogs().At(0), rLogs.ScopeLogs().At(0).LogRecords().At(0)
 }
 
+func Test_WithCache(t *testing.T) {
+	shared := ottl.NewCache(ottl.CacheConfig{})
+	path := &pathtest.Path[*TransformContext]{
+		N: "cache",
+		KeySlice: []ottl.Key[*TransformContext]{
+			&pathtest.Key[*TransformContext]{
+				S: ottltest.Strp("key"),
+			},
+		},
+	}
+	accessor, err := pathExpressionParser(getCache)(path)
+	require.NoError(t, err)
+
+	resource, scope, log := plog.NewResourceLogs(), plog.NewScopeLogs(), plog.NewLogRecord()
+	tCtx := NewTransformContextPtr(resource, scope, log, WithCache(shared))
+	require.NoError(t, accessor.Set(t.Context(), tCtx, "value"))
+	assert.Equal(t, 0, tCtx.cache.Len())
+	tCtx.Close()
+
+	// The values are available to the other contexts using the cache
+	tCtx = NewTransformContextPtr(resource, scope, log, WithCache(shared))
+	got, err := accessor.Get(t.Context(), tCtx)
+	require.NoError(t, err)
+	assert.Equal(t, "value", got)
+	tCtx.Close()
+
+	tCtx = NewTransformContextPtr(resource, scope, log)
+	defer tCtx.Close()
+	got, err = accessor.Get(t.Context(), tCtx)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
 func Test_InvalidBodyIndexing(t *testing.T) {
 	path := pathtest.Path[*TransformContext]{
 		N: "body",
@@ -798,6 +894,43 @@ func Test_InvalidBodyIndexing(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func Test_WithPathNamespace(t *testing.T) {
+	regionParser := func(path ottl.Path[*TransformContext]) (ottl.GetSetter[*TransformContext], error) {
+		if path.Name() != "region" {
+			return nil, fmt.Errorf("invalid myvendor path %q", path.String())
+		}
+		return ottl.StandardGetSetter[*TransformContext]{
+			Getter: func(_ context.Context, tCtx *TransformContext) (any, error) {
+				region, _ := tCtx.GetResource().Attributes().Get("cloud.region")
+				return region.Str(), nil
+			},
+		}, nil
+	}
+	p, err := NewParser(
+		ottl.CreateFactoryMap[*TransformContext](),
+		componenttest.NewNopTelemetrySettings(),
+		EnablePathContextNames(),
+		ottl.WithPathNamespace[*TransformContext]("myvendor", regionParser),
+	)
+	require.NoError(t, err)
+
+	resourceLogs := plog.NewResourceLogs()
+	resourceLogs.Resource().Attributes().PutStr("cloud.region", "eu-west-1")
+	log := plog.NewLogRecord()
+	log.SetSeverityText("ERROR")
+	tCtx := NewTransformContextPtr(resourceLogs, plog.NewScopeLogs(), log)
+	defer tCtx.Close()
+
+	condition, err := p.ParseCondition(`log.severity_text == "ERROR" and myvendor.region == "eu-west-1"`)
+	require.NoError(t, err)
+	matches, err := condition.Eval(t.Context(), tCtx)
+	require.NoError(t, err)
+	assert.True(t, matches)
+
+	_, err = p.ParseCondition(`myvendor.zone == "a"`)
+	assert.ErrorContains(t, err, `invalid myvendor path "myvendor.zone"`)
+}
+
 func Test_ParseEnum(t *testing.T) {
 	tests := []struct {
 		name string
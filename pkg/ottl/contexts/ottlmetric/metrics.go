@@ -45,6 +45,7 @@ type TransformContext struct {
 	scopeMetrics    pmetric.ScopeMetrics
 	metric          pmetric.Metric
 	cache           pcommon.Map
+	sharedCache     *ottl.Cache
 }
 
 // MarshalLogObject serializes the metric into a zapcore.ObjectEncoder for logging.
@@ -80,9 +81,22 @@ func (tCtx *TransformContext) Close() {
 	tCtx.scopeMetrics = pmetric.ScopeMetrics{}
 	tCtx.metric = pmetric.NewMetric()
 	tCtx.cache.Clear()
+	tCtx.sharedCache = nil
 	tcPool.Put(tCtx)
 }
 
+// WithCache sets a cache shared by the TransformContexts, which is used by the cache path
+// instead of the cache of the TransformContext. The values set by the statements are then
+// available to the statements executed on other TransformContexts, until they expire or are
+// evicted from the cache.
+//
+// Experimental: *NOTE* this option is subject to change or removal in the future.
+func WithCache(cache *ottl.Cache) TransformContextOption {
+	return func(p *TransformContext) {
+		p.sharedCache = cache
+	}
+}
+
 // GetMetric returns the metric from the TransformContext.
 func (tCtx *TransformContext) GetMetric() pmetric.Metric {
 	return tCtx.metric
@@ -159,6 +173,13 @@ func WithConditionSequenceErrorMode(errorMode ottl.ErrorMode) ConditionSequenceO
 	}
 }
 
+// WithConditionSequenceStatistics enables the statistics of the evaluations of the conditions of a condition sequence.
+func WithConditionSequenceStatistics() ConditionSequenceOption {
+	return func(c *ottl.ConditionSequence[*TransformContext]) {
+		ottl.WithConditionSequenceStatistics[*TransformContext]()(c)
+	}
+}
+
 // NewConditionSequence creates a new condition sequence with the provided conditions and options.
 func NewConditionSequence(conditions []*ottl.Condition[*TransformContext], telemetrySettings component.TelemetrySettings, options ...ConditionSequenceOption) ottl.ConditionSequence[*TransformContext] {
 	c := ottl.NewConditionSequence(conditions, telemetrySettings)
@@ -197,11 +218,16 @@ func getCache(tCtx *TransformContext) pcommon.Map {
 	return tCtx.cache
 }
 
+func getSharedCache(tCtx *TransformContext) *ottl.Cache {
+	return tCtx.sharedCache
+}
+
 func pathExpressionParser(cacheGetter ctxcache.Getter[*TransformContext]) ottl.PathExpressionParser[*TransformContext] {
 	return ctxcommon.PathExpressionParser(
 		ctxmetric.Name,
 		ctxmetric.DocRef,
 		cacheGetter,
+		getSharedCache,
 		map[string]ottl.PathExpressionParser[*TransformContext]{
 			ctxresource.Name:    ctxresource.PathGetSetter[*TransformContext],
 			ctxscope.Name:       ctxscope.PathGetSetter[*TransformContext],
@@ -285,6 +285,66 @@ func Test_newPathGetSetter_higherContextPath(t *testing.T) {
 	}
 }
 
+func Test_newPathGetSetter_schemaURLAndDroppedCounts(t *testing.T) {
+	rm := pmetric.NewResourceMetrics()
+	sm := rm.ScopeMetrics().AppendEmpty()
+	rm.SetSchemaUrl("https://opentelemetry.io/schemas/1.25.0")
+	rm.Resource().SetDroppedAttributesCount(1)
+	sm.SetSchemaUrl("https://opentelemetry.io/schemas/1.24.0")
+	sm.Scope().SetDroppedAttributesCount(2)
+	tCtx := NewTransformContextPtr(rm, sm, sm.Metrics().AppendEmpty())
+	defer tCtx.Close()
+	tests := []struct {
+		name     string
+		path     ottl.Path[*TransformContext]
+		orig     any
+		newVal   any
+		modified func() any
+	}{
+		{
+			name:     "resource schema_url",
+			path:     &pathtest.Path[*TransformContext]{C: "resource", N: "schema_url"},
+			orig:     "https://opentelemetry.io/schemas/1.25.0",
+			newVal:   "https://opentelemetry.io/schemas/1.26.0",
+			modified: func() any { return rm.SchemaUrl() },
+		},
+		{
+			name:     "resource dropped_attributes_count",
+			path:     &pathtest.Path[*TransformContext]{C: "resource", N: "dropped_attributes_count"},
+			orig:     int64(1),
+			newVal:   int64(0),
+			modified: func() any { return int64(rm.Resource().DroppedAttributesCount()) },
+		},
+		{
+			name:     "instrumentation_scope schema_url",
+			path:     &pathtest.Path[*TransformContext]{C: "instrumentation_scope", N: "schema_url"},
+			orig:     "https://opentelemetry.io/schemas/1.24.0",
+			newVal:   "https://opentelemetry.io/schemas/1.26.0",
+			modified: func() any { return sm.SchemaUrl() },
+		},
+		{
+			name:     "instrumentation_scope dropped_attributes_count",
+			path:     &pathtest.Path[*TransformContext]{N: "instrumentation_scope", NextPath: &pathtest.Path[*TransformContext]{N: "dropped_attributes_count"}},
+			orig:     int64(2),
+			newVal:   int64(0),
+			modified: func() any { return int64(sm.Scope().DroppedAttributesCount()) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessor, err := pathExpressionParser(getCache)(tt.path)
+			require.NoError(t, err)
+
+			got, err := accessor.Get(t.Context(), tCtx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.orig, got)
+
+			require.NoError(t, accessor.Set(t.Context(), tCtx, tt.newVal))
+			assert.Equal(t, tt.newVal, tt.modified())
+		})
+	}
+}
+
 func createTelemetry() pmetric.Metric {
 	metric := pmetric.NewMetric()
 	metric.SetName("name")
@@ -3,6 +3,7 @@
 
 package ottlotelcol // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlotelcol"
 import (
+	"context"
 	"errors"
 	"sync"
 
@@ -31,7 +32,8 @@ var _ zapcore.ObjectMarshaler = (*TransformContext)(nil)
 
 // TransformContext represents the data passed through the OpenTelemetry Collector by its components.
 type TransformContext struct {
-	cache pcommon.Map
+	cache       pcommon.Map
+	sharedCache *ottl.Cache
 }
 
 // MarshalLogObject serializes the TransformContext into a zapcore.ObjectEncoder for logging.
@@ -56,9 +58,22 @@ func NewTransformContextPtr(options ...TransformContextOption) *TransformContext
 // After this function returns this instance cannot be used.
 func (tCtx *TransformContext) Close() {
 	tCtx.cache.Clear()
+	tCtx.sharedCache = nil
 	tcPool.Put(tCtx)
 }
 
+// WithCache sets a cache shared by the TransformContexts, which is used by the cache path
+// instead of the cache of the TransformContext. The values set by the statements are then
+// available to the statements executed on other TransformContexts, until they expire or are
+// evicted from the cache.
+//
+// Experimental: *NOTE* this option is subject to change or removal in the future.
+func WithCache(cache *ottl.Cache) TransformContextOption {
+	return func(p *TransformContext) {
+		p.sharedCache = cache
+	}
+}
+
 // EnablePathContextNames enables the support for path's context names on statements.
 // When this option is configured, all statement's paths must have a valid context prefix,
 // otherwise an error is reported.
@@ -70,6 +85,37 @@ func EnablePathContextNames() ottl.Option[*TransformContext] {
 	}
 }
 
+// CollectorInfo describes the collector processing the data, read by the
+// `otelcol.collector` paths.
+//
+// Experimental: *NOTE* this type is subject to change or removal in the future.
+type CollectorInfo = ctxotelcol.CollectorInfo
+
+// NewCollectorInfoContext returns a context carrying the CollectorInfo, which statements
+// executed with it can read.
+//
+// Experimental: *NOTE* this function is subject to change or removal in the future.
+func NewCollectorInfoContext(ctx context.Context, info CollectorInfo) context.Context {
+	return ctxotelcol.NewCollectorInfoContext(ctx, info)
+}
+
+// NewOutgoingMetadataContext returns a context in which statements can set `otelcol.client.metadata`.
+// The changes are collected in the context and applied by ApplyOutgoingMetadata.
+//
+// Experimental: *NOTE* this function is subject to change or removal in the future.
+func NewOutgoingMetadataContext(ctx context.Context) context.Context {
+	return ctxotelcol.NewOutgoingMetadataContext(ctx)
+}
+
+// ApplyOutgoingMetadata returns a context whose client metadata includes the changes made by
+// statements executed with a context returned by NewOutgoingMetadataContext. It should be passed
+// to the next consumer, so exporters see the updated metadata.
+//
+// Experimental: *NOTE* this function is subject to change or removal in the future.
+func ApplyOutgoingMetadata(ctx context.Context) context.Context {
+	return ctxotelcol.ApplyOutgoingMetadata(ctx)
+}
+
 // StatementSequenceOption represents an option for configuring a statement sequence.
 type StatementSequenceOption func(*ottl.StatementSequence[*TransformContext])
 
@@ -99,6 +145,13 @@ func WithConditionSequenceErrorMode(errorMode ottl.ErrorMode) ConditionSequenceO
 	}
 }
 
+// WithConditionSequenceStatistics enables the statistics of the evaluations of the conditions of a condition sequence.
+func WithConditionSequenceStatistics() ConditionSequenceOption {
+	return func(c *ottl.ConditionSequence[*TransformContext]) {
+		ottl.WithConditionSequenceStatistics[*TransformContext]()(c)
+	}
+}
+
 // NewConditionSequence creates a new condition sequence with the provided conditions and options.
 func NewConditionSequence(conditions []*ottl.Condition[*TransformContext], telemetrySettings component.TelemetrySettings, options ...ConditionSequenceOption) ottl.ConditionSequence[*TransformContext] {
 	c := ottl.NewConditionSequence(conditions, telemetrySettings)
@@ -131,11 +184,16 @@ func getCache(tCtx *TransformContext) pcommon.Map {
 	return tCtx.cache
 }
 
+func getSharedCache(tCtx *TransformContext) *ottl.Cache {
+	return tCtx.sharedCache
+}
+
 func pathExpressionParser(cacheGetter ctxcache.Getter[*TransformContext]) ottl.PathExpressionParser[*TransformContext] {
 	return ctxcommon.PathExpressionParser(
 		ctxotelcol.Name,
 		ctxotelcol.DocRef,
 		cacheGetter,
+		getSharedCache,
 		map[string]ottl.PathExpressionParser[*TransformContext]{
 			ctxotelcol.Name: ctxotelcol.PathGetSetter[*TransformContext],
 		})
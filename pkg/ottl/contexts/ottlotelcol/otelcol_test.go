@@ -4,10 +4,13 @@
 package ottlotelcol
 
 import (
+	"os"
 	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
@@ -18,6 +21,8 @@ import (
 func Test_newPathGetSetter(t *testing.T) {
 	newCache := pcommon.NewMap()
 	newCache.PutStr("temp", "value")
+	hostname, err := os.Hostname()
+	require.NoError(t, err)
 
 	tests := []struct {
 		name         string
@@ -125,6 +130,126 @@ func Test_newPathGetSetter(t *testing.T) {
 			newVal:       nil,
 			wantErrOnSet: true,
 		},
+		{
+			name: "client.tls.subject",
+			path: &pathtest.Path[*TransformContext]{
+				N: "client",
+				NextPath: &pathtest.Path[*TransformContext]{
+					N: "tls",
+					NextPath: &pathtest.Path[*TransformContext]{
+						N: "subject",
+					},
+				},
+			},
+			orig:         nil,
+			newVal:       nil,
+			wantErrOnSet: true,
+		},
+		{
+			name: "client.tls.issuer",
+			path: &pathtest.Path[*TransformContext]{
+				N: "client",
+				NextPath: &pathtest.Path[*TransformContext]{
+					N: "tls",
+					NextPath: &pathtest.Path[*TransformContext]{
+						N: "issuer",
+					},
+				},
+			},
+			orig:         nil,
+			newVal:       nil,
+			wantErrOnSet: true,
+		},
+		{
+			name: "client.tls.not_after",
+			path: &pathtest.Path[*TransformContext]{
+				N: "client",
+				NextPath: &pathtest.Path[*TransformContext]{
+					N: "tls",
+					NextPath: &pathtest.Path[*TransformContext]{
+						N: "not_after",
+					},
+				},
+			},
+			orig:         nil,
+			newVal:       nil,
+			wantErrOnSet: true,
+		},
+		{
+			name: "client.tls.san",
+			path: &pathtest.Path[*TransformContext]{
+				N: "client",
+				NextPath: &pathtest.Path[*TransformContext]{
+					N: "tls",
+					NextPath: &pathtest.Path[*TransformContext]{
+						N: "san",
+					},
+				},
+			},
+			orig:         nil,
+			newVal:       nil,
+			wantErrOnSet: true,
+		},
+		{
+			name: "collector.version",
+			path: &pathtest.Path[*TransformContext]{
+				N: "collector",
+				NextPath: &pathtest.Path[*TransformContext]{
+					N: "version",
+				},
+			},
+			orig:         nil,
+			newVal:       "v1.2.3",
+			wantErrOnSet: true,
+		},
+		{
+			name: "collector.hostname",
+			path: &pathtest.Path[*TransformContext]{
+				N: "collector",
+				NextPath: &pathtest.Path[*TransformContext]{
+					N: "hostname",
+				},
+			},
+			orig:         hostname,
+			newVal:       "collector-0",
+			wantErrOnSet: true,
+		},
+		{
+			name: "http.user_agent",
+			path: &pathtest.Path[*TransformContext]{
+				N: "http",
+				NextPath: &pathtest.Path[*TransformContext]{
+					N: "user_agent",
+				},
+			},
+			orig:         nil,
+			newVal:       nil,
+			wantErrOnSet: true,
+		},
+		{
+			name: "http.content_encoding",
+			path: &pathtest.Path[*TransformContext]{
+				N: "http",
+				NextPath: &pathtest.Path[*TransformContext]{
+					N: "content_encoding",
+				},
+			},
+			orig:         nil,
+			newVal:       nil,
+			wantErrOnSet: true,
+		},
+		{
+			name: "http.remote_port",
+			path: &pathtest.Path[*TransformContext]{
+				N: "http",
+				NextPath: &pathtest.Path[*TransformContext]{
+					N: "remote_port",
+				},
+			},
+			orig:         nil,
+			newVal:       nil,
+			wantErrOnSet: true,
+		},
 		{
 			name: `grpc.metadata`,
 			path: &pathtest.Path[*TransformContext]{
@@ -188,3 +313,28 @@ func Test_newPathGetSetter(t *testing.T) {
 		})
 	}
 }
+
+func Test_OutgoingMetadata(t *testing.T) {
+	accessor, err := pathExpressionParser(getCache)(&pathtest.Path[*TransformContext]{
+		N: "client",
+		NextPath: &pathtest.Path[*TransformContext]{
+			N: "metadata",
+			KeySlice: []ottl.Key[*TransformContext]{
+				&pathtest.Key[*TransformContext]{
+					S: ottltest.Strp("x-tenant-id"),
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	tCtx := NewTransformContextPtr()
+	defer tCtx.Close()
+	ctx := NewOutgoingMetadataContext(client.NewContext(t.Context(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"x-tenant-id": {"incoming"}}),
+	}))
+	require.NoError(t, accessor.Set(ctx, tCtx, "tenant-a"))
+
+	assert.Equal(t, []string{"incoming"}, client.FromContext(ctx).Metadata.Get("x-tenant-id"))
+	assert.Equal(t, []string{"tenant-a"}, client.FromContext(ApplyOutgoingMetadata(ctx)).Metadata.Get("x-tenant-id"))
+}
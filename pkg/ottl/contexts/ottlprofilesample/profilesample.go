@@ -51,6 +51,7 @@ type TransformContext struct {
 	instrumentationScope pcommon.InstrumentationScope
 	resource             pcommon.Resource
 	cache                pcommon.Map
+	sharedCache          *ottl.Cache
 	scopeProfiles        pprofile.ScopeProfiles
 	resourceProfiles     pprofile.ResourceProfiles
 }
@@ -113,11 +114,24 @@ func (tCtx *TransformContext) Close() {
 	tCtx.instrumentationScope = pcommon.InstrumentationScope{}
 	tCtx.resource = pcommon.Resource{}
 	tCtx.cache.Clear()
+	tCtx.sharedCache = nil
 	tCtx.scopeProfiles = pprofile.ScopeProfiles{}
 	tCtx.resourceProfiles = pprofile.ResourceProfiles{}
 	tcPool.Put(tCtx)
 }
 
+// WithCache sets a cache shared by the TransformContexts, which is used by the cache path
+// instead of the cache of the TransformContext. The values set by the statements are then
+// available to the statements executed on other TransformContexts, until they expire or are
+// evicted from the cache.
+//
+// Experimental: *NOTE* this option is subject to change or removal in the future.
+func WithCache(cache *ottl.Cache) TransformContextOption {
+	return func(p *TransformContext) {
+		p.sharedCache = cache
+	}
+}
+
 // GetProfile returns the profile from the TransformContext.
 func (tCtx *TransformContext) GetProfile() pprofile.Profile {
 	return tCtx.profile
@@ -210,6 +224,13 @@ func WithConditionSequenceErrorMode(errorMode ottl.ErrorMode) ConditionSequenceO
 	}
 }
 
+// WithConditionSequenceStatistics enables the statistics of the evaluations of the conditions of a condition sequence.
+func WithConditionSequenceStatistics() ConditionSequenceOption {
+	return func(c *ottl.ConditionSequence[*TransformContext]) {
+		ottl.WithConditionSequenceStatistics[*TransformContext]()(c)
+	}
+}
+
 // NewConditionSequence creates a new condition sequence with the provided conditions and options.
 func NewConditionSequence(conditions []*ottl.Condition[*TransformContext], telemetrySettings component.TelemetrySettings, options ...ConditionSequenceOption) ottl.ConditionSequence[*TransformContext] {
 	c := ottl.NewConditionSequence(conditions, telemetrySettings)
@@ -230,11 +251,16 @@ func getCache(tCtx *TransformContext) pcommon.Map {
 	return tCtx.cache
 }
 
+func getSharedCache(tCtx *TransformContext) *ottl.Cache {
+	return tCtx.sharedCache
+}
+
 func pathExpressionParser(cacheGetter ctxcache.Getter[*TransformContext]) ottl.PathExpressionParser[*TransformContext] {
 	return ctxcommon.PathExpressionParser(
 		ctxprofilesample.Name,
 		ctxprofilesample.DocRef,
 		cacheGetter,
+		getSharedCache,
 		map[string]ottl.PathExpressionParser[*TransformContext]{
 			ctxresource.Name:      ctxresource.PathGetSetter[*TransformContext],
 			ctxscope.Name:         ctxscope.PathGetSetter[*TransformContext],
@@ -0,0 +1,158 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottltrace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/pathtest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
+)
+
+func Test_newPathGetSetter(t *testing.T) {
+	traceID, _ := createTelemetry()
+
+	newCache := pcommon.NewMap()
+	newCache.PutStr("temp", "value")
+
+	tests := []struct {
+		name     string
+		path     ottl.Path[*TransformContext]
+		orig     any
+		newVal   any
+		modified func(spans []ptrace.Span, cache pcommon.Map)
+	}{
+		{
+			name: "cache",
+			path: &pathtest.Path[*TransformContext]{
+				N: "cache",
+			},
+			orig:   pcommon.NewMap(),
+			newVal: newCache,
+			modified: func(_ []ptrace.Span, cache pcommon.Map) {
+				newCache.CopyTo(cache)
+			},
+		},
+		{
+			name: "cache access",
+			path: &pathtest.Path[*TransformContext]{
+				N: "cache",
+				KeySlice: []ottl.Key[*TransformContext]{
+					&pathtest.Key[*TransformContext]{
+						S: ottltest.Strp("temp"),
+					},
+				},
+			},
+			orig:   nil,
+			newVal: "new value",
+			modified: func(_ []ptrace.Span, cache pcommon.Map) {
+				cache.PutStr("temp", "new value")
+			},
+		},
+		{
+			name: "span_attributes",
+			path: &pathtest.Path[*TransformContext]{
+				N: "span_attributes",
+				KeySlice: []ottl.Key[*TransformContext]{
+					&pathtest.Key[*TransformContext]{
+						S: ottltest.Strp("service.name"),
+					},
+				},
+			},
+			orig:   "checkout",
+			newVal: "cart",
+			modified: func(spans []ptrace.Span, _ pcommon.Map) {
+				for _, span := range spans {
+					span.Attributes().PutStr("service.name", "cart")
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessor, err := pathExpressionParser(getCache)(tt.path)
+			require.NoError(t, err)
+
+			_, spans := createTelemetry()
+			tCtx := NewTransformContextPtr(traceID, spans)
+			defer tCtx.Close()
+
+			got, err := accessor.Get(t.Context(), tCtx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.orig, got)
+
+			err = accessor.Set(t.Context(), tCtx, tt.newVal)
+			require.NoError(t, err)
+
+			_, expectedSpans := createTelemetry()
+			expectedCache := pcommon.NewMap()
+			tt.modified(expectedSpans, expectedCache)
+
+			for i, span := range tCtx.GetSpans() {
+				assert.Equal(t, expectedSpans[i].Attributes().AsRaw(), span.Attributes().AsRaw())
+			}
+			assert.Equal(t, expectedCache, tCtx.cache)
+		})
+	}
+}
+
+func Test_Parser(t *testing.T) {
+	traceID, spans := createTelemetry()
+
+	p, err := NewParser(ottl.CreateFactoryMap[*TransformContext](), componenttest.NewNopTelemetrySettings(), EnablePathContextNames())
+	require.NoError(t, err)
+
+	tCtx := NewTransformContextPtr(traceID, spans)
+	defer tCtx.Close()
+
+	condition, err := p.ParseCondition(`trace.error_count > 0 and trace.root_span_name == "GET /checkout"`)
+	require.NoError(t, err)
+	matches, err := condition.Eval(t.Context(), tCtx)
+	require.NoError(t, err)
+	assert.True(t, matches)
+
+	value, err := p.ParseValueExpression(`[s["name"] for s in trace.spans where s["status_code"] == STATUS_CODE_ERROR]`)
+	require.NoError(t, err)
+	got, err := value.Eval(t.Context(), tCtx)
+	require.NoError(t, err)
+	assert.Equal(t, []any{"SELECT orders"}, got)
+}
+
+func Test_ParseEnum(t *testing.T) {
+	actual, err := parseEnum((*ottl.EnumSymbol)(ottltest.Strp("STATUS_CODE_ERROR")))
+	require.NoError(t, err)
+	assert.Equal(t, ottl.Enum(ptrace.StatusCodeError), *actual)
+
+	_, err = parseEnum((*ottl.EnumSymbol)(ottltest.Strp("not an enum")))
+	assert.Error(t, err)
+}
+
+func createTelemetry() (pcommon.TraceID, []ptrace.Span) {
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	rs := ptrace.NewResourceSpans()
+	ss := rs.ScopeSpans().AppendEmpty()
+
+	root := ss.Spans().AppendEmpty()
+	root.SetTraceID(traceID)
+	root.SetSpanID(pcommon.SpanID([8]byte{0, 0, 0, 0, 0, 0, 0, 1}))
+	root.SetName("GET /checkout")
+	root.Attributes().PutStr("service.name", "checkout")
+
+	child := ss.Spans().AppendEmpty()
+	child.SetTraceID(traceID)
+	child.SetSpanID(pcommon.SpanID([8]byte{0, 0, 0, 0, 0, 0, 0, 2}))
+	child.SetParentSpanID(root.SpanID())
+	child.SetName("SELECT orders")
+	child.Status().SetCode(ptrace.StatusCodeError)
+	child.Attributes().PutStr("service.name", "checkout")
+
+	return traceID, []ptrace.Span{root, child}
+}
@@ -894,6 +894,18 @@ func Test_e2e_converters(t *testing.T) {
 				tCtx.GetLogRecord().Attributes().PutStr("test", "3132")
 			},
 		},
+		{
+			statement: `set(attributes["test"], If(attributes["http.method"] == "get", "read", "write"))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "read")
+			},
+		},
+		{
+			statement: `set(attributes["test"], If(not IsMatch(attributes["http.path"], "^/api") and attributes["http.method"] != "post", attributes["http.path"], "api"))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "/health")
+			},
+		},
 		{
 			statement: `set(attributes["test"], "pass") where IsBool(false)`,
 			want: func(tCtx *ottllog.TransformContext) {
@@ -1059,6 +1071,20 @@ func Test_e2e_converters(t *testing.T) {
 				m.PutStr("k2", "v2__!__v2")
 			},
 		},
+		{
+			statement: `set(attributes["test"], ParseCookies("session=abc123; theme=dark"))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				m := tCtx.GetLogRecord().Attributes().PutEmptyMap("test")
+				m.PutStr("session", "abc123")
+				m.PutStr("theme", "dark")
+			},
+		},
+		{
+			statement: `set(attributes["test"], ParseAuthHeader("Bearer abc.def")["scheme"])`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "Bearer")
+			},
+		},
 		{
 			statement: `set(attributes["test"], ToKeyValueString(ParseKeyValue("k1=v1 k2=v2"), "=", " ", true))`,
 			want: func(tCtx *ottllog.TransformContext) {
@@ -1409,6 +1435,78 @@ func Test_e2e_converters(t *testing.T) {
 				m.PutStr("os.name", "Other")
 			},
 		},
+		{
+			statement: `set(attributes["test"], UserAgentDeviceType("Mozilla/5.0 (iPad; CPU OS 13_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/13.0.3 Mobile/15E148 Safari/604.1"))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "tablet")
+			},
+		},
+		{
+			statement: `set(attributes["test"], IsUserAgentBot("Googlebot/2.1 (+http://www.google.com/bot.html)"))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutBool("test", true)
+			},
+		},
+		{
+			statement: `set(attributes["test"], UserAgentVersionInRange("curl/7.81.0", "7.50", "8"))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutBool("test", true)
+			},
+		},
+		{
+			statement: `set(attributes["test"], LevenshteinDistance("kitten", "sitting"))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutInt("test", 3)
+			},
+		},
+		{
+			statement: `set(attributes["test"], JaroWinkler("operationA", "operationA"))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutDouble("test", 1)
+			},
+		},
+		{
+			statement: `set(attributes["test"], Soundex("Rupert"))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "R163")
+			},
+		},
+		{
+			statement: `set(attributes["test"], FormatTime(ConvertTimeZone(Time("2024-07-01 12:30", "%Y-%m-%d %H:%M", "UTC"), "Europe/Lisbon"), "%A %d %B %H:%M", "pt-PT"))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "segunda-feira 01 julho 13:30")
+			},
+		},
+		{
+			statement: `set(attributes["test"], BytesToHex(ByteSlice(trace_id, 8, 16)))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "090a0b0c0d0e0f10")
+			},
+		},
+		{
+			statement: `set(attributes["test"], HexToBytes("0xcafe"))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutEmptyBytes("test").FromRaw([]byte{0xca, 0xfe})
+			},
+		},
+		{
+			statement: `set(trace_id, TraceID(BitwiseXor(trace_id, HexToBytes("0102030405060708090a0b0c0d0e0f10"))))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().SetTraceID(pcommon.NewTraceIDEmpty())
+			},
+		},
+		{
+			statement: `set(span_id, SpanID(BitwiseOr(span_id, HexToBytes("f000000000000000"))))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().SetSpanID(pcommon.SpanID([8]byte{0xf1, 2, 3, 4, 5, 6, 7, 8}))
+			},
+		},
+		{
+			statement: `set(attributes["test"], BitwiseAnd(255, 15) + BitwiseOr(16, 1) + BitwiseXor(3, 1))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutInt("test", 34)
+			},
+		},
 		{
 			statement: `set(attributes["test"], SliceToMap(attributes["things"], ["name"]))`,
 			want: func(tCtx *ottllog.TransformContext) {
@@ -1506,6 +1604,62 @@ func Test_e2e_converters(t *testing.T) {
 				tCtx.GetLogRecord().Attributes().PutInt("test", 2)
 			},
 		},
+		{
+			statement: `set(attributes["test"], Sum([x["value"] for x in attributes["things"]]))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutInt("test", 7)
+			},
+		},
+		{
+			statement: `set(attributes["test"], Avg([x["value"] for x in attributes["things"]]))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutDouble("test", 3.5)
+			},
+		},
+		{
+			statement: `set(attributes["test"], Min([x["name"] for x in attributes["things"]]))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "bar")
+			},
+		},
+		{
+			statement: `set(attributes["test"], Max([x["value"] for x in attributes["things"]]))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutInt("test", 5)
+			},
+		},
+		{
+			statement: `set(attributes["test"], Count(["a", nil, "b"]))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutInt("test", 2)
+			},
+		},
+		{
+			statement: `set(attributes["test"], SampleConsistent(1, trace_id))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutBool("test", true)
+			},
+		},
+		{
+			statement: `set(attributes["test"], SampleConsistent(0.5, trace_id))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutBool("test", false)
+			},
+		},
+		{
+			statement: `set(attributes["test"], RateLimit(attributes["http.method"], 1, Duration("1m")))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutBool("test", true)
+			},
+		},
+		{
+			statement: `set(attributes["test"], Distinct(["a", "b", "a"]))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				s := tCtx.GetLogRecord().Attributes().PutEmptySlice("test")
+				s.AppendEmpty().SetStr("a")
+				s.AppendEmpty().SetStr("b")
+			},
+		},
 		{
 			statement: `set(
 	attributes["test"],
@@ -1599,6 +1753,55 @@ func Test_e2e_converters(t *testing.T) {
 				tCtx.GetLogRecord().Attributes().PutBool("in_cidr", true)
 			},
 		},
+		{
+			statement: `set(attributes["in_cidr_list"], IPInCIDRList(attributes["server.ip"], ["10.0.0.0/8", "192.168.0.0/16"]))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutBool("in_cidr_list", true)
+			},
+		},
+		{
+			statement: `set(attributes["private_ip"], IsPrivateIP(attributes["server.ip"]))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutBool("private_ip", true)
+			},
+		},
+		{
+			statement: `set(attributes["ip_version"], IPVersion(attributes["server.ip"]))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutInt("ip_version", 4)
+			},
+		},
+		{
+			statement: `set(attributes["db.query.text"], ObfuscateSQL("SELECT * FROM users WHERE name = 'alice' AND id IN (1, 2)"))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("db.query.text", "SELECT * FROM users WHERE name = ? AND id IN (?)")
+			},
+		},
+		{
+			statement: `set(attributes["test"], XPath("<a><b id=\"1\">foo</b><b id=\"2\">bar</b></a>", "/a/b[@id='2']/text()")[0])`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "bar")
+			},
+		},
+		{
+			statement: `merge_maps(attributes, ExtractRegex("GET /checkout 200", "^(?P<method>\\w+) (?P<path>\\S+)"), "upsert")`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("method", "GET")
+				tCtx.GetLogRecord().Attributes().PutStr("path", "/checkout")
+			},
+		},
+		{
+			statement: `set(time, TruncateTimestamp(time, "1h"))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().SetTimestamp(pcommon.NewTimestampFromTime(TestLogTimestamp.AsTime().Truncate(time.Hour)))
+			},
+		},
+		{
+			statement: `set(time, TimestampAdd(time, Duration("90m") % Duration("1h")))`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().SetTimestamp(pcommon.NewTimestampFromTime(TestLogTimestamp.AsTime().Add(30 * time.Minute)))
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1637,6 +1840,66 @@ func Test_e2e_ottl_features(t *testing.T) {
 			statement: `set(attributes["test"], "pass") where body == "operationB"`,
 			want:      func(*ottllog.TransformContext) {},
 		},
+		{
+			name:      "where clause with in",
+			statement: `set(attributes["test"], "pass") where attributes["http.method"] in ["get", "post"]`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "pass")
+			},
+		},
+		{
+			name:      "where clause with not in",
+			statement: `set(attributes["test"], "pass") where body not in ["operationA", "operationB"]`,
+			want:      func(*ottllog.TransformContext) {},
+		},
+		{
+			name:      "where clause with between",
+			statement: `set(attributes["test"], "pass") where severity_number between SEVERITY_NUMBER_TRACE and SEVERITY_NUMBER_DEBUG`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "pass")
+			},
+		},
+		{
+			name:      "in as argument",
+			statement: `set(attributes["test"], attributes["int_value"] not in [1, 2])`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutBool("test", true)
+			},
+		},
+		{
+			name:      "negative index",
+			statement: `set(attributes["test"], attributes["slices"][-1]["name"])`,
+			want: func(tCtx *ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "foo")
+			},
+		},
+		{
+			name:      "get range",
+			statement: `set(attributes["test"], attributes["slices"][:2])`,
+			want: func(tCtx *ottllog.TransformContext) {
+				s := tCtx.GetLogRecord().Attributes().PutEmptySlice("test")
+				s.AppendEmpty().SetStr("slice1")
+				s.AppendEmpty().SetStr("slice2")
+			},
+		},
+		{
+			name:      "set range",
+			statement: `set(attributes["slices"][1:], ["pass"])`,
+			want: func(tCtx *ottllog.TransformContext) {
+				s := tCtx.GetLogRecord().Attributes().PutEmptySlice("slices")
+				s.AppendEmpty().SetStr("slice1")
+				s.AppendEmpty().SetStr("pass")
+			},
+		},
+		{
+			name:      "range of converter result",
+			statement: `set(attributes["test"], Split(attributes["flags"], "|")[-2:])`,
+			want: func(tCtx *ottllog.TransformContext) {
+				s := tCtx.GetLogRecord().Attributes().PutEmptySlice("test")
+				s.AppendEmpty().SetStr("B")
+				s.AppendEmpty().SetStr("C")
+			},
+		},
 		{
 			name:      "reach upwards",
 			statement: `set(attributes["test"], "pass") where resource.attributes["host.name"] == "localhost"`,
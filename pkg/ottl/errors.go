@@ -0,0 +1,165 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"errors"
+
+	"github.com/alecthomas/participle/v2"
+	"github.com/alecthomas/participle/v2/lexer"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// StatementError is an error of a statement, condition or value expression, which tells which
+// part of its text caused the error. The errors returned by the Parser when the statements can't
+// be parsed, and by StatementSequence and ConditionSequence when they fail at runtime, wrap a
+// StatementError which can be retrieved with errors.As, or with StatementErrors when several
+// statements failed to parse. Its message is the one of the underlying error.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type StatementError struct {
+	// Source is the text of the statement, condition or value expression.
+	Source string
+	// Index is the index of the statement or condition in the slice it was parsed or executed
+	// with, or -1 if it was parsed on its own.
+	Index int
+	// Line and Column are the 1-based position in Source of the part which caused the error,
+	// and Offset its byte offset. They are 0 if the position is unknown.
+	Line   int
+	Column int
+	Offset int
+	// Path is the path which caused the error, if any.
+	Path string
+	// Function is the name of the innermost editor or converter whose call caused the error, if any.
+	Function string
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *StatementError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *StatementError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalLogObject serializes the StatementError into a zapcore.ObjectEncoder for logging.
+func (e *StatementError) MarshalLogObject(encoder zapcore.ObjectEncoder) error {
+	if e.Source != "" {
+		encoder.AddString("source", e.Source)
+	}
+	if e.Index >= 0 {
+		encoder.AddInt("index", e.Index)
+	}
+	if e.Line > 0 {
+		encoder.AddInt("line", e.Line)
+		encoder.AddInt("column", e.Column)
+		encoder.AddInt("offset", e.Offset)
+	}
+	if e.Path != "" {
+		encoder.AddString("path", e.Path)
+	}
+	if e.Function != "" {
+		encoder.AddString("function", e.Function)
+	}
+	return nil
+}
+
+// StatementErrors returns the StatementErrors wrapped by the error, including the ones of each
+// error joined by the Parser when several statements, conditions or value expressions failed to
+// be parsed.
+//
+// Experimental: *NOTE* this function is subject to change or removal in the future.
+func StatementErrors(err error) []*StatementError {
+	switch e := err.(type) {
+	case *StatementError:
+		return []*StatementError{e}
+	case interface{ Unwrap() []error }:
+		var statementErrs []*StatementError
+		for _, err := range e.Unwrap() {
+			statementErrs = append(statementErrs, StatementErrors(err)...)
+		}
+		return statementErrs
+	case interface{ Unwrap() error }:
+		return StatementErrors(e.Unwrap())
+	default:
+		return nil
+	}
+}
+
+// findStatementError returns the StatementError wrapped by the error, or wraps the error in a new
+// one if there is none.
+func findStatementError(err error) (*StatementError, error) {
+	var statementErr *StatementError
+	if errors.As(err, &statementErr) {
+		return statementErr, err
+	}
+	statementErr = &StatementError{Index: -1, Err: err}
+	return statementErr, statementErr
+}
+
+// setPosition sets the position of the error, unless a more precise one was already set.
+func (e *StatementError) setPosition(pos lexer.Position) {
+	if e.Line == 0 && pos.Line > 0 {
+		e.Line = pos.Line
+		e.Column = pos.Column
+		e.Offset = pos.Offset
+	}
+}
+
+// newSyntaxError returns a StatementError wrapping the error of the participle parser, at the
+// position of the unexpected token.
+func newSyntaxError(err error) error {
+	statementErr := &StatementError{Index: -1, Err: err}
+	var participleErr participle.Error
+	if errors.As(err, &participleErr) {
+		statementErr.setPosition(participleErr.Position())
+	}
+	return statementErr
+}
+
+// withFunction attributes the error to the call of the function at the position,
+// unless it was already attributed to a call of a nested function.
+func withFunction(err error, function string, pos lexer.Position) error {
+	statementErr, err := findStatementError(err)
+	if statementErr.Function == "" {
+		statementErr.Function = function
+		statementErr.setPosition(pos)
+	}
+	return err
+}
+
+// withPath attributes the error to the path at the position.
+func withPath(err error, path string, pos lexer.Position) error {
+	statementErr, err := findStatementError(err)
+	if statementErr.Path == "" {
+		statementErr.Path = path
+		statementErr.setPosition(pos)
+	}
+	return err
+}
+
+// withSource sets the text and the index of the statement, condition or value expression
+// which returned the error.
+func withSource(err error, source string, index int) error {
+	statementErr, err := findStatementError(err)
+	if statementErr.Source == "" {
+		statementErr.Source = source
+	}
+	if index >= 0 {
+		statementErr.Index = index
+	}
+	return err
+}
+
+// statementErrorField returns a field logging the details of the StatementError wrapped by the error.
+func statementErrorField(err error) zap.Field {
+	var statementErr *StatementError
+	if errors.As(err, &statementErr) {
+		return zap.Object("error_details", statementErr)
+	}
+	return zap.Skip()
+}
@@ -0,0 +1,221 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+type statementErrorSetArguments struct {
+	Target GetSetter[map[string]any]
+	Value  Getter[map[string]any]
+}
+
+type statementErrorFailArguments struct {
+	Message string
+}
+
+type statementErrorIdentityArguments struct {
+	Value Getter[map[string]any]
+}
+
+// newStatementErrorTestParser returns a parser with a set editor, a Fail converter returning
+// an error with its message at runtime, and an Identity converter. Its paths are the keys of
+// the TransformContext, except "invalid".
+func newStatementErrorTestParser(t *testing.T, settings component.TelemetrySettings) Parser[map[string]any] {
+	functions := CreateFactoryMap(
+		NewFactory("set", &statementErrorSetArguments{}, func(_ FunctionContext, args Arguments) (ExprFunc[map[string]any], error) {
+			a := args.(*statementErrorSetArguments)
+			return func(ctx context.Context, tCtx map[string]any) (any, error) {
+				val, err := a.Value.Get(ctx, tCtx)
+				if err != nil {
+					return nil, err
+				}
+				return nil, a.Target.Set(ctx, tCtx, val)
+			}, nil
+		}),
+		NewFactory("Fail", &statementErrorFailArguments{}, func(_ FunctionContext, args Arguments) (ExprFunc[map[string]any], error) {
+			a := args.(*statementErrorFailArguments)
+			if a.Message == "" {
+				return nil, errors.New("the message cannot be empty")
+			}
+			return func(context.Context, map[string]any) (any, error) {
+				return nil, errors.New(a.Message)
+			}, nil
+		}),
+		NewFactory("Identity", &statementErrorIdentityArguments{}, func(_ FunctionContext, args Arguments) (ExprFunc[map[string]any], error) {
+			a := args.(*statementErrorIdentityArguments)
+			return a.Value.Get, nil
+		}),
+	)
+	p, err := NewParser(
+		functions,
+		func(path Path[map[string]any]) (GetSetter[map[string]any], error) {
+			if path.Name() == "invalid" {
+				return nil, fmt.Errorf("invalid path %q", path.String())
+			}
+			return &StandardGetSetter[map[string]any]{
+				Getter: func(_ context.Context, tCtx map[string]any) (any, error) {
+					return tCtx[path.Name()], nil
+				},
+				Setter: func(_ context.Context, tCtx map[string]any, val any) error {
+					tCtx[path.Name()] = val
+					return nil
+				},
+			}, nil
+		},
+		settings,
+	)
+	require.NoError(t, err)
+	return p
+}
+
+func Test_StatementError_parse(t *testing.T) {
+	p := newStatementErrorTestParser(t, componenttest.NewNopTelemetrySettings())
+
+	tests := []struct {
+		name      string
+		statement string
+		expected  StatementError
+	}{
+		{
+			name:      "invalid syntax",
+			statement: `set(name, "a") where`,
+			expected:  StatementError{Line: 1, Column: 21, Offset: 20},
+		},
+		{
+			name:      "undefined function",
+			statement: `set(name, Missing(value))`,
+			expected:  StatementError{Line: 1, Column: 11, Offset: 10, Function: "Missing"},
+		},
+		{
+			name:      "invalid path",
+			statement: `set(name, Identity(invalid))`,
+			expected:  StatementError{Line: 1, Column: 20, Offset: 19, Path: "invalid", Function: "Identity"},
+		},
+		{
+			name:      "invalid function",
+			statement: `set(name, Identity(Fail("")))`,
+			expected:  StatementError{Line: 1, Column: 20, Offset: 19, Function: "Fail"},
+		},
+		{
+			name:      "invalid where clause",
+			statement: `set(name, "a") where invalid == "a"`,
+			expected:  StatementError{Line: 1, Column: 22, Offset: 21, Path: "invalid"},
+		},
+		{
+			name: "multiline statement",
+			statement: `set(name,
+  Identity(invalid))`,
+			expected: StatementError{Line: 2, Column: 12, Offset: 21, Path: "invalid", Function: "Identity"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := p.ParseStatements([]string{`set(name, "valid")`, tt.statement})
+			require.Error(t, err)
+			statementErrs := StatementErrors(err)
+			require.Len(t, statementErrs, 1)
+			statementErr := statementErrs[0]
+			assert.Equal(t, tt.statement, statementErr.Source)
+			assert.Equal(t, 1, statementErr.Index)
+			assert.Equal(t, tt.expected.Line, statementErr.Line)
+			assert.Equal(t, tt.expected.Column, statementErr.Column)
+			assert.Equal(t, tt.expected.Offset, statementErr.Offset)
+			assert.Equal(t, tt.expected.Path, statementErr.Path)
+			assert.Equal(t, tt.expected.Function, statementErr.Function)
+			assert.Equal(t, statementErr.Err.Error(), statementErr.Error())
+
+			_, err = p.ParseStatement(tt.statement)
+			var single *StatementError
+			require.ErrorAs(t, err, &single)
+			assert.Equal(t, -1, single.Index)
+			assert.Equal(t, tt.statement, single.Source)
+		})
+	}
+}
+
+func Test_StatementErrors_joined(t *testing.T) {
+	p := newStatementErrorTestParser(t, componenttest.NewNopTelemetrySettings())
+
+	_, err := p.ParseConditions([]string{`invalid == "a"`, `name == "a"`, `Missing() == "a"`})
+	require.Error(t, err)
+	statementErrs := StatementErrors(fmt.Errorf("wrapped: %w", err))
+	require.Len(t, statementErrs, 2)
+	assert.Equal(t, 0, statementErrs[0].Index)
+	assert.Equal(t, "invalid", statementErrs[0].Path)
+	assert.Equal(t, 2, statementErrs[1].Index)
+	assert.Equal(t, "Missing", statementErrs[1].Function)
+
+	assert.Nil(t, StatementErrors(errors.New("error")))
+	assert.Nil(t, StatementErrors(nil))
+}
+
+func Test_StatementError_execute(t *testing.T) {
+	core, observedLogs := observer.New(zapcore.WarnLevel)
+	settings := componenttest.NewNopTelemetrySettings()
+	settings.Logger = zap.New(core)
+	p := newStatementErrorTestParser(t, settings)
+
+	statements, err := p.ParseStatements([]string{
+		`set(name, "a")`,
+		`set(name, Identity(Fail("failed")))`,
+	})
+	require.NoError(t, err)
+
+	sequence := NewStatementSequence(statements, settings)
+	err = sequence.Execute(t.Context(), map[string]any{})
+	assert.EqualError(t, err, `failed to execute statement: set(name, Identity(Fail("failed"))), failed`)
+	var statementErr *StatementError
+	require.ErrorAs(t, err, &statementErr)
+	assert.Equal(t, StatementError{
+		Source:   `set(name, Identity(Fail("failed")))`,
+		Index:    1,
+		Line:     1,
+		Column:   20,
+		Offset:   19,
+		Function: "Fail",
+		Err:      statementErr.Err,
+	}, *statementErr)
+
+	sequence = NewStatementSequence(statements, settings, WithStatementSequenceErrorMode[map[string]any](IgnoreError))
+	require.NoError(t, sequence.ExecuteBatch(t.Context(), []map[string]any{{}}))
+	require.Equal(t, 1, observedLogs.Len())
+	assert.Equal(t, map[string]any{
+		"source":   `set(name, Identity(Fail("failed")))`,
+		"index":    1,
+		"line":     1,
+		"column":   20,
+		"offset":   19,
+		"function": "Fail",
+	}, observedLogs.All()[0].ContextMap()["error_details"])
+}
+
+func Test_StatementError_eval(t *testing.T) {
+	p := newStatementErrorTestParser(t, componenttest.NewNopTelemetrySettings())
+
+	conditions, err := p.ParseConditions([]string{`name == "a"`, `Fail("failed") == "a"`})
+	require.NoError(t, err)
+
+	sequence := NewConditionSequence(conditions, componenttest.NewNopTelemetrySettings())
+	_, err = sequence.Eval(t.Context(), map[string]any{})
+	assert.EqualError(t, err, `failed to eval condition: Fail("failed") == "a", failed`)
+	var statementErr *StatementError
+	require.ErrorAs(t, err, &statementErr)
+	assert.Equal(t, `Fail("failed") == "a"`, statementErr.Source)
+	assert.Equal(t, 1, statementErr.Index)
+	assert.Equal(t, 1, statementErr.Column)
+	assert.Equal(t, "Fail", statementErr.Function)
+}
@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap/zapcore"
+)
+
+// Explanation is a trace of the execution of a StatementSequence for a TransformContext.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type Explanation struct {
+	// Statements holds the trace of each executed statement, in order.
+	Statements []StatementExplanation
+}
+
+// StatementExplanation is a trace of the execution of a single Statement.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type StatementExplanation struct {
+	// Statement is the text of the statement.
+	Statement string
+	// ConditionMatched is true if the statement's condition was met and its function was run.
+	ConditionMatched bool
+	// Reads holds the paths read by the statement and the values returned, in order.
+	Reads []PathAccess
+	// Writes holds the paths set by the statement and the values set, in order.
+	Writes []PathAccess
+	// Error is the error returned by the statement, if any.
+	Error string
+}
+
+// PathAccess is a read or a write of a path.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type PathAccess struct {
+	Path string
+	// Value is a copy of the value read or written. pcommon.Map, pcommon.Slice and pcommon.Value
+	// are converted to their raw representation, so later changes do not affect it.
+	Value any
+}
+
+// EnableExplain makes the paths of the parsed statements report the values they read and
+// write to StatementSequence.Explain. When it is not used, the explanations only tell which
+// conditions matched. It adds a small overhead to each path access, so it is meant for debugging.
+//
+// Experimental: *NOTE* this option is subject to change or removal in the future.
+func EnableExplain[K any]() Option[K] {
+	return func(p *Parser[K]) {
+		p.explain = true
+	}
+}
+
+// WithStatementSequenceExplain makes StatementSequence.Execute explain each execution and pass
+// the Explanation to the handler.
+//
+// Experimental: *NOTE* this option is subject to change or removal in the future.
+func WithStatementSequenceExplain[K any](handler func(Explanation)) StatementSequenceOption[K] {
+	return func(s *StatementSequence[K]) {
+		s.explainHandler = handler
+	}
+}
+
+// Explain executes the statements as Execute does, and returns a trace of the execution: whether
+// the condition of each statement matched, and the paths read and written with their values.
+// The TransformContext is modified as with Execute, so a copy of it should be passed for a dry run.
+// The paths are only traced if the statements were parsed with EnableExplain.
+//
+// Experimental: *NOTE* this function is subject to change or removal in the future.
+func (s *StatementSequence[K]) Explain(ctx context.Context, tCtx K) (Explanation, error) {
+	recorder := &explainRecorder{}
+	err := s.execute(context.WithValue(ctx, explainRecorderKey{}, recorder), tCtx, recorder)
+	return recorder.explanation, err
+}
+
+// MarshalLogObject serializes the Explanation into a zapcore.ObjectEncoder for logging.
+func (e Explanation) MarshalLogObject(encoder zapcore.ObjectEncoder) error {
+	return encoder.AddArray("statements", zapcore.ArrayMarshalerFunc(func(arr zapcore.ArrayEncoder) error {
+		for _, statement := range e.Statements {
+			if err := arr.AppendObject(statement); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+// MarshalLogObject serializes the StatementExplanation into a zapcore.ObjectEncoder for logging.
+func (e StatementExplanation) MarshalLogObject(encoder zapcore.ObjectEncoder) error {
+	encoder.AddString("statement", e.Statement)
+	encoder.AddBool("condition_matched", e.ConditionMatched)
+	if err := encoder.AddArray("reads", pathAccesses(e.Reads)); err != nil {
+		return err
+	}
+	if err := encoder.AddArray("writes", pathAccesses(e.Writes)); err != nil {
+		return err
+	}
+	if e.Error != "" {
+		encoder.AddString("error", e.Error)
+	}
+	return nil
+}
+
+type pathAccesses []PathAccess
+
+func (a pathAccesses) MarshalLogArray(encoder zapcore.ArrayEncoder) error {
+	for _, access := range a {
+		err := encoder.AppendObject(zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+			enc.AddString("path", access.Path)
+			return enc.AddReflected("value", access.Value)
+		}))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type explainRecorderKey struct{}
+
+type explainRecorder struct {
+	explanation Explanation
+	current     *StatementExplanation
+}
+
+func explainRecorderFromContext(ctx context.Context) *explainRecorder {
+	recorder, _ := ctx.Value(explainRecorderKey{}).(*explainRecorder)
+	return recorder
+}
+
+func (r *explainRecorder) start(statement string) {
+	if r == nil {
+		return
+	}
+	r.current = &StatementExplanation{Statement: statement}
+}
+
+func (r *explainRecorder) finish(conditionMatched bool, err error) {
+	if r == nil || r.current == nil {
+		return
+	}
+	r.current.ConditionMatched = conditionMatched
+	if err != nil {
+		r.current.Error = err.Error()
+	}
+	r.explanation.Statements = append(r.explanation.Statements, *r.current)
+	r.current = nil
+}
+
+func (r *explainRecorder) read(path string, val any) {
+	if r == nil || r.current == nil {
+		return
+	}
+	r.current.Reads = append(r.current.Reads, PathAccess{Path: path, Value: explainValue(val)})
+}
+
+func (r *explainRecorder) write(path string, val any) {
+	if r == nil || r.current == nil {
+		return
+	}
+	r.current.Writes = append(r.current.Writes, PathAccess{Path: path, Value: explainValue(val)})
+}
+
+func explainValue(val any) any {
+	switch v := val.(type) {
+	case pcommon.Map:
+		return v.AsRaw()
+	case pcommon.Slice:
+		return v.AsRaw()
+	case pcommon.Value:
+		return v.AsRaw()
+	default:
+		return val
+	}
+}
+
+// explainGetSetter reports the values read and written through a path to the
+// explainRecorder of the context, if any.
+type explainGetSetter[K any] struct {
+	path      string
+	getSetter GetSetter[K]
+}
+
+func (g *explainGetSetter[K]) Get(ctx context.Context, tCtx K) (any, error) {
+	val, err := g.getSetter.Get(ctx, tCtx)
+	if err == nil {
+		explainRecorderFromContext(ctx).read(g.path, val)
+	}
+	return val, err
+}
+
+func (g *explainGetSetter[K]) Set(ctx context.Context, tCtx K, val any) error {
+	err := g.getSetter.Set(ctx, tCtx, val)
+	if err == nil {
+		explainRecorderFromContext(ctx).write(g.path, val)
+	}
+	return err
+}
@@ -0,0 +1,186 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+type explainTestContext map[string]any
+
+type explainSetArguments struct {
+	Target GetSetter[explainTestContext]
+	Value  Getter[explainTestContext]
+}
+
+func explainTestParser(t *testing.T, options ...Option[explainTestContext]) Parser[explainTestContext] {
+	setFactory := NewFactory("set", &explainSetArguments{},
+		func(_ FunctionContext, args Arguments) (ExprFunc[explainTestContext], error) {
+			setArgs := args.(*explainSetArguments)
+			return func(ctx context.Context, tCtx explainTestContext) (any, error) {
+				val, err := setArgs.Value.Get(ctx, tCtx)
+				if err != nil {
+					return nil, err
+				}
+				return nil, setArgs.Target.Set(ctx, tCtx, val)
+			}, nil
+		})
+	failFactory := NewFactory("fail", nil,
+		func(FunctionContext, Arguments) (ExprFunc[explainTestContext], error) {
+			return func(context.Context, explainTestContext) (any, error) {
+				return nil, errors.New("failed")
+			}, nil
+		})
+	pathParser := func(p Path[explainTestContext]) (GetSetter[explainTestContext], error) {
+		name := p.Name()
+		return &StandardGetSetter[explainTestContext]{
+			Getter: func(_ context.Context, tCtx explainTestContext) (any, error) {
+				return tCtx[name], nil
+			},
+			Setter: func(_ context.Context, tCtx explainTestContext, val any) error {
+				tCtx[name] = val
+				return nil
+			},
+		}, nil
+	}
+	parser, err := NewParser(CreateFactoryMap(setFactory, failFactory), pathParser, componenttest.NewNopTelemetrySettings(), options...)
+	require.NoError(t, err)
+	return parser
+}
+
+func Test_StatementSequence_Explain(t *testing.T) {
+	statements := []string{
+		`set(b, a) where a != nil`,
+		`set(c, "c") where a == nil`,
+		`fail()`,
+		`set(d, b)`,
+	}
+
+	t.Run("with paths", func(t *testing.T) {
+		parser := explainTestParser(t, EnableExplain[explainTestContext]())
+		parsed, err := parser.ParseStatements(statements)
+		require.NoError(t, err)
+		sequence := NewStatementSequence(parsed, componenttest.NewNopTelemetrySettings(), WithStatementSequenceErrorMode[explainTestContext](SilentError))
+
+		m := pcommon.NewMap()
+		m.PutStr("key", "value")
+		tCtx := explainTestContext{"a": m}
+		explanation, err := sequence.Explain(t.Context(), tCtx)
+		require.NoError(t, err)
+		assert.Equal(t, Explanation{Statements: []StatementExplanation{
+			{
+				Statement:        `set(b, a) where a != nil`,
+				ConditionMatched: true,
+				Reads:            []PathAccess{{Path: "a", Value: map[string]any{"key": "value"}}, {Path: "a", Value: map[string]any{"key": "value"}}},
+				Writes:           []PathAccess{{Path: "b", Value: map[string]any{"key": "value"}}},
+			},
+			{
+				Statement: `set(c, "c") where a == nil`,
+				Reads:     []PathAccess{{Path: "a", Value: map[string]any{"key": "value"}}},
+			},
+			{
+				Statement:        `fail()`,
+				ConditionMatched: true,
+				Error:            "failed",
+			},
+			{
+				Statement:        `set(d, b)`,
+				ConditionMatched: true,
+				Reads:            []PathAccess{{Path: "b", Value: map[string]any{"key": "value"}}},
+				Writes:           []PathAccess{{Path: "d", Value: map[string]any{"key": "value"}}},
+			},
+		}}, explanation)
+
+		// The values are copied when they are recorded
+		m.PutStr("key", "changed")
+		assert.Equal(t, map[string]any{"key": "value"}, explanation.Statements[0].Reads[0].Value)
+	})
+
+	t.Run("without paths", func(t *testing.T) {
+		parser := explainTestParser(t)
+		parsed, err := parser.ParseStatements(statements[:2])
+		require.NoError(t, err)
+		sequence := NewStatementSequence(parsed, componenttest.NewNopTelemetrySettings())
+
+		explanation, err := sequence.Explain(t.Context(), explainTestContext{"a": "a"})
+		require.NoError(t, err)
+		assert.Equal(t, Explanation{Statements: []StatementExplanation{
+			{Statement: `set(b, a) where a != nil`, ConditionMatched: true},
+			{Statement: `set(c, "c") where a == nil`},
+		}}, explanation)
+	})
+
+	t.Run("propagate error", func(t *testing.T) {
+		parser := explainTestParser(t, EnableExplain[explainTestContext]())
+		parsed, err := parser.ParseStatements(statements)
+		require.NoError(t, err)
+		sequence := NewStatementSequence(parsed, componenttest.NewNopTelemetrySettings())
+
+		explanation, err := sequence.Explain(t.Context(), explainTestContext{})
+		require.ErrorContains(t, err, "failed to execute statement: fail()")
+		require.Len(t, explanation.Statements, 3)
+		assert.Equal(t, "failed", explanation.Statements[2].Error)
+	})
+}
+
+func Test_StatementSequence_ExecuteWithExplain(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	logger := zap.New(core)
+
+	parser := explainTestParser(t, EnableExplain[explainTestContext]())
+	parsed, err := parser.ParseStatements([]string{`set(b, a)`})
+	require.NoError(t, err)
+	sequence := NewStatementSequence(parsed, componenttest.NewNopTelemetrySettings(), WithStatementSequenceExplain[explainTestContext](func(e Explanation) {
+		logger.Info("explanation", zap.Object("explanation", e))
+	}))
+
+	tCtx := explainTestContext{"a": "value"}
+	require.NoError(t, sequence.Execute(t.Context(), tCtx))
+	assert.Equal(t, "value", tCtx["b"])
+
+	require.Equal(t, 1, logs.Len())
+	assert.Equal(t, map[string]any{
+		"statements": []any{
+			map[string]any{
+				"statement":         "set(b, a)",
+				"condition_matched": true,
+				"reads":             []any{map[string]any{"path": "a", "value": "value"}},
+				"writes":            []any{map[string]any{"path": "b", "value": "value"}},
+			},
+		},
+	}, logs.All()[0].ContextMap()["explanation"])
+}
+
+func Test_ParserCollection_Explain(t *testing.T) {
+	parser := explainTestParser(t, WithPathContextNames[explainTestContext]([]string{"test"}))
+	pc, err := NewParserCollection(
+		componenttest.NewNopTelemetrySettings(),
+		WithParserCollectionContext("test", &parser, WithStatementConverter(newNopParsedStatementsConverter[explainTestContext]())),
+		WithParserCollectionExplain[any](true),
+	)
+	require.NoError(t, err)
+
+	result, err := pc.ParseStatementsWithContext("test", NewStatementsGetter([]string{`set(test.b, test.a)`}), false)
+	require.NoError(t, err)
+	sequence := NewStatementSequence(result.([]*Statement[explainTestContext]), componenttest.NewNopTelemetrySettings())
+
+	explanation, err := sequence.Explain(t.Context(), explainTestContext{"a": int64(1)})
+	require.NoError(t, err)
+	require.Len(t, explanation.Statements, 1)
+	assert.Equal(t, []PathAccess{{Path: "test.a", Value: int64(1)}}, explanation.Statements[0].Reads)
+	assert.Equal(t, []PathAccess{{Path: "test.b", Value: int64(1)}}, explanation.Statements[0].Writes)
+
+	// The parser registered in the collection is not modified
+	assert.False(t, parser.explain)
+}
@@ -113,10 +113,11 @@ func getIndexedValue[K any](ctx context.Context, tCtx K, val any, keys []Key[K])
 		case int64:
 			switch r := result.(type) {
 			case pcommon.Slice:
-				if int(keyVal) >= r.Len() || int(keyVal) < 0 {
-					return nil, fmt.Errorf("index %v out of bounds", keyVal)
+				idx, err := ottlcommon.SliceIndex(keyVal, r.Len())
+				if err != nil {
+					return nil, err
 				}
-				result = ottlcommon.GetValue(r.At(int(keyVal)))
+				result = ottlcommon.GetValue(r.At(idx))
 			case []any:
 				result, err = getElementByIndex(r, keyVal)
 				if err != nil {
@@ -150,6 +151,11 @@ func getIndexedValue[K any](ctx context.Context, tCtx K, val any, keys []Key[K])
 			default:
 				return nil, fmt.Errorf("type %T does not support int indexing", result)
 			}
+		case *KeyRange:
+			result, err = getElementsInRange(result, keyVal)
+			if err != nil {
+				return nil, err
+			}
 		default:
 			return nil, fmt.Errorf("invalid key: expected string or int index, got %T", rawKeyVal)
 		}
@@ -158,13 +164,49 @@ func getIndexedValue[K any](ctx context.Context, tCtx K, val any, keys []Key[K])
 }
 
 func getElementByIndex[T any](r []T, idx int64) (any, error) {
-	if int(idx) >= len(r) || int(idx) < 0 {
-		return nil, fmt.Errorf("index %v out of bounds", idx)
+	i, err := ottlcommon.SliceIndex(idx, len(r))
+	if err != nil {
+		return nil, err
 	}
-	return r[idx], nil
+	return r[i], nil
+}
+
+// getElementsInRange returns a copy of the elements in the range of a pcommon.Slice,
+// or of a slice of any element type.
+func getElementsInRange(val any, keyRange *KeyRange) (any, error) {
+	if s, ok := val.(pcommon.Slice); ok {
+		start, end, err := keyRange.Bounds(s.Len())
+		if err != nil {
+			return nil, err
+		}
+		elements := pcommon.NewSlice()
+		elements.EnsureCapacity(end - start)
+		for i := start; i < end; i++ {
+			s.At(i).CopyTo(elements.AppendEmpty())
+		}
+		return elements, nil
+	}
+
+	s := reflect.ValueOf(val)
+	if s.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("type %T does not support range indexing", val)
+	}
+	start, end, err := keyRange.Bounds(s.Len())
+	if err != nil {
+		return nil, err
+	}
+	elements := reflect.MakeSlice(s.Type(), end-start, end-start)
+	reflect.Copy(elements, s.Slice(start, end))
+	return elements.Interface(), nil
 }
 
 func resolveIndexKey[K any](ctx context.Context, tCtx K, key Key[K]) (any, error) {
+	if rangeKey, err := GetKeyRange(ctx, tCtx, key); err != nil {
+		return nil, err
+	} else if rangeKey != nil {
+		return rangeKey, nil
+	}
+
 	if strKey, err := key.String(ctx, tCtx); err != nil {
 		return nil, err
 	} else if strKey != nil {
@@ -295,33 +337,44 @@ func (m *mapGetter[K]) Get(ctx context.Context, tCtx K) (any, error) {
 		if err != nil {
 			return nil, err
 		}
-		switch typedVal := val.(type) {
-		case pcommon.Map:
-			target := result.PutEmpty(k).SetEmptyMap()
-			typedVal.CopyTo(target)
-		case []any:
-			target := result.PutEmpty(k).SetEmptySlice()
-			target.EnsureCapacity(len(typedVal))
-			for _, el := range typedVal {
-				switch typedEl := el.(type) {
-				case pcommon.Map:
-					m := target.AppendEmpty().SetEmptyMap()
-					typedEl.CopyTo(m)
-				default:
-					err := target.AppendEmpty().FromRaw(el)
-					if err != nil {
-						return nil, err
-					}
+		if err := putMapValue(result, k, val); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// putMapValue sets the key of the map to a copy of the evaluated value.
+func putMapValue(m pcommon.Map, k string, val any) error {
+	switch typedVal := val.(type) {
+	case pcommon.Map:
+		target := m.PutEmpty(k).SetEmptyMap()
+		typedVal.CopyTo(target)
+	case pcommon.Slice:
+		target := m.PutEmpty(k).SetEmptySlice()
+		typedVal.CopyTo(target)
+	case []any:
+		target := m.PutEmpty(k).SetEmptySlice()
+		target.EnsureCapacity(len(typedVal))
+		for _, el := range typedVal {
+			switch typedEl := el.(type) {
+			case pcommon.Map:
+				m := target.AppendEmpty().SetEmptyMap()
+				typedEl.CopyTo(m)
+			default:
+				err := target.AppendEmpty().FromRaw(el)
+				if err != nil {
+					return err
 				}
 			}
-		default:
-			err := result.PutEmpty(k).FromRaw(val)
-			if err != nil {
-				return nil, err
-			}
+		}
+	default:
+		err := m.PutEmpty(k).FromRaw(val)
+		if err != nil {
+			return err
 		}
 	}
-	return result, nil
+	return nil
 }
 
 // PSliceGetSetter is a GetSetter that must interact with a pcommon.Slice
@@ -1218,6 +1271,14 @@ func (p *parseContext[K]) newGetter(val value) (Getter[K], error) {
 		}
 	}
 
+	if val.List != nil && val.List.Comprehension != nil {
+		return p.newListComprehensionGetter(val.List.Comprehension)
+	}
+
+	if val.Map != nil && val.Map.Comprehension != nil {
+		return p.newMapComprehensionGetter(val.Map.Comprehension)
+	}
+
 	if val.List != nil {
 		slice := make([]Getter[K], len(val.List.Values))
 		for i, v := range val.List.Values {
@@ -1260,12 +1321,119 @@ func (p *parseContext[K]) newGetterFromConverter(c converter) (Getter[K], error)
 		return nil, err
 	}
 
+	if p.isConstantConverter(c) {
+		// Pure converters called with literals always return the same value, so it
+		// can be computed once here instead of for every execution.
+		return foldLiterals[K](&exprGetter[K]{expr: call, keys: keys}), nil
+	}
+	if key, inputs, ok := p.memoizableConverter(c); ok {
+		call = newMemoizedExpr(call, key, inputs)
+		p.memoKeys = append(p.memoKeys, key)
+	}
 	return &exprGetter[K]{
 		expr: call,
 		keys: keys,
 	}, nil
 }
 
+// isConstantConverter returns true if the converter is pure and all its arguments
+// and keys are literals, or calls to other constant converters.
+func (p *parseContext[K]) isConstantConverter(c converter) bool {
+	f, ok := p.functions[c.Function]
+	if !ok {
+		return false
+	}
+	if pf, ok := f.(interface{ isPure() bool }); !ok || !pf.isPure() {
+		return false
+	}
+	for _, arg := range c.Arguments {
+		if arg.Expr != nil || arg.FunctionName != nil || !p.isConstantValue(arg.Value) {
+			return false
+		}
+	}
+	for _, k := range c.Keys {
+		if k.MathExpression != nil && !p.isConstantMathExpression(k.MathExpression) {
+			return false
+		}
+		if k.Expression != nil && !p.isConstantMathExprLiteral(k.Expression) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *parseContext[K]) isConstantValue(val value) bool {
+	switch {
+	case val.Lambda != nil:
+		return false
+	case val.Literal != nil:
+		return p.isConstantMathExprLiteral(val.Literal)
+	case val.MathExpression != nil:
+		return p.isConstantMathExpression(val.MathExpression)
+	case val.List != nil:
+		if val.List.Comprehension != nil {
+			return false
+		}
+		for _, v := range val.List.Values {
+			if !p.isConstantValue(v) {
+				return false
+			}
+		}
+		return true
+	case val.Map != nil:
+		if val.Map.Comprehension != nil {
+			return false
+		}
+		for _, kvp := range val.Map.Values {
+			if kvp.Value == nil || !p.isConstantValue(*kvp.Value) {
+				return false
+			}
+		}
+		return true
+	default:
+		return true
+	}
+}
+
+func (p *parseContext[K]) isConstantMathExpression(expr *mathExpression) bool {
+	terms := []*addSubTerm{expr.Left}
+	for _, rhs := range expr.Right {
+		terms = append(terms, rhs.Term)
+	}
+	for _, term := range terms {
+		values := []*mathValue{term.Left}
+		for _, rhs := range term.Right {
+			values = append(values, rhs.Value)
+		}
+		for _, v := range values {
+			switch {
+			case v.Literal != nil:
+				if !p.isConstantMathExprLiteral(v.Literal) {
+					return false
+				}
+			case v.SubExpression != nil:
+				if !p.isConstantMathExpression(v.SubExpression) {
+					return false
+				}
+			default:
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (p *parseContext[K]) isConstantMathExprLiteral(l *mathExprLiteral) bool {
+	switch {
+	case l.Float != nil, l.Int != nil:
+		return true
+	case l.Converter != nil:
+		return p.isConstantConverter(*l.Converter)
+	default:
+		return false
+	}
+}
+
 // TimeGetter is a Getter that must return a time.Time.
 type TimeGetter[K any] interface {
 	// Get retrieves a time.Time value.
@@ -6,6 +6,7 @@ package ottl
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -322,6 +323,67 @@ func Test_newGetter(t *testing.T) {
 			},
 			want: "pass",
 		},
+		{
+			name: "function call pcommon slice negative index",
+			val: value{
+				Literal: &mathExprLiteral{
+					Converter: &converter{
+						Function: "PSlice",
+						Keys: []key{
+							{
+								Int: ottltest.Intp(0),
+							},
+							{
+								Int: ottltest.Intp(-1),
+							},
+						},
+					},
+				},
+			},
+			want: "pass",
+		},
+		{
+			name: "function call pcommon slice range",
+			val: value{
+				Literal: &mathExprLiteral{
+					Converter: &converter{
+						Function: "PSlice",
+						Keys: []key{
+							{
+								Range: &keyRange{
+									Start: ottltest.Intp(-1),
+								},
+							},
+							{
+								Int: ottltest.Intp(0),
+							},
+							{
+								Int: ottltest.Intp(0),
+							},
+						},
+					},
+				},
+			},
+			want: "pass",
+		},
+		{
+			name: "function call nested slice range",
+			val: value{
+				Literal: &mathExprLiteral{
+					Converter: &converter{
+						Function: "Slice",
+						Keys: []key{
+							{
+								Range: &keyRange{
+									End: ottltest.Intp(1),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []any{[]any{"pass"}},
+		},
 		{
 			name: "function call nested SliceString",
 			val: value{
@@ -1008,6 +1070,119 @@ func Test_newGetter_dynamic_path_key(t *testing.T) {
 	assert.Equal(t, "pass", got)
 }
 
+func Test_newGetter_constantConverter(t *testing.T) {
+	type joinArguments struct {
+		Values    []StringGetter[any]
+		Separator string
+	}
+	calls := 0
+	join := func(_ FunctionContext, args Arguments) (ExprFunc[any], error) {
+		joinArgs := args.(*joinArguments)
+		return func(ctx context.Context, tCtx any) (any, error) {
+			calls++
+			values := make([]string, len(joinArgs.Values))
+			for i, getter := range joinArgs.Values {
+				val, err := getter.Get(ctx, tCtx)
+				if err != nil {
+					return nil, err
+				}
+				values[i] = val
+			}
+			return strings.Join(values, joinArgs.Separator), nil
+		}, nil
+	}
+	fail := func(FunctionContext, Arguments) (ExprFunc[any], error) {
+		return func(context.Context, any) (any, error) {
+			calls++
+			return nil, errors.New("failed")
+		}, nil
+	}
+	functions := CreateFactoryMap(
+		NewFactory("Join", &joinArguments{}, join, WithPureFunction[any]()),
+		NewFactory("ImpureJoin", &joinArguments{}, join),
+		NewFactory("Fail", nil, fail, WithPureFunction[any]()),
+	)
+
+	p, err := NewParser[any](
+		functions,
+		testParsePath[any],
+		componenttest.NewNopTelemetrySettings(),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		expr        string
+		tCtx        any
+		want        any
+		wantLiteral bool
+		wantCalls   int
+		wantErr     string
+	}{
+		{
+			name:        "literal arguments",
+			expr:        `Join(["a", "b"], "-")`,
+			want:        "a-b",
+			wantLiteral: true,
+			wantCalls:   1,
+		},
+		{
+			name:        "nested pure converter",
+			expr:        `Join([Join(["a", "b"], "-"), "c"], "+")`,
+			want:        "a-b+c",
+			wantLiteral: true,
+			wantCalls:   2,
+		},
+		{
+			name:      "path argument",
+			expr:      `Join([name, "b"], "-")`,
+			tCtx:      "a",
+			want:      "a-b",
+			wantCalls: 3,
+		},
+		{
+			name:      "nested impure converter",
+			expr:      `Join([ImpureJoin(["a", "b"], "-"), "c"], "+")`,
+			want:      "a-b+c",
+			wantCalls: 6,
+		},
+		{
+			name:      "impure converter",
+			expr:      `ImpureJoin(["a", "b"], "-")`,
+			want:      "a-b",
+			wantCalls: 3,
+		},
+		{
+			name:      "error",
+			expr:      `Fail()`,
+			wantCalls: 4,
+			wantErr:   "failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls = 0
+			parsed, err := parseValueExpression(tt.expr)
+			require.NoError(t, err)
+			getter, err := p.newParseContext().newGetter(*parsed)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantLiteral, isLiteralGetter(getter))
+
+			for range 3 {
+				got, err := getter.Get(t.Context(), tt.tCtx)
+				if tt.wantErr != "" {
+					require.ErrorContains(t, err, tt.wantErr)
+					continue
+				}
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
+
 func Test_exprGetter_Get_Invalid(t *testing.T) {
 	tests := []struct {
 		name string
@@ -1070,13 +1245,13 @@ func Test_exprGetter_Get_Invalid(t *testing.T) {
 						Function: "PSlice",
 						Keys: []key{
 							{
-								Int: ottltest.Intp(-1),
+								Int: ottltest.Intp(-10),
 							},
 						},
 					},
 				},
 			},
-			err: errors.New("index -1 out of bounds"),
+			err: errors.New("index -10 out of bounds"),
 		},
 		{
 			name: "index too large for Go slice",
@@ -1102,13 +1277,13 @@ func Test_exprGetter_Get_Invalid(t *testing.T) {
 						Function: "Slice",
 						Keys: []key{
 							{
-								Int: ottltest.Intp(-1),
+								Int: ottltest.Intp(-10),
 							},
 						},
 					},
 				},
 			},
-			err: errors.New("index -1 out of bounds"),
+			err: errors.New("index -10 out of bounds"),
 		},
 		{
 			name: "invalid int indexing type",
@@ -1126,6 +1301,41 @@ func Test_exprGetter_Get_Invalid(t *testing.T) {
 			},
 			err: errors.New("type string does not support int indexing"),
 		},
+		{
+			name: "invalid range indexing type",
+			val: value{
+				Literal: &mathExprLiteral{
+					Converter: &converter{
+						Function: "Hello",
+						Keys: []key{
+							{
+								Range: &keyRange{},
+							},
+						},
+					},
+				},
+			},
+			err: errors.New("type string does not support range indexing"),
+		},
+		{
+			name: "range out of bounds",
+			val: value{
+				Literal: &mathExprLiteral{
+					Converter: &converter{
+						Function: "Slice",
+						Keys: []key{
+							{
+								Range: &keyRange{
+									Start: ottltest.Intp(1),
+									End:   ottltest.Intp(2),
+								},
+							},
+						},
+					},
+				},
+			},
+			err: errors.New("range [1:2] out of bounds for slice of length 1"),
+		},
 		{
 			name: "invalid string indexing type",
 			val: value{
@@ -17,6 +17,8 @@ type Arguments any
 // component to the OTTL for use in functions.
 type FunctionContext struct {
 	Set component.TelemetrySettings
+	// parser is the *Parser[K] parsing the function call, used to create PathResolvers.
+	parser any
 }
 
 // Factory defines an OTTL function factory that will generate an OTTL
@@ -44,11 +46,21 @@ type factory[K any] struct {
 	name               string
 	args               Arguments
 	createFunctionFunc CreateFunctionFunc[K]
+	pure               bool
+	memoized           bool
 }
 
 //nolint:unused
 func (*factory[K]) unexportedFactoryFunc() {}
 
+func (f *factory[K]) isPure() bool {
+	return f.pure
+}
+
+func (f *factory[K]) isMemoizable() bool {
+	return f.pure || f.memoized
+}
+
 func (f *factory[K]) Name() string {
 	return f.name
 }
@@ -64,6 +76,32 @@ func (f *factory[K]) CreateFunction(fCtx FunctionContext, args Arguments) (ExprF
 // FactoryOption is an option for a Factory
 type FactoryOption[K any] func(factory *factory[K])
 
+// WithPureFunction marks the functions created by the Factory as pure: their result only
+// depends on their arguments, and they have no side effects. When all the arguments of a call
+// to a pure Converter are literals, the parser evaluates it once, and the value it returned is
+// used by every execution of the statement. The returned values are shared, so pure functions
+// should only return values that are not modified afterwards, such as strings and numbers.
+//
+// Experimental: *NOTE* this option is subject to change or removal in the future.
+func WithPureFunction[K any]() FactoryOption[K] {
+	return func(factory *factory[K]) {
+		factory.pure = true
+	}
+}
+
+// WithMemoizedFunction marks the Converters created by the Factory as memoized: like pure Converters,
+// their result only depends on their arguments, and they have no side effects, but they may return
+// maps and slices, so they are never evaluated by the parser. When several statements of a
+// StatementSequence call a memoized or pure Converter with the same argument values on a
+// TransformContext, its result is only computed once, and the following calls return a copy of it.
+//
+// Experimental: *NOTE* this option is subject to change or removal in the future.
+func WithMemoizedFunction[K any]() FactoryOption[K] {
+	return func(factory *factory[K]) {
+		factory.memoized = true
+	}
+}
+
 // NewFactory creates a new Factory
 func NewFactory[K any](name string, args Arguments, createFunctionFunc CreateFunctionFunc[K], options ...FactoryOption[K]) Factory[K] {
 	f := &factory[K]{
@@ -0,0 +1,230 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/goccy/go-json"
+	"github.com/iancoleman/strcase"
+)
+
+// FunctionKind is the kind of an OTTL function, based on its name.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type FunctionKind string
+
+const (
+	// EditorFunction is a function transforming the telemetry, used as the function of a statement.
+	EditorFunction FunctionKind = "editor"
+	// ConverterFunction is a function returning a value, used in the arguments and conditions of a statement.
+	ConverterFunction FunctionKind = "converter"
+)
+
+// FunctionDescription describes the signature of a function provided by a Factory, so that tools,
+// such as editors and documentation generators, know which functions are available and how to call them.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type FunctionDescription struct {
+	// Name is the name used to call the function.
+	Name string `json:"name"`
+	// Kind is whether the function is an Editor or a Converter.
+	Kind FunctionKind `json:"kind"`
+	// Pure is true if the function was marked as pure with WithPureFunction.
+	Pure bool `json:"pure,omitempty"`
+	// Arguments are the arguments of the function, in the order they must be given.
+	Arguments []ArgumentDescription `json:"arguments"`
+}
+
+// ArgumentDescription describes an argument of a function.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type ArgumentDescription struct {
+	// Name is the name used to pass the argument as a named argument.
+	Name string `json:"name"`
+	// Type is the type of the argument, such as "StringGetter", "string", "int64" or "[]string",
+	// without the type of the transform context. The Getter types accept any value, such as paths
+	// and Converters, while the other types only accept literals.
+	Type string `json:"type"`
+	// Optional is true if the argument can be omitted.
+	Optional bool `json:"optional,omitempty"`
+	// Default is the value of the argument when it is omitted, if it is a literal set by the Factory.
+	Default any `json:"default,omitempty"`
+}
+
+// DescribeFunctions returns the descriptions of the functions created by the factories, sorted by name.
+// The arguments are described from the Arguments returned by the CreateDefaultArguments method of the factories.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func DescribeFunctions[K any](functions map[string]Factory[K]) ([]FunctionDescription, error) {
+	descriptions := make([]FunctionDescription, 0, len(functions))
+	for name, f := range functions {
+		description, err := describeFunction(name, f)
+		if err != nil {
+			return nil, err
+		}
+		descriptions = append(descriptions, description)
+	}
+	slices.SortFunc(descriptions, func(a, b FunctionDescription) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return descriptions, nil
+}
+
+func describeFunction[K any](name string, f Factory[K]) (FunctionDescription, error) {
+	description := FunctionDescription{
+		Name:      name,
+		Kind:      EditorFunction,
+		Arguments: []ArgumentDescription{},
+	}
+	if name != "" && unicode.IsUpper(rune(name[0])) {
+		description.Kind = ConverterFunction
+	}
+	if pf, ok := f.(interface{ isPure() bool }); ok {
+		description.Pure = pf.isPure()
+	}
+
+	defaultArgs := f.CreateDefaultArguments()
+	// A nil value indicates the function takes no arguments.
+	if defaultArgs == nil {
+		return description, nil
+	}
+	argsVal := reflect.ValueOf(defaultArgs)
+	if argsVal.Kind() != reflect.Pointer || argsVal.Elem().Kind() != reflect.Struct {
+		return FunctionDescription{}, fmt.Errorf("factory for %q must return a pointer to an Arguments value in its CreateDefaultArguments method", name)
+	}
+	argsVal = argsVal.Elem()
+
+	for i := 0; i < argsVal.NumField(); i++ {
+		field := argsVal.Field(i)
+		arg := ArgumentDescription{
+			Name: strcase.ToSnake(argsVal.Type().Field(i).Name),
+		}
+		value := field
+		if strings.HasPrefix(field.Type().Name(), "Optional") {
+			manager, ok := field.Interface().(optionalManager)
+			if !ok {
+				return FunctionDescription{}, fmt.Errorf("optional argument %q of %q is not manageable by the OTTL parser", arg.Name, name)
+			}
+			arg.Optional = true
+			value = manager.get()
+			if field.MethodByName("IsEmpty").Call(nil)[0].Bool() {
+				value = reflect.Zero(value.Type())
+			}
+		}
+		arg.Type = argumentTypeName(value.Type())
+		arg.Default = literalDefault(value)
+		description.Arguments = append(description.Arguments, arg)
+	}
+	return description, nil
+}
+
+// argumentTypeName returns the name of the type without its type parameters,
+// which are the transform context of the parser.
+func argumentTypeName(t reflect.Type) string {
+	if t.Kind() == reflect.Slice {
+		if t.Elem().Kind() == reflect.Uint8 {
+			return "[]byte"
+		}
+		return "[]" + argumentTypeName(t.Elem())
+	}
+	name, _, _ := strings.Cut(t.Name(), "[")
+	return name
+}
+
+// literalDefault returns the value of a literal argument, if it is not the zero value.
+func literalDefault(v reflect.Value) any {
+	switch v.Kind() {
+	case reflect.String, reflect.Int64, reflect.Float64, reflect.Bool:
+		if v.IsZero() {
+			return nil
+		}
+		return v.Interface()
+	default:
+		return nil
+	}
+}
+
+// FunctionsJSONSchema returns a JSON Schema document describing the functions created by the factories.
+// Each function is described in the "$defs" of the document by an object schema whose properties are
+// its arguments, with their OTTL type in the "x-ottl-type" keyword, and with the order of the arguments
+// in the "x-ottl-arguments" keyword, so it can be used for autocompletion and documentation generation.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func FunctionsJSONSchema[K any](functions map[string]Factory[K]) ([]byte, error) {
+	descriptions, err := DescribeFunctions(functions)
+	if err != nil {
+		return nil, err
+	}
+
+	defs := make(map[string]any, len(descriptions))
+	for _, description := range descriptions {
+		properties := make(map[string]any, len(description.Arguments))
+		required := []string{}
+		order := make([]string, 0, len(description.Arguments))
+		for _, arg := range description.Arguments {
+			property := map[string]any{"x-ottl-type": arg.Type}
+			if jsonType := literalJSONSchemaType(arg.Type); jsonType != nil {
+				property["type"] = jsonType["type"]
+				if items, ok := jsonType["items"]; ok {
+					property["items"] = items
+				}
+			}
+			if arg.Default != nil {
+				property["default"] = arg.Default
+			}
+			properties[arg.Name] = property
+			order = append(order, arg.Name)
+			if !arg.Optional {
+				required = append(required, arg.Name)
+			}
+		}
+		defs[description.Name] = map[string]any{
+			"type":                 "object",
+			"properties":           properties,
+			"required":             required,
+			"additionalProperties": false,
+			"x-ottl-kind":          description.Kind,
+			"x-ottl-pure":          description.Pure,
+			"x-ottl-arguments":     order,
+		}
+	}
+
+	return json.MarshalIndent(map[string]any{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title":   "OTTL functions",
+		"$defs":   defs,
+	}, "", "  ")
+}
+
+// literalJSONSchemaType returns the JSON Schema type of the arguments only accepting literals,
+// and nil for the Getters and the other arguments, which accept values of any type.
+func literalJSONSchemaType(typ string) map[string]any {
+	if elem, ok := strings.CutPrefix(typ, "[]"); ok {
+		if typ == "[]byte" {
+			return map[string]any{"type": "string"}
+		}
+		items := literalJSONSchemaType(elem)
+		if items == nil {
+			return map[string]any{"type": "array"}
+		}
+		return map[string]any{"type": "array", "items": items}
+	}
+	switch typ {
+	case "string":
+		return map[string]any{"type": "string"}
+	case "int64":
+		return map[string]any{"type": "integer"}
+	case "float64":
+		return map[string]any{"type": "number"}
+	case "bool":
+		return map[string]any{"type": "boolean"}
+	default:
+		return nil
+	}
+}
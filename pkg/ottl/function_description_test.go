@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type describedSetArguments[K any] struct {
+	Target Setter[K]
+	Value  Getter[K]
+}
+
+type describedConverterArguments[K any] struct {
+	Target    StringGetter[K]
+	Pattern   string
+	Keys      []string
+	Key       []byte
+	Limit     Optional[int64]
+	Separator Optional[string]
+	Fallback  Optional[FloatLikeGetter[K]]
+}
+
+func describedFunctions() map[string]Factory[any] {
+	createFunction := func(FunctionContext, Arguments) (ExprFunc[any], error) {
+		return func(context.Context, any) (any, error) {
+			return nil, nil
+		}, nil
+	}
+	return CreateFactoryMap[any](
+		NewFactory("set", &describedSetArguments[any]{}, createFunction),
+		NewFactory("Extract", &describedConverterArguments[any]{
+			Pattern:   "^.*$",
+			Separator: NewTestingOptional[string](","),
+		}, createFunction, WithPureFunction[any]()),
+		NewFactory("Now", nil, createFunction),
+	)
+}
+
+func Test_DescribeFunctions(t *testing.T) {
+	descriptions, err := DescribeFunctions(describedFunctions())
+	require.NoError(t, err)
+
+	expected := []FunctionDescription{
+		{
+			Name: "Extract",
+			Kind: ConverterFunction,
+			Pure: true,
+			Arguments: []ArgumentDescription{
+				{Name: "target", Type: "StringGetter"},
+				{Name: "pattern", Type: "string", Default: "^.*$"},
+				{Name: "keys", Type: "[]string"},
+				{Name: "key", Type: "[]byte"},
+				{Name: "limit", Type: "int64", Optional: true},
+				{Name: "separator", Type: "string", Optional: true, Default: ","},
+				{Name: "fallback", Type: "FloatLikeGetter", Optional: true},
+			},
+		},
+		{
+			Name:      "Now",
+			Kind:      ConverterFunction,
+			Arguments: []ArgumentDescription{},
+		},
+		{
+			Name: "set",
+			Kind: EditorFunction,
+			Arguments: []ArgumentDescription{
+				{Name: "target", Type: "Setter"},
+				{Name: "value", Type: "Getter"},
+			},
+		},
+	}
+	assert.Equal(t, expected, descriptions)
+}
+
+func Test_DescribeFunctions_invalidArguments(t *testing.T) {
+	functions := CreateFactoryMap[any](NewFactory("set", describedSetArguments[any]{}, func(FunctionContext, Arguments) (ExprFunc[any], error) {
+		return nil, nil
+	}))
+	_, err := DescribeFunctions(functions)
+	assert.EqualError(t, err, `factory for "set" must return a pointer to an Arguments value in its CreateDefaultArguments method`)
+}
+
+func Test_FunctionsJSONSchema(t *testing.T) {
+	data, err := FunctionsJSONSchema(describedFunctions())
+	require.NoError(t, err)
+
+	expected := `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title": "OTTL functions",
+		"$defs": {
+			"Extract": {
+				"type": "object",
+				"properties": {
+					"target": {"x-ottl-type": "StringGetter"},
+					"pattern": {"x-ottl-type": "string", "type": "string", "default": "^.*$"},
+					"keys": {"x-ottl-type": "[]string", "type": "array", "items": {"type": "string"}},
+					"key": {"x-ottl-type": "[]byte", "type": "string"},
+					"limit": {"x-ottl-type": "int64", "type": "integer"},
+					"separator": {"x-ottl-type": "string", "type": "string", "default": ","},
+					"fallback": {"x-ottl-type": "FloatLikeGetter"}
+				},
+				"required": ["target", "pattern", "keys", "key"],
+				"additionalProperties": false,
+				"x-ottl-kind": "converter",
+				"x-ottl-pure": true,
+				"x-ottl-arguments": ["target", "pattern", "keys", "key", "limit", "separator", "fallback"]
+			},
+			"Now": {
+				"type": "object",
+				"properties": {},
+				"required": [],
+				"additionalProperties": false,
+				"x-ottl-kind": "converter",
+				"x-ottl-pure": false,
+				"x-ottl-arguments": []
+			},
+			"set": {
+				"type": "object",
+				"properties": {
+					"target": {"x-ottl-type": "Setter"},
+					"value": {"x-ottl-type": "Getter"}
+				},
+				"required": ["target", "value"],
+				"additionalProperties": false,
+				"x-ottl-kind": "editor",
+				"x-ottl-pure": false,
+				"x-ottl-arguments": ["target", "value"]
+			}
+		}
+	}`
+	assert.JSONEq(t, expected, string(data))
+}
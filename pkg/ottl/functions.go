@@ -55,6 +55,9 @@ func buildOriginalKeysText(keys []key) string {
 	if len(keys) > 0 {
 		for _, k := range keys {
 			builder.WriteString("[")
+			if k.Range != nil {
+				builder.WriteString(formatKeyRange(k.Range.Start, k.Range.End))
+			}
 			if k.Int != nil {
 				builder.WriteString(strconv.FormatInt(*k.Int, 10))
 			}
@@ -129,7 +132,9 @@ func (p *parseContext[K]) parsePathContext(path *path) (string, []field, error)
 			return "", path.dottedSegments(), nil
 		}
 
-		if _, ok := p.pathContextNames[path.Context]; !ok {
+		_, isContext := p.pathContextNames[path.Context]
+		_, isNamespace := p.pathNamespaces[path.Context]
+		if !isContext && !isNamespace {
 			return "", path.Fields, fmt.Errorf(`context "%s" from path "%s" is not valid, it must be replaced by one of: %s`, path.Context, buildOriginalText(path), p.buildPathContextNamesText(""))
 		}
 
@@ -286,9 +291,17 @@ func (p *parseContext[K]) newKeys(keys []key) ([]Key[K], error) {
 			}
 			getter = g
 		}
+		var r *KeyRange
+		if keys[i].Range != nil {
+			r = &KeyRange{
+				Start: keys[i].Range.Start,
+				End:   keys[i].Range.End,
+			}
+		}
 		ks[i] = &baseKey[K]{
 			s: keys[i].String,
 			i: keys[i].Int,
+			r: r,
 			g: getter,
 		}
 	}
@@ -316,11 +329,90 @@ type Key[K any] interface {
 	ExpressionGetter(context.Context, K) (Getter[K], error)
 }
 
-var _ Key[any] = &baseKey[any]{}
+// RangeKey is implemented by the Keys which can select a range of slice elements, such as `[1:3]`.
+// It is not part of Key, so the implementations of Key are not required to support ranges.
+// GetKeyRange returns the range of any Key.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type RangeKey[K any] interface {
+	// Range returns a pointer to the range of slice elements selected by the Key.
+	// If the Key does not have a range the returned value is nil.
+	// If Key experiences an error retrieving the value it is returned.
+	Range(context.Context, K) (*KeyRange, error)
+}
+
+// GetKeyRange returns the range of slice elements selected by the Key, or nil if the Key
+// does not implement RangeKey or does not have a range.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func GetKeyRange[K any](ctx context.Context, tCtx K, key Key[K]) (*KeyRange, error) {
+	rangeKey, ok := key.(RangeKey[K])
+	if !ok {
+		return nil, nil
+	}
+	return rangeKey.Range(ctx, tCtx)
+}
+
+// KeyRange is the range of slice elements selected by a Key, from Start included to End excluded.
+// A nil Start or End was omitted and selects the elements from the beginning or up to the end of
+// the slice. Negative bounds count from the end of the slice.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type KeyRange struct {
+	Start *int64
+	End   *int64
+}
+
+// Bounds returns the indexes of the first element of the range and of the element following its
+// last one, in a slice of the given length. It returns an error if the range is out of the bounds
+// of the slice.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func (r *KeyRange) Bounds(length int) (int, int, error) {
+	start, end := 0, length
+	if r.Start != nil {
+		start = int(*r.Start)
+		if start < 0 {
+			start += length
+		}
+	}
+	if r.End != nil {
+		end = int(*r.End)
+		if end < 0 {
+			end += length
+		}
+	}
+	if start < 0 || start > end || end > length {
+		return 0, 0, fmt.Errorf("range %v out of bounds for slice of length %d", r, length)
+	}
+	return start, end, nil
+}
+
+func (r *KeyRange) String() string {
+	return "[" + formatKeyRange(r.Start, r.End) + "]"
+}
+
+func formatKeyRange(start, end *int64) string {
+	var builder strings.Builder
+	if start != nil {
+		builder.WriteString(strconv.FormatInt(*start, 10))
+	}
+	builder.WriteString(":")
+	if end != nil {
+		builder.WriteString(strconv.FormatInt(*end, 10))
+	}
+	return builder.String()
+}
+
+var (
+	_ Key[any]      = &baseKey[any]{}
+	_ RangeKey[any] = &baseKey[any]{}
+)
 
 type baseKey[K any] struct {
 	s *string
 	i *int64
+	r *KeyRange
 	g Getter[K]
 }
 
@@ -336,12 +428,16 @@ func (k *baseKey[K]) ExpressionGetter(_ context.Context, _ K) (Getter[K], error)
 	return k.g, nil
 }
 
+func (k *baseKey[K]) Range(_ context.Context, _ K) (*KeyRange, error) {
+	return k.r, nil
+}
+
 func (p *parseContext[K]) parsePath(ip *basePath[K]) (GetSetter[K], error) {
 	if ip.localIdentifier {
 		return p.newLocalIdentifierGetter(ip)
 	}
 
-	g, err := p.pathParser(ip)
+	g, err := p.parsePathExpression(ip)
 	if err != nil {
 		return nil, err
 	}
@@ -349,13 +445,45 @@ func (p *parseContext[K]) parsePath(ip *basePath[K]) (GetSetter[K], error) {
 	if err != nil {
 		return nil, err
 	}
+	if p.explain {
+		return &explainGetSetter[K]{path: ip.String(), getSetter: g}, nil
+	}
 	return g, nil
 }
 
+// parsePathExpression parses the path with the PathExpressionParser of its namespace registered
+// with WithPathNamespace, if any, or with the PathExpressionParser of the Parser otherwise.
+func (p *parseContext[K]) parsePathExpression(ip *basePath[K]) (GetSetter[K], error) {
+	if parser, ok := p.pathNamespaces[ip.context]; ok {
+		return parser(ip)
+	}
+	if parser, ok := p.pathNamespaces[ip.name]; ok && ip.context == "" && ip.nextPath != nil {
+		if ip.keys != nil {
+			return nil, fmt.Errorf("the path namespace %q cannot be indexed", ip.name)
+		}
+		return parser(ip.Next())
+	}
+	return p.pathParser(ip)
+}
+
 func (p *parseContext[K]) newFunctionCall(ed editor) (Expr[K], error) {
+	fn, err := p.newFunction(ed)
+	if err != nil {
+		return Expr[K]{}, withFunction(err, ed.Function, ed.Pos)
+	}
+	return Expr[K]{exprFunc: func(ctx context.Context, tCtx K) (any, error) {
+		val, err := fn(ctx, tCtx)
+		if err != nil {
+			return nil, withFunction(err, ed.Function, ed.Pos)
+		}
+		return val, nil
+	}}, nil
+}
+
+func (p *parseContext[K]) newFunction(ed editor) (ExprFunc[K], error) {
 	f, ok := p.functions[ed.Function]
 	if !ok {
-		return Expr[K]{}, fmt.Errorf("undefined function %q", ed.Function)
+		return nil, fmt.Errorf("undefined function %q", ed.Function)
 	}
 	defaultArgs := f.CreateDefaultArguments()
 	var args Arguments
@@ -366,23 +494,22 @@ func (p *parseContext[K]) newFunctionCall(ed editor) (Expr[K], error) {
 		// settability requirements. Non-pointer values are not
 		// modifiable through reflection.
 		if reflect.TypeOf(defaultArgs).Kind() != reflect.Pointer {
-			return Expr[K]{}, fmt.Errorf("factory for %q must return a pointer to an Arguments value in its CreateDefaultArguments method", ed.Function)
+			return nil, fmt.Errorf("factory for %q must return a pointer to an Arguments value in its CreateDefaultArguments method", ed.Function)
 		}
 
 		args = reflect.New(reflect.ValueOf(defaultArgs).Elem().Type()).Interface()
 
 		err := p.buildArgs(ed, reflect.ValueOf(args).Elem())
 		if err != nil {
-			return Expr[K]{}, fmt.Errorf("error while parsing arguments for call to %q: %w", ed.Function, err)
+			return nil, fmt.Errorf("error while parsing arguments for call to %q: %w", ed.Function, err)
 		}
 	}
 
-	fn, err := f.CreateFunction(FunctionContext{Set: p.telemetrySettings}, args)
+	fn, err := f.CreateFunction(FunctionContext{Set: p.telemetrySettings, parser: p.Parser}, args)
 	if err != nil {
-		return Expr[K]{}, fmt.Errorf("couldn't create function: %w", err)
+		return nil, fmt.Errorf("couldn't create function: %w", err)
 	}
-
-	return Expr[K]{exprFunc: fn}, err
+	return fn, nil
 }
 
 func (p *parseContext[K]) buildArgs(ed editor, argsVal reflect.Value) error {
@@ -457,7 +584,9 @@ func (p *parseContext[K]) buildArgs(ed editor, argsVal reflect.Value) error {
 			if !ok {
 				return fmt.Errorf("undefined function %s", name)
 			}
-			val = StandardFunctionGetter[K]{FCtx: FunctionContext{Set: p.telemetrySettings}, Fact: f}
+			val = StandardFunctionGetter[K]{FCtx: FunctionContext{Set: p.telemetrySettings, parser: p.Parser}, Fact: f}
+		case arg.Expr != nil:
+			val, err = p.buildBoolExprArg(arg.Expr, fieldType)
 		case fieldType.Kind() == reflect.Slice:
 			val, err = p.buildSliceArg(arg.Value, fieldType)
 		default:
@@ -574,6 +703,16 @@ func (p *parseContext[K]) buildSliceArg(argVal value, argType reflect.Type) (any
 }
 
 func (p *parseContext[K]) buildGetSetterFromPath(path *path) (GetSetter[K], error) {
+	getSetter, err := p.newPathGetSetter(path)
+	if err != nil {
+		return nil, withPath(err, buildOriginalText(path), path.Pos)
+	}
+	return getSetter, nil
+}
+
+// newPathGetSetter is buildGetSetterFromPath without the position of the path in the errors,
+// for paths which are not part of the parsed statement.
+func (p *parseContext[K]) newPathGetSetter(path *path) (GetSetter[K], error) {
 	np, err := p.newPath(path)
 	if err != nil {
 		return nil, err
@@ -742,12 +881,40 @@ func (p *parseContext[K]) buildArg(argVal value, argType reflect.Type) (any, err
 	}
 }
 
+// buildBoolExprArg builds an argument from a boolean expression. Boolean expressions
+// can only be passed to arguments accepting booleans.
+func (p *parseContext[K]) buildBoolExprArg(expr *booleanExpression, argType reflect.Type) (any, error) {
+	boolExpr, err := p.newBoolExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+	var getter Getter[K] = boolExprGetter[K]{expr: boolExpr}
+	if literal, ok := boolExpr.(*literalBoolExpr[K]); ok {
+		getter = newLiteral[K, any](literal.getValue())
+	}
+
+	name := argType.Name()
+	switch {
+	case strings.HasPrefix(name, "Getter"):
+		return getter, nil
+	case strings.HasPrefix(name, "BoolGetter"):
+		return newStandardBoolGetter[K](getter)
+	case strings.HasPrefix(name, "BoolLikeGetter"):
+		return newStandardBoolLikeGetter[K](getter)
+	default:
+		return nil, fmt.Errorf("boolean expressions cannot be used as arguments of type %s", name)
+	}
+}
+
 type buildArgFunc func(value, reflect.Type) (any, error)
 
 func buildSlice[T any](argVal value, argType reflect.Type, buildArg buildArgFunc, name string) (any, error) {
 	if argVal.List == nil {
 		return nil, fmt.Errorf("must be a list of type %v", name)
 	}
+	if argVal.List.Comprehension != nil {
+		return nil, fmt.Errorf("list comprehensions cannot be used as arguments of type %v, use a Getter instead", name)
+	}
 
 	vals := []T{}
 	values := argVal.List.Values
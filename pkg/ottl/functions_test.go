@@ -2210,6 +2210,79 @@ func Test_NewFunctionCall(t *testing.T) {
 	}
 }
 
+type booleanExpressionArguments struct {
+	Getter         Getter[any]
+	BoolGetter     BoolGetter[any]
+	BoolLikeGetter Optional[BoolLikeGetter[any]]
+}
+
+func Test_NewFunctionCall_booleanExpression(t *testing.T) {
+	var got []any
+	fact := NewFactory("testing_boolean_expression", &booleanExpressionArguments{}, func(_ FunctionContext, oArgs Arguments) (ExprFunc[any], error) {
+		args := oArgs.(*booleanExpressionArguments)
+		return func(ctx context.Context, tCtx any) (any, error) {
+			getterVal, err := args.Getter.Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			boolVal, err := args.BoolGetter.Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			got = []any{getterVal, boolVal}
+			if !args.BoolLikeGetter.IsEmpty() {
+				boolLikeVal, err := args.BoolLikeGetter.Get().Get(ctx, tCtx)
+				if err != nil {
+					return nil, err
+				}
+				got = append(got, *boolLikeVal)
+			}
+			return nil, nil
+		}, nil
+	})
+	p, err := NewParser(
+		CreateFactoryMap[any](fact, createFactory[any]("testing_string_getter", &stringGetterArguments{}, functionWithStringGetter)),
+		testParsePath[any],
+		componenttest.NewNopTelemetrySettings(),
+		WithEnumParser[any](testParseEnum),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		statement string
+		want      []any
+	}{
+		{
+			name:      "comparisons",
+			statement: `testing_boolean_expression(name == "value", name != "value")`,
+			want:      []any{true, false},
+		},
+		{
+			name:      "logical operators",
+			statement: `testing_boolean_expression(name == "other" or true, not (name == "value" and true), bool_like_getter = 1 < 2)`,
+			want:      []any{true, false, true},
+		},
+		{
+			name:      "literals",
+			statement: `testing_boolean_expression(true and false, not false)`,
+			want:      []any{false, true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statement, err := p.ParseStatement(tt.statement)
+			require.NoError(t, err)
+			_, _, err = statement.Execute(t.Context(), "value")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err = p.ParseStatement(`testing_string_getter(name == "value")`)
+	assert.ErrorContains(t, err, "boolean expressions cannot be used as arguments of type StringGetter")
+}
+
 func Test_ArgumentsNotMutated(t *testing.T) {
 	args := optionalArgsArguments{}
 	fact := createFactory[any](
@@ -3308,6 +3381,130 @@ func Test_baseKey_Int(t *testing.T) {
 	assert.Equal(t, int64(1), *i)
 }
 
+func Test_baseKey_Range(t *testing.T) {
+	bp := baseKey[any]{
+		r: &KeyRange{Start: ottltest.Intp(1)},
+	}
+	r, err := bp.Range(t.Context(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, &KeyRange{Start: ottltest.Intp(1)}, r)
+}
+
+// noRangeKey is a Key which does not implement RangeKey.
+type noRangeKey struct {
+	Key[any]
+}
+
+func Test_GetKeyRange(t *testing.T) {
+	r, err := GetKeyRange[any](t.Context(), nil, &baseKey[any]{r: &KeyRange{End: ottltest.Intp(2)}})
+	require.NoError(t, err)
+	assert.Equal(t, &KeyRange{End: ottltest.Intp(2)}, r)
+
+	r, err = GetKeyRange[any](t.Context(), nil, noRangeKey{Key: &baseKey[any]{i: ottltest.Intp(1)}})
+	require.NoError(t, err)
+	assert.Nil(t, r)
+}
+
+func Test_KeyRange_Bounds(t *testing.T) {
+	tests := []struct {
+		name     string
+		keyRange KeyRange
+		start    int
+		end      int
+		err      string
+	}{
+		{
+			name:     "range",
+			keyRange: KeyRange{Start: ottltest.Intp(1), End: ottltest.Intp(3)},
+			start:    1,
+			end:      3,
+		},
+		{
+			name:     "omitted bounds",
+			keyRange: KeyRange{},
+			start:    0,
+			end:      4,
+		},
+		{
+			name:     "negative bounds",
+			keyRange: KeyRange{Start: ottltest.Intp(-3), End: ottltest.Intp(-1)},
+			start:    1,
+			end:      3,
+		},
+		{
+			name:     "empty",
+			keyRange: KeyRange{Start: ottltest.Intp(4)},
+			start:    4,
+			end:      4,
+		},
+		{
+			name:     "start too small",
+			keyRange: KeyRange{Start: ottltest.Intp(-5)},
+			err:      "range [-5:] out of bounds for slice of length 4",
+		},
+		{
+			name:     "end too large",
+			keyRange: KeyRange{End: ottltest.Intp(5)},
+			err:      "range [:5] out of bounds for slice of length 4",
+		},
+		{
+			name:     "start after end",
+			keyRange: KeyRange{Start: ottltest.Intp(3), End: ottltest.Intp(2)},
+			err:      "range [3:2] out of bounds for slice of length 4",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := tt.keyRange.Bounds(4)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.start, start)
+			assert.Equal(t, tt.end, end)
+		})
+	}
+}
+
+func Test_newKey_range(t *testing.T) {
+	ps, _ := NewParser[any](
+		defaultFunctionsForTests(),
+		testParsePath[any],
+		componenttest.NewNopTelemetrySettings(),
+		WithEnumParser[any](testParseEnum),
+	)
+	fields := []field{
+		{
+			Name: "attributes",
+			Keys: []key{
+				{
+					String: ottltest.Strp("list"),
+				},
+				{
+					Range: &keyRange{
+						End: ottltest.Intp(-1),
+					},
+				},
+			},
+		},
+	}
+
+	np, err := ps.newParseContext().newPath(&path{Fields: fields})
+	require.NoError(t, err)
+	assert.Equal(t, "attributes[list][:-1]", np.String())
+	require.Len(t, np.Keys(), 2)
+	r, err := GetKeyRange(t.Context(), nil, np.Keys()[0])
+	require.NoError(t, err)
+	assert.Nil(t, r)
+	r, err = GetKeyRange(t.Context(), nil, np.Keys()[1])
+	require.NoError(t, err)
+	assert.Equal(t, &KeyRange{End: ottltest.Intp(-1)}, r)
+	i, err := np.Keys()[1].Int(t.Context(), nil)
+	require.NoError(t, err)
+	assert.Nil(t, i)
+}
+
 func Test_newKey(t *testing.T) {
 	ps, _ := NewParser[any](
 		defaultFunctionsForTests(),
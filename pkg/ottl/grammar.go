@@ -14,10 +14,14 @@ import (
 
 // parsedStatement represents a parsed statement. It is the entry point into the statement DSL.
 type parsedStatement struct {
-	Editor editor `parser:"(@@"`
+	// Let is matched by the statements binding a value to a name, such as `let parsed = ParseJSON(body)`.
+	Let    *letBinding `parser:"( @@"`
+	Editor editor      `parser:"| @@"`
 	// If converter is matched then return error
 	Converter   *converter         `parser:"|@@)"`
 	WhereClause *booleanExpression `parser:"( 'where' @@ )?"`
+	// ErrorMode overrides the ErrorMode of the StatementSequence for this statement.
+	ErrorMode *string `parser:"( 'on_error' @Lowercase )?"`
 }
 
 func (p *parsedStatement) checkForCustomError() error {
@@ -26,30 +30,54 @@ func (p *parsedStatement) checkForCustomError() error {
 		validator.add(fmt.Errorf("editor names must start with a lowercase letter but got '%v'", p.Converter.Function))
 	}
 
-	p.Editor.accept(validator)
-	if p.WhereClause != nil {
-		p.WhereClause.accept(validator)
+	if p.Let != nil && p.WhereClause != nil {
+		validator.add(fmt.Errorf("let statements cannot have a where clause, but %q has one", p.Let.Name))
 	}
+	p.accept(validator)
 
 	return validator.join()
 }
 
+func (p *parsedStatement) accept(v grammarVisitor) {
+	if p.Let != nil {
+		p.Let.Value.accept(v)
+	} else {
+		p.Editor.accept(v)
+	}
+	if p.WhereClause != nil {
+		p.WhereClause.accept(v)
+	}
+}
+
+// letBinding binds the value to the name, which can be used by the following statements
+// of the sequence like a local identifier.
+type letBinding struct {
+	Name  string `parser:"'let' @Lowercase Equal"`
+	Value value  `parser:"@@"`
+}
+
 type constExpr struct {
 	Boolean   *boolean   `parser:"( @Boolean"`
 	Converter *converter `parser:"| @@ )"`
 }
 
 // booleanValue represents something that evaluates to a boolean --
-// either an equality or inequality, explicit true or false, or
-// a parenthesized subexpression.
+// either an equality or inequality, a membership or range test,
+// explicit true or false, or a parenthesized subexpression.
 type booleanValue struct {
-	Negation   *string            `parser:"@OpNot?"`
-	Comparison *comparison        `parser:"( @@"`
+	Negation *string `parser:"@OpNot?"`
+	// Test is tried before Comparison so that the syntax errors of invalid
+	// conditions are the ones of the comparisons.
+	Test       *valueTest         `parser:"( @@"`
+	Comparison *comparison        `parser:"| @@"`
 	ConstExpr  *constExpr         `parser:"| @@"`
 	SubExpr    *booleanExpression `parser:"| '(' @@ ')' )"`
 }
 
 func (b *booleanValue) accept(v grammarVisitor) {
+	if b.Test != nil {
+		b.Test.accept(v)
+	}
 	if b.Comparison != nil {
 		b.Comparison.accept(v)
 	}
@@ -191,8 +219,40 @@ func (c *comparison) accept(v grammarVisitor) {
 	c.Right.accept(v)
 }
 
+// valueTest represents a value tested with the In or Between operators.
+type valueTest struct {
+	Left    value       `parser:"@@"`
+	In      *membership `parser:"( @@"`
+	Between *valueRange `parser:"| @@ )"`
+}
+
+func (t *valueTest) accept(v grammarVisitor) {
+	t.Left.accept(v)
+	if t.In != nil {
+		t.In.List.accept(v)
+	}
+	if t.Between != nil {
+		t.Between.Low.accept(v)
+		t.Between.High.accept(v)
+	}
+}
+
+// membership tests whether a value is, or is not, an element of a list, e.g. `x in ["a", "b"]`.
+type membership struct {
+	Negation *string `parser:"@OpNot?"`
+	List     value   `parser:"'in' @@"`
+}
+
+// valueRange tests whether a value is, or is not, within an inclusive range, e.g. `x between 1 and 5`.
+type valueRange struct {
+	Negation *string `parser:"@OpNot?"`
+	Low      value   `parser:"'between' @@"`
+	High     value   `parser:"OpAnd @@"`
+}
+
 // editor represents the function call of a statement.
 type editor struct {
+	Pos       lexer.Position
 	Function  string     `parser:"@(Lowercase(Uppercase | Lowercase)*)"`
 	Arguments []argument `parser:"'(' ( @@ ( ',' @@ )* )? ')'"`
 	// If keys are matched return an error
@@ -208,6 +268,7 @@ func (i *editor) accept(v grammarVisitor) {
 
 // converter represents a converter function call.
 type converter struct {
+	Pos       lexer.Position
 	Function  string     `parser:"@(Uppercase(Uppercase | Lowercase)*)"`
 	Arguments []argument `parser:"'(' ( @@ ( ',' @@ )* )? ')'"`
 	Keys      []key      `parser:"( @@ )*"`
@@ -233,7 +294,7 @@ type lambdaExpr struct {
 }
 
 type lambdaBody struct {
-	Value *value             `parser:"( @@ (?! OpOr | OpAnd | OpComparison)"`
+	Value *value             `parser:"( @@ (?! OpOr | OpAnd | OpComparison | OpNot | 'in' | 'between')"`
 	Expr  *booleanExpression `parser:"| @@ )"`
 }
 
@@ -260,12 +321,18 @@ func (n *localIdentifierDecl) IsBlank() bool {
 }
 
 type argument struct {
-	Name         string  `parser:"(@(Lowercase(Uppercase | Lowercase)*) Equal)?"`
-	Value        value   `parser:"( @@"`
-	FunctionName *string `parser:"| @(Uppercase(Uppercase | Lowercase)*) )"`
+	Name  string `parser:"(@(Lowercase(Uppercase | Lowercase)*) Equal)?"`
+	Value value  `parser:"( @@ (?! OpOr | OpAnd | OpComparison | OpNot | 'in' | 'between')"`
+	// Expr is a boolean expression, which can be passed to arguments accepting booleans.
+	Expr         *booleanExpression `parser:"| @@"`
+	FunctionName *string            `parser:"| @(Uppercase(Uppercase | Lowercase)*) )"`
 }
 
 func (a *argument) accept(v grammarVisitor) {
+	if a.Expr != nil {
+		a.Expr.accept(v)
+		return
+	}
 	a.Value.accept(v)
 }
 
@@ -309,9 +376,7 @@ func (v *value) accept(vis grammarVisitor) {
 		v.Map.accept(vis)
 	}
 	if v.List != nil {
-		for _, i := range v.List.Values {
-			i.accept(vis)
-		}
+		v.List.accept(vis)
 	}
 }
 
@@ -363,12 +428,20 @@ func (f *field) accept(v grammarVisitor) {
 }
 
 type key struct {
-	String         *string          `parser:"'[' (@String "`
+	Range          *keyRange        `parser:"'[' (@@"`
+	String         *string          `parser:"| @String"`
 	Int            *int64           `parser:"| @Int"`
 	MathExpression *mathExpression  `parser:"| @@"`
 	Expression     *mathExprLiteral `parser:"| @@ ) ']'"`
 }
 
+// keyRange selects the elements of a slice from Start included to End excluded, such as `[1:3]`.
+// The bounds can be omitted, and negative bounds count from the end of the slice.
+type keyRange struct {
+	Start *int64 `parser:"@(OpAddSub? Int)? ':'"`
+	End   *int64 `parser:"@(OpAddSub? Int)?"`
+}
+
 func (k *key) accept(v grammarVisitor) {
 	if k.MathExpression != nil {
 		k.MathExpression.accept(v)
@@ -379,14 +452,36 @@ func (k *key) accept(v grammarVisitor) {
 }
 
 type list struct {
-	Values []value `parser:"'[' (@@)* (',' @@)* ']'"`
+	Comprehension *listComprehension `parser:"( @@"`
+	Values        []value            `parser:"| '[' (@@)* (',' @@)* ']' )"`
+}
+
+func (l *list) accept(v grammarVisitor) {
+	if l.Comprehension != nil {
+		l.Comprehension.Loop.accept(v, &l.Comprehension.Value)
+		return
+	}
+	for _, i := range l.Values {
+		i.accept(v)
+	}
+}
+
+// listComprehension builds a list from the elements of a list or map, e.g. [x["name"] for x in attributes["events"]].
+type listComprehension struct {
+	Value value             `parser:"'[' @@"`
+	Loop  comprehensionLoop `parser:"@@ ']'"`
 }
 
 type mapValue struct {
-	Values []mapItem `parser:"'{' (@@ ','?)* '}'"`
+	Comprehension *mapComprehension `parser:"( @@"`
+	Values        []mapItem         `parser:"| '{' (@@ ','?)* '}' )"`
 }
 
 func (m *mapValue) accept(v grammarVisitor) {
+	if m.Comprehension != nil {
+		m.Comprehension.Loop.accept(v, &m.Comprehension.Key, &m.Comprehension.Value)
+		return
+	}
 	for _, i := range m.Values {
 		if i.Value != nil {
 			i.Value.accept(v)
@@ -394,6 +489,37 @@ func (m *mapValue) accept(v grammarVisitor) {
 	}
 }
 
+// mapComprehension builds a map from the elements of a list or map, e.g. {k: v for k, v in attributes}.
+type mapComprehension struct {
+	Key   value             `parser:"'{' @@ ':'"`
+	Value value             `parser:"@@"`
+	Loop  comprehensionLoop `parser:"@@ '}'"`
+}
+
+// comprehensionLoop declares the local identifiers bound to the elements of the Source,
+// and the optional Condition filtering them.
+type comprehensionLoop struct {
+	Identifiers []localIdentifierDecl `parser:"'for' (@Lowercase | @Underscore) ( ',' (@Lowercase | @Underscore) )?"`
+	Source      value                 `parser:"'in' @@"`
+	Condition   *booleanExpression    `parser:"( 'where' @@ )?"`
+}
+
+// accept visits the Source, and then the given values and the Condition with the
+// identifiers of the loop in scope.
+func (c *comprehensionLoop) accept(vis grammarVisitor, values ...*value) {
+	c.Source.accept(vis)
+	if scopeVisitor, ok := vis.(localIdentifierScopeVisitor); ok {
+		scopeVisitor.pushLocalIdentifiers(c.Identifiers)
+		defer scopeVisitor.popLocalIdentifiers()
+	}
+	for _, v := range values {
+		v.accept(vis)
+	}
+	if c.Condition != nil {
+		c.Condition.accept(vis)
+	}
+}
+
 type mapItem struct {
 	Key   *string `parser:"@String ':'"`
 	Value *value  `parser:"@@"`
@@ -528,6 +654,7 @@ const (
 	sub
 	mult
 	div
+	mod
 )
 
 var mathOpTable = map[string]mathOp{
@@ -535,6 +662,7 @@ var mathOpTable = map[string]mathOp{
 	"-": sub,
 	"*": mult,
 	"/": div,
+	"%": mod,
 }
 
 func (m *mathOp) Capture(values []string) error {
@@ -556,6 +684,8 @@ func (m *mathOp) String() string {
 		return "*"
 	case div:
 		return "/"
+	case mod:
+		return "%"
 	default:
 		return "UNKNOWN OP!"
 	}
@@ -579,7 +709,9 @@ func buildLexer() *lexer.StatefulDefinition {
 		{Name: `OpComparison`, Pattern: `==|!=|>=|<=|>|<`},
 		{Name: `LambdaArrow`, Pattern: `=>`},
 		{Name: `OpAddSub`, Pattern: `\+|\-`},
-		{Name: `OpMultDiv`, Pattern: `\/|\*`},
+		// Comments must be matched before the division operator, as they both start with a slash.
+		{Name: "comment", Pattern: `(//|#)[^\n]*`},
+		{Name: `OpMultDiv`, Pattern: `\/|\*|%`},
 		{Name: `Boolean`, Pattern: `\b(true|false)\b`},
 		{Name: `Equal`, Pattern: `=`},
 		{Name: `LParen`, Pattern: `\(`},
@@ -588,9 +720,12 @@ func buildLexer() *lexer.StatefulDefinition {
 		{Name: `RBrace`, Pattern: `\}`},
 		{Name: `Colon`, Pattern: `\:`},
 		{Name: `Punct`, Pattern: `[,.\[\]]`},
-		{Name: `Uppercase`, Pattern: `[A-Z][A-Z0-9_]*`},
+		// The keywords are matched at the start of the remaining input, where \b always matches, so the
+		// uppercase tokens include the keywords following them, such as the "or" of "Xor".
+		{Name: `Uppercase`, Pattern: `[A-Z][A-Z0-9_]*((nil|not|or|and|true|false)\b)?`},
 		{Name: `Lowercase`, Pattern: `[a-z][a-z0-9_]*`},
 		{Name: "whitespace", Pattern: `\s+`},
+		{Name: "continuation", Pattern: `\\\r?\n`},
 		{Name: "Underscore", Pattern: `\b(_)\b`},
 	})
 }
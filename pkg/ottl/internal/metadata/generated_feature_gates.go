@@ -29,3 +29,35 @@ var OttlFunctionsEnableLambdaFeatureGate = featuregate.GlobalRegistry().MustRegi
 	featuregate.WithRegisterReferenceURL("https://github.com/open-telemetry/opentelemetry-collector-contrib/pull/48227"),
 	featuregate.WithRegisterFromVersion("v0.155.0"),
 )
+
+var OttlFunctionsEnableEnvFeatureGate = featuregate.GlobalRegistry().MustRegister(
+	"ottl.functions.enableEnv",
+	featuregate.StageAlpha,
+	featuregate.WithRegisterDescription("Allow the `Env` converter to read environment variables of the collector process. When disabled, statements using `Env` are rejected."),
+	featuregate.WithRegisterReferenceURL("https://github.com/paulojmdias/opentelemetry-collector-contrib/issues/2855"),
+	featuregate.WithRegisterFromVersion("v0.156.0"),
+)
+
+var OttlFunctionsEnableFileContentsFeatureGate = featuregate.GlobalRegistry().MustRegister(
+	"ottl.functions.enableFileContents",
+	featuregate.StageAlpha,
+	featuregate.WithRegisterDescription("Allow the `FileContents` converter to read files from the collector host. When disabled, statements using `FileContents` are rejected."),
+	featuregate.WithRegisterReferenceURL("https://github.com/paulojmdias/opentelemetry-collector-contrib/issues/2855"),
+	featuregate.WithRegisterFromVersion("v0.156.0"),
+)
+
+var OttlFunctionsEnableInSetFeatureGate = featuregate.GlobalRegistry().MustRegister(
+	"ottl.functions.enableInSet",
+	featuregate.StageAlpha,
+	featuregate.WithRegisterDescription("Allow the `InSet` converter to read sets from files of the collector host. When disabled, statements using `InSet` are rejected."),
+	featuregate.WithRegisterReferenceURL("https://github.com/paulojmdias/opentelemetry-collector-contrib/issues/2856"),
+	featuregate.WithRegisterFromVersion("v0.156.0"),
+)
+
+var OttlFunctionsEnableLookupFeatureGate = featuregate.GlobalRegistry().MustRegister(
+	"ottl.functions.enableLookup",
+	featuregate.StageAlpha,
+	featuregate.WithRegisterDescription("Allow the `Lookup` converter to read tables from files of the collector host and from extensions. When disabled, statements using `Lookup` are rejected."),
+	featuregate.WithRegisterReferenceURL("https://github.com/paulojmdias/opentelemetry-collector-contrib/issues/2842"),
+	featuregate.WithRegisterFromVersion("v0.156.0"),
+)
@@ -0,0 +1,21 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlcommon // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/ottlcommon"
+
+import (
+	"fmt"
+)
+
+// SliceIndex returns the index of an element of a slice of the given length,
+// negative indexes counting from the end of the slice.
+func SliceIndex(idx int64, length int) (int, error) {
+	i := int(idx)
+	if i < 0 {
+		i += length
+	}
+	if i < 0 || i >= length {
+		return 0, fmt.Errorf("index %v out of bounds", idx)
+	}
+	return i, nil
+}
@@ -37,6 +37,16 @@ func Test_lexer(t *testing.T) {
 		{"basic_uppercase", "ABC", false, []result{
 			{"Uppercase", "ABC"},
 		}},
+		{"uppercase_followed_by_keyword", "BitwiseXor(X or Y)", false, []result{
+			{"Uppercase", "B"},
+			{"Lowercase", "itwise"},
+			{"Uppercase", "Xor"},
+			{"LParen", "("},
+			{"Uppercase", "X"},
+			{"OpOr", "or"},
+			{"Uppercase", "Y"},
+			{"RParen", ")"},
+		}},
 		{"basic_int", "12345", false, []result{
 			{"Int", "12345"},
 		}},
@@ -123,11 +133,12 @@ func Test_lexer(t *testing.T) {
 			{"Lowercase", "d_123"},
 			{"Uppercase", "E_4"},
 		}},
-		{"Math Operations", `+-*/`, false, []result{
+		{"Math Operations", `+-*/%`, false, []result{
 			{"OpAddSub", "+"},
 			{"OpAddSub", "-"},
 			{"OpMultDiv", "*"},
 			{"OpMultDiv", "/"},
+			{"OpMultDiv", "%"},
 		}},
 		{"Math Equation AddSub", `1000 - 600`, false, []result{
 			{"Int", "1000"},
@@ -272,6 +283,24 @@ func Test_lexer(t *testing.T) {
 			{"LambdaArrow", "=>"},
 			{"Lowercase", "value"},
 		}},
+		{"slash comment", "a // comment / with * operators\n/ b", false, []result{
+			{"Lowercase", "a"},
+			{"OpMultDiv", "/"},
+			{"Lowercase", "b"},
+		}},
+		{"hash comment", "a # comment\n+ b # trailing comment", false, []result{
+			{"Lowercase", "a"},
+			{"OpAddSub", "+"},
+			{"Lowercase", "b"},
+		}},
+		{"comment characters in string", `"http://example.com/#anchor"`, false, []result{
+			{"String", `"http://example.com/#anchor"`},
+		}},
+		{"line continuation", "a \\\n+ \\\r\nb", false, []result{
+			{"Lowercase", "a"},
+			{"OpAddSub", "+"},
+			{"Lowercase", "b"},
+		}},
 	}
 
 	for _, tt := range tests {
@@ -47,3 +47,19 @@ func GetLiteralValue[K, V any](getter typedGetter[K, V]) (V, bool) {
 
 	return val, true
 }
+
+// foldLiterals evaluates the getter once and returns the value as a literal if all the
+// operands it depends on are literals. If the evaluation fails, the getter is returned
+// as is, so the error is reported when the statement is executed.
+func foldLiterals[K any](getter Getter[K], operands ...Getter[K]) Getter[K] {
+	for _, operand := range operands {
+		if !isLiteralGetter[K, any](operand) {
+			return getter
+		}
+	}
+	val, err := getter.Get(context.Background(), *new(K))
+	if err != nil {
+		return getter
+	}
+	return newLiteral[K, any](val)
+}
@@ -168,3 +168,44 @@ func makeLocalIdentifiers(args ...string) []LocalIdentifierDecl {
 	}
 	return res
 }
+
+// newLetBinding returns the function of a let statement, which binds the value to the name in
+// the localActivation of the StatementSequence execution, so the following statements can use it.
+func (p *parseContext[K]) newLetBinding(let *letBinding, bindings localScopeFrame) (Expr[K], error) {
+	if _, ok := bindings[let.Name]; ok {
+		return Expr[K]{}, fmt.Errorf("%q is already bound by a previous let statement", let.Name)
+	}
+	if _, ok := p.pathContextNames[let.Name]; ok {
+		return Expr[K]{}, fmt.Errorf("%q is a context name and cannot be bound by a let statement", let.Name)
+	}
+	if _, ok := p.pathNamespaces[let.Name]; ok {
+		return Expr[K]{}, fmt.Errorf("%q is a path namespace and cannot be bound by a let statement", let.Name)
+	}
+	getter, err := p.newGetter(let.Value)
+	if err != nil {
+		return Expr[K]{}, err
+	}
+	name := let.Name
+	return Expr[K]{exprFunc: func(ctx context.Context, tCtx K) (any, error) {
+		activation, ok := ctx.Value(localActivationKey{}).(*localActivation)
+		if !ok {
+			return nil, fmt.Errorf("let binding %q executed outside of a StatementSequence", name)
+		}
+		val, err := getter.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		activation.bindings[name] = val
+		return nil, nil
+	}}, nil
+}
+
+// hasLetBindings returns true if any of the statements is a let statement.
+func hasLetBindings[K any](statements []*Statement[K]) bool {
+	for _, statement := range statements {
+		if statement.letName != "" {
+			return true
+		}
+	}
+	return false
+}
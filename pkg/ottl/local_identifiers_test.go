@@ -282,3 +282,148 @@ func Test_resolveLocalIdentifierBinding(t *testing.T) {
 		})
 	}
 }
+
+func Test_LetBindings(t *testing.T) {
+	tests := []struct {
+		name          string
+		statements    []string
+		tCtx          map[string]any
+		expected      map[string]any
+		expectedCalls int
+	}{
+		{
+			name: "binding is used by the following statements",
+			statements: []string{
+				`let parsed = Parse(body, "-p")`,
+				`set(a, parsed)`,
+				`set(b, Parse(parsed, "-q")) where parsed != nil`,
+			},
+			tCtx:          map[string]any{"body": "x"},
+			expected:      map[string]any{"body": "x", "a": "x-p", "b": "x-p-q"},
+			expectedCalls: 2,
+		},
+		{
+			name: "binding is used by a following binding",
+			statements: []string{
+				`let first = Parse(body, "-1")`,
+				`let second = Parse(first, "-2")`,
+				`set(a, second)`,
+			},
+			tCtx:          map[string]any{"body": "x"},
+			expected:      map[string]any{"body": "x", "a": "x-1-2"},
+			expectedCalls: 2,
+		},
+		{
+			name: "binding is indexed",
+			statements: []string{
+				`let values = ["a", "b"]`,
+				`set(a, values[1])`,
+			},
+			tCtx:     map[string]any{},
+			expected: map[string]any{"a": "b"},
+		},
+		{
+			name: "binding holds the value when it is executed",
+			statements: []string{
+				`let before = body`,
+				`set(body, "y")`,
+				`set(a, before)`,
+			},
+			tCtx:     map[string]any{"body": "x"},
+			expected: map[string]any{"body": "y", "a": "x"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			p := newMemoizeTestParser(t, &calls)
+			statements, err := p.ParseStatements(tt.statements)
+			require.NoError(t, err)
+			sequence := NewStatementSequence(statements, componenttest.NewNopTelemetrySettings())
+
+			require.NoError(t, sequence.Execute(t.Context(), tt.tCtx))
+			assert.Equal(t, tt.expected, tt.tCtx)
+			assert.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
+
+func Test_LetBindings_ExecuteBatch(t *testing.T) {
+	calls := 0
+	p := newMemoizeTestParser(t, &calls)
+	statements, err := p.ParseStatements([]string{
+		`set(a, Parse(body, "-a")) where body == "y"`,
+		`let parsed = Parse(body, "-p")`,
+		`set(b, parsed)`,
+	})
+	require.NoError(t, err)
+	sequence := NewStatementSequence(statements, componenttest.NewNopTelemetrySettings())
+
+	tCtxs := []map[string]any{{"body": "x"}, {"body": "y"}}
+	require.NoError(t, sequence.ExecuteBatch(t.Context(), tCtxs))
+	assert.Equal(t, []map[string]any{
+		{"body": "x", "b": "x-p"},
+		{"body": "y", "a": "y-a", "b": "y-p"},
+	}, tCtxs)
+}
+
+func Test_LetBindings_errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		statements []string
+		wantErr    string
+	}{
+		{
+			name: "already bound",
+			statements: []string{
+				`let parsed = log.body`,
+				`set(log.body, parsed)`,
+				`let parsed = log.other`,
+			},
+			wantErr: `"parsed" is already bound by a previous let statement`,
+		},
+		{
+			name:       "context name",
+			statements: []string{`let log = log.body`},
+			wantErr:    `"log" is a context name and cannot be bound by a let statement`,
+		},
+		{
+			name:       "where clause",
+			statements: []string{`let parsed = log.body where log.body != nil`},
+			wantErr:    `let statements cannot have a where clause, but "parsed" has one`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			p := newMemoizeTestParser(t, &calls, WithPathContextNames[map[string]any]([]string{"log"}))
+			_, err := p.ParseStatements(tt.statements)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_LetBindings_outsideStatementSequence(t *testing.T) {
+	calls := 0
+	p := newMemoizeTestParser(t, &calls)
+	statement, err := p.ParseStatement(`let parsed = body`)
+	require.NoError(t, err)
+	_, _, err = statement.Execute(t.Context(), map[string]any{})
+	assert.EqualError(t, err, `let binding "parsed" executed outside of a StatementSequence`)
+}
+
+func Test_prependContextToStatementsPaths_LetBindings(t *testing.T) {
+	calls := 0
+	p := newMemoizeTestParser(t, &calls, WithPathContextNames[map[string]any]([]string{"log"}))
+	result, err := p.prependContextToStatementsPaths("log", []string{
+		`set(parsed, body)`,
+		`let parsed = ParseJSON(body)`,
+		`set(attributes["a"], parsed["a"]) where parsed["b"] != nil`,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		`set(log.parsed, log.body)`,
+		`let parsed = ParseJSON(log.body)`,
+		`set(log.attributes["a"], parsed["a"]) where parsed["b"] != nil`,
+	}, result)
+}
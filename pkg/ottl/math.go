@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"time"
 )
 
@@ -86,7 +87,7 @@ func (p *parseContext[K]) evaluateMathValue(val *mathValue) (Getter[K], error) {
 }
 
 func negateGetter[K any](baseGetter Getter[K]) Getter[K] {
-	return &exprGetter[K]{
+	return foldLiterals[K](&exprGetter[K]{
 		expr: Expr[K]{
 			exprFunc: func(ctx context.Context, tCtx K) (any, error) {
 				x, err := baseGetter.Get(ctx, tCtx)
@@ -103,11 +104,11 @@ func negateGetter[K any](baseGetter Getter[K]) Getter[K] {
 				}
 			},
 		},
-	}
+	}, baseGetter)
 }
 
 func attemptMathOperation[K any](lhs Getter[K], op mathOp, rhs Getter[K]) Getter[K] {
-	return &exprGetter[K]{
+	return foldLiterals[K](&exprGetter[K]{
 		expr: Expr[K]{
 			exprFunc: func(ctx context.Context, tCtx K) (any, error) {
 				x, err := lhs.Get(ctx, tCtx)
@@ -162,7 +163,7 @@ func attemptMathOperation[K any](lhs Getter[K], op mathOp, rhs Getter[K]) Getter
 				}
 			},
 		},
-	}
+	}, lhs, rhs)
 }
 
 func performOpTime(x time.Time, y any, op mathOp) (any, error) {
@@ -211,8 +212,48 @@ func performOpDuration(x time.Duration, y any, op mathOp) (any, error) {
 		default:
 			return nil, fmt.Errorf("time.Duration must be subtracted from time.Duration; found %v instead", y)
 		}
+	case mult:
+		switch newY := y.(type) {
+		case int64:
+			return x * time.Duration(newY), nil
+		case float64:
+			return time.Duration(float64(x) * newY), nil
+		default:
+			return nil, fmt.Errorf("time.Duration must be multiplied by int64 or float64; found %v instead", y)
+		}
+	case div:
+		switch newY := y.(type) {
+		case time.Duration:
+			if newY == 0 {
+				return nil, errors.New("attempted to divide by 0")
+			}
+			// Dividing durations gives the number of times the divisor fits in the duration.
+			return int64(x / newY), nil
+		case int64:
+			if newY == 0 {
+				return nil, errors.New("attempted to divide by 0")
+			}
+			return x / time.Duration(newY), nil
+		case float64:
+			if newY == 0 {
+				return nil, errors.New("attempted to divide by 0")
+			}
+			return time.Duration(float64(x) / newY), nil
+		default:
+			return nil, fmt.Errorf("time.Duration must be divided by time.Duration, int64 or float64; found %v instead", y)
+		}
+	case mod:
+		switch newY := y.(type) {
+		case time.Duration:
+			if newY == 0 {
+				return nil, errors.New("attempted to divide by 0")
+			}
+			return x % newY, nil
+		default:
+			return nil, fmt.Errorf("the modulo of a time.Duration must be computed with a time.Duration; found %v instead", y)
+		}
 	}
-	return nil, errors.New("only addition and subtraction supported for time.Time and time.Duration")
+	return nil, fmt.Errorf("invalid operation %v", op)
 }
 
 func performOp[N int64 | float64](x, y N, op mathOp) (N, error) {
@@ -228,6 +269,16 @@ func performOp[N int64 | float64](x, y N, op mathOp) (N, error) {
 			return 0, errors.New("attempted to divide by 0")
 		}
 		return x / y, nil
+	case mod:
+		if y == 0 {
+			return 0, errors.New("attempted to divide by 0")
+		}
+		switch newX := any(x).(type) {
+		case int64:
+			return N(newX % any(y).(int64)), nil
+		case float64:
+			return N(math.Mod(newX, any(y).(float64))), nil
+		}
 	}
 	return 0, fmt.Errorf("invalid operation %v", op)
 }
@@ -178,6 +178,26 @@ func Test_evaluateMathExpression(t *testing.T) {
 			input:    "10 / 3",
 			expected: int64(3),
 		},
+		{
+			name:     "int modulo",
+			input:    "10 % 3",
+			expected: int64(1),
+		},
+		{
+			name:     "negative int modulo",
+			input:    "-10 % 3",
+			expected: int64(-1),
+		},
+		{
+			name:     "float modulo",
+			input:    "7.5 % 2",
+			expected: 1.5,
+		},
+		{
+			name:     "modulo has the precedence of multiplication",
+			input:    "1 + 10 % 4 * 2",
+			expected: int64(5),
+		},
 		{
 			name:     "multiply large ints",
 			input:    "9223372036854775807 * 9223372036854775807",
@@ -363,6 +383,10 @@ func Test_evaluateMathExpression_error(t *testing.T) {
 			name:  "divide by 0 is gracefully handled",
 			input: "1 / 0",
 		},
+		{
+			name:  "modulo by 0 is gracefully handled",
+			input: "1 % 0",
+		},
 		{
 			name: "time div time",
 			mathExpr: &mathExpression{
@@ -457,7 +481,7 @@ func Test_evaluateMathExpression_error(t *testing.T) {
 					},
 				},
 			},
-			errorMsg: "only addition and subtraction supported",
+			errorMsg: "time.Duration must be multiplied by int64 or float64",
 		},
 		{
 			name: "time add int",
@@ -687,6 +711,69 @@ func Test_evaluateMathExpression_error(t *testing.T) {
 	}
 }
 
+func Test_evaluateMathExpression_literals(t *testing.T) {
+	p, _ := NewParser[any](
+		map[string]Factory[any]{},
+		mathParsePath[any],
+		componenttest.NewNopTelemetrySettings(),
+	)
+
+	tests := []struct {
+		name        string
+		input       string
+		expected    any
+		wantLiteral bool
+	}{
+		{
+			name:        "literals are folded",
+			input:       "1 + 2 * 3",
+			expected:    int64(7),
+			wantLiteral: true,
+		},
+		{
+			name:        "negated sub expression is folded",
+			input:       "-(1.5 + 2)",
+			expected:    float64(-3.5),
+			wantLiteral: true,
+		},
+		{
+			name:        "literal sub expression next to a path",
+			input:       "one + (2 * 3)",
+			expected:    int64(7),
+			wantLiteral: false,
+		},
+	}
+
+	mathParser := newParser[value]()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := mathParser.ParseString("", tt.input)
+			require.NoError(t, err)
+
+			getter, err := p.newParseContext().evaluateMathExpression(parsed.MathExpression)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantLiteral, isLiteralGetter(getter))
+
+			result, err := getter.Get(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	t.Run("errors are returned on execution", func(t *testing.T) {
+		parsed, err := mathParser.ParseString("", "1 / 0")
+		require.NoError(t, err)
+
+		getter, err := p.newParseContext().evaluateMathExpression(parsed.MathExpression)
+		require.NoError(t, err)
+		assert.False(t, isLiteralGetter(getter))
+
+		_, err = getter.Get(t.Context(), nil)
+		assert.ErrorContains(t, err, "attempted to divide by 0")
+	})
+}
+
 func Test_evaluateMathExpressionTimeDuration(t *testing.T) {
 	functions := CreateFactoryMap(
 		createFactory("Time", &struct {
@@ -1250,3 +1337,101 @@ func Test_evaluateMathExpressionTimeDuration(t *testing.T) {
 		assert.Equal(t, tt.expected, result)
 	}
 }
+
+func Test_evaluateMathExpressionDurationArithmetic(t *testing.T) {
+	functions := CreateFactoryMap(
+		createFactory("Duration", &struct {
+			Duration string
+		}{}, testDuration[any]),
+	)
+
+	p, _ := NewParser(
+		functions,
+		mathParsePath[any],
+		componenttest.NewNopTelemetrySettings(),
+		WithEnumParser[any](testParseEnum),
+	)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected any
+		errorMsg string
+	}{
+		{
+			name:     "dur mult int",
+			input:    `Duration("90m") * 2`,
+			expected: 3 * time.Hour,
+		},
+		{
+			name:     "dur mult float",
+			input:    `Duration("1h") * 1.5`,
+			expected: 90 * time.Minute,
+		},
+		{
+			name:     "dur div dur is an integer division",
+			input:    `Duration("150m") / Duration("1h")`,
+			expected: int64(2),
+		},
+		{
+			name:     "dur div int",
+			input:    `Duration("1h") / 4`,
+			expected: 15 * time.Minute,
+		},
+		{
+			name:     "dur div float",
+			input:    `Duration("1h") / 0.5`,
+			expected: 2 * time.Hour,
+		},
+		{
+			name:     "dur mod dur",
+			input:    `Duration("150m") % Duration("1h")`,
+			expected: 30 * time.Minute,
+		},
+		{
+			name:     "bucket start",
+			input:    `Duration("150m") - Duration("150m") % Duration("1h")`,
+			expected: 2 * time.Hour,
+		},
+		{
+			name:     "dur div 0 dur",
+			input:    `Duration("1h") / Duration("0s")`,
+			errorMsg: "attempted to divide by 0",
+		},
+		{
+			name:     "dur div 0",
+			input:    `Duration("1h") / 0`,
+			errorMsg: "attempted to divide by 0",
+		},
+		{
+			name:     "dur mod 0",
+			input:    `Duration("1h") % Duration("0s")`,
+			errorMsg: "attempted to divide by 0",
+		},
+		{
+			name:     "dur mod int",
+			input:    `Duration("1h") % 2`,
+			errorMsg: "the modulo of a time.Duration must be computed with a time.Duration",
+		},
+	}
+
+	mathParser := newParser[value]()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := mathParser.ParseString("", tt.input)
+			require.NoError(t, err)
+
+			getter, err := p.newParseContext().evaluateMathExpression(parsed.MathExpression)
+			require.NoError(t, err)
+
+			result, err := getter.Get(t.Context(), nil)
+			if tt.errorMsg != "" {
+				assert.ErrorContains(t, err, tt.errorMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// converterCacheKey is a [context.Context] key used for storing the *converterCache
+// of the execution of a StatementSequence on a TransformContext.
+type converterCacheKey struct{}
+
+// converterCache holds the results of the memoizable Converters called while executing a StatementSequence
+// on a TransformContext, so the Converters called with the same arguments by several statements, such as
+// `ParseJSON(body)`, are only computed once.
+type converterCache struct {
+	// entries are the results of the calls, by the key of the converter called.
+	entries map[string][]converterCacheEntry
+}
+
+// converterCacheEntry is the result of a Converter, along with the values of the arguments it was called with.
+type converterCacheEntry struct {
+	args  []any
+	value any
+}
+
+func newConverterCache() *converterCache {
+	return &converterCache{entries: map[string][]converterCacheEntry{}}
+}
+
+func withConverterCache(ctx context.Context, cache *converterCache) context.Context {
+	return context.WithValue(ctx, converterCacheKey{}, cache)
+}
+
+// newMemoizedExpr returns an Expr reusing the cached result of a previous call with the same argument values.
+// The cache is only used during the executions of a StatementSequence, other executions evaluate the call.
+func newMemoizedExpr[K any](call Expr[K], key string, args []Getter[K]) Expr[K] {
+	return Expr[K]{exprFunc: func(ctx context.Context, tCtx K) (any, error) {
+		cache, ok := ctx.Value(converterCacheKey{}).(*converterCache)
+		if !ok {
+			return call.Eval(ctx, tCtx)
+		}
+
+		values := make([]any, len(args))
+		for i, arg := range args {
+			val, err := arg.Get(ctx, tCtx)
+			if err != nil || !isMemoizableArgument(val) {
+				return call.Eval(ctx, tCtx)
+			}
+			values[i] = val
+		}
+		for _, entry := range cache.entries[key] {
+			if slices.Equal(entry.args, values) {
+				return copyCachedValue(entry.value), nil
+			}
+		}
+
+		val, err := call.Eval(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		// The returned value may be modified, so a copy of it is cached.
+		cache.entries[key] = append(cache.entries[key], converterCacheEntry{args: values, value: copyCachedValue(val)})
+		return val, nil
+	}}
+}
+
+// isMemoizableArgument returns true if the value is comparable and immutable, so comparing it to
+// the argument of a previous call is enough to know whether the call is the same.
+func isMemoizableArgument(val any) bool {
+	switch val.(type) {
+	case nil, string, int64, float64, bool:
+		return true
+	default:
+		return false
+	}
+}
+
+// copyCachedValue returns a copy of the maps and slices returned by the memoized Converters,
+// which are not shared between the calls.
+func copyCachedValue(val any) any {
+	switch v := val.(type) {
+	case pcommon.Map:
+		m := pcommon.NewMap()
+		v.CopyTo(m)
+		return m
+	case pcommon.Slice:
+		sl := pcommon.NewSlice()
+		v.CopyTo(sl)
+		return sl
+	case []byte:
+		return slices.Clone(v)
+	default:
+		return val
+	}
+}
+
+// memoizableConverter returns the cache key of the converter call and the getters of its arguments if its
+// result can be memoized: the converter must be pure or memoized, and its arguments must be paths or constant
+// values. The calls to the same converter are told apart by the values of their arguments when executed.
+func (p *parseContext[K]) memoizableConverter(c converter) (string, []Getter[K], bool) {
+	f, ok := p.functions[c.Function]
+	if !ok {
+		return "", nil, false
+	}
+	if mf, ok := f.(interface{ isMemoizable() bool }); !ok || !mf.isMemoizable() {
+		return "", nil, false
+	}
+
+	names := make([]string, 0, len(c.Arguments))
+	args := make([]Getter[K], 0, len(c.Arguments))
+	for _, arg := range c.Arguments {
+		if arg.Expr != nil || arg.FunctionName != nil {
+			return "", nil, false
+		}
+		isPath := arg.Value.Literal != nil && arg.Value.Literal.Path != nil
+		if !isPath && !p.isConstantValue(arg.Value) {
+			return "", nil, false
+		}
+		getter, err := p.newGetter(arg.Value)
+		if err != nil {
+			return "", nil, false
+		}
+		names = append(names, arg.Name)
+		args = append(args, getter)
+	}
+	// The names of the arguments are part of the key, since named arguments can be passed in any order.
+	return c.Function + "(" + strings.Join(names, ",") + ")", args, true
+}
+
+// hasRepeatedConverters returns true if a memoizable converter is called
+// more than once by the statements.
+func hasRepeatedConverters[K any](statements []*Statement[K]) bool {
+	seen := map[string]struct{}{}
+	for _, statement := range statements {
+		for _, key := range statement.memoKeys {
+			if _, ok := seen[key]; ok {
+				return true
+			}
+			seen[key] = struct{}{}
+		}
+	}
+	return false
+}
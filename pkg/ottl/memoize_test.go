@@ -0,0 +1,285 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+type memoizeSetArguments struct {
+	Target GetSetter[map[string]any]
+	Value  Getter[map[string]any]
+}
+
+type memoizeParseArguments struct {
+	Value  Getter[map[string]any]
+	Suffix string
+}
+
+// newMemoizeTestParser returns a parser whose paths are the keys of the TransformContext,
+// with a pure Parse converter counting its calls.
+func newMemoizeTestParser(t *testing.T, calls *int, options ...Option[map[string]any]) Parser[map[string]any] {
+	functions := CreateFactoryMap(
+		NewFactory("set", &memoizeSetArguments{}, func(_ FunctionContext, args Arguments) (ExprFunc[map[string]any], error) {
+			a := args.(*memoizeSetArguments)
+			return func(ctx context.Context, tCtx map[string]any) (any, error) {
+				val, err := a.Value.Get(ctx, tCtx)
+				if err != nil {
+					return nil, err
+				}
+				return nil, a.Target.Set(ctx, tCtx, val)
+			}, nil
+		}),
+		NewFactory("Parse", &memoizeParseArguments{}, func(_ FunctionContext, args Arguments) (ExprFunc[map[string]any], error) {
+			a := args.(*memoizeParseArguments)
+			return func(ctx context.Context, tCtx map[string]any) (any, error) {
+				*calls++
+				val, err := a.Value.Get(ctx, tCtx)
+				if err != nil {
+					return nil, err
+				}
+				if val == "invalid" {
+					return nil, errors.New("invalid value")
+				}
+				return fmt.Sprintf("%v%s", val, a.Suffix), nil
+			}, nil
+		}, WithPureFunction[map[string]any]()),
+	)
+	p, err := NewParser(
+		functions,
+		func(path Path[map[string]any]) (GetSetter[map[string]any], error) {
+			return &StandardGetSetter[map[string]any]{
+				Getter: func(_ context.Context, tCtx map[string]any) (any, error) {
+					return tCtx[path.Name()], nil
+				},
+				Setter: func(_ context.Context, tCtx map[string]any, val any) error {
+					tCtx[path.Name()] = val
+					return nil
+				},
+			}, nil
+		},
+		componenttest.NewNopTelemetrySettings(),
+		options...,
+	)
+	require.NoError(t, err)
+	return p
+}
+
+func Test_StatementSequence_memoization(t *testing.T) {
+	tests := []struct {
+		name          string
+		statements    []string
+		tCtx          map[string]any
+		expected      map[string]any
+		expectedCalls int
+	}{
+		{
+			name: "repeated converter is computed once",
+			statements: []string{
+				`set(a, Parse(body, "-a"))`,
+				`set(b, Parse(body, "-a")) where Parse(body, "-a") != nil`,
+			},
+			tCtx:          map[string]any{"body": "x"},
+			expected:      map[string]any{"body": "x", "a": "x-a", "b": "x-a"},
+			expectedCalls: 1,
+		},
+		{
+			name: "different arguments are computed separately",
+			statements: []string{
+				`set(a, Parse(body, "-a"))`,
+				`set(b, Parse(body, "-b"))`,
+				`set(c, Parse(other, "-a"))`,
+			},
+			tCtx:          map[string]any{"body": "x", "other": "y"},
+			expected:      map[string]any{"body": "x", "other": "y", "a": "x-a", "b": "x-b", "c": "y-a"},
+			expectedCalls: 3,
+		},
+		{
+			name: "converter is computed again when its input changes",
+			statements: []string{
+				`set(a, Parse(body, "-a"))`,
+				`set(body, "z")`,
+				`set(b, Parse(body, "-a"))`,
+			},
+			tCtx:          map[string]any{"body": "x"},
+			expected:      map[string]any{"body": "z", "a": "x-a", "b": "z-a"},
+			expectedCalls: 2,
+		},
+		{
+			name: "same argument values from different paths are computed once",
+			statements: []string{
+				`set(a, Parse(body, "-a"))`,
+				`set(b, Parse(other, "-a"))`,
+			},
+			tCtx:          map[string]any{"body": "x", "other": "x"},
+			expected:      map[string]any{"body": "x", "other": "x", "a": "x-a", "b": "x-a"},
+			expectedCalls: 1,
+		},
+		{
+			name: "non scalar inputs are not memoized",
+			statements: []string{
+				`set(a, Parse(body, "-a"))`,
+				`set(b, Parse(body, "-a"))`,
+			},
+			tCtx:          map[string]any{"body": []any{"x"}},
+			expected:      map[string]any{"body": []any{"x"}, "a": "[x]-a", "b": "[x]-a"},
+			expectedCalls: 2,
+		},
+		{
+			name: "errors are not memoized",
+			statements: []string{
+				`set(a, Parse(body, "-a")) on_error ignore`,
+				`set(b, Parse(body, "-a")) on_error ignore`,
+			},
+			tCtx:          map[string]any{"body": "invalid"},
+			expected:      map[string]any{"body": "invalid"},
+			expectedCalls: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			p := newMemoizeTestParser(t, &calls)
+			statements, err := p.ParseStatements(tt.statements)
+			require.NoError(t, err)
+			sequence := NewStatementSequence(statements, componenttest.NewNopTelemetrySettings())
+
+			require.NoError(t, sequence.Execute(t.Context(), tt.tCtx))
+			assert.Equal(t, tt.expected, tt.tCtx)
+			assert.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
+
+func Test_StatementSequence_memoizationPerTransformContext(t *testing.T) {
+	calls := 0
+	p := newMemoizeTestParser(t, &calls)
+	statements, err := p.ParseStatements([]string{
+		`set(a, Parse(body, "-a"))`,
+		`set(b, Parse(body, "-a"))`,
+	})
+	require.NoError(t, err)
+	sequence := NewStatementSequence(statements, componenttest.NewNopTelemetrySettings())
+
+	tCtxs := []map[string]any{{"body": "x"}, {"body": "x"}}
+	require.NoError(t, sequence.ExecuteBatch(t.Context(), tCtxs))
+	assert.Equal(t, 2, calls)
+	for _, tCtx := range tCtxs {
+		assert.Equal(t, map[string]any{"body": "x", "a": "x-a", "b": "x-a"}, tCtx)
+	}
+}
+
+func Test_StatementSequence_noRepeatedConverters(t *testing.T) {
+	calls := 0
+	p := newMemoizeTestParser(t, &calls)
+	statements, err := p.ParseStatements([]string{
+		`set(a, Parse(body, "-a"))`,
+		`set(b, body)`,
+	})
+	require.NoError(t, err)
+	sequence := NewStatementSequence(statements, componenttest.NewNopTelemetrySettings())
+	assert.False(t, sequence.memoize)
+}
+
+type memoizeParseMapArguments struct {
+	Value Getter[map[string]any]
+}
+
+func Test_StatementSequence_memoizedFunction(t *testing.T) {
+	calls := 0
+	functions := CreateFactoryMap(
+		NewFactory("ParseMap", &memoizeParseMapArguments{}, func(_ FunctionContext, args Arguments) (ExprFunc[map[string]any], error) {
+			a := args.(*memoizeParseMapArguments)
+			return func(ctx context.Context, tCtx map[string]any) (any, error) {
+				calls++
+				val, err := a.Value.Get(ctx, tCtx)
+				if err != nil {
+					return nil, err
+				}
+				m := pcommon.NewMap()
+				m.PutStr("value", fmt.Sprint(val))
+				return m, nil
+			}, nil
+		}, WithMemoizedFunction[map[string]any]()),
+	)
+	maps := map[string]pcommon.Map{}
+	functions["capture"] = NewFactory("capture", &memoizeSetArguments{}, func(_ FunctionContext, args Arguments) (ExprFunc[map[string]any], error) {
+		a := args.(*memoizeSetArguments)
+		return func(ctx context.Context, tCtx map[string]any) (any, error) {
+			val, err := a.Value.Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			// The map returned to each call can be modified without changing the others.
+			m := val.(pcommon.Map)
+			id := strconv.Itoa(len(maps))
+			m.PutStr("captured", id)
+			maps[id] = m
+			return nil, nil
+		}, nil
+	})
+	p, err := NewParser(
+		functions,
+		func(path Path[map[string]any]) (GetSetter[map[string]any], error) {
+			return &StandardGetSetter[map[string]any]{
+				Getter: func(_ context.Context, tCtx map[string]any) (any, error) {
+					return tCtx[path.Name()], nil
+				},
+				Setter: func(_ context.Context, tCtx map[string]any, val any) error {
+					tCtx[path.Name()] = val
+					return nil
+				},
+			}, nil
+		},
+		componenttest.NewNopTelemetrySettings(),
+	)
+	require.NoError(t, err)
+
+	statements, err := p.ParseStatements([]string{
+		`capture(body, ParseMap(body))`,
+		`capture(body, ParseMap(body))`,
+		`capture(body, ParseMap(body))`,
+	})
+	require.NoError(t, err)
+	sequence := NewStatementSequence(statements, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, sequence.Execute(t.Context(), map[string]any{"body": "x"}))
+
+	assert.Equal(t, 1, calls)
+	require.Len(t, maps, 3)
+	for id, m := range maps {
+		assert.Equal(t, map[string]any{"value": "x", "captured": id}, m.AsRaw())
+	}
+}
+
+func Test_memoizedFunction_notFolded(t *testing.T) {
+	calls := 0
+	functions := CreateFactoryMap(
+		NewFactory("ParseMap", &memoizeParseMapArguments{}, func(_ FunctionContext, _ Arguments) (ExprFunc[map[string]any], error) {
+			return func(context.Context, map[string]any) (any, error) {
+				calls++
+				return pcommon.NewMap(), nil
+			}, nil
+		}, WithMemoizedFunction[map[string]any]()),
+	)
+	p := newMemoizeTestParser(t, new(int))
+	p.functions["ParseMap"] = functions["ParseMap"]
+
+	statements, err := p.ParseStatements([]string{`set(a, ParseMap("{}"))`})
+	require.NoError(t, err)
+	assert.Zero(t, calls)
+
+	sequence := NewStatementSequence(statements, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, sequence.Execute(t.Context(), map[string]any{}))
+	require.NoError(t, sequence.Execute(t.Context(), map[string]any{}))
+	assert.Equal(t, 2, calls)
+}
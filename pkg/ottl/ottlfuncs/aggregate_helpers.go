@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"cmp"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// aggregatedNumbers are the numbers of a list, with the integers kept apart from
+// the doubles so that aggregating integers only does not lose any precision.
+type aggregatedNumbers struct {
+	ints    []int64
+	doubles []float64
+}
+
+func (n aggregatedNumbers) len() int {
+	return len(n.ints) + len(n.doubles)
+}
+
+// sliceNumbers returns the numbers of the slice, ignoring its empty values, so that
+// lists built from missing attributes can be aggregated. An error is returned if
+// the slice contains a value that is not a number.
+func sliceNumbers(funcName string, slice pcommon.Slice) (aggregatedNumbers, error) {
+	var numbers aggregatedNumbers
+	for i := 0; i < slice.Len(); i++ {
+		switch v := slice.At(i); v.Type() {
+		case pcommon.ValueTypeInt:
+			numbers.ints = append(numbers.ints, v.Int())
+		case pcommon.ValueTypeDouble:
+			numbers.doubles = append(numbers.doubles, v.Double())
+		case pcommon.ValueTypeEmpty:
+		default:
+			return aggregatedNumbers{}, fmt.Errorf("%s: the list must only contain numbers, but it contains a value of type %s", funcName, v.Type())
+		}
+	}
+	return numbers, nil
+}
+
+// sliceExtremum returns the smallest value of the slice if less is true, or the
+// largest one otherwise, ignoring its empty values. The values must either all
+// be numbers, which are compared as doubles, or all be strings.
+// Nil is returned if the slice has no value to compare.
+func sliceExtremum(funcName string, slice pcommon.Slice, less bool) (any, error) {
+	var extremum pcommon.Value
+	found := false
+	for i := 0; i < slice.Len(); i++ {
+		v := slice.At(i)
+		switch v.Type() {
+		case pcommon.ValueTypeEmpty:
+			continue
+		case pcommon.ValueTypeInt, pcommon.ValueTypeDouble, pcommon.ValueTypeStr:
+		default:
+			return nil, fmt.Errorf("%s: the list must only contain numbers or strings, but it contains a value of type %s", funcName, v.Type())
+		}
+		if !found {
+			extremum, found = v, true
+			continue
+		}
+		c, err := compareAggregatedValues(extremum, v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", funcName, err)
+		}
+		if (less && c > 0) || (!less && c < 0) {
+			extremum = v
+		}
+	}
+	if !found {
+		return nil, nil
+	}
+	return extremum.AsRaw(), nil
+}
+
+// compareAggregatedValues returns -1, 0 or 1 if a is respectively lower, equal or greater than b.
+func compareAggregatedValues(a, b pcommon.Value) (int, error) {
+	if a.Type() == pcommon.ValueTypeStr || b.Type() == pcommon.ValueTypeStr {
+		if a.Type() != b.Type() {
+			return 0, fmt.Errorf("cannot compare a value of type %s with a value of type %s", a.Type(), b.Type())
+		}
+		return cmp.Compare(a.Str(), b.Str()), nil
+	}
+	if a.Type() == pcommon.ValueTypeInt && b.Type() == pcommon.ValueTypeInt {
+		return cmp.Compare(a.Int(), b.Int()), nil
+	}
+	return cmp.Compare(valueAsDouble(a), valueAsDouble(b)), nil
+}
+
+func valueAsDouble(v pcommon.Value) float64 {
+	if v.Type() == pcommon.ValueTypeInt {
+		return float64(v.Int())
+	}
+	return v.Double()
+}
@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type AvgArguments[K any] struct {
+	Target ottl.PSliceGetter[K]
+}
+
+func NewAvgFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Avg", &AvgArguments[K]{}, createAvgFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createAvgFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*AvgArguments[K])
+
+	if !ok {
+		return nil, errors.New("AvgFactory args must be of type *AvgArguments[K]")
+	}
+
+	return avg(args.Target), nil
+}
+
+func avg[K any](target ottl.PSliceGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		slice, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		numbers, err := sliceNumbers("Avg", slice)
+		if err != nil {
+			return nil, err
+		}
+		if numbers.len() == 0 {
+			return nil, nil
+		}
+
+		var total float64
+		for _, i := range numbers.ints {
+			total += float64(i)
+		}
+		for _, d := range numbers.doubles {
+			total += d
+		}
+		return total / float64(numbers.len()), nil
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Avg(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []any
+		expected any
+	}{
+		{
+			name:     "integers",
+			values:   []any{int64(1), int64(2)},
+			expected: 1.5,
+		},
+		{
+			name:     "integers and doubles",
+			values:   []any{int64(1), 2.0, 6.0},
+			expected: 3.0,
+		},
+		{
+			name:     "nil values are ignored",
+			values:   []any{int64(4), nil},
+			expected: 4.0,
+		},
+		{
+			name:     "empty list",
+			values:   []any{},
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := avg(sliceGetter(tt.values...))(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Avg_error(t *testing.T) {
+	_, err := avg(sliceGetter(int64(1), true))(t.Context(), nil)
+	assert.EqualError(t, err, "Avg: the list must only contain numbers, but it contains a value of type Bool")
+}
@@ -18,7 +18,7 @@ type Base64EncodeArguments[K any] struct {
 }
 
 func NewBase64EncodeFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("Base64Encode", &Base64EncodeArguments[K]{}, createBase64EncodeFunction[K])
+	return ottl.NewFactory("Base64Encode", &Base64EncodeArguments[K]{}, createBase64EncodeFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createBase64EncodeFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type BitwiseArguments[K any] struct {
+	Left  ottl.Getter[K]
+	Right ottl.Getter[K]
+}
+
+// bitwiseOperator applies a bitwise operation to two integers, or two bytes.
+type bitwiseOperator[T int64 | byte] func(a, b T) T
+
+func NewBitwiseAndFactory[K any]() ottl.Factory[K] {
+	return newBitwiseFactory[K]("BitwiseAnd", func(a, b int64) int64 { return a & b }, func(a, b byte) byte { return a & b })
+}
+
+func NewBitwiseOrFactory[K any]() ottl.Factory[K] {
+	return newBitwiseFactory[K]("BitwiseOr", func(a, b int64) int64 { return a | b }, func(a, b byte) byte { return a | b })
+}
+
+func NewBitwiseXorFactory[K any]() ottl.Factory[K] {
+	return newBitwiseFactory[K]("BitwiseXor", func(a, b int64) int64 { return a ^ b }, func(a, b byte) byte { return a ^ b })
+}
+
+func newBitwiseFactory[K any](name string, intOp bitwiseOperator[int64], byteOp bitwiseOperator[byte]) ottl.Factory[K] {
+	return ottl.NewFactory(name, &BitwiseArguments[K]{}, func(_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+		args, ok := oArgs.(*BitwiseArguments[K])
+		if !ok {
+			return nil, fmt.Errorf("%sFactory args must be of type *BitwiseArguments[K]", name)
+		}
+		return bitwise(name, args.Left, args.Right, intOp, byteOp), nil
+	}, ottl.WithPureFunction[K]())
+}
+
+// bitwise applies the operation to two integers, or to each byte of two binary values of the same length.
+func bitwise[K any](name string, left, right ottl.Getter[K], intOp bitwiseOperator[int64], byteOp bitwiseOperator[byte]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		leftVal, err := left.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		rightVal, err := right.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if a, ok := leftVal.(int64); ok {
+			b, ok := rightVal.(int64)
+			if !ok {
+				return nil, fmt.Errorf("the %s function requires two integers or two binary values, got %T and %T", name, leftVal, rightVal)
+			}
+			return intOp(a, b), nil
+		}
+		a, err := binaryValue(leftVal)
+		if err != nil {
+			return nil, fmt.Errorf("the %s function requires two integers or two binary values: %w", name, err)
+		}
+		b, err := binaryValue(rightVal)
+		if err != nil {
+			return nil, fmt.Errorf("the %s function requires two integers or two binary values: %w", name, err)
+		}
+		if len(a) != len(b) {
+			return nil, fmt.Errorf("the %s function requires binary values of the same length, got %d and %d bytes", name, len(a), len(b))
+		}
+		result := make([]byte, len(a))
+		for i := range a {
+			result[i] = byteOp(a[i], b[i])
+		}
+		return result, nil
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Bitwise(t *testing.T) {
+	tests := []struct {
+		name     string
+		factory  ottl.Factory[any]
+		left     any
+		right    any
+		expected any
+	}{
+		{
+			name:     "and integers",
+			factory:  NewBitwiseAndFactory[any](),
+			left:     int64(0b1100),
+			right:    int64(0b1010),
+			expected: int64(0b1000),
+		},
+		{
+			name:     "or integers",
+			factory:  NewBitwiseOrFactory[any](),
+			left:     int64(0b1100),
+			right:    int64(0b1010),
+			expected: int64(0b1110),
+		},
+		{
+			name:     "xor integers",
+			factory:  NewBitwiseXorFactory[any](),
+			left:     int64(0b1100),
+			right:    int64(0b1010),
+			expected: int64(0b0110),
+		},
+		{
+			name:     "and negative integers",
+			factory:  NewBitwiseAndFactory[any](),
+			left:     int64(-1),
+			right:    int64(0xff),
+			expected: int64(0xff),
+		},
+		{
+			name:     "and byte slices",
+			factory:  NewBitwiseAndFactory[any](),
+			left:     []byte{0xff, 0x0f},
+			right:    []byte{0x0f, 0xff},
+			expected: []byte{0x0f, 0x0f},
+		},
+		{
+			name:     "or span IDs",
+			factory:  NewBitwiseOrFactory[any](),
+			left:     pcommon.SpanID{0xf0, 0, 0, 0, 0, 0, 0, 1},
+			right:    pcommon.SpanID{0x0f, 0, 0, 0, 0, 0, 0, 2},
+			expected: []byte{0xff, 0, 0, 0, 0, 0, 0, 3},
+		},
+		{
+			name:     "xor trace ID with bytes",
+			factory:  NewBitwiseXorFactory[any](),
+			left:     pcommon.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+			right:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+			expected: make([]byte, 16),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := tt.factory.CreateFunction(ottl.FunctionContext{}, &BitwiseArguments[any]{
+				Left:  binaryGetter(tt.left),
+				Right: binaryGetter(tt.right),
+			})
+			require.NoError(t, err)
+			result, err := exprFunc(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Bitwise_error(t *testing.T) {
+	tests := []struct {
+		name          string
+		left          any
+		right         any
+		expectedError string
+	}{
+		{
+			name:          "integer and bytes",
+			left:          int64(1),
+			right:         []byte{1},
+			expectedError: "the BitwiseAnd function requires two integers or two binary values, got int64 and []uint8",
+		},
+		{
+			name:          "bytes and integer",
+			left:          []byte{1},
+			right:         int64(1),
+			expectedError: "the BitwiseAnd function requires two integers or two binary values: expected a binary value but got int64",
+		},
+		{
+			name:          "strings",
+			left:          "a",
+			right:         "b",
+			expectedError: "the BitwiseAnd function requires two integers or two binary values: expected a binary value but got string",
+		},
+		{
+			name:          "different lengths",
+			left:          pcommon.TraceID{},
+			right:         pcommon.SpanID{},
+			expectedError: "the BitwiseAnd function requires binary values of the same length, got 16 and 8 bytes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := NewBitwiseAndFactory[any]().CreateFunction(ottl.FunctionContext{}, &BitwiseArguments[any]{
+				Left:  binaryGetter(tt.left),
+				Right: binaryGetter(tt.right),
+			})
+			require.NoError(t, err)
+			_, err = exprFunc(t.Context(), nil)
+			assert.EqualError(t, err, tt.expectedError)
+		})
+	}
+}
+
+func Test_Bitwise_invalidArgs(t *testing.T) {
+	_, err := NewBitwiseXorFactory[any]().CreateFunction(ottl.FunctionContext{}, nil)
+	assert.EqualError(t, err, "BitwiseXorFactory args must be of type *BitwiseArguments[K]")
+}
@@ -15,7 +15,7 @@ type BoolArguments[K any] struct {
 }
 
 func NewBoolFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("Bool", &BoolArguments[K]{}, createBoolFunction[K])
+	return ottl.NewFactory("Bool", &BoolArguments[K]{}, createBoolFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createBoolFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
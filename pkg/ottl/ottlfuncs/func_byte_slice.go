@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type ByteSliceArguments[K any] struct {
+	Target ottl.Getter[K]
+	Start  ottl.IntGetter[K]
+	End    ottl.IntGetter[K]
+}
+
+func NewByteSliceFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("ByteSlice", &ByteSliceArguments[K]{}, createByteSliceFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createByteSliceFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ByteSliceArguments[K])
+
+	if !ok {
+		return nil, errors.New("ByteSliceFactory args must be of type *ByteSliceArguments[K]")
+	}
+
+	return byteSlice(args.Target, args.Start, args.End), nil
+}
+
+func byteSlice[K any](target ottl.Getter[K], startGetter, endGetter ottl.IntGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		b, err := binaryValue(val)
+		if err != nil {
+			return nil, err
+		}
+		start, err := startGetter.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		end, err := endGetter.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if start < 0 || end < start || end > int64(len(b)) {
+			return nil, fmt.Errorf("invalid range [%d, %d) for the ByteSlice function on %d bytes", start, end, len(b))
+		}
+		return slices.Clone(b[start:end]), nil
+	}
+}
+
+// binaryValue returns the bytes of the binary values, which are the byte slices and the
+// trace, span and profile IDs.
+func binaryValue(val any) ([]byte, error) {
+	switch v := val.(type) {
+	case []byte:
+		return v, nil
+	case pcommon.ByteSlice:
+		return v.AsRaw(), nil
+	case pcommon.Value:
+		if v.Type() == pcommon.ValueTypeBytes {
+			return v.Bytes().AsRaw(), nil
+		}
+		return nil, ottl.TypeError(fmt.Sprintf("expected a binary value but got a value of type %v", v.Type()))
+	case pcommon.TraceID:
+		return v[:], nil
+	case pcommon.SpanID:
+		return v[:], nil
+	case pprofile.ProfileID:
+		return v[:], nil
+	default:
+		return nil, ottl.TypeError(fmt.Sprintf("expected a binary value but got %T", val))
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ByteSlice(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		start    int64
+		end      int64
+		expected []byte
+	}{
+		{
+			name:     "byte slice",
+			value:    []byte{1, 2, 3, 4},
+			start:    1,
+			end:      3,
+			expected: []byte{2, 3},
+		},
+		{
+			name:     "trace ID high bits",
+			value:    pcommon.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+			start:    0,
+			end:      8,
+			expected: []byte{1, 2, 3, 4, 5, 6, 7, 8},
+		},
+		{
+			name:     "whole span ID",
+			value:    pcommon.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+			start:    0,
+			end:      8,
+			expected: []byte{1, 2, 3, 4, 5, 6, 7, 8},
+		},
+		{
+			name:     "empty range",
+			value:    []byte{1, 2},
+			start:    2,
+			end:      2,
+			expected: []byte{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := byteSlice(binaryGetter(tt.value), intGetter(tt.start), intGetter(tt.end))
+			result, err := exprFunc(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_ByteSlice_copy(t *testing.T) {
+	value := []byte{1, 2, 3}
+	result, err := byteSlice(binaryGetter(value), intGetter(0), intGetter(2))(t.Context(), nil)
+	require.NoError(t, err)
+	result.([]byte)[0] = 9
+	assert.Equal(t, []byte{1, 2, 3}, value)
+}
+
+func Test_ByteSlice_error(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         any
+		start         int64
+		end           int64
+		expectedError string
+	}{
+		{
+			name:          "negative start",
+			value:         []byte{1, 2},
+			start:         -1,
+			end:           1,
+			expectedError: "invalid range [-1, 1) for the ByteSlice function on 2 bytes",
+		},
+		{
+			name:          "end before start",
+			value:         []byte{1, 2},
+			start:         2,
+			end:           1,
+			expectedError: "invalid range [2, 1) for the ByteSlice function on 2 bytes",
+		},
+		{
+			name:          "end out of range",
+			value:         pcommon.SpanID{},
+			start:         0,
+			end:           9,
+			expectedError: "invalid range [0, 9) for the ByteSlice function on 8 bytes",
+		},
+		{
+			name:          "not binary",
+			value:         "abc",
+			expectedError: "expected a binary value but got string",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := byteSlice(binaryGetter(tt.value), intGetter(tt.start), intGetter(tt.end))(t.Context(), nil)
+			assert.EqualError(t, err, tt.expectedError)
+		})
+	}
+}
+
+func intGetter(val int64) ottl.IntGetter[any] {
+	return &ottl.StandardIntGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return val, nil
+		},
+	}
+}
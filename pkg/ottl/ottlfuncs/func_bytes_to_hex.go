@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type BytesToHexArguments[K any] struct {
+	Target ottl.Getter[K]
+}
+
+func NewBytesToHexFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("BytesToHex", &BytesToHexArguments[K]{}, createBytesToHexFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createBytesToHexFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*BytesToHexArguments[K])
+
+	if !ok {
+		return nil, errors.New("BytesToHexFactory args must be of type *BytesToHexArguments[K]")
+	}
+
+	return bytesToHex(args.Target), nil
+}
+
+func bytesToHex[K any](target ottl.Getter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		b, err := binaryValue(val)
+		if err != nil {
+			return nil, err
+		}
+		return hex.EncodeToString(b), nil
+	}
+}
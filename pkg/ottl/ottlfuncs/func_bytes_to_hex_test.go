@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func binaryGetter(val any) ottl.Getter[any] {
+	return &ottl.StandardGetSetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return val, nil
+		},
+	}
+}
+
+func Test_BytesToHex(t *testing.T) {
+	bytesValue := pcommon.NewValueBytes()
+	bytesValue.Bytes().FromRaw([]byte{0xca, 0xfe})
+
+	tests := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{
+			name:     "byte slice",
+			value:    []byte{0x00, 0x0a, 0xff},
+			expected: "000aff",
+		},
+		{
+			name:     "bytes value",
+			value:    bytesValue,
+			expected: "cafe",
+		},
+		{
+			name:     "pcommon byte slice",
+			value:    bytesValue.Bytes(),
+			expected: "cafe",
+		},
+		{
+			name:     "trace ID",
+			value:    pcommon.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+			expected: "0102030405060708090a0b0c0d0e0f10",
+		},
+		{
+			name:     "span ID",
+			value:    pcommon.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+			expected: "0102030405060708",
+		},
+		{
+			name:     "profile ID",
+			value:    pprofile.ProfileID{16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1},
+			expected: "100f0e0d0c0b0a090807060504030201",
+		},
+		{
+			name:     "empty",
+			value:    []byte{},
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := bytesToHex(binaryGetter(tt.value))(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_BytesToHex_error(t *testing.T) {
+	_, err := bytesToHex(binaryGetter("cafe"))(t.Context(), nil)
+	assert.ErrorContains(t, err, "expected a binary value but got string")
+	_, err = bytesToHex(binaryGetter(pcommon.NewValueInt(1)))(t.Context(), nil)
+	assert.ErrorContains(t, err, "expected a binary value but got a value of type Int")
+	_, err = bytesToHex(binaryGetter(nil))(t.Context(), nil)
+	assert.ErrorContains(t, err, "expected a binary value but got <nil>")
+}
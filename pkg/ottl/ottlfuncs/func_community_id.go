@@ -35,7 +35,7 @@ type CommunityIDArguments[K any] struct {
 }
 
 func NewCommunityIDFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("CommunityID", &CommunityIDArguments[K]{}, createCommunityIDFunction[K])
+	return ottl.NewFactory("CommunityID", &CommunityIDArguments[K]{}, createCommunityIDFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createCommunityIDFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
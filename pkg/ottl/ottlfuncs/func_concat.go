@@ -18,7 +18,7 @@ type ConcatArguments[K any] struct {
 }
 
 func NewConcatFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("Concat", &ConcatArguments[K]{}, createConcatFunction[K])
+	return ottl.NewFactory("Concat", &ConcatArguments[K]{}, createConcatFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createConcatFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
@@ -16,7 +16,7 @@ type ContainsValueArguments[K any] struct {
 }
 
 func NewContainsValueFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("ContainsValue", &ContainsValueArguments[K]{}, createContainsValueFunction[K])
+	return ottl.NewFactory("ContainsValue", &ContainsValueArguments[K]{}, createContainsValueFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createContainsValueFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
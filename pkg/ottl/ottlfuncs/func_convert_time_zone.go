@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	// The time zone database is embedded as a fallback for the systems which do not have it,
+	// such as the collector container images, so time zones can be converted everywhere.
+	_ "time/tzdata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type ConvertTimeZoneArguments[K any] struct {
+	Time     ottl.TimeGetter[K]
+	Location string
+}
+
+func NewConvertTimeZoneFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("ConvertTimeZone", &ConvertTimeZoneArguments[K]{}, createConvertTimeZoneFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createConvertTimeZoneFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ConvertTimeZoneArguments[K])
+
+	if !ok {
+		return nil, errors.New("ConvertTimeZoneFactory args must be of type *ConvertTimeZoneArguments[K]")
+	}
+
+	return convertTimeZone(args.Time, args.Location)
+}
+
+func convertTimeZone[K any](timeValue ottl.TimeGetter[K], location string) (ottl.ExprFunc[K], error) {
+	if location == "" {
+		return nil, errors.New("location cannot be empty")
+	}
+	loc, err := time.LoadLocation(location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load location %s: %w", location, err)
+	}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		t, err := timeValue.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		return t.In(loc), nil
+	}, nil
+}
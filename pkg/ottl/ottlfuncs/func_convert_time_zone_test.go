@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ConvertTimeZone(t *testing.T) {
+	utcTime := time.Date(2024, 7, 1, 12, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name           string
+		location       string
+		expectedOffset int
+		expectedHour   int
+	}{
+		{
+			name:           "summer time",
+			location:       "Europe/Lisbon",
+			expectedOffset: 3600,
+			expectedHour:   13,
+		},
+		{
+			name:           "negative offset",
+			location:       "America/New_York",
+			expectedOffset: -4 * 3600,
+			expectedHour:   8,
+		},
+		{
+			name:           "UTC",
+			location:       "UTC",
+			expectedOffset: 0,
+			expectedHour:   12,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeGetter := &ottl.StandardTimeGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return utcTime, nil
+				},
+			}
+			exprFunc, err := convertTimeZone[any](timeGetter, tt.location)
+			require.NoError(t, err)
+			result, err := exprFunc(t.Context(), nil)
+			require.NoError(t, err)
+			converted, ok := result.(time.Time)
+			require.True(t, ok)
+			assert.True(t, utcTime.Equal(converted))
+			assert.Equal(t, tt.location, converted.Location().String())
+			_, offset := converted.Zone()
+			assert.Equal(t, tt.expectedOffset, offset)
+			assert.Equal(t, tt.expectedHour, converted.Hour())
+		})
+	}
+}
+
+func Test_ConvertTimeZone_error(t *testing.T) {
+	_, err := convertTimeZone[any](&ottl.StandardTimeGetter[any]{}, "")
+	assert.EqualError(t, err, "location cannot be empty")
+
+	_, err = convertTimeZone[any](&ottl.StandardTimeGetter[any]{}, "Mars/Olympus_Mons")
+	assert.ErrorContains(t, err, "failed to load location Mars/Olympus_Mons")
+
+	timeGetter := &ottl.StandardTimeGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return "not a time", nil
+		},
+	}
+	exprFunc, err := convertTimeZone[any](timeGetter, "Europe/Lisbon")
+	require.NoError(t, err)
+	_, err = exprFunc(t.Context(), nil)
+	assert.ErrorContains(t, err, "expected time but got string")
+}
@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type CountArguments[K any] struct {
+	Target ottl.PSliceGetter[K]
+}
+
+func NewCountFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Count", &CountArguments[K]{}, createCountFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createCountFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*CountArguments[K])
+
+	if !ok {
+		return nil, errors.New("CountFactory args must be of type *CountArguments[K]")
+	}
+
+	return count(args.Target), nil
+}
+
+// count returns the number of values of the list which are not nil, unlike Len which
+// counts all its values.
+func count[K any](target ottl.PSliceGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		slice, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		var n int64
+		for i := 0; i < slice.Len(); i++ {
+			if slice.At(i).Type() != pcommon.ValueTypeEmpty {
+				n++
+			}
+		}
+		return n, nil
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Count(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []any
+		expected int64
+	}{
+		{
+			name:     "values",
+			values:   []any{"a", int64(1), map[string]any{}},
+			expected: 3,
+		},
+		{
+			name:     "nil values are not counted",
+			values:   []any{"a", nil, nil},
+			expected: 1,
+		},
+		{
+			name:     "empty list",
+			values:   []any{},
+			expected: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := count(sliceGetter(tt.values...))(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -15,7 +15,7 @@ type DayArguments[K any] struct {
 }
 
 func NewDayFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("Day", &DayArguments[K]{}, createDayFunction[K])
+	return ottl.NewFactory("Day", &DayArguments[K]{}, createDayFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createDayFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type DecryptArguments[K any] struct {
+	Target ottl.StringGetter[K]
+	KeyRef string
+}
+
+// NewDecryptFactory returns the factory of the Decrypt Converter, using the keys of the
+// key provider of the store. Like HMAC_SHA256, it is not part of the standard functions.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func NewDecryptFactory[K any](keys *KeyStore) ottl.Factory[K] {
+	return ottl.NewFactory("Decrypt", &DecryptArguments[K]{}, func(_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+		return createDecryptFunction[K](keys, oArgs)
+	})
+}
+
+func createDecryptFunction[K any](keys *KeyStore, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*DecryptArguments[K])
+
+	if !ok {
+		return nil, errors.New("DecryptFactory args must be of type *DecryptArguments[K]")
+	}
+
+	return decrypt(keys, args.Target, args.KeyRef)
+}
+
+func decrypt[K any](keys *KeyStore, target ottl.StringGetter[K], keyRef string) (ottl.ExprFunc[K], error) {
+	if keys == nil {
+		return nil, errors.New("the Decrypt function requires a key store")
+	}
+	if keyRef == "" {
+		return nil, errors.New("the key reference cannot be empty")
+	}
+
+	var ciphers aesGCMCache
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		data, err := base64.StdEncoding.DecodeString(val)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the encrypted value: %w", err)
+		}
+		key, err := keys.key(ctx, keyRef)
+		if err != nil {
+			return nil, err
+		}
+		k, err := ciphers.get(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid encryption key %q: %w", keyRef, err)
+		}
+		nonceSize := k.aead.NonceSize()
+		if len(data) < nonceSize {
+			return nil, errors.New("failed to decrypt the value: ciphertext too short")
+		}
+		plaintext, err := k.aead.Open(nil, data[:nonceSize], data[nonceSize:], nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt the value: %w", err)
+		}
+		return string(plaintext), nil
+	}, nil
+}
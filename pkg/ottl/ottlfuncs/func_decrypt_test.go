@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Decrypt_error(t *testing.T) {
+	keys := newTestKeyStore()
+
+	encryptFunc, err := encrypt(keys, stringGetter("user@example.com"), "aes256", false)
+	require.NoError(t, err)
+	encrypted, err := encryptFunc(t.Context(), nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		value         any
+		keyRef        string
+		expectedError string
+	}{
+		{
+			name:          "wrong key",
+			value:         encrypted,
+			keyRef:        "aes128",
+			expectedError: "failed to decrypt the value: cipher: message authentication failed",
+		},
+		{
+			name:          "not base64",
+			value:         "not base64!",
+			keyRef:        "aes256",
+			expectedError: "failed to decode the encrypted value",
+		},
+		{
+			name:          "too short",
+			value:         base64.StdEncoding.EncodeToString([]byte("short")),
+			keyRef:        "aes256",
+			expectedError: "failed to decrypt the value: ciphertext too short",
+		},
+		{
+			name:          "invalid key size",
+			value:         encrypted,
+			keyRef:        "short",
+			expectedError: `invalid encryption key "short": crypto/aes: invalid key size 5`,
+		},
+		{
+			name:          "key not found",
+			value:         encrypted,
+			keyRef:        "unknown",
+			expectedError: `key "unknown" not found`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := decrypt(keys, stringGetter(tt.value), tt.keyRef)
+			require.NoError(t, err)
+			_, err = exprFunc(t.Context(), nil)
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
+
+func Test_Decrypt_invalidArguments(t *testing.T) {
+	_, err := decrypt(nil, stringGetter("value"), "aes256")
+	assert.EqualError(t, err, "the Decrypt function requires a key store")
+
+	_, err = decrypt(newTestKeyStore(), stringGetter("value"), "")
+	assert.EqualError(t, err, "the key reference cannot be empty")
+}
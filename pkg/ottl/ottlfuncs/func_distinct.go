@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type DistinctArguments[K any] struct {
+	Target ottl.PSliceGetter[K]
+}
+
+func NewDistinctFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Distinct", &DistinctArguments[K]{}, createDistinctFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createDistinctFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*DistinctArguments[K])
+
+	if !ok {
+		return nil, errors.New("DistinctFactory args must be of type *DistinctArguments[K]")
+	}
+
+	return distinct(args.Target), nil
+}
+
+// distinct returns the values of the list without their duplicates, in the order
+// of their first occurrence.
+func distinct[K any](target ottl.PSliceGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		slice, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		result := pcommon.NewSlice()
+		for i := 0; i < slice.Len(); i++ {
+			v := slice.At(i)
+			if !containsEqualValue(result, v) {
+				v.CopyTo(result.AppendEmpty())
+			}
+		}
+		return result, nil
+	}
+}
+
+func containsEqualValue(slice pcommon.Slice, v pcommon.Value) bool {
+	for i := 0; i < slice.Len(); i++ {
+		if slice.At(i).Equal(v) {
+			return true
+		}
+	}
+	return false
+}
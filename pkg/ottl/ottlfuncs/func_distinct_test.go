@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_Distinct(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []any
+		expected []any
+	}{
+		{
+			name:     "strings",
+			values:   []any{"b", "a", "b", "c", "a"},
+			expected: []any{"b", "a", "c"},
+		},
+		{
+			name:     "mixed types",
+			values:   []any{int64(1), "1", int64(1), 1.0, nil, nil},
+			expected: []any{int64(1), "1", 1.0, nil},
+		},
+		{
+			name:     "maps",
+			values:   []any{map[string]any{"k": "v"}, map[string]any{"k": "v"}, map[string]any{"k": "w"}},
+			expected: []any{map[string]any{"k": "v"}, map[string]any{"k": "w"}},
+		},
+		{
+			name:     "empty list",
+			values:   []any{},
+			expected: []any{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := distinct(sliceGetter(tt.values...))(t.Context(), nil)
+			require.NoError(t, err)
+			require.IsType(t, pcommon.Slice{}, result)
+			assert.Equal(t, tt.expected, result.(pcommon.Slice).AsRaw())
+		})
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// encryptNonceKeyInfo separates the subkey deriving the nonces of the deterministic
+// encryption from the encryption key.
+const encryptNonceKeyInfo = "ottl Encrypt deterministic nonce"
+
+type EncryptArguments[K any] struct {
+	Target        ottl.StringGetter[K]
+	KeyRef        string
+	Deterministic ottl.Optional[bool]
+}
+
+// NewEncryptFactory returns the factory of the Encrypt Converter, using the keys of the
+// key provider of the store. Like HMAC_SHA256, it is not part of the standard functions.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func NewEncryptFactory[K any](keys *KeyStore) ottl.Factory[K] {
+	return ottl.NewFactory("Encrypt", &EncryptArguments[K]{}, func(_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+		return createEncryptFunction[K](keys, oArgs)
+	})
+}
+
+func createEncryptFunction[K any](keys *KeyStore, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*EncryptArguments[K])
+
+	if !ok {
+		return nil, errors.New("EncryptFactory args must be of type *EncryptArguments[K]")
+	}
+
+	return encrypt(keys, args.Target, args.KeyRef, !args.Deterministic.IsEmpty() && args.Deterministic.Get())
+}
+
+// aesGCMKey is the AES-GCM cipher of a key, with the subkey deriving the nonces of the
+// deterministic encryption.
+type aesGCMKey struct {
+	key      []byte
+	aead     cipher.AEAD
+	nonceKey []byte
+}
+
+// aesGCMCache keeps the cipher of the last key used by a function, so that it is only
+// built again when the key provider rotates the key.
+type aesGCMCache struct {
+	last atomic.Pointer[aesGCMKey]
+}
+
+// get returns the cipher of the key, which must be 16, 24 or 32 bytes long.
+func (c *aesGCMCache) get(key []byte) (*aesGCMKey, error) {
+	if last := c.last.Load(); last != nil && subtle.ConstantTimeCompare(last.key, key) == 1 {
+		return last, nil
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonceKey, err := hkdf.Key(sha256.New, key, nil, encryptNonceKeyInfo, sha256.Size)
+	if err != nil {
+		return nil, err
+	}
+	k := &aesGCMKey{key: bytes.Clone(key), aead: aead, nonceKey: nonceKey}
+	c.last.Store(k)
+	return k, nil
+}
+
+func encrypt[K any](keys *KeyStore, target ottl.StringGetter[K], keyRef string, deterministic bool) (ottl.ExprFunc[K], error) {
+	if keys == nil {
+		return nil, errors.New("the Encrypt function requires a key store")
+	}
+	if keyRef == "" {
+		return nil, errors.New("the key reference cannot be empty")
+	}
+
+	var ciphers aesGCMCache
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		key, err := keys.key(ctx, keyRef)
+		if err != nil {
+			return nil, err
+		}
+		k, err := ciphers.get(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid encryption key %q: %w", keyRef, err)
+		}
+
+		nonce := make([]byte, k.aead.NonceSize())
+		if deterministic {
+			// The nonce is derived from the value, so the same value always has the same
+			// ciphertext and a nonce is only reused to encrypt the same value again.
+			mac := hmac.New(sha256.New, k.nonceKey)
+			_, _ = mac.Write([]byte(val))
+			copy(nonce, mac.Sum(nil))
+		} else if _, err = rand.Read(nonce); err != nil {
+			return nil, err
+		}
+		// The nonce is prepended to the ciphertext so it can be decrypted.
+		return base64.StdEncoding.EncodeToString(k.aead.Seal(nonce, nonce, []byte(val), nil)), nil
+	}, nil
+}
@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func stringGetter(val any) ottl.StringGetter[any] {
+	return &ottl.StandardStringGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return val, nil
+		},
+	}
+}
+
+func Test_Encrypt(t *testing.T) {
+	keys := newTestKeyStore()
+
+	tests := []struct {
+		name          string
+		keyRef        string
+		deterministic bool
+	}{
+		{
+			name:   "AES-256",
+			keyRef: "aes256",
+		},
+		{
+			name:   "AES-128",
+			keyRef: "aes128",
+		},
+		{
+			name:          "deterministic",
+			keyRef:        "aes256",
+			deterministic: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encryptFunc, err := encrypt(keys, stringGetter("user@example.com"), tt.keyRef, tt.deterministic)
+			require.NoError(t, err)
+
+			first, err := encryptFunc(t.Context(), nil)
+			require.NoError(t, err)
+			second, err := encryptFunc(t.Context(), nil)
+			require.NoError(t, err)
+			if tt.deterministic {
+				assert.Equal(t, first, second)
+			} else {
+				assert.NotEqual(t, first, second)
+			}
+
+			decryptFunc, err := decrypt(keys, stringGetter(first), tt.keyRef)
+			require.NoError(t, err)
+			result, err := decryptFunc(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, "user@example.com", result)
+		})
+	}
+}
+
+func Test_Encrypt_deterministicDifferentValues(t *testing.T) {
+	keys := newTestKeyStore()
+
+	first, err := encrypt(keys, stringGetter("alice"), "aes256", true)
+	require.NoError(t, err)
+	second, err := encrypt(keys, stringGetter("bob"), "aes256", true)
+	require.NoError(t, err)
+
+	firstResult, err := first(t.Context(), nil)
+	require.NoError(t, err)
+	secondResult, err := second(t.Context(), nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, firstResult, secondResult)
+
+	// The nonces must also differ, so that two values are never encrypted with the same nonce.
+	firstData, err := base64.StdEncoding.DecodeString(firstResult.(string))
+	require.NoError(t, err)
+	secondData, err := base64.StdEncoding.DecodeString(secondResult.(string))
+	require.NoError(t, err)
+	assert.NotEqual(t, firstData[:12], secondData[:12])
+}
+
+func Test_Encrypt_error(t *testing.T) {
+	keys := newTestKeyStore()
+
+	tests := []struct {
+		name          string
+		value         any
+		keyRef        string
+		expectedError string
+	}{
+		{
+			name:          "invalid key size",
+			value:         "user@example.com",
+			keyRef:        "short",
+			expectedError: `invalid encryption key "short": crypto/aes: invalid key size 5`,
+		},
+		{
+			name:          "key not found",
+			value:         "user@example.com",
+			keyRef:        "unknown",
+			expectedError: `key "unknown" not found`,
+		},
+		{
+			name:          "non-string",
+			value:         10,
+			keyRef:        "aes256",
+			expectedError: "expected string but got int",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := encrypt(keys, stringGetter(tt.value), tt.keyRef, false)
+			require.NoError(t, err)
+			_, err = exprFunc(t.Context(), nil)
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
+
+func Test_Encrypt_deterministicNonceKey(t *testing.T) {
+	keys := newTestKeyStore()
+
+	exprFunc, err := encrypt(keys, stringGetter("user@example.com"), "aes256", true)
+	require.NoError(t, err)
+	result, err := exprFunc(t.Context(), nil)
+	require.NoError(t, err)
+	data, err := base64.StdEncoding.DecodeString(result.(string))
+	require.NoError(t, err)
+
+	// The nonce is not derived with the encryption key itself.
+	mac := hmac.New(sha256.New, []byte("0123456789abcdef0123456789abcdef"))
+	_, _ = mac.Write([]byte("user@example.com"))
+	assert.NotEqual(t, mac.Sum(nil)[:12], data[:12])
+}
+
+func Test_Encrypt_keyRotation(t *testing.T) {
+	provider := testKeyProvider{"pii": []byte("0123456789abcdef")}
+	keys := NewKeyStore()
+	keys.SetKeyProvider(provider)
+
+	encryptFunc, err := encrypt(keys, stringGetter("user@example.com"), "pii", false)
+	require.NoError(t, err)
+	first, err := encryptFunc(t.Context(), nil)
+	require.NoError(t, err)
+
+	provider["pii"] = []byte("fedcba9876543210")
+	second, err := encryptFunc(t.Context(), nil)
+	require.NoError(t, err)
+
+	decryptFunc, err := decrypt(keys, stringGetter(second), "pii")
+	require.NoError(t, err)
+	result, err := decryptFunc(t.Context(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "user@example.com", result)
+
+	decryptFunc, err = decrypt(keys, stringGetter(first), "pii")
+	require.NoError(t, err)
+	_, err = decryptFunc(t.Context(), nil)
+	assert.ErrorContains(t, err, "message authentication failed")
+}
+
+func Test_Encrypt_invalidArguments(t *testing.T) {
+	_, err := encrypt(nil, stringGetter("value"), "aes256", false)
+	assert.EqualError(t, err, "the Encrypt function requires a key store")
+
+	_, err = encrypt(newTestKeyStore(), stringGetter("value"), "", false)
+	assert.EqualError(t, err, "the key reference cannot be empty")
+}
@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/metadata"
+)
+
+type EnvArguments[K any] struct {
+	Name string
+}
+
+func NewEnvFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Env", &EnvArguments[K]{}, createEnvFunction[K])
+}
+
+func createEnvFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*EnvArguments[K])
+
+	if !ok {
+		return nil, errors.New("EnvFactory args must be of type *EnvArguments[K]")
+	}
+
+	return env[K](args.Name)
+}
+
+func env[K any](name string) (ottl.ExprFunc[K], error) {
+	if !metadata.OttlFunctionsEnableEnvFeatureGate.IsEnabled() {
+		return nil, fmt.Errorf("the Env function requires the `%s` feature gate to be enabled", metadata.OttlFunctionsEnableEnvFeatureGate.ID())
+	}
+	if name == "" {
+		return nil, errors.New("the environment variable name cannot be empty")
+	}
+
+	// The environment of the collector does not change once started, so the
+	// variable is read once when the statement is parsed.
+	value, found := os.LookupEnv(name)
+	return func(context.Context, K) (any, error) {
+		if !found {
+			return nil, nil
+		}
+		return value, nil
+	}, nil
+}
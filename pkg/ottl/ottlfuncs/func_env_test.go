@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
+)
+
+func Test_Env(t *testing.T) {
+	t.Cleanup(ottltest.SetFeatureGateForTest(t, metadata.OttlFunctionsEnableEnvFeatureGate, true))
+	t.Setenv("OTTL_TEST_ENV", "eu-west-1")
+	t.Setenv("OTTL_TEST_EMPTY_ENV", "")
+
+	tests := []struct {
+		name     string
+		variable string
+		expected any
+	}{
+		{
+			name:     "set",
+			variable: "OTTL_TEST_ENV",
+			expected: "eu-west-1",
+		},
+		{
+			name:     "set to empty",
+			variable: "OTTL_TEST_EMPTY_ENV",
+			expected: "",
+		},
+		{
+			name:     "not set",
+			variable: "OTTL_TEST_UNSET_ENV",
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := env[any](tt.variable)
+			require.NoError(t, err)
+			result, err := exprFunc(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Env_error(t *testing.T) {
+	t.Run("feature gate disabled", func(t *testing.T) {
+		t.Cleanup(ottltest.SetFeatureGateForTest(t, metadata.OttlFunctionsEnableEnvFeatureGate, false))
+		_, err := env[any]("HOME")
+		assert.EqualError(t, err, "the Env function requires the `ottl.functions.enableEnv` feature gate to be enabled")
+	})
+	t.Run("empty name", func(t *testing.T) {
+		t.Cleanup(ottltest.SetFeatureGateForTest(t, metadata.OttlFunctionsEnableEnvFeatureGate, true))
+		_, err := env[any]("")
+		assert.EqualError(t, err, "the environment variable name cannot be empty")
+	})
+}
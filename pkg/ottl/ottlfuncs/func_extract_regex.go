@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type ExtractRegexArguments[K any] struct {
+	Target  ottl.StringGetter[K]
+	Pattern string
+	All     ottl.Optional[bool]
+}
+
+func NewExtractRegexFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("ExtractRegex", &ExtractRegexArguments[K]{}, createExtractRegexFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createExtractRegexFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ExtractRegexArguments[K])
+
+	if !ok {
+		return nil, errors.New("ExtractRegexFactory args must be of type *ExtractRegexArguments[K]")
+	}
+
+	return extractRegex(args.Target, args.Pattern, !args.All.IsEmpty() && args.All.Get())
+}
+
+func extractRegex[K any](target ottl.StringGetter[K], pattern string, all bool) (ottl.ExprFunc[K], error) {
+	// The pattern is a literal, so it is only compiled once when the statement is parsed.
+	compiledPattern, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("the pattern supplied to ExtractRegex is not a valid regexp pattern: %w", err)
+	}
+	names := compiledPattern.SubexpNames()
+	if !slices.ContainsFunc(names, func(name string) bool { return name != "" }) {
+		return nil, errors.New("at least 1 named capture group must be supplied in the pattern of ExtractRegex")
+	}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		if !all {
+			result := pcommon.NewMap()
+			if match := compiledPattern.FindStringSubmatchIndex(val); match != nil {
+				putNamedCaptures(result, val, names, match)
+			}
+			return result, nil
+		}
+
+		result := pcommon.NewSlice()
+		for _, match := range compiledPattern.FindAllStringSubmatchIndex(val, -1) {
+			putNamedCaptures(result.AppendEmpty().SetEmptyMap(), val, names, match)
+		}
+		return result, nil
+	}, nil
+}
+
+// putNamedCaptures puts the values of the named capture groups of the match in the map.
+// Groups which did not participate in the match are omitted.
+func putNamedCaptures(m pcommon.Map, val string, names []string, match []int) {
+	for i, name := range names {
+		if name == "" || match[2*i] < 0 {
+			continue
+		}
+		m.PutStr(name, val[match[2*i]:match[2*i+1]])
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ExtractRegex(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		pattern  string
+		all      bool
+		expected any
+	}{
+		{
+			name:    "named captures",
+			target:  "GET /checkout 200 12ms",
+			pattern: `^(?P<method>\w+) (?P<path>\S+) (?P<status>\d+)`,
+			expected: map[string]any{
+				"method": "GET",
+				"path":   "/checkout",
+				"status": "200",
+			},
+		},
+		{
+			name:    "unnamed groups are ignored",
+			target:  "user=alice id=42",
+			pattern: `user=(?P<user>\w+) (id)=(\d+)`,
+			expected: map[string]any{
+				"user": "alice",
+			},
+		},
+		{
+			name:    "optional group not matched is omitted",
+			target:  "sshd: session opened",
+			pattern: `^(?P<process>\w+)(\[(?P<pid>\d+)\])?: (?P<message>.*)$`,
+			expected: map[string]any{
+				"process": "sshd",
+				"message": "session opened",
+			},
+		},
+		{
+			name:    "optional group matching an empty string is kept",
+			target:  "key=",
+			pattern: `^(?P<key>\w+)=(?P<value>\w*)$`,
+			expected: map[string]any{
+				"key":   "key",
+				"value": "",
+			},
+		},
+		{
+			name:     "no match",
+			target:   "no match here",
+			pattern:  `^(?P<number>\d+)$`,
+			expected: map[string]any{},
+		},
+		{
+			name:    "all matches",
+			target:  "a=1 b=2 c=3",
+			pattern: `(?P<key>\w)=(?P<value>\d)`,
+			all:     true,
+			expected: []any{
+				map[string]any{"key": "a", "value": "1"},
+				map[string]any{"key": "b", "value": "2"},
+				map[string]any{"key": "c", "value": "3"},
+			},
+		},
+		{
+			name:     "all without match",
+			target:   "nothing",
+			pattern:  `(?P<key>\w)=(?P<value>\d)`,
+			all:      true,
+			expected: []any{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := extractRegex[any](&ottl.StandardStringGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.target, nil
+				},
+			}, tt.pattern, tt.all)
+			require.NoError(t, err)
+
+			result, err := exprFunc(t.Context(), nil)
+			require.NoError(t, err)
+			switch r := result.(type) {
+			case pcommon.Map:
+				assert.Equal(t, tt.expected, r.AsRaw())
+			case pcommon.Slice:
+				assert.Equal(t, tt.expected, r.AsRaw())
+			default:
+				t.Fatalf("unexpected result type %T", result)
+			}
+		})
+	}
+}
+
+func Test_ExtractRegex_validation(t *testing.T) {
+	_, err := extractRegex[any](&ottl.StandardStringGetter[any]{}, `(`, false)
+	assert.ErrorContains(t, err, "the pattern supplied to ExtractRegex is not a valid regexp pattern")
+
+	_, err = extractRegex[any](&ottl.StandardStringGetter[any]{}, `(\d+)`, false)
+	assert.EqualError(t, err, "at least 1 named capture group must be supplied in the pattern of ExtractRegex")
+}
+
+func Test_ExtractRegex_bad_input(t *testing.T) {
+	exprFunc, err := extractRegex[any](&ottl.StandardStringGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return 1, nil
+		},
+	}, `(?P<number>\d+)`, false)
+	require.NoError(t, err)
+
+	_, err = exprFunc(t.Context(), nil)
+	assert.ErrorContains(t, err, "expected string but got int")
+}
@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/metadata"
+)
+
+// maxFileContentsSize is the maximum size of the files read by FileContents,
+// so that a misconfigured path cannot make the collector load a large file in memory.
+const maxFileContentsSize = 1 << 20
+
+type FileContentsArguments[K any] struct {
+	Path string
+	TTL  ottl.Optional[ottl.DurationGetter[K]]
+}
+
+func NewFileContentsFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("FileContents", &FileContentsArguments[K]{}, createFileContentsFunction[K])
+}
+
+func createFileContentsFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*FileContentsArguments[K])
+
+	if !ok {
+		return nil, errors.New("FileContentsFactory args must be of type *FileContentsArguments[K]")
+	}
+
+	var ttl time.Duration
+	if !args.TTL.IsEmpty() {
+		literalTTL, isLiteral := ottl.GetLiteralValue(args.TTL.Get())
+		if !isLiteral {
+			return nil, errors.New("the ttl of the FileContents function must be a literal duration")
+		}
+		if literalTTL <= 0 {
+			return nil, fmt.Errorf("the ttl of the FileContents function must be positive, got %s", literalTTL)
+		}
+		ttl = literalTTL
+	}
+
+	return fileContents[K](args.Path, ttl)
+}
+
+func fileContents[K any](path string, ttl time.Duration) (ottl.ExprFunc[K], error) {
+	if !metadata.OttlFunctionsEnableFileContentsFeatureGate.IsEnabled() {
+		return nil, fmt.Errorf("the FileContents function requires the `%s` feature gate to be enabled", metadata.OttlFunctionsEnableFileContentsFeatureGate.ID())
+	}
+	if path == "" {
+		return nil, errors.New("the file path cannot be empty")
+	}
+
+	cache := &fileContentsCache{path: path, ttl: ttl, now: time.Now}
+	return func(context.Context, K) (any, error) {
+		return cache.get()
+	}, nil
+}
+
+// fileContentsCache keeps the contents of a file so that it is not read for every
+// telemetry item. Without ttl, the file is only read once.
+type fileContentsCache struct {
+	path string
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu       sync.Mutex
+	loaded   bool
+	contents string
+	expiry   time.Time
+}
+
+func (c *fileContentsCache) get() (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.loaded && (c.ttl == 0 || c.now().Before(c.expiry)) {
+		return c.contents, nil
+	}
+
+	contents, err := readFileContents(c.path)
+	if err != nil {
+		return "", err
+	}
+	c.contents = contents
+	c.loaded = true
+	c.expiry = c.now().Add(c.ttl)
+	return contents, nil
+}
+
+func readFileContents(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the file: %w", err)
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.LimitReader(f, maxFileContentsSize+1))
+	if err != nil {
+		return "", fmt.Errorf("failed to read the file: %w", err)
+	}
+	if len(data) > maxFileContentsSize {
+		return "", fmt.Errorf("the file %q exceeds the maximum size of %d bytes", path, maxFileContentsSize)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
+)
+
+func Test_FileContents(t *testing.T) {
+	t.Cleanup(ottltest.SetFeatureGateForTest(t, metadata.OttlFunctionsEnableFileContentsFeatureGate, true))
+	path := filepath.Join(t.TempDir(), "region")
+	require.NoError(t, os.WriteFile(path, []byte("eu-west-1"), 0o600))
+
+	exprFunc, err := fileContents[any](path, 0)
+	require.NoError(t, err)
+	result, err := exprFunc(t.Context(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "eu-west-1", result)
+
+	// Without ttl, the contents are never read again.
+	require.NoError(t, os.WriteFile(path, []byte("us-east-1"), 0o600))
+	result, err = exprFunc(t.Context(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "eu-west-1", result)
+}
+
+func Test_FileContents_ttl(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "region")
+	require.NoError(t, os.WriteFile(path, []byte("eu-west-1"), 0o600))
+
+	now := time.Date(2024, 3, 5, 14, 0, 0, 0, time.UTC)
+	cache := &fileContentsCache{path: path, ttl: time.Minute, now: func() time.Time { return now }}
+
+	result, err := cache.get()
+	require.NoError(t, err)
+	assert.Equal(t, "eu-west-1", result)
+
+	require.NoError(t, os.WriteFile(path, []byte("us-east-1"), 0o600))
+	now = now.Add(30 * time.Second)
+	result, err = cache.get()
+	require.NoError(t, err)
+	assert.Equal(t, "eu-west-1", result)
+
+	now = now.Add(time.Minute)
+	result, err = cache.get()
+	require.NoError(t, err)
+	assert.Equal(t, "us-east-1", result)
+}
+
+func Test_FileContents_error(t *testing.T) {
+	dir := t.TempDir()
+	largePath := filepath.Join(dir, "large")
+	require.NoError(t, os.WriteFile(largePath, []byte(strings.Repeat("a", maxFileContentsSize+1)), 0o600))
+
+	t.Run("feature gate disabled", func(t *testing.T) {
+		t.Cleanup(ottltest.SetFeatureGateForTest(t, metadata.OttlFunctionsEnableFileContentsFeatureGate, false))
+		_, err := fileContents[any](largePath, 0)
+		assert.EqualError(t, err, "the FileContents function requires the `ottl.functions.enableFileContents` feature gate to be enabled")
+	})
+
+	t.Run("empty path", func(t *testing.T) {
+		t.Cleanup(ottltest.SetFeatureGateForTest(t, metadata.OttlFunctionsEnableFileContentsFeatureGate, true))
+		_, err := fileContents[any]("", 0)
+		assert.EqualError(t, err, "the file path cannot be empty")
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Cleanup(ottltest.SetFeatureGateForTest(t, metadata.OttlFunctionsEnableFileContentsFeatureGate, true))
+		exprFunc, err := fileContents[any](filepath.Join(dir, "missing"), 0)
+		require.NoError(t, err)
+		_, err = exprFunc(t.Context(), nil)
+		assert.ErrorContains(t, err, "failed to read the file")
+	})
+
+	t.Run("file too large", func(t *testing.T) {
+		t.Cleanup(ottltest.SetFeatureGateForTest(t, metadata.OttlFunctionsEnableFileContentsFeatureGate, true))
+		exprFunc, err := fileContents[any](largePath, 0)
+		require.NoError(t, err)
+		_, err = exprFunc(t.Context(), nil)
+		assert.ErrorContains(t, err, "exceeds the maximum size of 1048576 bytes")
+	})
+}
+
+func Test_createFileContentsFunction_ttl(t *testing.T) {
+	t.Cleanup(ottltest.SetFeatureGateForTest(t, metadata.OttlFunctionsEnableFileContentsFeatureGate, true))
+	nonLiteral, err := ottl.NewTestingLiteralGetter[any, time.Duration](false, ottl.StandardDurationGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return time.Minute, nil
+		},
+	})
+	require.NoError(t, err)
+	zero, err := ottl.NewTestingLiteralGetter[any, time.Duration](true, ottl.StandardDurationGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return time.Duration(0), nil
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = createFileContentsFunction[any](ottl.FunctionContext{}, &FileContentsArguments[any]{
+		Path: "/etc/hostname",
+		TTL:  ottl.NewTestingOptional[ottl.DurationGetter[any]](nonLiteral),
+	})
+	assert.EqualError(t, err, "the ttl of the FileContents function must be a literal duration")
+
+	_, err = createFileContentsFunction[any](ottl.FunctionContext{}, &FileContentsArguments[any]{
+		Path: "/etc/hostname",
+		TTL:  ottl.NewTestingOptional[ottl.DurationGetter[any]](zero),
+	})
+	assert.EqualError(t, err, "the ttl of the FileContents function must be positive, got 0s")
+}
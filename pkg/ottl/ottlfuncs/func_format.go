@@ -17,7 +17,7 @@ type FormatArguments[K any] struct {
 }
 
 func NewFormatFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("Format", &FormatArguments[K]{}, createFormatFunction[K])
+	return ottl.NewFactory("Format", &FormatArguments[K]{}, createFormatFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createFormatFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
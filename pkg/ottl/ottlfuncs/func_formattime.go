@@ -14,10 +14,11 @@ import (
 type FormatTimeArguments[K any] struct {
 	Time   ottl.TimeGetter[K]
 	Format string
+	Locale ottl.Optional[string]
 }
 
 func NewFormatTimeFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("FormatTime", &FormatTimeArguments[K]{}, createFormatTimeFunction[K])
+	return ottl.NewFactory("FormatTime", &FormatTimeArguments[K]{}, createFormatTimeFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createFormatTimeFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
@@ -27,10 +28,10 @@ func createFormatTimeFunction[K any](_ ottl.FunctionContext, oArgs ottl.Argument
 		return nil, errors.New("FormatTimeFactory args must be of type *FormatTimeArguments[K]")
 	}
 
-	return FormatTime(args.Time, args.Format)
+	return FormatTime(args.Time, args.Format, args.Locale)
 }
 
-func FormatTime[K any](timeValue ottl.TimeGetter[K], format string) (ottl.ExprFunc[K], error) {
+func FormatTime[K any](timeValue ottl.TimeGetter[K], format string, locale ottl.Optional[string]) (ottl.ExprFunc[K], error) {
 	if format == "" {
 		return nil, errors.New("format cannot be nil")
 	}
@@ -39,6 +40,21 @@ func FormatTime[K any](timeValue ottl.TimeGetter[K], format string) (ottl.ExprFu
 		return nil, err
 	}
 
+	if !locale.IsEmpty() {
+		l := locale.Get()
+		if err := timeutils.ValidateLocale(l); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, tCtx K) (any, error) {
+			t, err := timeValue.Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+
+			return timeutils.FormatLocalizedStrptime(format, t, l)
+		}, nil
+	}
+
 	return func(ctx context.Context, tCtx K) (any, error) {
 		t, err := timeValue.Get(ctx, tCtx)
 		if err != nil {
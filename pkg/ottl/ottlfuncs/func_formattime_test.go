@@ -19,6 +19,7 @@ func Test_FormatTime(t *testing.T) {
 		name         string
 		time         ottl.TimeGetter[any]
 		format       string
+		locale       ottl.Optional[string]
 		expected     string
 		errorMsg     string
 		funcErrorMsg string
@@ -149,10 +150,39 @@ func Test_FormatTime(t *testing.T) {
 			format:   "%Y-%m-%dT%H:%M:%S",
 			expected: "1986-10-01T00:17:33",
 		},
+		{
+			name: "localized names",
+			time: &ottl.StandardTimeGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return time.Date(2023, 5, 1, 0, 0, 0, 0, time.Local), nil
+				},
+			},
+			format:   "%A, %d de %B de %Y",
+			locale:   ottl.NewTestingOptional("pt-PT"),
+			expected: "segunda-feira, 01 de maio de 2023",
+		},
+		{
+			name: "localized abbreviations",
+			time: &ottl.StandardTimeGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return time.Date(2023, 2, 15, 0, 0, 0, 0, time.Local), nil
+				},
+			},
+			format:   "%a %d %b %Y",
+			locale:   ottl.NewTestingOptional("es"),
+			expected: "mié 15 feb 2023",
+		},
+		{
+			name:     "unsupported locale",
+			time:     &ottl.StandardTimeGetter[any]{},
+			format:   "%Y-%m-%d",
+			locale:   ottl.NewTestingOptional("xx-unknown"),
+			errorMsg: "unsupported locale 'xx-unknown'",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			exprFunc, err := FormatTime(tt.time, tt.format)
+			exprFunc, err := FormatTime(tt.time, tt.format, tt.locale)
 			if tt.errorMsg != "" {
 				assert.ErrorContains(t, err, tt.errorMsg)
 			} else {
@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type GetPathArguments[K any] struct {
+	Path ottl.StringGetter[K]
+}
+
+func NewGetPathFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("GetPath", &GetPathArguments[K]{}, createGetPathFunction[K])
+}
+
+func createGetPathFunction[K any](fCtx ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*GetPathArguments[K])
+
+	if !ok {
+		return nil, errors.New("GetPathFactory args must be of type *GetPathArguments[K]")
+	}
+
+	resolver, err := ottl.NewPathResolver[K](fCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return getPath(resolver, args.Path), nil
+}
+
+func getPath[K any](resolver *ottl.PathResolver[K], pathGetter ottl.StringGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		path, err := pathGetter.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		target, err := resolver.Resolve(path)
+		if err != nil {
+			return nil, err
+		}
+		return target.Get(ctx, tCtx)
+	}
+}
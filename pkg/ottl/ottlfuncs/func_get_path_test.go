@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// newPathTestParser returns a parser whose paths are the keys of the TransformContext,
+// as GetPath and set_path can only be created by a parser.
+func newPathTestParser(t *testing.T) ottl.Parser[map[string]any] {
+	p, err := ottl.NewParser(
+		ottl.CreateFactoryMap(NewGetPathFactory[map[string]any](), NewSetPathFactory[map[string]any](), NewConcatFactory[map[string]any]()),
+		func(path ottl.Path[map[string]any]) (ottl.GetSetter[map[string]any], error) {
+			return &ottl.StandardGetSetter[map[string]any]{
+				Getter: func(_ context.Context, tCtx map[string]any) (any, error) {
+					return tCtx[path.Name()], nil
+				},
+				Setter: func(_ context.Context, tCtx map[string]any, val any) error {
+					tCtx[path.Name()] = val
+					return nil
+				},
+			}, nil
+		},
+		componenttest.NewNopTelemetrySettings(),
+	)
+	require.NoError(t, err)
+	return p
+}
+
+func Test_GetPath(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		expected   any
+	}{
+		{
+			name:       "literal path",
+			expression: `GetPath("source")`,
+			expected:   "value",
+		},
+		{
+			name:       "path from the telemetry",
+			expression: `GetPath(mapping)`,
+			expected:   "value",
+		},
+		{
+			name:       "built path",
+			expression: `GetPath(Concat(["sou", "rce"], ""))`,
+			expected:   "value",
+		},
+		{
+			name:       "missing path",
+			expression: `GetPath("missing")`,
+			expected:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newPathTestParser(t)
+			expr, err := p.ParseValueExpression(tt.expression)
+			require.NoError(t, err)
+
+			result, err := expr.Eval(t.Context(), map[string]any{"source": "value", "mapping": "source"})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_GetPath_error(t *testing.T) {
+	p := newPathTestParser(t)
+	expr, err := p.ParseValueExpression(`GetPath("not a path")`)
+	require.NoError(t, err)
+
+	_, err = expr.Eval(t.Context(), map[string]any{})
+	assert.ErrorContains(t, err, `invalid path "not a path"`)
+}
+
+func Test_GetPath_withoutParser(t *testing.T) {
+	_, err := NewGetPathFactory[any]().CreateFunction(ottl.FunctionContext{}, &GetPathArguments[any]{})
+	assert.EqualError(t, err, "paths can only be resolved by functions created by a Parser")
+}
@@ -16,7 +16,7 @@ type HexArguments[K any] struct {
 }
 
 func NewHexFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("Hex", &HexArguments[K]{}, createHexFunction[K])
+	return ottl.NewFactory("Hex", &HexArguments[K]{}, createHexFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createHexFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type HexToBytesArguments[K any] struct {
+	Target ottl.StringGetter[K]
+}
+
+func NewHexToBytesFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("HexToBytes", &HexToBytesArguments[K]{}, createHexToBytesFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createHexToBytesFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*HexToBytesArguments[K])
+
+	if !ok {
+		return nil, errors.New("HexToBytesFactory args must be of type *HexToBytesArguments[K]")
+	}
+
+	return hexToBytes(args.Target), nil
+}
+
+func hexToBytes[K any](target ottl.StringGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		trimmed := strings.TrimPrefix(strings.TrimPrefix(val, "0x"), "0X")
+		b, err := hex.DecodeString(trimmed)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hexadecimal string %q for the HexToBytes function: %w", val, err)
+		}
+		return b, nil
+	}
+}
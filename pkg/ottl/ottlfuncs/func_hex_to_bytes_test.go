@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_HexToBytes(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected []byte
+	}{
+		{
+			name:     "lowercase",
+			value:    "0a1bff",
+			expected: []byte{0x0a, 0x1b, 0xff},
+		},
+		{
+			name:     "uppercase with prefix",
+			value:    "0X0A1BFF",
+			expected: []byte{0x0a, 0x1b, 0xff},
+		},
+		{
+			name:     "prefix",
+			value:    "0x0102",
+			expected: []byte{0x01, 0x02},
+		},
+		{
+			name:     "empty",
+			value:    "",
+			expected: []byte{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := hexToBytes(stringGetter(tt.value))(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_HexToBytes_error(t *testing.T) {
+	_, err := hexToBytes(stringGetter("0x0g"))(t.Context(), nil)
+	assert.ErrorContains(t, err, `invalid hexadecimal string "0x0g" for the HexToBytes function`)
+	_, err = hexToBytes(stringGetter("abc"))(t.Context(), nil)
+	assert.ErrorContains(t, err, `invalid hexadecimal string "abc" for the HexToBytes function`)
+}
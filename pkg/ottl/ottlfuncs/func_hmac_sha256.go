@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type HMACSHA256Arguments[K any] struct {
+	Target ottl.StringGetter[K]
+	KeyRef string
+}
+
+// NewHMACSHA256Factory returns the factory of the HMAC_SHA256 Converter, using the keys of the
+// key provider of the store. Since the key provider is provided by the components, HMAC_SHA256 is
+// not part of the standard functions, and must be registered by the components using a key provider extension.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func NewHMACSHA256Factory[K any](keys *KeyStore) ottl.Factory[K] {
+	return ottl.NewFactory("HMAC_SHA256", &HMACSHA256Arguments[K]{}, func(_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+		return createHMACSHA256Function[K](keys, oArgs)
+	})
+}
+
+func createHMACSHA256Function[K any](keys *KeyStore, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*HMACSHA256Arguments[K])
+
+	if !ok {
+		return nil, errors.New("HMACSHA256Factory args must be of type *HMACSHA256Arguments[K]")
+	}
+
+	return hmacSHA256(keys, args.Target, args.KeyRef)
+}
+
+func hmacSHA256[K any](keys *KeyStore, target ottl.StringGetter[K], keyRef string) (ottl.ExprFunc[K], error) {
+	if keys == nil {
+		return nil, errors.New("the HMAC_SHA256 function requires a key store")
+	}
+	if keyRef == "" {
+		return nil, errors.New("the key reference cannot be empty")
+	}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		key, err := keys.key(ctx, keyRef)
+		if err != nil {
+			return nil, err
+		}
+		mac := hmac.New(sha256.New, key)
+		_, err = mac.Write([]byte(val))
+		if err != nil {
+			return nil, err
+		}
+		return hex.EncodeToString(mac.Sum(nil)), nil
+	}, nil
+}
@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_HMACSHA256(t *testing.T) {
+	keys := newTestKeyStore()
+
+	tests := []struct {
+		name          string
+		value         any
+		keyRef        string
+		expected      any
+		expectedError string
+	}{
+		{
+			name:     "string",
+			value:    "user@example.com",
+			keyRef:   "aes256",
+			expected: "6af73c4e2677574b4822fcfbd41a408258718a25e65b1c039fe2934576e8344b",
+		},
+		{
+			name:          "key not found",
+			value:         "user@example.com",
+			keyRef:        "unknown",
+			expectedError: `key "unknown" not found`,
+		},
+		{
+			name:          "non-string",
+			value:         10,
+			keyRef:        "aes256",
+			expectedError: "expected string but got int",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := hmacSHA256[any](keys, &ottl.StandardStringGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.value, nil
+				},
+			}, tt.keyRef)
+			require.NoError(t, err)
+			result, err := exprFunc(t.Context(), nil)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_HMACSHA256_error(t *testing.T) {
+	_, err := hmacSHA256[any](nil, &ottl.StandardStringGetter[any]{}, "aes256")
+	assert.EqualError(t, err, "the HMAC_SHA256 function requires a key store")
+
+	_, err = hmacSHA256[any](newTestKeyStore(), &ottl.StandardStringGetter[any]{}, "")
+	assert.EqualError(t, err, "the key reference cannot be empty")
+}
@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type IfArguments[K any] struct {
+	Condition  ottl.BoolGetter[K]
+	TrueValue  ottl.Getter[K]
+	FalseValue ottl.Getter[K]
+}
+
+func NewIfFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("If", &IfArguments[K]{}, createIfFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createIfFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*IfArguments[K])
+
+	if !ok {
+		return nil, errors.New("IfFactory args must be of type *IfArguments[K]")
+	}
+
+	return ifFunc(args.Condition, args.TrueValue, args.FalseValue), nil
+}
+
+// ifFunc only evaluates the value selected by the condition, so the other one
+// is not evaluated and cannot fail.
+func ifFunc[K any](condition ottl.BoolGetter[K], trueValue, falseValue ottl.Getter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		cond, err := condition.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if cond {
+			return trueValue.Get(ctx, tCtx)
+		}
+		return falseValue.Get(ctx, tCtx)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ifFunc(t *testing.T) {
+	failingGetter := ottl.StandardGetSetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return nil, errors.New("must not be evaluated")
+		},
+	}
+	tests := []struct {
+		name       string
+		condition  bool
+		trueValue  ottl.Getter[any]
+		falseValue ottl.Getter[any]
+		expected   any
+	}{
+		{
+			name:      "true",
+			condition: true,
+			trueValue: ottl.StandardGetSetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return "yes", nil
+				},
+			},
+			falseValue: failingGetter,
+			expected:   "yes",
+		},
+		{
+			name:      "false",
+			condition: false,
+			trueValue: failingGetter,
+			falseValue: ottl.StandardGetSetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return int64(1), nil
+				},
+			},
+			expected: int64(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition := ottl.StandardBoolGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.condition, nil
+				},
+			}
+			exprFunc := ifFunc[any](condition, tt.trueValue, tt.falseValue)
+			result, err := exprFunc(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_ifFunc_error(t *testing.T) {
+	condition := ottl.StandardBoolGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return "not a bool", nil
+		},
+	}
+	exprFunc := ifFunc[any](condition, ottl.StandardGetSetter[any]{}, ottl.StandardGetSetter[any]{})
+	_, err := exprFunc(t.Context(), nil)
+	assert.ErrorContains(t, err, "expected bool but got string")
+}
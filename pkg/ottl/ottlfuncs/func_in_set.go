@@ -0,0 +1,195 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"hash/maphash"
+	"math"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/metadata"
+)
+
+type InSetArguments[K any] struct {
+	Value  ottl.StringLikeGetter[K]
+	SetRef string
+}
+
+func NewInSetFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("InSet", &InSetArguments[K]{}, createInSetFunction[K])
+}
+
+func createInSetFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*InSetArguments[K])
+
+	if !ok {
+		return nil, errors.New("InSetFactory args must be of type *InSetArguments[K]")
+	}
+
+	return inSet(args.Value, args.SetRef)
+}
+
+func inSet[K any](value ottl.StringLikeGetter[K], setRef string) (ottl.ExprFunc[K], error) {
+	if !metadata.OttlFunctionsEnableInSetFeatureGate.IsEnabled() {
+		return nil, fmt.Errorf("the InSet function requires the `%s` feature gate to be enabled", metadata.OttlFunctionsEnableInSetFeatureGate.ID())
+	}
+	if setRef == "" {
+		return nil, errors.New("the set of the InSet function cannot be empty")
+	}
+	// The file is loaded when the statement is parsed, so the sets are ready
+	// before any telemetry is processed.
+	set, err := membershipSets.load(setRef)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := value.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			return false, nil
+		}
+		return set.contains(*val), nil
+	}, nil
+}
+
+// membershipSets caches the sets by the path of their file, so that the statements
+// referencing the same file share a single set and the file is only read once.
+var membershipSets = &membershipSetCache{sets: map[string]*membershipSet{}}
+
+type membershipSetCache struct {
+	mu   sync.Mutex
+	sets map[string]*membershipSet
+}
+
+func (c *membershipSetCache) load(path string) (*membershipSet, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if set, ok := c.sets[path]; ok {
+		return set, nil
+	}
+	set, err := loadMembershipSet(path)
+	if err != nil {
+		return nil, err
+	}
+	c.sets[path] = set
+	return set, nil
+}
+
+// loadMembershipSet reads a file with one entry per line. The entries are trimmed, and
+// the empty lines and the lines starting with "#" are ignored.
+func loadMembershipSet(path string) (*membershipSet, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read set: %w", err)
+	}
+	defer f.Close()
+
+	var entries []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		entries = append(entries, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read set %q: %w", path, err)
+	}
+	return newMembershipSet(entries), nil
+}
+
+// membershipSet is an immutable set of strings optimized for large sets where most
+// lookups are misses, such as allowlists and denylists. A Bloom filter rejects most
+// of the values that are not in the set without searching the entries, and the
+// values it accepts are confirmed with a binary search in the sorted entries, so
+// there are no false positives. The entries are kept in a sorted slice rather than
+// a map to reduce the memory used by sets with millions of entries.
+type membershipSet struct {
+	filter  bloomFilter
+	entries []string
+}
+
+// membershipSetFalsePositiveRate is the rate of the values not in the set accepted
+// by the Bloom filter, which then require a binary search.
+const membershipSetFalsePositiveRate = 0.01
+
+func newMembershipSet(entries []string) *membershipSet {
+	slices.Sort(entries)
+	entries = slices.Clip(slices.Compact(entries))
+
+	filter := newBloomFilter(len(entries), membershipSetFalsePositiveRate)
+	for _, entry := range entries {
+		filter.add(entry)
+	}
+	return &membershipSet{
+		filter:  filter,
+		entries: entries,
+	}
+}
+
+func (s *membershipSet) contains(value string) bool {
+	if !s.filter.mayContain(value) {
+		return false
+	}
+	_, found := slices.BinarySearch(s.entries, value)
+	return found
+}
+
+// bloomFilter is a Bloom filter using double hashing to compute the positions
+// of the bits of a value from a single 64-bit hash.
+type bloomFilter struct {
+	seed   maphash.Seed
+	bits   []uint64
+	size   uint64
+	hashes uint64
+}
+
+func newBloomFilter(n int, falsePositiveRate float64) bloomFilter {
+	n = max(n, 1)
+	size := uint64(math.Ceil(-float64(n) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	hashes := uint64(max(math.Round(float64(size)/float64(n)*math.Ln2), 1))
+	return bloomFilter{
+		seed:   maphash.MakeSeed(),
+		bits:   make([]uint64, (size+63)/64),
+		size:   size,
+		hashes: hashes,
+	}
+}
+
+func (f *bloomFilter) add(value string) {
+	h1, h2 := f.hash(value)
+	for i := range f.hashes {
+		pos := (h1 + i*h2) % f.size
+		f.bits[pos/64] |= 1 << (pos % 64)
+	}
+}
+
+func (f *bloomFilter) mayContain(value string) bool {
+	h1, h2 := f.hash(value)
+	for i := range f.hashes {
+		pos := (h1 + i*h2) % f.size
+		if f.bits[pos/64]&(1<<(pos%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *bloomFilter) hash(value string) (uint64, uint64) {
+	h := maphash.String(f.seed, value)
+	// The second hash must be odd, so the positions do not repeat when the size is even.
+	return h, (h >> 32) | 1
+}
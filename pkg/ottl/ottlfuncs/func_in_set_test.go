@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
+)
+
+func Test_InSet(t *testing.T) {
+	t.Cleanup(ottltest.SetFeatureGateForTest(t, metadata.OttlFunctionsEnableInSetFeatureGate, true))
+	tests := []struct {
+		name     string
+		value    any
+		expected bool
+	}{
+		{
+			name:     "in set",
+			value:    "alice@example.com",
+			expected: true,
+		},
+		{
+			name:     "trimmed entry",
+			value:    "bob@example.com",
+			expected: true,
+		},
+		{
+			name:     "not in set",
+			value:    "dave@example.com",
+			expected: false,
+		},
+		{
+			name:     "comments are ignored",
+			value:    "# Users excluded from the telemetry",
+			expected: false,
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := inSet[any](&ottl.StandardStringLikeGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.value, nil
+				},
+			}, filepath.Join("testdata", "in_set", "denylist.txt"))
+			require.NoError(t, err)
+			result, err := exprFunc(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_InSet_error(t *testing.T) {
+	t.Cleanup(ottltest.SetFeatureGateForTest(t, metadata.OttlFunctionsEnableInSetFeatureGate, true))
+	_, err := inSet[any](&ottl.StandardStringLikeGetter[any]{}, "")
+	assert.EqualError(t, err, "the set of the InSet function cannot be empty")
+
+	_, err = inSet[any](&ottl.StandardStringLikeGetter[any]{}, filepath.Join("testdata", "in_set", "missing.txt"))
+	assert.ErrorContains(t, err, "failed to read set")
+}
+
+func Test_InSet_featureGateDisabled(t *testing.T) {
+	t.Cleanup(ottltest.SetFeatureGateForTest(t, metadata.OttlFunctionsEnableInSetFeatureGate, false))
+	_, err := inSet[any](&ottl.StandardStringLikeGetter[any]{}, filepath.Join("testdata", "in_set", "denylist.txt"))
+	assert.EqualError(t, err, "the InSet function requires the `ottl.functions.enableInSet` feature gate to be enabled")
+}
+
+func Test_membershipSetCache(t *testing.T) {
+	cache := &membershipSetCache{sets: map[string]*membershipSet{}}
+	path := filepath.Join("testdata", "in_set", "denylist.txt")
+
+	set, err := cache.load(path)
+	require.NoError(t, err)
+	cached, err := cache.load(path)
+	require.NoError(t, err)
+	assert.Same(t, set, cached)
+
+	_, err = cache.load(filepath.Join("testdata", "in_set", "missing.txt"))
+	assert.ErrorContains(t, err, "failed to read set")
+	assert.Len(t, cache.sets, 1)
+}
+
+func Test_membershipSet(t *testing.T) {
+	entries := make([]string, 0, 10000)
+	for i := range 10000 {
+		entries = append(entries, "member-"+strconv.Itoa(i))
+	}
+	set := newMembershipSet(entries)
+
+	for i := range 10000 {
+		assert.True(t, set.contains("member-"+strconv.Itoa(i)))
+	}
+
+	falsePositives := 0
+	for i := range 10000 {
+		value := "other-" + strconv.Itoa(i)
+		assert.False(t, set.contains(value))
+		if set.filter.mayContain(value) {
+			falsePositives++
+		}
+	}
+	// The Bloom filter is sized for a 1% false positive rate.
+	assert.Less(t, falsePositives, 300)
+}
+
+func Test_membershipSet_empty(t *testing.T) {
+	set := newMembershipSet(nil)
+	assert.False(t, set.contains(""))
+	assert.False(t, set.contains("value"))
+}
@@ -19,7 +19,7 @@ type IndexArguments[K any] struct {
 }
 
 func NewIndexFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("Index", &IndexArguments[K]{}, createIndexFunction[K])
+	return ottl.NewFactory("Index", &IndexArguments[K]{}, createIndexFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createIndexFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
@@ -15,7 +15,7 @@ type IntArguments[K any] struct {
 }
 
 func NewIntFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("Int", &IntArguments[K]{}, createIntFunction[K])
+	return ottl.NewFactory("Int", &IntArguments[K]{}, createIntFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createIntFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type IPInCIDRListArguments[K any] struct {
+	Target   ottl.StringGetter[K]
+	Networks ottl.PSliceGetter[K]
+}
+
+func NewIPInCIDRListFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("IPInCIDRList", &IPInCIDRListArguments[K]{}, createIPInCIDRListFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createIPInCIDRListFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*IPInCIDRListArguments[K])
+	if !ok {
+		return nil, errors.New("IPInCIDRListFactory args must be of type *IPInCIDRListArguments[K]")
+	}
+
+	return ipInCIDRList(args.Target, args.Networks)
+}
+
+func ipInCIDRList[K any](target ottl.StringGetter[K], networks ottl.PSliceGetter[K]) (ottl.ExprFunc[K], error) {
+	// The literal lists are parsed once, and the dynamic ones are cached once parsed.
+	var literalList cidrList
+	if literal, isLiteral := ottl.GetLiteralValue(networks); isLiteral {
+		list, err := parseCIDRList(literal)
+		if err != nil {
+			return nil, err
+		}
+		literalList = list
+	}
+	cache := &cidrListCache{}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		list := literalList
+		if list == nil {
+			networksVal, err := networks.Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			list, err = cache.get(networksVal)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		addr, ok := parseIPAddr(val)
+		if !ok {
+			return false, nil
+		}
+		return list.contains(addr), nil
+	}, nil
+}
@@ -0,0 +1,151 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func newTestNetworksGetter(t *testing.T, literal bool, networks ...any) ottl.PSliceGetter[any] {
+	s := pcommon.NewSlice()
+	require.NoError(t, s.FromRaw(networks))
+	getter := ottl.StandardPSliceGetter[any]{
+		Getter: func(context.Context, any) (any, error) { return s, nil },
+	}
+	if !literal {
+		return getter
+	}
+	literalGetter, err := ottl.NewTestingLiteralGetter(true, ottl.PSliceGetter[any](getter))
+	require.NoError(t, err)
+	return literalGetter
+}
+
+func Test_ipInCIDRList(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		networks []any
+		expected bool
+	}{
+		{
+			name:     "IPv4 in list",
+			target:   "10.1.2.3",
+			networks: []any{"192.168.0.0/16", "10.0.0.0/8"},
+			expected: true,
+		},
+		{
+			name:     "IPv4 not in list",
+			target:   "172.16.0.1",
+			networks: []any{"192.168.0.0/16", "10.0.0.0/8"},
+			expected: false,
+		},
+		{
+			name:     "IPv6 in list",
+			target:   "2001:db8::1",
+			networks: []any{"2001:db8::/32"},
+			expected: true,
+		},
+		{
+			name:     "IPv4-mapped IPv6 in IPv4 network",
+			target:   "::ffff:10.1.2.3",
+			networks: []any{"10.0.0.0/8"},
+			expected: true,
+		},
+		{
+			name:     "network with host bits",
+			target:   "10.1.2.3",
+			networks: []any{"10.1.2.1/24"},
+			expected: true,
+		},
+		{
+			name:     "not an IP",
+			target:   "hello world",
+			networks: []any{"10.0.0.0/8"},
+			expected: false,
+		},
+		{
+			name:     "empty list",
+			target:   "10.1.2.3",
+			networks: []any{},
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		for _, literal := range []bool{true, false} {
+			name := tt.name
+			if literal {
+				name += " with literal networks"
+			}
+			t.Run(name, func(t *testing.T) {
+				exprFunc, err := ipInCIDRList[any](ottl.StandardStringGetter[any]{
+					Getter: func(context.Context, any) (any, error) { return tt.target, nil },
+				}, newTestNetworksGetter(t, literal, tt.networks...))
+				require.NoError(t, err)
+				result, err := exprFunc(t.Context(), nil)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			})
+		}
+	}
+}
+
+func Test_ipInCIDRList_dynamicNetworksCached(t *testing.T) {
+	networks := pcommon.NewSlice()
+	networks.AppendEmpty().SetStr("10.0.0.0/8")
+	exprFunc, err := ipInCIDRList[any](ottl.StandardStringGetter[any]{
+		Getter: func(context.Context, any) (any, error) { return "192.168.1.1", nil },
+	}, ottl.StandardPSliceGetter[any]{
+		Getter: func(context.Context, any) (any, error) { return networks, nil },
+	})
+	require.NoError(t, err)
+
+	result, err := exprFunc(t.Context(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, false, result)
+
+	networks.AppendEmpty().SetStr("192.168.0.0/16")
+	result, err = exprFunc(t.Context(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, true, result)
+}
+
+func Test_ipInCIDRList_error(t *testing.T) {
+	tests := []struct {
+		name          string
+		networks      []any
+		expectedError string
+	}{
+		{
+			name:          "invalid CIDR",
+			networks:      []any{"10.0.0/8"},
+			expectedError: "invalid CIDR address",
+		},
+		{
+			name:          "non-string network",
+			networks:      []any{int64(10)},
+			expectedError: "expected the networks to be strings but got Int",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := ottl.StandardStringGetter[any]{
+				Getter: func(context.Context, any) (any, error) { return "10.1.2.3", nil },
+			}
+			_, err := ipInCIDRList[any](target, newTestNetworksGetter(t, true, tt.networks...))
+			assert.ErrorContains(t, err, tt.expectedError)
+
+			exprFunc, err := ipInCIDRList[any](target, newTestNetworksGetter(t, false, tt.networks...))
+			require.NoError(t, err)
+			_, err = exprFunc(t.Context(), nil)
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
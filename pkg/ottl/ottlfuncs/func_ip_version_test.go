@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ipVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		expected int64
+	}{
+		{
+			name:     "IPv4",
+			target:   "192.168.1.1",
+			expected: 4,
+		},
+		{
+			name:     "IPv6",
+			target:   "2001:db8::1",
+			expected: 6,
+		},
+		{
+			name:     "IPv6 with zone",
+			target:   "fe80::1%eth0",
+			expected: 6,
+		},
+		{
+			name:     "IPv4-mapped IPv6",
+			target:   "::ffff:192.168.1.1",
+			expected: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := ipVersion[any](ottl.StandardStringGetter[any]{
+				Getter: func(context.Context, any) (any, error) { return tt.target, nil },
+			})
+			result, err := exprFunc(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_ipVersion_error(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        any
+		expectedError string
+	}{
+		{
+			name:          "not an IP",
+			target:        "192.168.1",
+			expectedError: `"192.168.1" is not a valid IP address`,
+		},
+		{
+			name:          "non-string",
+			target:        10,
+			expectedError: "expected string but got int",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := ipVersion[any](ottl.StandardStringGetter[any]{
+				Getter: func(context.Context, any) (any, error) { return tt.target, nil },
+			})
+			_, err := exprFunc(t.Context(), nil)
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
@@ -15,7 +15,7 @@ type IsDoubleArguments[K any] struct {
 }
 
 func NewIsDoubleFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("IsDouble", &IsDoubleArguments[K]{}, createIsDoubleFunction[K])
+	return ottl.NewFactory("IsDouble", &IsDoubleArguments[K]{}, createIsDoubleFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createIsDoubleFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
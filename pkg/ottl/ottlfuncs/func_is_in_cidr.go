@@ -16,7 +16,7 @@ type IsInCIDRArguments[K any] struct {
 }
 
 func NewIsInCIDRFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("IsInCIDR", &IsInCIDRArguments[K]{}, createIsInCIDRFunction[K])
+	return ottl.NewFactory("IsInCIDR", &IsInCIDRArguments[K]{}, createIsInCIDRFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createIsInCIDRFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
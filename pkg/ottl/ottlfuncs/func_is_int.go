@@ -15,7 +15,7 @@ type IsIntArguments[K any] struct {
 }
 
 func NewIsIntFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("IsInt", &IsIntArguments[K]{}, createIsIntFunction[K])
+	return ottl.NewFactory("IsInt", &IsIntArguments[K]{}, createIsIntFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createIsIntFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
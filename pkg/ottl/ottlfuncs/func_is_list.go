@@ -20,7 +20,7 @@ type IsListArguments[K any] struct {
 }
 
 func NewIsListFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("IsList", &IsListArguments[K]{}, createIsListFunction[K])
+	return ottl.NewFactory("IsList", &IsListArguments[K]{}, createIsListFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createIsListFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
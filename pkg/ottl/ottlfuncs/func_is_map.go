@@ -15,7 +15,7 @@ type IsMapArguments[K any] struct {
 }
 
 func NewIsMapFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("IsMap", &IsMapArguments[K]{}, createIsMapFunction[K])
+	return ottl.NewFactory("IsMap", &IsMapArguments[K]{}, createIsMapFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createIsMapFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
@@ -16,7 +16,7 @@ type IsMatchArguments[K any] struct {
 }
 
 func NewIsMatchFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("IsMatch", &IsMatchArguments[K]{}, createIsMatchFunction[K])
+	return ottl.NewFactory("IsMatch", &IsMatchArguments[K]{}, createIsMatchFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createIsMatchFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
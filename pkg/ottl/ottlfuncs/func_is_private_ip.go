@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type IsPrivateIPArguments[K any] struct {
+	Target ottl.StringGetter[K]
+}
+
+func NewIsPrivateIPFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("IsPrivateIP", &IsPrivateIPArguments[K]{}, createIsPrivateIPFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createIsPrivateIPFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*IsPrivateIPArguments[K])
+	if !ok {
+		return nil, errors.New("IsPrivateIPFactory args must be of type *IsPrivateIPArguments[K]")
+	}
+
+	return isPrivateIP(args.Target), nil
+}
+
+func isPrivateIP[K any](target ottl.StringGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		addr, ok := parseIPAddr(val)
+		if !ok {
+			return false, nil
+		}
+		return addr.IsPrivate(), nil
+	}
+}
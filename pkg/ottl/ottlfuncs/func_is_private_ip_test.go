@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_isPrivateIP(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		expected bool
+	}{
+		{
+			name:     "10.0.0.0/8",
+			target:   "10.1.2.3",
+			expected: true,
+		},
+		{
+			name:     "172.16.0.0/12",
+			target:   "172.31.255.255",
+			expected: true,
+		},
+		{
+			name:     "192.168.0.0/16",
+			target:   "192.168.1.1",
+			expected: true,
+		},
+		{
+			name:     "IPv6 unique local address",
+			target:   "fd12:3456::1",
+			expected: true,
+		},
+		{
+			name:     "IPv4-mapped IPv6 private address",
+			target:   "::ffff:192.168.1.1",
+			expected: true,
+		},
+		{
+			name:     "public IPv4",
+			target:   "8.8.8.8",
+			expected: false,
+		},
+		{
+			name:     "public IPv6",
+			target:   "2001:4860:4860::8888",
+			expected: false,
+		},
+		{
+			name:     "loopback",
+			target:   "127.0.0.1",
+			expected: false,
+		},
+		{
+			name:     "not an IP",
+			target:   "localhost",
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := isPrivateIP[any](ottl.StandardStringGetter[any]{
+				Getter: func(context.Context, any) (any, error) { return tt.target, nil },
+			})
+			result, err := exprFunc(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_isPrivateIP_error(t *testing.T) {
+	exprFunc := isPrivateIP[any](ottl.StandardStringGetter[any]{
+		Getter: func(context.Context, any) (any, error) { return 10, nil },
+	})
+	_, err := exprFunc(t.Context(), nil)
+	assert.ErrorContains(t, err, "expected string but got int")
+}
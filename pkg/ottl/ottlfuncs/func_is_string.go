@@ -15,7 +15,7 @@ type IsStringArguments[K any] struct {
 }
 
 func NewIsStringFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("IsString", &IsStringArguments[K]{}, createIsStringFunction[K])
+	return ottl.NewFactory("IsString", &IsStringArguments[K]{}, createIsStringFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createIsStringFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
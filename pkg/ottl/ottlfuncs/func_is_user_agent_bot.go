@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type IsUserAgentBotArguments[K any] struct {
+	UserAgent ottl.StringGetter[K]
+}
+
+func NewIsUserAgentBotFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("IsUserAgentBot", &IsUserAgentBotArguments[K]{}, createIsUserAgentBotFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createIsUserAgentBotFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*IsUserAgentBotArguments[K])
+	if !ok {
+		return nil, errors.New("IsUserAgentBotFactory args must be of type *IsUserAgentBotArguments[K]")
+	}
+
+	return isUserAgentBotFunc(args.UserAgent), nil
+}
+
+func isUserAgentBotFunc[K any](userAgentSource ottl.StringGetter[K]) ottl.ExprFunc[K] {
+	parser := userAgentParser()
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		userAgentString, err := userAgentSource.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		return isUserAgentBot(parser.ParseDevice(userAgentString)), nil
+	}
+}
@@ -17,7 +17,7 @@ type IsValidLuhnArguments[K any] struct {
 }
 
 func NewIsValidLuhnFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("IsValidLuhn", &IsValidLuhnArguments[K]{}, createIsValidLuhnFunction[K])
+	return ottl.NewFactory("IsValidLuhn", &IsValidLuhnArguments[K]{}, createIsValidLuhnFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createIsValidLuhnFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+const (
+	// jaroWinklerPrefixScale is the weight given to the common prefix of the strings.
+	jaroWinklerPrefixScale = 0.1
+	// jaroWinklerMaxPrefix is the maximum length of the common prefix taken into account.
+	jaroWinklerMaxPrefix = 4
+)
+
+type JaroWinklerArguments[K any] struct {
+	Left  ottl.StringGetter[K]
+	Right ottl.StringGetter[K]
+}
+
+func NewJaroWinklerFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("JaroWinkler", &JaroWinklerArguments[K]{}, createJaroWinklerFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createJaroWinklerFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*JaroWinklerArguments[K])
+
+	if !ok {
+		return nil, errors.New("JaroWinklerFactory args must be of type *JaroWinklerArguments[K]")
+	}
+
+	return jaroWinklerSimilarity(args.Left, args.Right), nil
+}
+
+func jaroWinklerSimilarity[K any](left, right ottl.StringGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		leftVal, err := left.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		rightVal, err := right.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		return jaroWinkler([]rune(leftVal), []rune(rightVal)), nil
+	}
+}
+
+// jaroWinkler returns the Jaro-Winkler similarity of a and b, between 0 for strings
+// with no characters in common and 1 for equal strings.
+func jaroWinkler(a, b []rune) float64 {
+	similarity := jaro(a, b)
+	prefix := 0
+	for prefix < min(len(a), len(b), jaroWinklerMaxPrefix) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	return similarity + float64(prefix)*jaroWinklerPrefixScale*(1-similarity)
+}
+
+// jaro returns the Jaro similarity of a and b.
+func jaro(a, b []rune) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	// Characters only match if they are not farther apart than the window.
+	window := max(max(len(a), len(b))/2-1, 0)
+	aMatched := make([]bool, len(a))
+	bMatched := make([]bool, len(b))
+	matches := 0
+	for i := range a {
+		for j := max(0, i-window); j < min(len(b), i+window+1); j++ {
+			if !bMatched[j] && a[i] == b[j] {
+				aMatched[i], bMatched[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	// Transpositions are the matching characters which are not in the same order, halved.
+	transpositions := 0
+	j := 0
+	for i := range a {
+		if !aMatched[i] {
+			continue
+		}
+		for !bMatched[j] {
+			j++
+		}
+		if a[i] != b[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	return (m/float64(len(a)) + m/float64(len(b)) + (m-float64(transpositions)/2)/m) / 3
+}
@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_JaroWinkler(t *testing.T) {
+	tests := []struct {
+		name     string
+		left     string
+		right    string
+		expected float64
+	}{
+		{name: "equal", left: "checkout", right: "checkout", expected: 1},
+		{name: "transposition", left: "MARTHA", right: "MARHTA", expected: 0.9611111111111111},
+		{name: "common prefix", left: "DWAYNE", right: "DUANE", expected: 0.84},
+		{name: "different lengths", left: "DIXON", right: "DICKSONX", expected: 0.8133333333333332},
+		{name: "nothing in common", left: "abc", right: "xyz", expected: 0},
+		{name: "one empty", left: "abc", right: "", expected: 0},
+		{name: "both empty", left: "", right: "", expected: 1},
+		{name: "single characters", left: "a", right: "a", expected: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := jaroWinklerSimilarity[any](stringGetter(tt.left), stringGetter(tt.right))
+			result, err := exprFunc(t.Context(), nil)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expected, result, 1e-9)
+		})
+	}
+}
+
+func Test_JaroWinkler_error(t *testing.T) {
+	exprFunc := jaroWinklerSimilarity[any](stringGetter("a"), stringGetter(1))
+	_, err := exprFunc(t.Context(), nil)
+	assert.ErrorContains(t, err, "expected string but got int")
+}
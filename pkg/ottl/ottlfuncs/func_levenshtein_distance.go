@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type LevenshteinDistanceArguments[K any] struct {
+	Left  ottl.StringGetter[K]
+	Right ottl.StringGetter[K]
+}
+
+func NewLevenshteinDistanceFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("LevenshteinDistance", &LevenshteinDistanceArguments[K]{}, createLevenshteinDistanceFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createLevenshteinDistanceFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*LevenshteinDistanceArguments[K])
+
+	if !ok {
+		return nil, errors.New("LevenshteinDistanceFactory args must be of type *LevenshteinDistanceArguments[K]")
+	}
+
+	return levenshteinDistance(args.Left, args.Right), nil
+}
+
+func levenshteinDistance[K any](left, right ottl.StringGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		leftVal, err := left.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		rightVal, err := right.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		return int64(levenshtein([]rune(leftVal), []rune(rightVal))), nil
+	}
+}
+
+// levenshtein returns the minimum number of single character insertions, deletions and
+// substitutions required to change a into b. It only keeps two rows of the distance matrix.
+func levenshtein(a, b []rune) int {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			substitution := previous[j-1]
+			if a[i-1] != b[j-1] {
+				substitution++
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, substitution)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LevenshteinDistance(t *testing.T) {
+	tests := []struct {
+		name     string
+		left     string
+		right    string
+		expected int64
+	}{
+		{name: "equal", left: "checkout", right: "checkout", expected: 0},
+		{name: "substitutions and insertion", left: "kitten", right: "sitting", expected: 3},
+		{name: "symmetric", left: "sitting", right: "kitten", expected: 3},
+		{name: "empty left", left: "", right: "abc", expected: 3},
+		{name: "empty right", left: "abc", right: "", expected: 3},
+		{name: "both empty", left: "", right: "", expected: 0},
+		{name: "service names", left: "checkout-service", right: "checkout_svc", expected: 5},
+		{name: "multibyte characters", left: "café", right: "cafe", expected: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := levenshteinDistance[any](stringGetter(tt.left), stringGetter(tt.right))
+			result, err := exprFunc(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_LevenshteinDistance_error(t *testing.T) {
+	exprFunc := levenshteinDistance[any](stringGetter(1), stringGetter("a"))
+	_, err := exprFunc(t.Context(), nil)
+	assert.ErrorContains(t, err, "expected string but got int")
+}
@@ -0,0 +1,413 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/metadata"
+)
+
+// LookupTable is a table of values indexed by key, used by the Lookup Converter.
+// It is implemented by the lookup table extensions, and implementations must be safe
+// for concurrent use.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type LookupTable interface {
+	// Lookup returns the value of the key, and false if the table has no value for it.
+	Lookup(ctx context.Context, key string) (any, bool, error)
+}
+
+// GetLookupTable returns the lookup table extension with the given id.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func GetLookupTable(host component.Host, id component.ID) (LookupTable, error) {
+	ext, found := host.GetExtensions()[id]
+	if !found {
+		return nil, fmt.Errorf("lookup table extension %q not found", id)
+	}
+	table, ok := ext.(LookupTable)
+	if !ok {
+		return nil, fmt.Errorf("extension %q is not a lookup table", id)
+	}
+	return table, nil
+}
+
+// LookupTableStore holds the tables of the Lookup Converter. The lookup table extensions
+// are only available once the collector has started, so the components create the store
+// with their functions, and bind it to their host with SetHost when they start. The files
+// are loaded when the statements are parsed, and shared by the statements using them.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type LookupTableStore struct {
+	mu     sync.RWMutex
+	host   component.Host
+	hostID uint64
+	tables map[component.ID]LookupTable
+	files  map[string]*fileLookupTable
+}
+
+// NewLookupTableStore returns a LookupTableStore without host, on which the lookups in the
+// tables of extensions fail until a host is set.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func NewLookupTableStore() *LookupTableStore {
+	return &LookupTableStore{
+		tables: map[component.ID]LookupTable{},
+		files:  map[string]*fileLookupTable{},
+	}
+}
+
+// SetHost sets the host providing the lookup table extensions. It is meant to be called by
+// the components when they start, and with nil when they shut down.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func (s *LookupTableStore) SetHost(host component.Host) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.host = host
+	s.hostID++
+	clear(s.tables)
+}
+
+// table returns the lookup table extension with the given id, which is resolved
+// from the host the first time it is used.
+func (s *LookupTableStore) table(id component.ID) (LookupTable, error) {
+	s.mu.RLock()
+	table, ok := s.tables[id]
+	host, hostID := s.host, s.hostID
+	s.mu.RUnlock()
+	if ok {
+		return table, nil
+	}
+	if host == nil {
+		return nil, errors.New("the lookup tables are not available")
+	}
+	table, err := GetLookupTable(host, id)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// The host may have been changed while the table was resolved.
+	if s.hostID == hostID {
+		s.tables[id] = table
+	}
+	return table, nil
+}
+
+// file returns the table of the file, which is loaded the first time it is used. The
+// statements using the same file share its table, which is reloaded after the shortest
+// positive ttl they use.
+func (s *LookupTableStore) file(path string, ttl time.Duration, logger *zap.Logger) (*fileLookupTable, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if table, ok := s.files[path]; ok {
+		table.setTTL(ttl)
+		return table, nil
+	}
+	table, err := newFileLookupTable(path, ttl, logger)
+	if err != nil {
+		return nil, err
+	}
+	s.files[path] = table
+	return table, nil
+}
+
+type LookupArguments[K any] struct {
+	Key   ottl.StringGetter[K]
+	Table string
+	TTL   ottl.Optional[ottl.DurationGetter[K]]
+}
+
+// NewLookupFactory returns the factory of the Lookup Converter, using the tables of the store.
+// Like HMAC_SHA256, it is not part of the standard functions.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func NewLookupFactory[K any](tables *LookupTableStore) ottl.Factory[K] {
+	return ottl.NewFactory("Lookup", &LookupArguments[K]{}, func(fCtx ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+		return createLookupFunction[K](tables, fCtx, oArgs)
+	})
+}
+
+func createLookupFunction[K any](tables *LookupTableStore, fCtx ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*LookupArguments[K])
+
+	if !ok {
+		return nil, errors.New("LookupFactory args must be of type *LookupArguments[K]")
+	}
+	if !metadata.OttlFunctionsEnableLookupFeatureGate.IsEnabled() {
+		return nil, fmt.Errorf("the Lookup function requires the `%s` feature gate to be enabled", metadata.OttlFunctionsEnableLookupFeatureGate.ID())
+	}
+
+	var ttl time.Duration
+	if !args.TTL.IsEmpty() {
+		literalTTL, isLiteral := ottl.GetLiteralValue(args.TTL.Get())
+		if !isLiteral {
+			return nil, errors.New("the ttl of the Lookup function must be a literal duration")
+		}
+		if literalTTL < 0 {
+			return nil, fmt.Errorf("the ttl of the Lookup function cannot be negative, got %s", literalTTL)
+		}
+		ttl = literalTTL
+	}
+
+	logger := fCtx.Set.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return lookup(tables, args.Key, args.Table, ttl, logger)
+}
+
+func lookup[K any](tables *LookupTableStore, key ottl.StringGetter[K], table string, ttl time.Duration, logger *zap.Logger) (ottl.ExprFunc[K], error) {
+	if table == "" {
+		return nil, errors.New("the table of the Lookup function cannot be empty")
+	}
+
+	var getTable func() (LookupTable, error)
+	if isLookupFile(table) {
+		fileTable, err := tables.file(table, ttl, logger)
+		if err != nil {
+			return nil, err
+		}
+		getTable = func() (LookupTable, error) {
+			return fileTable, nil
+		}
+	} else {
+		if ttl != 0 {
+			return nil, fmt.Errorf("the ttl of the Lookup function is only supported for files, got table %q", table)
+		}
+		var id component.ID
+		if err := id.UnmarshalText([]byte(table)); err != nil {
+			return nil, fmt.Errorf("invalid lookup table %q: %w", table, err)
+		}
+		// The lookup table extensions are only available once the collector
+		// has started, so they are resolved when the function is executed.
+		getTable = func() (LookupTable, error) {
+			return tables.table(id)
+		}
+	}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		keyVal, err := key.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		t, err := getTable()
+		if err != nil {
+			return nil, err
+		}
+		val, found, err := t.Lookup(ctx, keyVal)
+		if err != nil || !found {
+			return nil, err
+		}
+		return val, nil
+	}, nil
+}
+
+func isLookupFile(table string) bool {
+	switch strings.ToLower(filepath.Ext(table)) {
+	case ".csv", ".json":
+		return true
+	default:
+		return false
+	}
+}
+
+// fileLookupTable is a LookupTable loaded from a CSV or JSON file. The file is
+// reloaded when it is accessed after the ttl expired, if it was modified.
+type fileLookupTable struct {
+	path   string
+	logger *zap.Logger
+
+	mu       sync.RWMutex
+	ttl      time.Duration
+	values   map[string]any
+	modTime  time.Time
+	loadedAt time.Time
+}
+
+func newFileLookupTable(path string, ttl time.Duration, logger *zap.Logger) (*fileLookupTable, error) {
+	t := &fileLookupTable{
+		path:   path,
+		ttl:    ttl,
+		logger: logger,
+	}
+	if err := t.load(time.Now()); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// setTTL sets the ttl of the table if it is shorter, for the statements sharing it.
+func (t *fileLookupTable) setTTL(ttl time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if ttl > 0 && (t.ttl == 0 || ttl < t.ttl) {
+		t.ttl = ttl
+	}
+}
+
+func (t *fileLookupTable) Lookup(_ context.Context, key string) (any, bool, error) {
+	t.reloadIfExpired(time.Now())
+
+	t.mu.RLock()
+	val, ok := t.values[key]
+	t.mu.RUnlock()
+	if !ok {
+		return nil, false, nil
+	}
+
+	// Maps and slices are copied so the table cannot be modified by the statements.
+	switch v := val.(type) {
+	case pcommon.Map:
+		m := pcommon.NewMap()
+		v.CopyTo(m)
+		return m, true, nil
+	case pcommon.Slice:
+		s := pcommon.NewSlice()
+		v.CopyTo(s)
+		return s, true, nil
+	default:
+		return val, true, nil
+	}
+}
+
+func (t *fileLookupTable) reloadIfExpired(now time.Time) {
+	t.mu.RLock()
+	expired := t.ttl > 0 && now.Sub(t.loadedAt) >= t.ttl
+	t.mu.RUnlock()
+	if !expired {
+		return
+	}
+	// The previous values are kept if the file cannot be reloaded, so the
+	// statements keep working until the file is fixed.
+	if err := t.load(now); err != nil {
+		t.logger.Warn("failed to reload lookup table, keeping the previous values", zap.String("path", t.path), zap.Error(err))
+	}
+}
+
+func (t *fileLookupTable) load(now time.Time) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// Another call may have reloaded the file while waiting for the lock.
+	if !t.loadedAt.IsZero() && now.Sub(t.loadedAt) < t.ttl {
+		return nil
+	}
+	// The ttl is restarted even if the file cannot be loaded, so a broken
+	// file is only read once per ttl.
+	t.loadedAt = now
+
+	info, err := os.Stat(t.path)
+	if err != nil {
+		return fmt.Errorf("failed to read lookup table: %w", err)
+	}
+	if t.values != nil && info.ModTime().Equal(t.modTime) {
+		return nil
+	}
+
+	f, err := os.Open(t.path)
+	if err != nil {
+		return fmt.Errorf("failed to read lookup table: %w", err)
+	}
+	defer f.Close()
+
+	var values map[string]any
+	if strings.EqualFold(filepath.Ext(t.path), ".csv") {
+		values, err = parseLookupCSV(f)
+	} else {
+		values, err = parseLookupJSON(f)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to parse lookup table %q: %w", t.path, err)
+	}
+
+	t.values = values
+	t.modTime = info.ModTime()
+	return nil
+}
+
+// parseLookupCSV parses a CSV file with a header row. The first column holds the keys.
+// If the file has two columns, the values are the strings of the second column,
+// otherwise they are maps of the other columns indexed by their header.
+func parseLookupCSV(r io.Reader) (map[string]any, error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("missing header row")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(header) < 2 {
+		return nil, errors.New("at least two columns are required")
+	}
+
+	values := map[string]any{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return values, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(header) == 2 {
+			values[record[0]] = record[1]
+			continue
+		}
+		m := pcommon.NewMap()
+		m.EnsureCapacity(len(header) - 1)
+		for i := 1; i < len(header); i++ {
+			m.PutStr(header[i], record[i])
+		}
+		values[record[0]] = m
+	}
+}
+
+// parseLookupJSON parses a JSON object whose keys are the keys of the table.
+// Objects are converted to pcommon.Map and arrays to pcommon.Slice.
+func parseLookupJSON(r io.Reader) (map[string]any, error) {
+	var raw map[string]any
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]any, len(raw))
+	for k, v := range raw {
+		switch rv := v.(type) {
+		case map[string]any:
+			m := pcommon.NewMap()
+			if err := m.FromRaw(rv); err != nil {
+				return nil, err
+			}
+			values[k] = m
+		case []any:
+			s := pcommon.NewSlice()
+			if err := s.FromRaw(rv); err != nil {
+				return nil, err
+			}
+			values[k] = s
+		default:
+			values[k] = v
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,320 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
+)
+
+type testLookupTable map[string]any
+
+func (t testLookupTable) Lookup(_ context.Context, key string) (any, bool, error) {
+	val, ok := t[key]
+	return val, ok, nil
+}
+
+type testLookupTableExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+	testLookupTable
+}
+
+// newTestLookupTableStore returns a store whose host has a lookup table extension
+// with the id "lookuptable/owners".
+func newTestLookupTableStore() *LookupTableStore {
+	tables := NewLookupTableStore()
+	tables.SetHost(testHost{
+		component.MustNewIDWithName("lookuptable", "owners"): testLookupTableExtension{testLookupTable: testLookupTable{"checkout": "payments-team"}},
+	})
+	return tables
+}
+
+func Test_lookup(t *testing.T) {
+	tables := newTestLookupTableStore()
+
+	tests := []struct {
+		name     string
+		key      string
+		table    string
+		expected any
+	}{
+		{
+			name:     "csv with two columns",
+			key:      "checkout",
+			table:    filepath.Join("testdata", "lookup", "owners.csv"),
+			expected: "payments-team",
+		},
+		{
+			name:  "csv with more columns",
+			key:   "frontend",
+			table: filepath.Join("testdata", "lookup", "services.csv"),
+			expected: map[string]any{
+				"owner": "web-team",
+				"tier":  "silver",
+			},
+		},
+		{
+			name:  "json object",
+			key:   "checkout",
+			table: filepath.Join("testdata", "lookup", "services.json"),
+			expected: map[string]any{
+				"owner": "payments-team",
+				"tier":  "gold",
+			},
+		},
+		{
+			name:     "json array",
+			key:      "cart",
+			table:    filepath.Join("testdata", "lookup", "services.json"),
+			expected: []any{"us-east-1", "eu-west-1"},
+		},
+		{
+			name:     "json number",
+			key:      "search",
+			table:    filepath.Join("testdata", "lookup", "services.json"),
+			expected: float64(3),
+		},
+		{
+			name:     "missing key",
+			key:      "unknown",
+			table:    filepath.Join("testdata", "lookup", "owners.csv"),
+			expected: nil,
+		},
+		{
+			name:     "extension table",
+			key:      "checkout",
+			table:    "lookuptable/owners",
+			expected: "payments-team",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := ottl.StandardStringGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.key, nil
+				},
+			}
+			exprFunc, err := lookup[any](tables, key, tt.table, 0, zap.NewNop())
+			require.NoError(t, err)
+
+			result, err := exprFunc(t.Context(), nil)
+			require.NoError(t, err)
+			switch v := result.(type) {
+			case pcommon.Map:
+				assert.Equal(t, tt.expected, v.AsRaw())
+			case pcommon.Slice:
+				assert.Equal(t, tt.expected, v.AsRaw())
+			default:
+				assert.Equal(t, tt.expected, v)
+			}
+		})
+	}
+}
+
+func Test_lookup_copy(t *testing.T) {
+	key := ottl.StandardStringGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return "checkout", nil
+		},
+	}
+	exprFunc, err := lookup[any](NewLookupTableStore(), key, filepath.Join("testdata", "lookup", "services.json"), 0, zap.NewNop())
+	require.NoError(t, err)
+
+	result, err := exprFunc(t.Context(), nil)
+	require.NoError(t, err)
+	result.(pcommon.Map).PutStr("owner", "changed")
+
+	result, err = exprFunc(t.Context(), nil)
+	require.NoError(t, err)
+	owner, _ := result.(pcommon.Map).Get("owner")
+	assert.Equal(t, "payments-team", owner.Str())
+}
+
+func Test_lookup_reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "owners.csv")
+	require.NoError(t, os.WriteFile(path, []byte("service,owner\ncheckout,payments-team\n"), 0o600))
+
+	table, err := newFileLookupTable(path, time.Minute, zap.NewNop())
+	require.NoError(t, err)
+	loadedAt := table.loadedAt
+
+	require.NoError(t, os.WriteFile(path, []byte("service,owner\ncheckout,checkout-team\n"), 0o600))
+	require.NoError(t, os.Chtimes(path, loadedAt.Add(time.Second), loadedAt.Add(time.Second)))
+
+	// The ttl did not expire
+	table.reloadIfExpired(loadedAt.Add(30 * time.Second))
+	val, found, err := table.Lookup(t.Context(), "checkout")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "payments-team", val)
+
+	table.reloadIfExpired(loadedAt.Add(time.Minute))
+	val, found, err = table.Lookup(t.Context(), "checkout")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "checkout-team", val)
+
+	// The previous values are kept if the file cannot be reloaded
+	require.NoError(t, os.Remove(path))
+	table.reloadIfExpired(loadedAt.Add(2 * time.Minute))
+	val, found, err = table.Lookup(t.Context(), "checkout")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "checkout-team", val)
+}
+
+func Test_lookup_error(t *testing.T) {
+	key := ottl.StandardStringGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return "checkout", nil
+		},
+	}
+	tables := newTestLookupTableStore()
+	exprFunc, err := lookup[any](tables, key, "lookuptable/unknown", 0, zap.NewNop())
+	require.NoError(t, err)
+
+	_, err = exprFunc(t.Context(), nil)
+	assert.EqualError(t, err, `lookup table extension "lookuptable/unknown" not found`)
+
+	tables.SetHost(nil)
+	exprFunc, err = lookup[any](tables, key, "lookuptable/owners", 0, zap.NewNop())
+	require.NoError(t, err)
+	_, err = exprFunc(t.Context(), nil)
+	assert.EqualError(t, err, "the lookup tables are not available")
+}
+
+func Test_lookup_invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		table   string
+		ttl     time.Duration
+		wantErr string
+	}{
+		{
+			name:    "empty table",
+			wantErr: "the table of the Lookup function cannot be empty",
+		},
+		{
+			name:    "missing file",
+			table:   filepath.Join("testdata", "lookup", "missing.csv"),
+			wantErr: "failed to read lookup table",
+		},
+		{
+			name:    "invalid json",
+			table:   filepath.Join("testdata", "lookup", "invalid.json"),
+			wantErr: "failed to parse lookup table",
+		},
+		{
+			name:    "ttl of extension table",
+			table:   "lookuptable/owners",
+			ttl:     time.Minute,
+			wantErr: "the ttl of the Lookup function is only supported for files",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := lookup[any](NewLookupTableStore(), ottl.StandardStringGetter[any]{}, tt.table, tt.ttl, zap.NewNop())
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_createLookupFunction_ttl(t *testing.T) {
+	t.Cleanup(ottltest.SetFeatureGateForTest(t, metadata.OttlFunctionsEnableLookupFeatureGate, true))
+
+	table := filepath.Join("testdata", "lookup", "owners.csv")
+	nonLiteral, err := ottl.NewTestingLiteralGetter[any, time.Duration](false, ottl.StandardDurationGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return time.Minute, nil
+		},
+	})
+	require.NoError(t, err)
+	negative, err := ottl.NewTestingLiteralGetter[any, time.Duration](true, ottl.StandardDurationGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return -time.Minute, nil
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = createLookupFunction[any](NewLookupTableStore(), ottl.FunctionContext{}, &LookupArguments[any]{
+		Table: table,
+		TTL:   ottl.NewTestingOptional[ottl.DurationGetter[any]](nonLiteral),
+	})
+	assert.ErrorContains(t, err, "the ttl of the Lookup function must be a literal duration")
+
+	_, err = createLookupFunction[any](NewLookupTableStore(), ottl.FunctionContext{}, &LookupArguments[any]{
+		Table: table,
+		TTL:   ottl.NewTestingOptional[ottl.DurationGetter[any]](negative),
+	})
+	assert.ErrorContains(t, err, "the ttl of the Lookup function cannot be negative")
+}
+
+func Test_GetLookupTable(t *testing.T) {
+	tableID := component.MustNewIDWithName("lookuptable", "owners")
+	otherID := component.MustNewID("other")
+	host := testHost{
+		tableID: testLookupTableExtension{testLookupTable: testLookupTable{"checkout": "payments-team"}},
+		otherID: struct {
+			component.StartFunc
+			component.ShutdownFunc
+		}{},
+	}
+
+	table, err := GetLookupTable(host, tableID)
+	require.NoError(t, err)
+	val, found, err := table.Lookup(t.Context(), "checkout")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "payments-team", val)
+
+	_, err = GetLookupTable(host, otherID)
+	assert.EqualError(t, err, `extension "other" is not a lookup table`)
+
+	_, err = GetLookupTable(host, component.MustNewID("missing"))
+	assert.EqualError(t, err, `lookup table extension "missing" not found`)
+}
+
+func Test_LookupTableStore_files(t *testing.T) {
+	tables := NewLookupTableStore()
+	path := filepath.Join("testdata", "lookup", "owners.csv")
+
+	first, err := tables.file(path, 0, zap.NewNop())
+	require.NoError(t, err)
+	second, err := tables.file(path, time.Hour, zap.NewNop())
+	require.NoError(t, err)
+	third, err := tables.file(path, time.Minute, zap.NewNop())
+	require.NoError(t, err)
+
+	// The statements using the same file share its table, with the shortest ttl
+	assert.Same(t, first, second)
+	assert.Same(t, first, third)
+	assert.Equal(t, time.Minute, first.ttl)
+
+	other, err := NewLookupTableStore().file(path, 0, zap.NewNop())
+	require.NoError(t, err)
+	assert.NotSame(t, first, other)
+}
+
+func Test_createLookupFunction_featureGate(t *testing.T) {
+	t.Cleanup(ottltest.SetFeatureGateForTest(t, metadata.OttlFunctionsEnableLookupFeatureGate, false))
+
+	_, err := createLookupFunction[any](NewLookupTableStore(), ottl.FunctionContext{}, &LookupArguments[any]{
+		Table: filepath.Join("testdata", "lookup", "owners.csv"),
+	})
+	assert.EqualError(t, err, "the Lookup function requires the `ottl.functions.enableLookup` feature gate to be enabled")
+}
@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type MaxArguments[K any] struct {
+	Target ottl.PSliceGetter[K]
+}
+
+func NewMaxFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Max", &MaxArguments[K]{}, createMaxFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createMaxFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*MaxArguments[K])
+
+	if !ok {
+		return nil, errors.New("MaxFactory args must be of type *MaxArguments[K]")
+	}
+
+	return sliceMax(args.Target), nil
+}
+
+func sliceMax[K any](target ottl.PSliceGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		slice, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		return sliceExtremum("Max", slice, false)
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Max(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []any
+		expected any
+	}{
+		{
+			name:     "integers",
+			values:   []any{int64(1), int64(3), int64(2)},
+			expected: int64(3),
+		},
+		{
+			name:     "integers and doubles",
+			values:   []any{int64(3), 3.5, int64(2)},
+			expected: 3.5,
+		},
+		{
+			name:     "strings",
+			values:   []any{"2024-01-02", "2024-01-03", "2024-01-01"},
+			expected: "2024-01-03",
+		},
+		{
+			name:     "empty list",
+			values:   []any{nil},
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := sliceMax(sliceGetter(tt.values...))(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Max_error(t *testing.T) {
+	_, err := sliceMax(sliceGetter(int64(1), "a"))(t.Context(), nil)
+	assert.EqualError(t, err, "Max: cannot compare a value of type Int with a value of type Str")
+}
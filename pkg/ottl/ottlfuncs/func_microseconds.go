@@ -15,7 +15,7 @@ type MicrosecondsArguments[K any] struct {
 }
 
 func NewMicrosecondsFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("Microseconds", &MicrosecondsArguments[K]{}, createMicrosecondsFunction[K])
+	return ottl.NewFactory("Microseconds", &MicrosecondsArguments[K]{}, createMicrosecondsFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createMicrosecondsFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
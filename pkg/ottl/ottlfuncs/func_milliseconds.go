@@ -15,7 +15,7 @@ type MillisecondsArguments[K any] struct {
 }
 
 func NewMillisecondsFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("Milliseconds", &MillisecondsArguments[K]{}, createMillisecondsFunction[K])
+	return ottl.NewFactory("Milliseconds", &MillisecondsArguments[K]{}, createMillisecondsFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createMillisecondsFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
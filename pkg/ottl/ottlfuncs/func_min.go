@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type MinArguments[K any] struct {
+	Target ottl.PSliceGetter[K]
+}
+
+func NewMinFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Min", &MinArguments[K]{}, createMinFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createMinFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*MinArguments[K])
+
+	if !ok {
+		return nil, errors.New("MinFactory args must be of type *MinArguments[K]")
+	}
+
+	return sliceMin(args.Target), nil
+}
+
+func sliceMin[K any](target ottl.PSliceGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		slice, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		return sliceExtremum("Min", slice, true)
+	}
+}
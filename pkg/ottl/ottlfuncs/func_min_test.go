@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Min(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []any
+		expected any
+	}{
+		{
+			name:     "integers",
+			values:   []any{int64(3), int64(1), int64(2)},
+			expected: int64(1),
+		},
+		{
+			name:     "integers and doubles",
+			values:   []any{int64(3), 1.5, int64(2)},
+			expected: 1.5,
+		},
+		{
+			name:     "strings",
+			values:   []any{"b", "a", "c"},
+			expected: "a",
+		},
+		{
+			name:     "nil values are ignored",
+			values:   []any{nil, int64(2), nil},
+			expected: int64(2),
+		},
+		{
+			name:     "empty list",
+			values:   []any{},
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := sliceMin(sliceGetter(tt.values...))(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Min_error(t *testing.T) {
+	tests := []struct {
+		name          string
+		values        []any
+		expectedError string
+	}{
+		{
+			name:          "strings and numbers",
+			values:        []any{"a", int64(1)},
+			expectedError: "Min: cannot compare a value of type Str with a value of type Int",
+		},
+		{
+			name:          "map",
+			values:        []any{int64(1), map[string]any{}},
+			expectedError: "Min: the list must only contain numbers or strings, but it contains a value of type Map",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := sliceMin(sliceGetter(tt.values...))(t.Context(), nil)
+			assert.EqualError(t, err, tt.expectedError)
+		})
+	}
+}
@@ -15,7 +15,7 @@ type MonthArguments[K any] struct {
 }
 
 func NewMonthFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("Month", &MonthArguments[K]{}, createMonthFunction[K])
+	return ottl.NewFactory("Month", &MonthArguments[K]{}, createMonthFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createMonthFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
@@ -19,7 +19,7 @@ type Murmur3Hash128Arguments[K any] struct {
 }
 
 func NewMurmur3Hash128Factory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("Murmur3Hash128", &Murmur3Hash128Arguments[K]{}, createMurmur3Hash128Function[K])
+	return ottl.NewFactory("Murmur3Hash128", &Murmur3Hash128Arguments[K]{}, createMurmur3Hash128Function[K], ottl.WithPureFunction[K]())
 }
 
 func createMurmur3Hash128Function[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
@@ -15,7 +15,7 @@ type NanosecondArguments[K any] struct {
 }
 
 func NewNanosecondFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("Nanosecond", &NanosecondArguments[K]{}, createNanosecondFunction[K])
+	return ottl.NewFactory("Nanosecond", &NanosecondArguments[K]{}, createNanosecondFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createNanosecondFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
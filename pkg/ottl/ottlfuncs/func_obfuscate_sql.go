@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/sqlutils"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type ObfuscateSQLArguments[K any] struct {
+	Target ottl.StringGetter[K]
+}
+
+func NewObfuscateSQLFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("ObfuscateSQL", &ObfuscateSQLArguments[K]{}, createObfuscateSQLFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createObfuscateSQLFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ObfuscateSQLArguments[K])
+
+	if !ok {
+		return nil, errors.New("ObfuscateSQLFactory args must be of type *ObfuscateSQLArguments[K]")
+	}
+
+	return obfuscateSQL(args.Target), nil
+}
+
+func obfuscateSQL[K any](target ottl.StringGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		return sqlutils.ObfuscateQuery(val)
+	}
+}
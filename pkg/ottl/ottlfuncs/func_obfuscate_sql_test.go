@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ObfuscateSQL(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        any
+		expected      any
+		expectedError string
+	}{
+		{
+			name:     "query",
+			target:   "SELECT * FROM users WHERE email = 'alice@example.com' AND id IN (1, 2, 3)",
+			expected: "SELECT * FROM users WHERE email = ? AND id IN (?)",
+		},
+		{
+			name:     "multi-line query with comments",
+			target:   "UPDATE accounts\n  SET balance = 100.50 -- new balance\n  WHERE id = 42",
+			expected: "UPDATE accounts SET balance = ? WHERE id = ?",
+		},
+		{
+			name:          "unterminated string",
+			target:        "SELECT * FROM users WHERE email = 'alice",
+			expectedError: "unterminated string literal",
+		},
+		{
+			name:          "non-string",
+			target:        10,
+			expectedError: "expected string but got int",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := obfuscateSQL[any](&ottl.StandardStringGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.target, nil
+				},
+			})
+			result, err := exprFunc(t.Context(), nil)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type ParseAuthHeaderArguments[K any] struct {
+	Target ottl.StringGetter[K]
+}
+
+func NewParseAuthHeaderFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("ParseAuthHeader", &ParseAuthHeaderArguments[K]{}, createParseAuthHeaderFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createParseAuthHeaderFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ParseAuthHeaderArguments[K])
+	if !ok {
+		return nil, errors.New("ParseAuthHeaderFactory args must be of type *ParseAuthHeaderArguments[K]")
+	}
+
+	return parseAuthHeader(args.Target), nil
+}
+
+func parseAuthHeader[K any](target ottl.StringGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		header, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		scheme, credentials, _ := strings.Cut(strings.TrimSpace(header), " ")
+		if scheme == "" {
+			return nil, errors.New("cannot parse an empty authorization header")
+		}
+		if !isHTTPToken(scheme) {
+			return nil, fmt.Errorf("invalid authorization scheme %q", scheme)
+		}
+
+		result := pcommon.NewMap()
+		result.PutStr("scheme", scheme)
+		credentials = strings.TrimSpace(credentials)
+		switch {
+		case credentials == "":
+		case isToken68(credentials):
+			result.PutStr("token", credentials)
+		default:
+			if err := parseAuthParams(credentials, result.PutEmptyMap("params")); err != nil {
+				return nil, fmt.Errorf("failed to parse the authorization parameters: %w", err)
+			}
+		}
+		return result, nil
+	}
+}
+
+// parseAuthParams parses the comma separated list of `name=value` parameters, whose values are
+// tokens or quoted strings, as defined by RFC 9110. The names are case-insensitive, so they are lowercased.
+func parseAuthParams(s string, params pcommon.Map) error {
+	for s != "" {
+		s = strings.TrimLeft(s, " \t,")
+		if s == "" {
+			break
+		}
+		i := strings.IndexByte(s, '=')
+		if i < 0 {
+			return fmt.Errorf("missing value for parameter %q", s)
+		}
+		name := strings.TrimSpace(s[:i])
+		if !isHTTPToken(name) {
+			return fmt.Errorf("invalid parameter name %q", name)
+		}
+		s = strings.TrimLeft(s[i+1:], " \t")
+
+		var value string
+		if strings.HasPrefix(s, `"`) {
+			var err error
+			value, s, err = cutQuotedString(s)
+			if err != nil {
+				return fmt.Errorf("invalid value for parameter %q: %w", name, err)
+			}
+		} else {
+			end := strings.IndexByte(s, ',')
+			if end < 0 {
+				end = len(s)
+			}
+			value, s = strings.TrimSpace(s[:end]), s[end:]
+		}
+		params.PutStr(strings.ToLower(name), value)
+
+		s = strings.TrimLeft(s, " \t")
+		if s != "" && s[0] != ',' {
+			return fmt.Errorf("unexpected characters after parameter %q", name)
+		}
+	}
+	return nil
+}
+
+// cutQuotedString returns the unescaped content of the quoted string starting s, and the rest of s.
+func cutQuotedString(s string) (string, string, error) {
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+			if i < len(s) {
+				b.WriteByte(s[i])
+			}
+		case '"':
+			return b.String(), s[i+1:], nil
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return "", "", errors.New("unterminated quoted string")
+}
+
+// isHTTPToken returns true if s is a token as defined by RFC 9110.
+func isHTTPToken(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !isAlphanumeric(c) && !strings.ContainsRune("!#$%&'*+-.^_`|~", rune(c)) {
+			return false
+		}
+	}
+	return true
+}
+
+// isToken68 returns true if s is a token68 as defined by RFC 9110, such as the base64
+// encoded credentials of the Basic scheme or the token of the Bearer scheme.
+func isToken68(s string) bool {
+	trimmed := strings.TrimRight(s, "=")
+	if trimmed == "" {
+		return false
+	}
+	for i := 0; i < len(trimmed); i++ {
+		c := trimmed[i]
+		if !isAlphanumeric(c) && !strings.ContainsRune("-._~+/", rune(c)) {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphanumeric(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_parseAuthHeader(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected map[string]any
+	}{
+		{
+			name:   "bearer",
+			header: "Bearer eyJhbGciOiJIUzI1NiJ9.e30.ZRrHA1JJJW8opsbCGfG_HACGpVUMN_a9IV7pAx_Zmeo",
+			expected: map[string]any{
+				"scheme": "Bearer",
+				"token":  "eyJhbGciOiJIUzI1NiJ9.e30.ZRrHA1JJJW8opsbCGfG_HACGpVUMN_a9IV7pAx_Zmeo",
+			},
+		},
+		{
+			name:   "basic with padding",
+			header: "Basic dXNlcjpwYXNz==",
+			expected: map[string]any{
+				"scheme": "Basic",
+				"token":  "dXNlcjpwYXNz==",
+			},
+		},
+		{
+			name:   "scheme only",
+			header: " Negotiate ",
+			expected: map[string]any{
+				"scheme": "Negotiate",
+			},
+		},
+		{
+			name:   "parameters",
+			header: `Digest username="Mufasa", Realm="http-auth@example.org", qop=auth, nc=00000001,opaque="FQhe\"/qHfn"`,
+			expected: map[string]any{
+				"scheme": "Digest",
+				"params": map[string]any{
+					"username": "Mufasa",
+					"realm":    "http-auth@example.org",
+					"qop":      "auth",
+					"nc":       "00000001",
+					"opaque":   `FQhe"/qHfn`,
+				},
+			},
+		},
+		{
+			name:   "single parameter",
+			header: "AWS4-HMAC-SHA256 Credential=AKIA/20240101/us-east-1/s3/aws4_request",
+			expected: map[string]any{
+				"scheme": "AWS4-HMAC-SHA256",
+				"params": map[string]any{
+					"credential": "AKIA/20240101/us-east-1/s3/aws4_request",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parseAuthHeader(stringGetter(tt.header))(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.(pcommon.Map).AsRaw())
+		})
+	}
+}
+
+func Test_parseAuthHeader_error(t *testing.T) {
+	tests := []struct {
+		name          string
+		header        any
+		expectedError string
+	}{
+		{
+			name:          "empty",
+			header:        "  ",
+			expectedError: "cannot parse an empty authorization header",
+		},
+		{
+			name:          "invalid scheme",
+			header:        "token=abc",
+			expectedError: `invalid authorization scheme "token=abc"`,
+		},
+		{
+			name:          "missing parameter value",
+			header:        "Custom a=1, b",
+			expectedError: `failed to parse the authorization parameters: missing value for parameter "b"`,
+		},
+		{
+			name:          "unterminated quoted string",
+			header:        `Digest realm="example`,
+			expectedError: `failed to parse the authorization parameters: invalid value for parameter "realm": unterminated quoted string`,
+		},
+		{
+			name:          "characters after quoted string",
+			header:        `Digest realm="example" nonce="abc"`,
+			expectedError: `failed to parse the authorization parameters: unexpected characters after parameter "realm"`,
+		},
+		{
+			name:          "non-string",
+			header:        10,
+			expectedError: "expected string but got int",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseAuthHeader(stringGetter(tt.header))(t.Context(), nil)
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type ParseCookiesArguments[K any] struct {
+	Target ottl.StringGetter[K]
+}
+
+func NewParseCookiesFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("ParseCookies", &ParseCookiesArguments[K]{}, createParseCookiesFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createParseCookiesFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ParseCookiesArguments[K])
+	if !ok {
+		return nil, errors.New("ParseCookiesFactory args must be of type *ParseCookiesArguments[K]")
+	}
+
+	return parseCookies(args.Target), nil
+}
+
+func parseCookies[K any](target ottl.StringGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		header, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		result := pcommon.NewMap()
+		for pair := range strings.SplitSeq(header, ";") {
+			name, value, found := strings.Cut(pair, "=")
+			name = strings.TrimSpace(name)
+			if !found || name == "" {
+				continue
+			}
+			// User agents send the most specific cookie first when several have the same name.
+			if _, exists := result.Get(name); exists {
+				continue
+			}
+			value = strings.TrimSpace(value)
+			if len(value) > 1 && value[0] == '"' && value[len(value)-1] == '"' {
+				value = value[1 : len(value)-1]
+			}
+			result.PutStr(name, value)
+		}
+		return result, nil
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_parseCookies(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected map[string]any
+	}{
+		{
+			name:   "single cookie",
+			header: "session=abc123",
+			expected: map[string]any{
+				"session": "abc123",
+			},
+		},
+		{
+			name:   "multiple cookies",
+			header: "session=abc123; theme=dark;lang=en-US",
+			expected: map[string]any{
+				"session": "abc123",
+				"theme":   "dark",
+				"lang":    "en-US",
+			},
+		},
+		{
+			name:   "quoted value",
+			header: `id="a b"; empty=""`,
+			expected: map[string]any{
+				"id":    "a b",
+				"empty": "",
+			},
+		},
+		{
+			name:   "value with equal sign",
+			header: "token=YWJj==; flag=",
+			expected: map[string]any{
+				"token": "YWJj==",
+				"flag":  "",
+			},
+		},
+		{
+			name:   "first cookie with the same name wins",
+			header: "id=specific; id=generic",
+			expected: map[string]any{
+				"id": "specific",
+			},
+		},
+		{
+			name:   "invalid pairs are ignored",
+			header: "; novalue; =orphan;  ok = 1 ",
+			expected: map[string]any{
+				"ok": "1",
+			},
+		},
+		{
+			name:     "empty header",
+			header:   "",
+			expected: map[string]any{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parseCookies(stringGetter(tt.header))(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.(pcommon.Map).AsRaw())
+		})
+	}
+}
+
+func Test_parseCookies_error(t *testing.T) {
+	_, err := parseCookies(stringGetter(1))(t.Context(), nil)
+	assert.ErrorContains(t, err, "expected string but got int")
+}
@@ -19,7 +19,7 @@ type ParseJSONArguments[K any] struct {
 }
 
 func NewParseJSONFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("ParseJSON", &ParseJSONArguments[K]{}, createParseJSONFunction[K])
+	return ottl.NewFactory("ParseJSON", &ParseJSONArguments[K]{}, createParseJSONFunction[K], ottl.WithMemoizedFunction[K]())
 }
 
 func createParseJSONFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
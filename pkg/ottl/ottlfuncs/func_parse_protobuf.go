@@ -0,0 +1,192 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// ProtobufDescriptors are the protobuf message descriptors used by the ParseProtobuf Converter.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type ProtobufDescriptors struct {
+	files *protoregistry.Files
+}
+
+// LoadProtobufDescriptors loads the descriptor sets written in the files, as generated by
+// `protoc --include_imports --descriptor_set_out`. The descriptor sets must contain the
+// files imported by the messages they describe.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func LoadProtobufDescriptors(descriptorSetFiles ...string) (*ProtobufDescriptors, error) {
+	if len(descriptorSetFiles) == 0 {
+		return nil, errors.New("at least one protobuf descriptor set file must be provided")
+	}
+	merged := &descriptorpb.FileDescriptorSet{}
+	loaded := map[string]struct{}{}
+	for _, path := range descriptorSetFiles {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the protobuf descriptor set %q: %w", path, err)
+		}
+		set := &descriptorpb.FileDescriptorSet{}
+		if err = proto.Unmarshal(content, set); err != nil {
+			return nil, fmt.Errorf("failed to decode the protobuf descriptor set %q: %w", path, err)
+		}
+		// The descriptor sets commonly share their imports, which are only loaded once.
+		for _, file := range set.GetFile() {
+			if _, ok := loaded[file.GetName()]; ok {
+				continue
+			}
+			loaded[file.GetName()] = struct{}{}
+			merged.File = append(merged.File, file)
+		}
+	}
+	files, err := protodesc.NewFiles(merged)
+	if err != nil {
+		return nil, fmt.Errorf("invalid protobuf descriptor sets: %w", err)
+	}
+	return &ProtobufDescriptors{files: files}, nil
+}
+
+func (d *ProtobufDescriptors) findMessage(name string) (protoreflect.MessageDescriptor, error) {
+	descriptor, err := d.files.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, fmt.Errorf("message %q not found in the protobuf descriptor sets", name)
+	}
+	message, ok := descriptor.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a protobuf message", name)
+	}
+	return message, nil
+}
+
+type ParseProtobufArguments[K any] struct {
+	Target  ottl.ByteSliceLikeGetter[K]
+	Message string
+}
+
+// NewParseProtobufFactory returns the factory of the ParseProtobuf Converter, decoding the
+// messages of the descriptors. Since the descriptors are provided by the user, ParseProtobuf
+// is not part of the standard functions, and must be registered by the components with the
+// descriptors loaded from their configuration.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func NewParseProtobufFactory[K any](descriptors *ProtobufDescriptors) ottl.Factory[K] {
+	return ottl.NewFactory("ParseProtobuf", &ParseProtobufArguments[K]{}, func(_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+		return createParseProtobufFunction[K](descriptors, oArgs)
+	}, ottl.WithPureFunction[K]())
+}
+
+func createParseProtobufFunction[K any](descriptors *ProtobufDescriptors, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ParseProtobufArguments[K])
+
+	if !ok {
+		return nil, errors.New("ParseProtobufFactory args must be of type *ParseProtobufArguments[K]")
+	}
+
+	return parseProtobuf(descriptors, args.Target, args.Message)
+}
+
+func parseProtobuf[K any](descriptors *ProtobufDescriptors, target ottl.ByteSliceLikeGetter[K], messageName string) (ottl.ExprFunc[K], error) {
+	if descriptors == nil {
+		return nil, errors.New("the ParseProtobuf function requires protobuf descriptor sets")
+	}
+	message, err := descriptors.findMessage(messageName)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			return nil, errors.New("cannot parse a nil protobuf message")
+		}
+		decoded := dynamicpb.NewMessage(message)
+		if err = proto.Unmarshal(val, decoded); err != nil {
+			return nil, fmt.Errorf("failed to decode the %s protobuf message: %w", messageName, err)
+		}
+		result := pcommon.NewMap()
+		protobufMessageToMap(decoded, result)
+		return result, nil
+	}, nil
+}
+
+// protobufMessageToMap sets the populated fields of the message into the map, keyed by
+// their name. The fields which are not set, including the proto3 fields set to their
+// default value, are not present in the map.
+func protobufMessageToMap(message protoreflect.Message, m pcommon.Map) {
+	m.EnsureCapacity(message.Descriptor().Fields().Len())
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		dest := m.PutEmpty(string(field.Name()))
+		switch {
+		case field.IsList():
+			list := value.List()
+			slice := dest.SetEmptySlice()
+			slice.EnsureCapacity(list.Len())
+			for i := 0; i < list.Len(); i++ {
+				setProtobufValue(field, list.Get(i), slice.AppendEmpty())
+			}
+		case field.IsMap():
+			entries := dest.SetEmptyMap()
+			value.Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+				setProtobufValue(field.MapValue(), value, entries.PutEmpty(key.String()))
+				return true
+			})
+		default:
+			setProtobufValue(field, value, dest)
+		}
+		return true
+	})
+}
+
+// setProtobufValue sets the singular value of the field into dest. Enums are set to the
+// name of their value if it is known, and unsigned integers which cannot be represented
+// as an int64 are set as doubles.
+func setProtobufValue(field protoreflect.FieldDescriptor, value protoreflect.Value, dest pcommon.Value) {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		dest.SetBool(value.Bool())
+	case protoreflect.EnumKind:
+		if enumValue := field.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			dest.SetStr(string(enumValue.Name()))
+		} else {
+			dest.SetInt(int64(value.Enum()))
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		dest.SetInt(value.Int())
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if u := value.Uint(); u <= math.MaxInt64 {
+			dest.SetInt(int64(u))
+		} else {
+			dest.SetDouble(float64(u))
+		}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		dest.SetDouble(value.Float())
+	case protoreflect.StringKind:
+		dest.SetStr(value.String())
+	case protoreflect.BytesKind:
+		dest.SetEmptyBytes().FromRaw(value.Bytes())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		protobufMessageToMap(value.Message(), dest.SetEmptyMap())
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func protobufTestField(name string, number int32, fieldType descriptorpb.FieldDescriptorProto_Type, typeName string, repeated bool) *descriptorpb.FieldDescriptorProto {
+	label := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	if repeated {
+		label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	}
+	field := &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		JsonName: proto.String(name),
+		Number:   proto.Int32(number),
+		Label:    label.Enum(),
+		Type:     fieldType.Enum(),
+	}
+	if typeName != "" {
+		field.TypeName = proto.String(typeName)
+	}
+	return field
+}
+
+// writeProtobufTestDescriptors writes a descriptor set describing the following file, and returns its path:
+//
+//	syntax = "proto3";
+//	package test;
+//	enum Level { LEVEL_UNSPECIFIED = 0; LEVEL_INFO = 1; }
+//	message Inner { string name = 1; }
+//	message Event {
+//	  string message = 1; int64 count = 2; bool ok = 3; double ratio = 4; bytes payload = 5;
+//	  Level level = 6; Inner inner = 7; repeated string tags = 8; map<string, int64> labels = 9;
+//	  uint64 big = 10;
+//	}
+func writeProtobufTestDescriptors(t *testing.T) string {
+	set := &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{{
+		Name:    proto.String("test.proto"),
+		Package: proto.String("test"),
+		Syntax:  proto.String("proto3"),
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name: proto.String("Level"),
+			Value: []*descriptorpb.EnumValueDescriptorProto{
+				{Name: proto.String("LEVEL_UNSPECIFIED"), Number: proto.Int32(0)},
+				{Name: proto.String("LEVEL_INFO"), Number: proto.Int32(1)},
+			},
+		}},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name:  proto.String("Inner"),
+				Field: []*descriptorpb.FieldDescriptorProto{protobufTestField("name", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, "", false)},
+			},
+			{
+				Name: proto.String("Event"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					protobufTestField("message", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, "", false),
+					protobufTestField("count", 2, descriptorpb.FieldDescriptorProto_TYPE_INT64, "", false),
+					protobufTestField("ok", 3, descriptorpb.FieldDescriptorProto_TYPE_BOOL, "", false),
+					protobufTestField("ratio", 4, descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, "", false),
+					protobufTestField("payload", 5, descriptorpb.FieldDescriptorProto_TYPE_BYTES, "", false),
+					protobufTestField("level", 6, descriptorpb.FieldDescriptorProto_TYPE_ENUM, ".test.Level", false),
+					protobufTestField("inner", 7, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".test.Inner", false),
+					protobufTestField("tags", 8, descriptorpb.FieldDescriptorProto_TYPE_STRING, "", true),
+					protobufTestField("labels", 9, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".test.Event.LabelsEntry", true),
+					protobufTestField("big", 10, descriptorpb.FieldDescriptorProto_TYPE_UINT64, "", false),
+				},
+				NestedType: []*descriptorpb.DescriptorProto{{
+					Name: proto.String("LabelsEntry"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						protobufTestField("key", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, "", false),
+						protobufTestField("value", 2, descriptorpb.FieldDescriptorProto_TYPE_INT64, "", false),
+					},
+					Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+				}},
+			},
+		},
+	}}}
+	content, err := proto.Marshal(set)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "test.pb")
+	require.NoError(t, os.WriteFile(path, content, 0o600))
+	return path
+}
+
+func newProtobufTestEvent(t *testing.T, descriptors *ProtobufDescriptors) []byte {
+	descriptor, err := descriptors.findMessage("test.Event")
+	require.NoError(t, err)
+	fields := descriptor.Fields()
+	event := dynamicpb.NewMessage(descriptor)
+	event.Set(fields.ByName("message"), protoreflect.ValueOfString("hello"))
+	event.Set(fields.ByName("count"), protoreflect.ValueOfInt64(-3))
+	event.Set(fields.ByName("ok"), protoreflect.ValueOfBool(true))
+	event.Set(fields.ByName("ratio"), protoreflect.ValueOfFloat64(0.5))
+	event.Set(fields.ByName("payload"), protoreflect.ValueOfBytes([]byte{1, 2}))
+	event.Set(fields.ByName("level"), protoreflect.ValueOfEnum(1))
+	inner := event.Mutable(fields.ByName("inner")).Message()
+	inner.Set(inner.Descriptor().Fields().ByName("name"), protoreflect.ValueOfString("nested"))
+	tags := event.Mutable(fields.ByName("tags")).List()
+	tags.Append(protoreflect.ValueOfString("a"))
+	tags.Append(protoreflect.ValueOfString("b"))
+	labels := event.Mutable(fields.ByName("labels")).Map()
+	labels.Set(protoreflect.ValueOfString("key").MapKey(), protoreflect.ValueOfInt64(7))
+	event.Set(fields.ByName("big"), protoreflect.ValueOfUint64(math.MaxUint64))
+	content, err := proto.Marshal(event)
+	require.NoError(t, err)
+	return content
+}
+
+func Test_ParseProtobuf(t *testing.T) {
+	descriptors, err := LoadProtobufDescriptors(writeProtobufTestDescriptors(t))
+	require.NoError(t, err)
+	event := newProtobufTestEvent(t, descriptors)
+
+	tests := []struct {
+		name     string
+		target   any
+		expected map[string]any
+	}{
+		{
+			name:   "all field types",
+			target: event,
+			expected: map[string]any{
+				"message": "hello",
+				"count":   int64(-3),
+				"ok":      true,
+				"ratio":   0.5,
+				"payload": []byte{1, 2},
+				"level":   "LEVEL_INFO",
+				"inner":   map[string]any{"name": "nested"},
+				"tags":    []any{"a", "b"},
+				"labels":  map[string]any{"key": int64(7)},
+				"big":     float64(math.MaxUint64),
+			},
+		},
+		{
+			name: "bytes value",
+			target: func() pcommon.Value {
+				v := pcommon.NewValueBytes()
+				v.Bytes().FromRaw([]byte{0x0a, 0x02, 'h', 'i', 0x30, 0x05})
+				return v
+			}(),
+			expected: map[string]any{
+				"message": "hi",
+				"level":   int64(5),
+			},
+		},
+		{
+			name:     "empty message",
+			target:   []byte{},
+			expected: map[string]any{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardByteSliceLikeGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.target, nil
+				},
+			}
+			exprFunc, err := parseProtobuf[any](descriptors, target, "test.Event")
+			require.NoError(t, err)
+			result, err := exprFunc(t.Context(), nil)
+			require.NoError(t, err)
+			require.IsType(t, pcommon.Map{}, result)
+			assert.Equal(t, tt.expected, result.(pcommon.Map).AsRaw())
+		})
+	}
+}
+
+func Test_ParseProtobuf_error(t *testing.T) {
+	descriptors, err := LoadProtobufDescriptors(writeProtobufTestDescriptors(t))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		target        any
+		expectedError string
+	}{
+		{
+			name:          "nil",
+			target:        nil,
+			expectedError: "cannot parse a nil protobuf message",
+		},
+		{
+			name:          "invalid message",
+			target:        []byte{0x0a, 0x05, 'h'},
+			expectedError: "failed to decode the test.Event protobuf message",
+		},
+		{
+			name:          "unsupported type",
+			target:        map[string]any{},
+			expectedError: "unsupported type",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardByteSliceLikeGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.target, nil
+				},
+			}
+			exprFunc, err := parseProtobuf[any](descriptors, target, "test.Event")
+			require.NoError(t, err)
+			_, err = exprFunc(t.Context(), nil)
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
+
+func Test_ParseProtobuf_invalidMessage(t *testing.T) {
+	descriptors, err := LoadProtobufDescriptors(writeProtobufTestDescriptors(t))
+	require.NoError(t, err)
+	target := &ottl.StandardByteSliceLikeGetter[any]{}
+
+	_, err = parseProtobuf[any](descriptors, target, "test.Missing")
+	assert.EqualError(t, err, `message "test.Missing" not found in the protobuf descriptor sets`)
+	_, err = parseProtobuf[any](descriptors, target, "test.Level")
+	assert.EqualError(t, err, `"test.Level" is not a protobuf message`)
+	_, err = parseProtobuf[any](nil, target, "test.Event")
+	assert.EqualError(t, err, "the ParseProtobuf function requires protobuf descriptor sets")
+}
+
+func Test_LoadProtobufDescriptors(t *testing.T) {
+	path := writeProtobufTestDescriptors(t)
+	descriptors, err := LoadProtobufDescriptors(path, path)
+	require.NoError(t, err)
+	_, err = descriptors.findMessage("test.Inner")
+	require.NoError(t, err)
+
+	_, err = LoadProtobufDescriptors()
+	assert.EqualError(t, err, "at least one protobuf descriptor set file must be provided")
+
+	_, err = LoadProtobufDescriptors(filepath.Join(t.TempDir(), "missing.pb"))
+	assert.ErrorContains(t, err, "failed to read the protobuf descriptor set")
+
+	invalid := filepath.Join(t.TempDir(), "invalid.pb")
+	require.NoError(t, os.WriteFile(invalid, []byte{0x0a, 0x05}, 0o600))
+	_, err = LoadProtobufDescriptors(invalid)
+	assert.ErrorContains(t, err, "failed to decode the protobuf descriptor set")
+}
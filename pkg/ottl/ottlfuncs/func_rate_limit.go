@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// maxRateLimitKeys is the maximum number of keys tracked by a RateLimit function. Once it is
+// reached and no window has expired, the other keys share a single window, so keys built from
+// telemetry values cannot grow the memory used by the function indefinitely.
+const maxRateLimitKeys = 10000
+
+type RateLimitArguments[K any] struct {
+	Key   ottl.StringGetter[K]
+	Limit int64
+	Per   ottl.DurationGetter[K]
+}
+
+func NewRateLimitFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("RateLimit", &RateLimitArguments[K]{}, createRateLimitFunction[K])
+}
+
+func createRateLimitFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*RateLimitArguments[K])
+	if !ok {
+		return nil, errors.New("RateLimitFactory args must be of type *RateLimitArguments[K]")
+	}
+
+	per, isLiteral := ottl.GetLiteralValue(args.Per)
+	if !isLiteral {
+		return nil, errors.New("the per duration of the RateLimit function must be a literal duration")
+	}
+
+	return rateLimit(args.Key, args.Limit, per, time.Now)
+}
+
+func rateLimit[K any](key ottl.StringGetter[K], limit int64, per time.Duration, now func() time.Time) (ottl.ExprFunc[K], error) {
+	if limit < 0 {
+		return nil, fmt.Errorf("the limit of the RateLimit function cannot be negative, got %d", limit)
+	}
+	if per <= 0 {
+		return nil, fmt.Errorf("the per duration of the RateLimit function must be positive, got %s", per)
+	}
+
+	limiter := &rateLimiter{
+		limit:   limit,
+		per:     per,
+		windows: map[string]*rateLimitWindow{},
+	}
+	return func(ctx context.Context, tCtx K) (any, error) {
+		k, err := key.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		return limiter.allow(k, now()), nil
+	}, nil
+}
+
+type rateLimitWindow struct {
+	start time.Time
+	count int64
+}
+
+// rateLimiter counts the calls made for each key over fixed windows of the per duration.
+type rateLimiter struct {
+	limit    int64
+	per      time.Duration
+	mu       sync.Mutex
+	windows  map[string]*rateLimitWindow
+	overflow rateLimitWindow
+}
+
+// allow returns true if the calls made for the key in the current window, including this one,
+// do not exceed the limit.
+func (l *rateLimiter) allow(key string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	w, ok := l.windows[key]
+	if !ok {
+		if len(l.windows) >= maxRateLimitKeys {
+			l.removeExpiredWindows(now)
+		}
+		if len(l.windows) >= maxRateLimitKeys {
+			w = &l.overflow
+		} else {
+			w = &rateLimitWindow{start: now}
+			l.windows[key] = w
+		}
+	}
+	if now.Sub(w.start) >= l.per {
+		w.start = now
+		w.count = 0
+	}
+	w.count++
+	return w.count <= l.limit
+}
+
+func (l *rateLimiter) removeExpiredWindows(now time.Time) {
+	for key, w := range l.windows {
+		if now.Sub(w.start) >= l.per {
+			delete(l.windows, key)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_RateLimit(t *testing.T) {
+	now := time.Unix(1000, 0)
+	exprFunc, err := rateLimit[any](
+		ottl.StandardStringGetter[any]{
+			Getter: func(_ context.Context, tCtx any) (any, error) {
+				return tCtx, nil
+			},
+		},
+		2,
+		time.Second,
+		func() time.Time { return now },
+	)
+	require.NoError(t, err)
+
+	allowed := func(key string) bool {
+		result, err := exprFunc(t.Context(), key)
+		require.NoError(t, err)
+		return result.(bool)
+	}
+
+	assert.True(t, allowed("a"))
+	assert.True(t, allowed("a"))
+	assert.False(t, allowed("a"))
+	assert.True(t, allowed("b"))
+
+	now = now.Add(500 * time.Millisecond)
+	assert.False(t, allowed("a"))
+	assert.True(t, allowed("b"))
+	assert.False(t, allowed("b"))
+
+	now = now.Add(500 * time.Millisecond)
+	assert.True(t, allowed("a"))
+	assert.True(t, allowed("a"))
+	assert.False(t, allowed("a"))
+}
+
+func Test_RateLimit_maxKeys(t *testing.T) {
+	now := time.Unix(1000, 0)
+	limiter := &rateLimiter{
+		limit:   1,
+		per:     time.Second,
+		windows: map[string]*rateLimitWindow{},
+	}
+	for i := range maxRateLimitKeys {
+		assert.True(t, limiter.allow(strconv.Itoa(i), now))
+	}
+
+	// The keys which are not tracked share a single window.
+	assert.True(t, limiter.allow("overflow1", now))
+	assert.False(t, limiter.allow("overflow2", now))
+	assert.Len(t, limiter.windows, maxRateLimitKeys)
+
+	// The expired windows are removed to track new keys.
+	now = now.Add(time.Second)
+	assert.True(t, limiter.allow("new", now))
+	assert.Len(t, limiter.windows, 1)
+}
+
+func Test_RateLimit_error(t *testing.T) {
+	_, err := rateLimit[any](ottl.StandardStringGetter[any]{}, -1, time.Second, time.Now)
+	assert.EqualError(t, err, "the limit of the RateLimit function cannot be negative, got -1")
+
+	_, err = rateLimit[any](ottl.StandardStringGetter[any]{}, 1, 0, time.Now)
+	assert.EqualError(t, err, "the per duration of the RateLimit function must be positive, got 0s")
+
+	nonLiteral, err := ottl.NewTestingLiteralGetter[any, time.Duration](false, ottl.StandardDurationGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return time.Second, nil
+		},
+	})
+	require.NoError(t, err)
+	_, err = createRateLimitFunction[any](ottl.FunctionContext{}, &RateLimitArguments[any]{
+		Key:   ottl.StandardStringGetter[any]{},
+		Limit: 1,
+		Per:   nonLiteral,
+	})
+	assert.EqualError(t, err, "the per duration of the RateLimit function must be a literal duration")
+}
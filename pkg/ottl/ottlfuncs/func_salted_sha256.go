@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type SaltedSHA256Arguments[K any] struct {
+	Target  ottl.StringGetter[K]
+	SaltRef string
+}
+
+// NewSaltedSHA256Factory returns the factory of the SaltedSHA256 Converter, using the salts of the
+// key provider of the store. Like HMAC_SHA256, it is not part of the standard functions.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func NewSaltedSHA256Factory[K any](keys *KeyStore) ottl.Factory[K] {
+	return ottl.NewFactory("SaltedSHA256", &SaltedSHA256Arguments[K]{}, func(_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+		return createSaltedSHA256Function[K](keys, oArgs)
+	})
+}
+
+func createSaltedSHA256Function[K any](keys *KeyStore, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*SaltedSHA256Arguments[K])
+
+	if !ok {
+		return nil, errors.New("SaltedSHA256Factory args must be of type *SaltedSHA256Arguments[K]")
+	}
+
+	return saltedSHA256(keys, args.Target, args.SaltRef)
+}
+
+func saltedSHA256[K any](keys *KeyStore, target ottl.StringGetter[K], saltRef string) (ottl.ExprFunc[K], error) {
+	if keys == nil {
+		return nil, errors.New("the SaltedSHA256 function requires a key store")
+	}
+	if saltRef == "" {
+		return nil, errors.New("the salt reference cannot be empty")
+	}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		salt, err := keys.key(ctx, saltRef)
+		if err != nil {
+			return nil, err
+		}
+		hash := sha256.New()
+		// Write on a hash never returns an error.
+		_, _ = hash.Write(salt)
+		_, _ = hash.Write([]byte(val))
+		return hex.EncodeToString(hash.Sum(nil)), nil
+	}, nil
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_SaltedSHA256(t *testing.T) {
+	keys := newTestKeyStore()
+
+	tests := []struct {
+		name          string
+		value         any
+		saltRef       string
+		expected      any
+		expectedError string
+	}{
+		{
+			name:     "string",
+			value:    "user@example.com",
+			saltRef:  "aes256",
+			expected: "1d2a859b5b2df33db4acbb0e51437992c7bbda964a6c59e620215413a694bccd",
+		},
+		{
+			name:     "empty string",
+			value:    "",
+			saltRef:  "aes256",
+			expected: "3eb1bd439947eb762998e566ccc2e099c791118b2f40579cc4f7da2b5061b7f9",
+		},
+		{
+			name:          "salt not found",
+			value:         "user@example.com",
+			saltRef:       "salt",
+			expectedError: `key "salt" not found`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := saltedSHA256[any](keys, &ottl.StandardStringGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.value, nil
+				},
+			}, tt.saltRef)
+			require.NoError(t, err)
+			result, err := exprFunc(t.Context(), nil)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+const sampleConsistentFuncName = "SampleConsistent"
+
+// maxSamplingAdjustedCount is 2^56, the number of distinct randomness values taken from
+// the least-significant 56 bits of a trace ID, as defined by the W3C Trace Context
+// Level 2 specification and used by the consistent probability samplers.
+const maxSamplingAdjustedCount uint64 = 1 << 56
+
+type SampleConsistentArguments[K any] struct {
+	Ratio   ottl.FloatLikeGetter[K]
+	TraceID ottl.Getter[K]
+}
+
+func NewSampleConsistentFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory(sampleConsistentFuncName, &SampleConsistentArguments[K]{}, createSampleConsistentFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createSampleConsistentFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*SampleConsistentArguments[K])
+	if !ok {
+		return nil, errors.New("SampleConsistentFactory args must be of type *SampleConsistentArguments[K]")
+	}
+
+	return sampleConsistent(args.Ratio, args.TraceID), nil
+}
+
+func sampleConsistent[K any](ratio ottl.FloatLikeGetter[K], target ottl.Getter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		r, err := ratio.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if r == nil {
+			return nil, fmt.Errorf("%s: the ratio cannot be nil", sampleConsistentFuncName)
+		}
+		threshold, err := samplingThreshold(*r)
+		if err != nil {
+			return nil, err
+		}
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		id, err := sampledTraceID(val)
+		if err != nil {
+			return nil, err
+		}
+		return traceIDRandomness(id) >= threshold, nil
+	}
+}
+
+// samplingThreshold returns the rejection threshold of the sampling ratio: the trace IDs whose
+// randomness is lower than the threshold are not sampled.
+func samplingThreshold(ratio float64) (uint64, error) {
+	if math.IsNaN(ratio) || ratio < 0 || ratio > 1 {
+		return 0, fmt.Errorf("%s: the ratio must be between 0 and 1, got %v", sampleConsistentFuncName, ratio)
+	}
+	return maxSamplingAdjustedCount - uint64(math.Round(ratio*float64(maxSamplingAdjustedCount))), nil
+}
+
+// traceIDRandomness returns the least-significant 56 bits of the trace ID.
+func traceIDRandomness(id pcommon.TraceID) uint64 {
+	return binary.BigEndian.Uint64(id[8:]) & (maxSamplingAdjustedCount - 1)
+}
+
+func sampledTraceID(val any) (pcommon.TraceID, error) {
+	var b []byte
+	switch v := val.(type) {
+	case pcommon.TraceID:
+		return v, nil
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	case pcommon.Value:
+		switch v.Type() {
+		case pcommon.ValueTypeBytes:
+			b = v.Bytes().AsRaw()
+		case pcommon.ValueTypeStr:
+			b = []byte(v.Str())
+		default:
+			return pcommon.TraceID{}, fmt.Errorf("%s: unsupported trace ID type %s", sampleConsistentFuncName, v.Type())
+		}
+	default:
+		return pcommon.TraceID{}, fmt.Errorf("%s: unsupported trace ID type %T", sampleConsistentFuncName, val)
+	}
+	var id pcommon.TraceID
+	decoded, err := bytesToID(sampleConsistentFuncName, b, len(id), len(id)*2, decodeHexToTraceID)
+	if err != nil {
+		return pcommon.TraceID{}, err
+	}
+	return decoded.(pcommon.TraceID), nil
+}
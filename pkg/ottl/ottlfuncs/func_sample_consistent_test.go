@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_SampleConsistent(t *testing.T) {
+	// The randomness of this trace ID, its least-significant 56 bits, is exactly half of 2^56.
+	half := pcommon.TraceID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x80, 0, 0, 0, 0, 0, 0}
+	belowHalf := pcommon.TraceID{0, 0, 0, 0, 0, 0, 0, 0, 0, 0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+	tests := []struct {
+		name     string
+		ratio    any
+		traceID  any
+		expected bool
+	}{
+		{
+			name:     "sampled",
+			ratio:    0.5,
+			traceID:  half,
+			expected: true,
+		},
+		{
+			name:     "not sampled",
+			ratio:    0.5,
+			traceID:  belowHalf,
+			expected: false,
+		},
+		{
+			name:     "always sampled",
+			ratio:    int64(1),
+			traceID:  pcommon.TraceID{},
+			expected: true,
+		},
+		{
+			name:     "never sampled",
+			ratio:    int64(0),
+			traceID:  pcommon.TraceID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			expected: false,
+		},
+		{
+			name:     "bytes",
+			ratio:    0.5,
+			traceID:  half[:],
+			expected: true,
+		},
+		{
+			name:     "hex string",
+			ratio:    0.5,
+			traceID:  "00000000000000000080000000000000",
+			expected: true,
+		},
+		{
+			name:     "hex string value",
+			ratio:    "0.5",
+			traceID:  pcommon.NewValueStr("0000000000000000007fffffffffffff"),
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := sampleConsistent[any](
+				ottl.StandardFloatLikeGetter[any]{
+					Getter: func(context.Context, any) (any, error) {
+						return tt.ratio, nil
+					},
+				},
+				ottl.StandardGetSetter[any]{
+					Getter: func(context.Context, any) (any, error) {
+						return tt.traceID, nil
+					},
+				},
+			)
+			result, err := exprFunc(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_SampleConsistent_error(t *testing.T) {
+	tests := []struct {
+		name          string
+		ratio         any
+		traceID       any
+		expectedError string
+	}{
+		{
+			name:          "ratio above 1",
+			ratio:         1.5,
+			traceID:       pcommon.TraceID{},
+			expectedError: "SampleConsistent: the ratio must be between 0 and 1, got 1.5",
+		},
+		{
+			name:          "negative ratio",
+			ratio:         -0.1,
+			traceID:       pcommon.TraceID{},
+			expectedError: "SampleConsistent: the ratio must be between 0 and 1, got -0.1",
+		},
+		{
+			name:          "nil ratio",
+			ratio:         nil,
+			traceID:       pcommon.TraceID{},
+			expectedError: "SampleConsistent: the ratio cannot be nil",
+		},
+		{
+			name:          "invalid trace ID length",
+			ratio:         0.5,
+			traceID:       []byte{1, 2, 3},
+			expectedError: "SampleConsistent: could not decode ID: invalid length: expected 16 or 32 bytes, got 3",
+		},
+		{
+			name:          "unsupported trace ID type",
+			ratio:         0.5,
+			traceID:       int64(1),
+			expectedError: "SampleConsistent: unsupported trace ID type int64",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := sampleConsistent[any](
+				ottl.StandardFloatLikeGetter[any]{
+					Getter: func(context.Context, any) (any, error) {
+						return tt.ratio, nil
+					},
+				},
+				ottl.StandardGetSetter[any]{
+					Getter: func(context.Context, any) (any, error) {
+						return tt.traceID, nil
+					},
+				},
+			)
+			_, err := exprFunc(t.Context(), nil)
+			assert.EqualError(t, err, tt.expectedError)
+		})
+	}
+}
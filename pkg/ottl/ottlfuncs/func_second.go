@@ -15,7 +15,7 @@ type SecondArguments[K any] struct {
 }
 
 func NewSecondFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("Second", &SecondArguments[K]{}, createSecondFunction[K])
+	return ottl.NewFactory("Second", &SecondArguments[K]{}, createSecondFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createSecondFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type SetPathArguments[K any] struct {
+	Path  ottl.StringGetter[K]
+	Value ottl.Getter[K]
+}
+
+func NewSetPathFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("set_path", &SetPathArguments[K]{}, createSetPathFunction[K])
+}
+
+func createSetPathFunction[K any](fCtx ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*SetPathArguments[K])
+
+	if !ok {
+		return nil, errors.New("SetPathFactory args must be of type *SetPathArguments[K]")
+	}
+
+	resolver, err := ottl.NewPathResolver[K](fCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return setPath(resolver, args.Path, args.Value), nil
+}
+
+func setPath[K any](resolver *ottl.PathResolver[K], pathGetter ottl.StringGetter[K], value ottl.Getter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		path, err := pathGetter.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		target, err := resolver.Resolve(path)
+		if err != nil {
+			return nil, err
+		}
+		return set[K](target, value)(ctx, tCtx)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_setPath(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		expected  map[string]any
+	}{
+		{
+			name:      "literal path",
+			statement: `set_path("target", "new")`,
+			expected:  map[string]any{"target": "new", "mapping": "target"},
+		},
+		{
+			name:      "path from the telemetry",
+			statement: `set_path(mapping, 1)`,
+			expected:  map[string]any{"target": int64(1), "mapping": "target"},
+		},
+		{
+			name:      "nil value",
+			statement: `set_path(mapping, nil)`,
+			expected:  map[string]any{"mapping": "target"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newPathTestParser(t)
+			statement, err := p.ParseStatement(tt.statement)
+			require.NoError(t, err)
+
+			tCtx := map[string]any{"mapping": "target"}
+			_, _, err = statement.Execute(t.Context(), tCtx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, tCtx)
+		})
+	}
+}
+
+func Test_setPath_error(t *testing.T) {
+	p := newPathTestParser(t)
+	statement, err := p.ParseStatement(`set_path("Concat()", "value")`)
+	require.NoError(t, err)
+
+	_, _, err = statement.Execute(t.Context(), map[string]any{})
+	assert.ErrorContains(t, err, `invalid path "Concat()": must be a path`)
+}
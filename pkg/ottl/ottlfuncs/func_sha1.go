@@ -17,7 +17,7 @@ type SHA1Arguments[K any] struct {
 }
 
 func NewSHA1Factory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("SHA1", &SHA1Arguments[K]{}, createSHA1Function[K])
+	return ottl.NewFactory("SHA1", &SHA1Arguments[K]{}, createSHA1Function[K], ottl.WithPureFunction[K]())
 }
 
 func createSHA1Function[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
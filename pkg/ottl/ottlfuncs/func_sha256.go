@@ -17,7 +17,7 @@ type SHA256Arguments[K any] struct {
 }
 
 func NewSHA256Factory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("SHA256", &SHA256Arguments[K]{}, createSHA256Function[K])
+	return ottl.NewFactory("SHA256", &SHA256Arguments[K]{}, createSHA256Function[K], ottl.WithPureFunction[K]())
 }
 
 func createSHA256Function[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// soundexLength is the length of the Soundex codes: a letter followed by three digits.
+const soundexLength = 4
+
+// soundexCodes are the digits of the letters, indexed from 'A'. Vowels and the letters
+// H, W and Y are not coded.
+const soundexCodes = "01230120022455012623010202"
+
+type SoundexArguments[K any] struct {
+	Target ottl.StringGetter[K]
+}
+
+func NewSoundexFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Soundex", &SoundexArguments[K]{}, createSoundexFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createSoundexFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*SoundexArguments[K])
+
+	if !ok {
+		return nil, errors.New("SoundexFactory args must be of type *SoundexArguments[K]")
+	}
+
+	return soundexFunc(args.Target), nil
+}
+
+func soundexFunc[K any](target ottl.StringGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		return soundex(val), nil
+	}
+}
+
+// soundex returns the American Soundex code of the ASCII letters of the value, ignoring its
+// other characters. An empty string is returned if the value has no ASCII letter.
+func soundex(val string) string {
+	var code strings.Builder
+	var last byte
+	for i := 0; i < len(val) && code.Len() < soundexLength; i++ {
+		c := val[i]
+		if c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		if c < 'A' || c > 'Z' {
+			continue
+		}
+		digit := soundexCodes[c-'A']
+		switch {
+		case code.Len() == 0:
+			code.WriteByte(c)
+		case digit != '0' && digit != last:
+			code.WriteByte(digit)
+		}
+		// H and W do not separate letters with the same code, while vowels do.
+		if c != 'H' && c != 'W' {
+			last = digit
+		}
+	}
+	if code.Len() == 0 {
+		return ""
+	}
+	for code.Len() < soundexLength {
+		code.WriteByte('0')
+	}
+	return code.String()
+}
@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Soundex(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		expected string
+	}{
+		{name: "Robert", target: "Robert", expected: "R163"},
+		{name: "same code", target: "Rupert", expected: "R163"},
+		{name: "padded", target: "Rubin", expected: "R150"},
+		{name: "h separator", target: "Ashcraft", expected: "A261"},
+		{name: "vowel separator", target: "Tymczak", expected: "T522"},
+		{name: "first letter code", target: "Pfister", expected: "P236"},
+		{name: "lower case", target: "honeyman", expected: "H555"},
+		{name: "non letters ignored", target: "  o'brien-2 ", expected: "O165"},
+		{name: "single letter", target: "a", expected: "A000"},
+		{name: "no letters", target: "1234", expected: ""},
+		{name: "empty", target: "", expected: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := soundexFunc[any](stringGetter(tt.target))
+			result, err := exprFunc(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Soundex_error(t *testing.T) {
+	exprFunc := soundexFunc[any](stringGetter(1))
+	_, err := exprFunc(t.Context(), nil)
+	assert.ErrorContains(t, err, "expected string but got int")
+}
@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type StateGetArguments[K any] struct {
+	Key ottl.StringGetter[K]
+}
+
+// NewStateGetFactory returns the factory of the StateGet Converter, reading the values persisted
+// in the storage of the store. Since the storage is provided by the components, StateGet is not
+// part of the standard functions, and must be registered by the components using a storage extension.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func NewStateGetFactory[K any](store *StateStore) ottl.Factory[K] {
+	return ottl.NewFactory("StateGet", &StateGetArguments[K]{}, func(_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+		return createStateGetFunction[K](store, oArgs)
+	})
+}
+
+func createStateGetFunction[K any](store *StateStore, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*StateGetArguments[K])
+
+	if !ok {
+		return nil, errors.New("StateGetFactory args must be of type *StateGetArguments[K]")
+	}
+
+	return stateGet(store, args.Key)
+}
+
+func stateGet[K any](store *StateStore, key ottl.StringGetter[K]) (ottl.ExprFunc[K], error) {
+	if store == nil {
+		return nil, errors.New("the StateGet function requires a state store")
+	}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		k, err := key.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		return store.get(ctx, k)
+	}, nil
+}
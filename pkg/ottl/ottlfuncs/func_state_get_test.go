@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_StateGet(t *testing.T) {
+	now := time.Unix(100, 0)
+	store := newTestStateStore(newMemoryStateStorage(), &now)
+	require.NoError(t, store.set(t.Context(), "session", "abc", time.Hour))
+
+	exprFunc, err := stateGet(store, stringGetter("session"))
+	require.NoError(t, err)
+	result, err := exprFunc(t.Context(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "abc", result)
+
+	exprFunc, err = stateGet(store, stringGetter("missing"))
+	require.NoError(t, err)
+	result, err = exprFunc(t.Context(), nil)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func Test_StateGet_noStore(t *testing.T) {
+	_, err := stateGet[any](nil, stringGetter("key"))
+	assert.EqualError(t, err, "the StateGet function requires a state store")
+}
@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type StateSetArguments[K any] struct {
+	Key   ottl.StringGetter[K]
+	Value ottl.Getter[K]
+	TTL   ottl.DurationGetter[K]
+}
+
+// NewStateSetFactory returns the factory of the StateSet Converter, persisting the values in the
+// storage of the store. Since the storage is provided by the components, StateSet is not part of
+// the standard functions, and must be registered by the components using a storage extension.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func NewStateSetFactory[K any](store *StateStore) ottl.Factory[K] {
+	return ottl.NewFactory("StateSet", &StateSetArguments[K]{}, func(_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+		return createStateSetFunction[K](store, oArgs)
+	})
+}
+
+func createStateSetFunction[K any](store *StateStore, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*StateSetArguments[K])
+
+	if !ok {
+		return nil, errors.New("StateSetFactory args must be of type *StateSetArguments[K]")
+	}
+
+	return stateSet(store, args.Key, args.Value, args.TTL)
+}
+
+func stateSet[K any](store *StateStore, key ottl.StringGetter[K], value ottl.Getter[K], ttl ottl.DurationGetter[K]) (ottl.ExprFunc[K], error) {
+	if store == nil {
+		return nil, errors.New("the StateSet function requires a state store")
+	}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		k, err := key.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		val, err := value.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		d, err := ttl.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("the ttl of the StateSet function must be positive, got %s", d)
+		}
+		previous, err := store.get(ctx, k)
+		if err != nil {
+			return nil, err
+		}
+		if err = store.set(ctx, k, val, d); err != nil {
+			return nil, err
+		}
+		return previous, nil
+	}, nil
+}
@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func durationGetter(d time.Duration) ottl.DurationGetter[any] {
+	return &ottl.StandardDurationGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return d, nil
+		},
+	}
+}
+
+func Test_StateSet(t *testing.T) {
+	now := time.Unix(100, 0)
+	store := newTestStateStore(newMemoryStateStorage(), &now)
+
+	exprFunc, err := stateSet(store, stringGetter("user"), binaryGetter(int64(1)), durationGetter(time.Hour))
+	require.NoError(t, err)
+
+	result, err := exprFunc(t.Context(), nil)
+	require.NoError(t, err)
+	assert.Nil(t, result, "the first call must return nil, as the key was not set")
+
+	result, err = exprFunc(t.Context(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), result)
+
+	now = now.Add(time.Hour)
+	result, err = exprFunc(t.Context(), nil)
+	require.NoError(t, err)
+	assert.Nil(t, result, "the previous value must have expired")
+
+	got, err := store.get(t.Context(), "user")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), got)
+}
+
+func Test_StateSet_invalidTTL(t *testing.T) {
+	now := time.Unix(100, 0)
+	store := newTestStateStore(newMemoryStateStorage(), &now)
+
+	exprFunc, err := stateSet(store, stringGetter("user"), binaryGetter(true), durationGetter(0))
+	require.NoError(t, err)
+	_, err = exprFunc(t.Context(), nil)
+	assert.EqualError(t, err, "the ttl of the StateSet function must be positive, got 0s")
+}
+
+func Test_StateSet_noStore(t *testing.T) {
+	_, err := stateSet[any](nil, stringGetter("key"), binaryGetter(true), durationGetter(time.Hour))
+	assert.EqualError(t, err, "the StateSet function requires a state store")
+}
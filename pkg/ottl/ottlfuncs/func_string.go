@@ -15,7 +15,7 @@ type StringArguments[K any] struct {
 }
 
 func NewStringFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("String", &StringArguments[K]{}, createStringFunction[K])
+	return ottl.NewFactory("String", &StringArguments[K]{}, createStringFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createStringFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
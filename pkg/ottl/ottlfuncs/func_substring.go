@@ -20,7 +20,7 @@ type SubstringArguments[K any] struct {
 }
 
 func NewSubstringFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("Substring", &SubstringArguments[K]{}, createSubstringFunction[K])
+	return ottl.NewFactory("Substring", &SubstringArguments[K]{}, createSubstringFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createSubstringFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
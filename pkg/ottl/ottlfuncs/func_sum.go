@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type SumArguments[K any] struct {
+	Target ottl.PSliceGetter[K]
+}
+
+func NewSumFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Sum", &SumArguments[K]{}, createSumFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createSumFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*SumArguments[K])
+
+	if !ok {
+		return nil, errors.New("SumFactory args must be of type *SumArguments[K]")
+	}
+
+	return sum(args.Target), nil
+}
+
+func sum[K any](target ottl.PSliceGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		slice, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		numbers, err := sliceNumbers("Sum", slice)
+		if err != nil {
+			return nil, err
+		}
+
+		var intSum int64
+		for _, i := range numbers.ints {
+			intSum += i
+		}
+		if len(numbers.doubles) == 0 {
+			return intSum, nil
+		}
+		doubleSum := float64(intSum)
+		for _, d := range numbers.doubles {
+			doubleSum += d
+		}
+		return doubleSum, nil
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func sliceGetter(values ...any) ottl.PSliceGetter[any] {
+	return ottl.StandardPSliceGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return values, nil
+		},
+	}
+}
+
+func Test_Sum(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []any
+		expected any
+	}{
+		{
+			name:     "integers",
+			values:   []any{int64(1), int64(2), int64(3)},
+			expected: int64(6),
+		},
+		{
+			name:     "doubles",
+			values:   []any{1.5, 2.25},
+			expected: 3.75,
+		},
+		{
+			name:     "integers and doubles",
+			values:   []any{int64(1), 2.5},
+			expected: 3.5,
+		},
+		{
+			name:     "nil values are ignored",
+			values:   []any{int64(1), nil, int64(2)},
+			expected: int64(3),
+		},
+		{
+			name:     "empty list",
+			values:   []any{},
+			expected: int64(0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := sum(sliceGetter(tt.values...))(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Sum_error(t *testing.T) {
+	_, err := sum(sliceGetter(int64(1), "2"))(t.Context(), nil)
+	assert.EqualError(t, err, "Sum: the list must only contain numbers, but it contains a value of type Str")
+}
@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type TimestampAddArguments[K any] struct {
+	Timestamp ottl.Getter[K]
+	Duration  ottl.DurationGetter[K]
+}
+
+func NewTimestampAddFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("TimestampAdd", &TimestampAddArguments[K]{}, createTimestampAddFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createTimestampAddFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*TimestampAddArguments[K])
+
+	if !ok {
+		return nil, errors.New("TimestampAddFactory args must be of type *TimestampAddArguments[K]")
+	}
+
+	return timestampAdd(args.Timestamp, args.Duration), nil
+}
+
+func timestampAdd[K any](timestamp ottl.Getter[K], duration ottl.DurationGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		ts, err := timestamp.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		d, err := duration.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		switch v := ts.(type) {
+		case int64:
+			return v + int64(d), nil
+		case time.Time:
+			return v.Add(d), nil
+		default:
+			return nil, fmt.Errorf("unsupported timestamp type %T, expected time.Time or int64 unix nanoseconds", ts)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_TimestampAdd(t *testing.T) {
+	tests := []struct {
+		name          string
+		timestamp     any
+		duration      time.Duration
+		expected      any
+		expectedError string
+	}{
+		{
+			name:      "time",
+			timestamp: time.Date(2024, 3, 5, 14, 42, 0, 0, time.UTC),
+			duration:  90 * time.Minute,
+			expected:  time.Date(2024, 3, 5, 16, 12, 0, 0, time.UTC),
+		},
+		{
+			name:      "negative duration",
+			timestamp: time.Date(2024, 3, 5, 14, 42, 0, 0, time.UTC),
+			duration:  -24 * time.Hour,
+			expected:  time.Date(2024, 3, 4, 14, 42, 0, 0, time.UTC),
+		},
+		{
+			name:      "unix nanoseconds",
+			timestamp: int64(1_700_000_000_000_000_000),
+			duration:  time.Second,
+			expected:  int64(1_700_000_001_000_000_000),
+		},
+		{
+			name:          "unsupported type",
+			timestamp:     "2024-03-05",
+			duration:      time.Second,
+			expectedError: "unsupported timestamp type string, expected time.Time or int64 unix nanoseconds",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc := timestampAdd[any](
+				&ottl.StandardGetSetter[any]{
+					Getter: func(context.Context, any) (any, error) {
+						return tt.timestamp, nil
+					},
+				},
+				&ottl.StandardDurationGetter[any]{
+					Getter: func(context.Context, any) (any, error) {
+						return tt.duration, nil
+					},
+				},
+			)
+			result, err := exprFunc(t.Context(), nil)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
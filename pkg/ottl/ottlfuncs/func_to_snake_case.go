@@ -17,7 +17,7 @@ type ToSnakeCaseArguments[K any] struct {
 }
 
 func NewToSnakeCaseFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("ToSnakeCase", &ToSnakeCaseArguments[K]{}, createToSnakeCaseFunction[K])
+	return ottl.NewFactory("ToSnakeCase", &ToSnakeCaseArguments[K]{}, createToSnakeCaseFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createToSnakeCaseFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
@@ -17,7 +17,7 @@ type TrimArguments[K any] struct {
 }
 
 func NewTrimFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("Trim", &TrimArguments[K]{}, createTrimFunction[K])
+	return ottl.NewFactory("Trim", &TrimArguments[K]{}, createTrimFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createTrimFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type TruncateTimestampArguments[K any] struct {
+	Timestamp ottl.Getter[K]
+	Interval  string
+}
+
+func NewTruncateTimestampFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("TruncateTimestamp", &TruncateTimestampArguments[K]{}, createTruncateTimestampFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createTruncateTimestampFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*TruncateTimestampArguments[K])
+
+	if !ok {
+		return nil, errors.New("TruncateTimestampFactory args must be of type *TruncateTimestampArguments[K]")
+	}
+
+	return truncateTimestamp(args.Timestamp, args.Interval)
+}
+
+func truncateTimestamp[K any](timestamp ottl.Getter[K], interval string) (ottl.ExprFunc[K], error) {
+	d, err := time.ParseDuration(interval)
+	if err != nil {
+		return nil, fmt.Errorf("invalid interval %q: %w", interval, err)
+	}
+	if d <= 0 {
+		return nil, fmt.Errorf("the interval must be positive, got %q", interval)
+	}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		ts, err := timestamp.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		switch v := ts.(type) {
+		case int64:
+			return truncateUnixNano(v, d), nil
+		case time.Time:
+			return time.Unix(0, truncateUnixNano(v.UnixNano(), d)).In(v.Location()), nil
+		default:
+			return nil, fmt.Errorf("unsupported timestamp type %T, expected time.Time or int64 unix nanoseconds", ts)
+		}
+	}, nil
+}
+
+// truncateUnixNano rounds the unix nanoseconds down to a multiple of the interval,
+// so the buckets are aligned on the unix epoch whatever the interval.
+func truncateUnixNano(nanos int64, d time.Duration) int64 {
+	remainder := nanos % int64(d)
+	if remainder < 0 {
+		remainder += int64(d)
+	}
+	return nanos - remainder
+}
@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_TruncateTimestamp(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		timestamp any
+		interval  string
+		expected  any
+	}{
+		{
+			name:      "time to the hour",
+			timestamp: time.Date(2024, 3, 5, 14, 42, 17, 5, time.UTC),
+			interval:  "1h",
+			expected:  time.Date(2024, 3, 5, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "time with a location keeps it",
+			timestamp: time.Date(2024, 3, 5, 14, 42, 17, 0, paris),
+			interval:  "15m",
+			expected:  time.Date(2024, 3, 5, 14, 30, 0, 0, paris),
+		},
+		{
+			name:      "buckets are aligned on the epoch",
+			timestamp: time.Date(1970, 1, 1, 8, 59, 0, 0, time.UTC),
+			interval:  "7h",
+			expected:  time.Date(1970, 1, 1, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "unix nanoseconds",
+			timestamp: int64(1_700_000_123_456_789_000),
+			interval:  "1m",
+			expected:  int64(1_700_000_100_000_000_000),
+		},
+		{
+			name:      "negative unix nanoseconds",
+			timestamp: int64(-1_500_000_000),
+			interval:  "1s",
+			expected:  int64(-2_000_000_000),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := truncateTimestamp[any](&ottl.StandardGetSetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.timestamp, nil
+				},
+			}, tt.interval)
+			require.NoError(t, err)
+			result, err := exprFunc(t.Context(), nil)
+			require.NoError(t, err)
+			if expected, ok := tt.expected.(time.Time); ok {
+				assert.True(t, expected.Equal(result.(time.Time)), "expected %s, got %s", expected, result)
+				assert.Equal(t, expected.Location(), result.(time.Time).Location())
+				return
+			}
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_TruncateTimestamp_error(t *testing.T) {
+	getter := &ottl.StandardGetSetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return "2024-03-05", nil
+		},
+	}
+
+	_, err := truncateTimestamp[any](getter, "1 hour")
+	assert.ErrorContains(t, err, `invalid interval "1 hour"`)
+
+	_, err = truncateTimestamp[any](getter, "0s")
+	assert.EqualError(t, err, `the interval must be positive, got "0s"`)
+
+	exprFunc, err := truncateTimestamp[any](getter, "1h")
+	require.NoError(t, err)
+	_, err = exprFunc(t.Context(), nil)
+	assert.EqualError(t, err, "unsupported timestamp type string, expected time.Time or int64 unix nanoseconds")
+}
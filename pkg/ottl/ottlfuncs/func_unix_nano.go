@@ -15,7 +15,7 @@ type UnixNanoArguments[K any] struct {
 }
 
 func NewUnixNanoFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("UnixNano", &UnixNanoArguments[K]{}, createUnixNanoFunction[K])
+	return ottl.NewFactory("UnixNano", &UnixNanoArguments[K]{}, createUnixNanoFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createUnixNanoFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
@@ -5,6 +5,8 @@ package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-c
 import (
 	"context"
 	"errors"
+	"strings"
+	"sync"
 
 	"github.com/ua-parser/uap-go/uaparser"
 	conventions "go.opentelemetry.io/otel/semconv/v1.40.0"
@@ -12,6 +14,71 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 )
 
+// userAgentParser returns the parser shared by the UserAgent functions. Its regular expressions
+// database is large, so it is only loaded once instead of once per function.
+var userAgentParser = sync.OnceValue(uaparser.NewFromSaved)
+
+// Device types returned by the UserAgentDeviceType Converter.
+const (
+	userAgentDeviceTypeBot     = "bot"
+	userAgentDeviceTypeDesktop = "desktop"
+	userAgentDeviceTypeMobile  = "mobile"
+	userAgentDeviceTypeTablet  = "tablet"
+	userAgentDeviceTypeOther   = "other"
+)
+
+// userAgentMobileOSes are the operating systems of mobile devices, as named by uap-core.
+var userAgentMobileOSes = map[string]struct{}{
+	"Android":       {},
+	"BlackBerry OS": {},
+	"Firefox OS":    {},
+	"iOS":           {},
+	"KaiOS":         {},
+	"Symbian OS":    {},
+	"Windows Phone": {},
+}
+
+// userAgentDesktopOSes are the operating systems of desktop devices, as named by uap-core.
+var userAgentDesktopOSes = map[string]struct{}{
+	"Chrome OS": {},
+	"Debian":    {},
+	"Fedora":    {},
+	"FreeBSD":   {},
+	"Linux":     {},
+	"Mac OS X":  {},
+	"OpenBSD":   {},
+	"Ubuntu":    {},
+	"Windows":   {},
+}
+
+// isUserAgentBot returns true if the user agent is a bot, which uap-core classifies as a "Spider" device.
+func isUserAgentBot(device *uaparser.Device) bool {
+	return device.Family == "Spider"
+}
+
+// userAgentDeviceType classifies the device of the user agent, based on the device and
+// operating system detected by uap-core.
+func userAgentDeviceType(userAgentString string, client *uaparser.Client) string {
+	if isUserAgentBot(client.Device) {
+		return userAgentDeviceTypeBot
+	}
+	device := client.Device
+	if device.Family == "iPad" || strings.Contains(device.Brand, "Tablet") || strings.Contains(device.Family, "Tablet") || strings.Contains(device.Family, "Kindle") {
+		return userAgentDeviceTypeTablet
+	}
+	if _, ok := userAgentMobileOSes[client.Os.Family]; ok {
+		// Android tablets do not have the "Mobile" token of Android phones.
+		if client.Os.Family == "Android" && !strings.Contains(userAgentString, "Mobile") {
+			return userAgentDeviceTypeTablet
+		}
+		return userAgentDeviceTypeMobile
+	}
+	if _, ok := userAgentDesktopOSes[client.Os.Family]; ok {
+		return userAgentDeviceTypeDesktop
+	}
+	return userAgentDeviceTypeOther
+}
+
 type UserAgentArguments[K any] struct {
 	UserAgent ottl.StringGetter[K]
 }
@@ -30,21 +97,26 @@ func createUserAgentFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments
 }
 
 func userAgent[K any](userAgentSource ottl.StringGetter[K]) ottl.ExprFunc[K] { //revive:disable-line:var-naming
-	parser := uaparser.NewFromSaved()
+	parser := userAgentParser()
 
 	return func(ctx context.Context, tCtx K) (any, error) {
 		userAgentString, err := userAgentSource.Get(ctx, tCtx)
 		if err != nil {
 			return nil, err
 		}
-		parsedUserAgent := parser.ParseUserAgent(userAgentString)
-		parsedOS := parser.ParseOs(userAgentString)
+		client := parser.Parse(userAgentString)
+		parsedUserAgent := client.UserAgent
+		parsedOS := client.Os
 		result := map[string]any{
 			string(conventions.UserAgentNameKey):     parsedUserAgent.Family,
 			string(conventions.UserAgentOriginalKey): userAgentString,
 			string(conventions.UserAgentVersionKey):  parsedUserAgent.ToVersionString(),
 		}
 
+		if isUserAgentBot(client.Device) {
+			result[string(conventions.UserAgentSyntheticTypeKey)] = conventions.UserAgentSyntheticTypeBot.Value.AsString()
+		}
+
 		osName := parsedOS.Family
 		if osName != "" {
 			result[string(conventions.OSNameKey)] = osName
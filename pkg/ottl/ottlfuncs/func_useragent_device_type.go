@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type UserAgentDeviceTypeArguments[K any] struct {
+	UserAgent ottl.StringGetter[K]
+}
+
+func NewUserAgentDeviceTypeFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("UserAgentDeviceType", &UserAgentDeviceTypeArguments[K]{}, createUserAgentDeviceTypeFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createUserAgentDeviceTypeFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*UserAgentDeviceTypeArguments[K])
+	if !ok {
+		return nil, errors.New("UserAgentDeviceTypeFactory args must be of type *UserAgentDeviceTypeArguments[K]")
+	}
+
+	return userAgentDeviceTypeFunc(args.UserAgent), nil
+}
+
+func userAgentDeviceTypeFunc[K any](userAgentSource ottl.StringGetter[K]) ottl.ExprFunc[K] {
+	parser := userAgentParser()
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		userAgentString, err := userAgentSource.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		return userAgentDeviceType(userAgentString, parser.Parse(userAgentString)), nil
+	}
+}
@@ -91,6 +91,17 @@ func TestUserAgentParser(t *testing.T) {
 				"os.name":             "Other",
 			},
 		},
+		{
+			Name:     "Googlebot",
+			UAString: "Googlebot/2.1 (+http://www.google.com/bot.html)",
+			ExpectedMap: map[string]any{
+				"user_agent.original":       "Googlebot/2.1 (+http://www.google.com/bot.html)",
+				"user_agent.name":           "Googlebot",
+				"user_agent.version":        "2.1",
+				"user_agent.synthetic.type": "bot",
+				"os.name":                   "Other",
+			},
+		},
 		{
 			Name:     "Unknown user agent",
 			UAString: "foobar/1.2.3 (foo; bar baz)",
@@ -158,3 +169,7 @@ func TestUserAgentParser(t *testing.T) {
 		})
 	}
 }
+
+func TestUserAgentParserShared(t *testing.T) {
+	assert.Same(t, userAgentParser(), userAgentParser())
+}
@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ua-parser/uap-go/uaparser"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type UserAgentVersionInRangeArguments[K any] struct {
+	UserAgent  ottl.StringGetter[K]
+	MinVersion string
+	MaxVersion ottl.Optional[string]
+}
+
+func NewUserAgentVersionInRangeFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("UserAgentVersionInRange", &UserAgentVersionInRangeArguments[K]{}, createUserAgentVersionInRangeFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createUserAgentVersionInRangeFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*UserAgentVersionInRangeArguments[K])
+	if !ok {
+		return nil, errors.New("UserAgentVersionInRangeFactory args must be of type *UserAgentVersionInRangeArguments[K]")
+	}
+
+	return userAgentVersionInRange(args.UserAgent, args.MinVersion, args.MaxVersion)
+}
+
+func userAgentVersionInRange[K any](userAgentSource ottl.StringGetter[K], minVersion string, maxVersion ottl.Optional[string]) (ottl.ExprFunc[K], error) {
+	minParts, err := parseUserAgentVersion(minVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid min_version of the UserAgentVersionInRange function: %w", err)
+	}
+	var maxParts []int
+	if !maxVersion.IsEmpty() {
+		maxParts, err = parseUserAgentVersion(maxVersion.Get())
+		if err != nil {
+			return nil, fmt.Errorf("invalid max_version of the UserAgentVersionInRange function: %w", err)
+		}
+		if compareUserAgentVersions(minParts, maxParts) >= 0 {
+			return nil, fmt.Errorf("the max_version of the UserAgentVersionInRange function must be greater than its min_version, got %q and %q", maxVersion.Get(), minVersion)
+		}
+	}
+	parser := userAgentParser()
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		userAgentString, err := userAgentSource.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		version, ok := userAgentVersionParts(parser.ParseUserAgent(userAgentString))
+		if !ok {
+			return false, nil
+		}
+		if compareUserAgentVersions(version, minParts) < 0 {
+			return false, nil
+		}
+		return maxParts == nil || compareUserAgentVersions(version, maxParts) < 0, nil
+	}, nil
+}
+
+// parseUserAgentVersion parses a version made of dot separated numbers, such as "120" or "17.4.1".
+func parseUserAgentVersion(version string) ([]int, error) {
+	if version == "" {
+		return nil, errors.New("the version cannot be empty")
+	}
+	fields := strings.Split(version, ".")
+	parts := make([]int, len(fields))
+	for i, field := range fields {
+		part, err := strconv.Atoi(field)
+		if err != nil || part < 0 {
+			return nil, fmt.Errorf("the version %q must be made of dot separated numbers", version)
+		}
+		parts[i] = part
+	}
+	return parts, nil
+}
+
+// userAgentVersionParts returns the numbers of the version of the user agent, up to the first
+// part which is not a number. It returns false if the major version is not a number.
+func userAgentVersionParts(userAgent *uaparser.UserAgent) ([]int, bool) {
+	var parts []int
+	for _, field := range []string{userAgent.Major, userAgent.Minor, userAgent.Patch} {
+		part, err := strconv.Atoi(field)
+		if err != nil || part < 0 {
+			break
+		}
+		parts = append(parts, part)
+	}
+	return parts, len(parts) > 0
+}
+
+// compareUserAgentVersions compares the versions part by part, the missing parts being zeros,
+// so that "17" and "17.0" are equal.
+func compareUserAgentVersions(a, b []int) int {
+	for i := range max(len(a), len(b)) {
+		var partA, partB int
+		if i < len(a) {
+			partA = a[i]
+		}
+		if i < len(b) {
+			partB = b[i]
+		}
+		if c := cmp.Compare(partA, partB); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
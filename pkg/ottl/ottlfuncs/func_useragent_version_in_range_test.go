@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+const chrome91UserAgent = "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/91.0.4472.124 Safari/537.36"
+
+func TestUserAgentVersionInRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		userAgent  string
+		minVersion string
+		maxVersion ottl.Optional[string]
+		expected   bool
+	}{
+		{
+			name:       "above the min version",
+			userAgent:  chrome91UserAgent,
+			minVersion: "90",
+			expected:   true,
+		},
+		{
+			name:       "equal to the min version",
+			userAgent:  chrome91UserAgent,
+			minVersion: "91.0.4472",
+			expected:   true,
+		},
+		{
+			name:       "below the min version",
+			userAgent:  chrome91UserAgent,
+			minVersion: "91.1",
+			expected:   false,
+		},
+		{
+			name:       "within the range",
+			userAgent:  chrome91UserAgent,
+			minVersion: "90",
+			maxVersion: ottl.NewTestingOptional("92"),
+			expected:   true,
+		},
+		{
+			name:       "max version is exclusive",
+			userAgent:  chrome91UserAgent,
+			minVersion: "90",
+			maxVersion: ottl.NewTestingOptional("91"),
+			expected:   false,
+		},
+		{
+			name:       "missing parts are zeros",
+			userAgent:  "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Safari/605.1.15",
+			minVersion: "17.0.0",
+			maxVersion: ottl.NewTestingOptional("17.0.1"),
+			expected:   true,
+		},
+		{
+			name:       "unknown version",
+			userAgent:  "foobar/1.2.3 (foo; bar baz)",
+			minVersion: "0",
+			expected:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := &ottl.StandardStringGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.userAgent, nil
+				},
+			}
+			exprFunc, err := userAgentVersionInRange[any](source, tt.minVersion, tt.maxVersion)
+			require.NoError(t, err)
+			result, err := exprFunc(t.Context(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestUserAgentVersionInRange_invalidArguments(t *testing.T) {
+	tests := []struct {
+		name          string
+		minVersion    string
+		maxVersion    ottl.Optional[string]
+		expectedError string
+	}{
+		{
+			name:          "empty min version",
+			minVersion:    "",
+			expectedError: "invalid min_version of the UserAgentVersionInRange function: the version cannot be empty",
+		},
+		{
+			name:          "invalid min version",
+			minVersion:    "1.x",
+			expectedError: `invalid min_version of the UserAgentVersionInRange function: the version "1.x" must be made of dot separated numbers`,
+		},
+		{
+			name:          "invalid max version",
+			minVersion:    "1",
+			maxVersion:    ottl.NewTestingOptional("-2"),
+			expectedError: `invalid max_version of the UserAgentVersionInRange function: the version "-2" must be made of dot separated numbers`,
+		},
+		{
+			name:          "max version not greater than min version",
+			minVersion:    "2",
+			maxVersion:    ottl.NewTestingOptional("2.0"),
+			expectedError: `the max_version of the UserAgentVersionInRange function must be greater than its min_version, got "2.0" and "2"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := userAgentVersionInRange[any](&ottl.StandardStringGetter[any]{}, tt.minVersion, tt.maxVersion)
+			assert.EqualError(t, err, tt.expectedError)
+		})
+	}
+}
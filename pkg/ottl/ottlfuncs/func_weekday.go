@@ -15,7 +15,7 @@ type WeekdayArguments[K any] struct {
 }
 
 func NewWeekdayFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("Weekday", &WeekdayArguments[K]{}, createWeekdayFunction[K])
+	return ottl.NewFactory("Weekday", &WeekdayArguments[K]{}, createWeekdayFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createWeekdayFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/antchfx/xmlquery"
+	"github.com/antchfx/xpath"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type XPathArguments[K any] struct {
+	Target ottl.StringGetter[K]
+	XPath  string
+}
+
+func NewXPathFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("XPath", &XPathArguments[K]{}, createXPathFunction[K], ottl.WithPureFunction[K]())
+}
+
+func createXPathFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*XPathArguments[K])
+
+	if !ok {
+		return nil, errors.New("XPathFactory args must be of type *XPathArguments[K]")
+	}
+
+	return xPathQuery(args.Target, args.XPath)
+}
+
+// xPathQuery returns the values matched by the XPath expression in the target XML document.
+func xPathQuery[K any](target ottl.StringGetter[K], xPath string) (ottl.ExprFunc[K], error) {
+	expr, err := xpath.Compile(xPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid xpath: %w", err)
+	}
+	// Compiled expressions keep the state of their evaluation, so they cannot be used concurrently.
+	exprs := sync.Pool{
+		New: func() any {
+			// The expression was already compiled successfully.
+			e, _ := xpath.Compile(xPath)
+			return e
+		},
+	}
+	exprs.Put(expr)
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		targetVal, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if targetVal == "" {
+			return nil, nil
+		}
+		doc, err := parseNodesXML(targetVal)
+		if err != nil {
+			return nil, err
+		}
+
+		e := exprs.Get().(*xpath.Expr)
+		defer exprs.Put(e)
+		switch val := e.Evaluate(xmlquery.CreateXPathNavigator(doc)).(type) {
+		case *xpath.NodeIterator:
+			result := pcommon.NewSlice()
+			for val.MoveNext() {
+				result.AppendEmpty().SetStr(nodeValue(val.Current()))
+			}
+			return result, nil
+		case float64, string, bool:
+			return val, nil
+		default:
+			return nil, fmt.Errorf("unsupported result type %T for xpath %q", val, xPath)
+		}
+	}, nil
+}
+
+// nodeValue returns the value of an attribute, or the text of the other nodes, including CDATA sections.
+func nodeValue(nav xpath.NodeNavigator) string {
+	if xmlNav, ok := nav.(*xmlquery.NodeNavigator); ok && nav.NodeType() != xpath.AttributeNode {
+		return xmlNav.Current().InnerText()
+	}
+	return nav.Value()
+}
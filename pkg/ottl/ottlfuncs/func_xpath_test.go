@@ -0,0 +1,151 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_XPath(t *testing.T) {
+	const document = `<?xml version="1.0"?>
+<catalog>
+  <book id="1" lang="en"><title>Go</title><price>30.5</price></book>
+  <book id="2" lang="fr"><title><![CDATA[Le Go]]></title><price>25</price></book>
+</catalog>`
+
+	tests := []struct {
+		name     string
+		document string
+		xPath    string
+		want     any
+	}{
+		{
+			name:     "text of a filtered element",
+			document: document,
+			xPath:    "/catalog/book[@id='1']/title/text()",
+			want:     []any{"Go"},
+		},
+		{
+			name:     "CDATA text",
+			document: document,
+			xPath:    "/catalog/book[@id='2']/title/text()",
+			want:     []any{"Le Go"},
+		},
+		{
+			name:     "attributes",
+			document: document,
+			xPath:    "//book/@lang",
+			want:     []any{"en", "fr"},
+		},
+		{
+			name:     "elements inner text",
+			document: document,
+			xPath:    "//book",
+			want:     []any{"Go30.5", "Le Go25"},
+		},
+		{
+			name:     "no match",
+			document: document,
+			xPath:    "//author",
+			want:     []any{},
+		},
+		{
+			name:     "count",
+			document: document,
+			xPath:    "count(//book)",
+			want:     float64(2),
+		},
+		{
+			name:     "sum",
+			document: document,
+			xPath:    "sum(//price)",
+			want:     55.5,
+		},
+		{
+			name:     "string function",
+			document: document,
+			xPath:    "string(/catalog/book[2]/@id)",
+			want:     "2",
+		},
+		{
+			name:     "boolean",
+			document: document,
+			xPath:    "boolean(//book[@lang='de'])",
+			want:     false,
+		},
+		{
+			name:     "empty document",
+			document: "",
+			xPath:    "//book",
+			want:     nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := xPathQuery[any](ottl.StandardStringGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.document, nil
+				},
+			}, tt.xPath)
+			require.NoError(t, err)
+
+			result, err := exprFunc(t.Context(), nil)
+			require.NoError(t, err)
+			if s, ok := result.(pcommon.Slice); ok {
+				result = s.AsRaw()
+			}
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
+func Test_XPath_concurrent(t *testing.T) {
+	exprFunc, err := xPathQuery[any](ottl.StandardStringGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return `<a><b>1</b><b>2</b></a>`, nil
+		},
+	}, "/a/b/text()")
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			result, err := exprFunc(t.Context(), nil)
+			assert.NoError(t, err)
+			assert.Equal(t, []any{"1", "2"}, result.(pcommon.Slice).AsRaw())
+		})
+	}
+	wg.Wait()
+}
+
+func Test_XPath_errors(t *testing.T) {
+	_, err := xPathQuery[any](ottl.StandardStringGetter[any]{}, "/a[")
+	assert.ErrorContains(t, err, "invalid xpath")
+
+	exprFunc, err := xPathQuery[any](ottl.StandardStringGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return "<a>", nil
+		},
+	}, "/a")
+	require.NoError(t, err)
+	_, err = exprFunc(t.Context(), nil)
+	assert.ErrorContains(t, err, "parse xml")
+
+	exprFunc, err = xPathQuery[any](ottl.StandardStringGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return 1, nil
+		},
+	}, "/a")
+	require.NoError(t, err)
+	_, err = exprFunc(t.Context(), nil)
+	assert.ErrorContains(t, err, "expected string but got int")
+}
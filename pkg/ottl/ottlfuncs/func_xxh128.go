@@ -18,7 +18,7 @@ type XXH128Arguments[K any] struct {
 }
 
 func NewXXH128Factory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("XXH128", &XXH128Arguments[K]{}, createXXH128Function[K])
+	return ottl.NewFactory("XXH128", &XXH128Arguments[K]{}, createXXH128Function[K], ottl.WithPureFunction[K]())
 }
 
 func createXXH128Function[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
@@ -18,7 +18,7 @@ type XXH3Arguments[K any] struct {
 }
 
 func NewXXH3Factory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("XXH3", &XXH3Arguments[K]{}, createXXH3Function[K])
+	return ottl.NewFactory("XXH3", &XXH3Arguments[K]{}, createXXH3Function[K], ottl.WithPureFunction[K]())
 }
 
 func createXXH3Function[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
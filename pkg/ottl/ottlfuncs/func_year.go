@@ -15,7 +15,7 @@ type YearArguments[K any] struct {
 }
 
 func NewYearFactory[K any]() ottl.Factory[K] {
-	return ottl.NewFactory("Year", &YearArguments[K]{}, createYearFunction[K])
+	return ottl.NewFactory("Year", &YearArguments[K]{}, createYearFunction[K], ottl.WithPureFunction[K]())
 }
 
 func createYearFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
@@ -23,6 +23,7 @@ func StandardFuncs[K any]() map[string]ottl.Factory[K] {
 		NewReplaceMatchFactory[K](),
 		NewReplacePatternFactory[K](),
 		NewSetFactory[K](),
+		NewSetPathFactory[K](),
 		NewStringifyAllFactory[K](),
 		NewTruncateAllFactory[K](),
 	}
@@ -61,6 +62,7 @@ func converters[K any]() []ottl.Factory[K] {
 		NewHasSuffixFactory[K](),
 		NewHourFactory[K](),
 		NewHoursFactory[K](),
+		NewIfFactory[K](),
 		NewIndexFactory[K](),
 		NewInsertXMLFactory[K](),
 		NewIntFactory[K](),
@@ -137,5 +139,40 @@ func converters[K any]() []ottl.Factory[K] {
 		NewXXH3Factory[K](),
 		NewXXH128Factory[K](),
 		NewIsInCIDRFactory[K](),
+		NewIPInCIDRListFactory[K](),
+		NewIsPrivateIPFactory[K](),
+		NewIPVersionFactory[K](),
+		NewObfuscateSQLFactory[K](),
+		NewXPathFactory[K](),
+		NewExtractRegexFactory[K](),
+		NewTruncateTimestampFactory[K](),
+		NewTimestampAddFactory[K](),
+		NewEnvFactory[K](),
+		NewFileContentsFactory[K](),
+		NewInSetFactory[K](),
+		NewParseCookiesFactory[K](),
+		NewParseAuthHeaderFactory[K](),
+		NewSumFactory[K](),
+		NewAvgFactory[K](),
+		NewMinFactory[K](),
+		NewMaxFactory[K](),
+		NewCountFactory[K](),
+		NewDistinctFactory[K](),
+		NewGetPathFactory[K](),
+		NewRateLimitFactory[K](),
+		NewSampleConsistentFactory[K](),
+		NewUserAgentDeviceTypeFactory[K](),
+		NewIsUserAgentBotFactory[K](),
+		NewUserAgentVersionInRangeFactory[K](),
+		NewLevenshteinDistanceFactory[K](),
+		NewJaroWinklerFactory[K](),
+		NewSoundexFactory[K](),
+		NewConvertTimeZoneFactory[K](),
+		NewHexToBytesFactory[K](),
+		NewBytesToHexFactory[K](),
+		NewBitwiseAndFactory[K](),
+		NewBitwiseOrFactory[K](),
+		NewBitwiseXorFactory[K](),
+		NewByteSliceFactory[K](),
 	}
 }
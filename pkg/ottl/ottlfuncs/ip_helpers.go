@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// maxCachedCIDRLists is the maximum number of network lists parsed at runtime which are
+// kept by a function. The cache is cleared once it is reached.
+const maxCachedCIDRLists = 128
+
+// parseIPAddr parses an IPv4 or IPv6 address. IPv4-mapped IPv6 addresses are
+// converted to IPv4 addresses.
+func parseIPAddr(s string) (netip.Addr, bool) {
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap(), true
+}
+
+// cidrList is a list of networks which an IP address can be matched against.
+type cidrList []netip.Prefix
+
+func parseCIDRList(networks pcommon.Slice) (cidrList, error) {
+	list := make(cidrList, 0, networks.Len())
+	for _, network := range networks.All() {
+		if network.Type() != pcommon.ValueTypeStr {
+			return nil, fmt.Errorf("expected the networks to be strings but got %s", network.Type())
+		}
+		prefix, err := netip.ParsePrefix(network.Str())
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR address: %w", err)
+		}
+		list = append(list, prefix.Masked())
+	}
+	return list, nil
+}
+
+func (l cidrList) contains(addr netip.Addr) bool {
+	for _, prefix := range l {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// cidrListCache keeps the network lists parsed at runtime, so lists read from the
+// telemetry or from the cache are not parsed again for each record.
+type cidrListCache struct {
+	mu    sync.Mutex
+	lists map[string]cidrList
+}
+
+func (c *cidrListCache) get(networks pcommon.Slice) (cidrList, error) {
+	key := cidrListCacheKey(networks)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if list, ok := c.lists[key]; ok {
+		return list, nil
+	}
+	list, err := parseCIDRList(networks)
+	if err != nil {
+		return nil, err
+	}
+	if c.lists == nil || len(c.lists) >= maxCachedCIDRLists {
+		c.lists = make(map[string]cidrList)
+	}
+	c.lists[key] = list
+	return list, nil
+}
+
+func cidrListCacheKey(networks pcommon.Slice) string {
+	var b strings.Builder
+	for _, network := range networks.All() {
+		b.WriteString(network.AsString())
+		b.WriteByte(',')
+	}
+	return b.String()
+}
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// KeyProvider provides the secret keys used by the HMAC_SHA256, SaltedSHA256, Encrypt and Decrypt Converters.
+// It is implemented by the key provider extensions, and implementations must be safe for concurrent use.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type KeyProvider interface {
+	// Key returns the key with the given id, and false if the provider has no key for it.
+	Key(ctx context.Context, id string) ([]byte, bool, error)
+}
+
+// GetKeyProvider returns the key provider extension with the given id, as configured for a component.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func GetKeyProvider(host component.Host, id component.ID) (KeyProvider, error) {
+	ext, found := host.GetExtensions()[id]
+	if !found {
+		return nil, fmt.Errorf("key provider extension %q not found", id)
+	}
+	provider, ok := ext.(KeyProvider)
+	if !ok {
+		return nil, fmt.Errorf("extension %q is not a key provider", id)
+	}
+	return provider, nil
+}
+
+// KeyStore holds the key provider of the HMAC_SHA256, SaltedSHA256, Encrypt and Decrypt Converters.
+// The key provider extensions are only available once the collector has started, so the
+// components create the store with their functions, and bind it to their key provider
+// extension with SetKeyProvider when they start.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type KeyStore struct {
+	mu       sync.RWMutex
+	provider KeyProvider
+}
+
+// NewKeyStore returns a KeyStore without key provider, on which the Converters using keys
+// fail until a key provider is set.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func NewKeyStore() *KeyStore {
+	return &KeyStore{}
+}
+
+// SetKeyProvider sets the key provider of the store. It is meant to be called by the components
+// when they start, and with nil when they shut down.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func (s *KeyStore) SetKeyProvider(provider KeyProvider) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.provider = provider
+}
+
+// key returns the key with the given id. The key provider may rotate its keys, so the key
+// is resolved every time it is used.
+func (s *KeyStore) key(ctx context.Context, id string) ([]byte, error) {
+	s.mu.RLock()
+	provider := s.provider
+	s.mu.RUnlock()
+	if provider == nil {
+		return nil, errors.New("the key provider is not available")
+	}
+	key, found, err := provider.Key(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get key %q: %w", id, err)
+	}
+	if !found {
+		return nil, fmt.Errorf("key %q not found", id)
+	}
+	return key, nil
+}
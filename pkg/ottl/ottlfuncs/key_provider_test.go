@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+)
+
+type testKeyProvider map[string][]byte
+
+func (p testKeyProvider) Key(_ context.Context, id string) ([]byte, bool, error) {
+	if id == "broken" {
+		return nil, false, errors.New("vault unavailable")
+	}
+	key, ok := p[id]
+	return key, ok, nil
+}
+
+type testKeyProviderExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+	testKeyProvider
+}
+
+type testHost map[component.ID]component.Component
+
+func (h testHost) GetExtensions() map[component.ID]component.Component {
+	return h
+}
+
+// newTestKeyStore returns a store with a 32 bytes AES key named "aes256", a 16 bytes
+// AES key named "aes128" and an invalid AES key named "short".
+func newTestKeyStore() *KeyStore {
+	keys := NewKeyStore()
+	keys.SetKeyProvider(testKeyProvider{
+		"aes256": []byte("0123456789abcdef0123456789abcdef"),
+		"aes128": []byte("0123456789abcdef"),
+		"short":  []byte("short"),
+	})
+	return keys
+}
+
+func Test_GetKeyProvider(t *testing.T) {
+	providerID := component.MustNewID("vault")
+	otherID := component.MustNewID("other")
+	host := testHost{
+		providerID: testKeyProviderExtension{testKeyProvider: testKeyProvider{"aes128": []byte("0123456789abcdef")}},
+		otherID: struct {
+			component.StartFunc
+			component.ShutdownFunc
+		}{},
+	}
+
+	provider, err := GetKeyProvider(host, providerID)
+	require.NoError(t, err)
+	key, found, err := provider.Key(t.Context(), "aes128")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []byte("0123456789abcdef"), key)
+
+	_, err = GetKeyProvider(host, otherID)
+	assert.EqualError(t, err, `extension "other" is not a key provider`)
+
+	_, err = GetKeyProvider(host, component.MustNewID("missing"))
+	assert.EqualError(t, err, `key provider extension "missing" not found`)
+}
+
+func Test_KeyStore(t *testing.T) {
+	keys := newTestKeyStore()
+
+	tests := []struct {
+		name          string
+		id            string
+		expected      []byte
+		expectedError string
+	}{
+		{
+			name:     "found",
+			id:       "aes128",
+			expected: []byte("0123456789abcdef"),
+		},
+		{
+			name:          "key not found",
+			id:            "unknown",
+			expectedError: `key "unknown" not found`,
+		},
+		{
+			name:          "provider error",
+			id:            "broken",
+			expectedError: `failed to get key "broken": vault unavailable`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := keys.key(t.Context(), tt.id)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, key)
+		})
+	}
+}
+
+func Test_KeyStore_noKeyProvider(t *testing.T) {
+	keys := newTestKeyStore()
+	keys.SetKeyProvider(nil)
+
+	_, err := keys.key(t.Context(), "aes128")
+	assert.EqualError(t, err, "the key provider is not available")
+}
@@ -0,0 +1,239 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// stateKeyPrefix namespaces the keys of the StateGet and StateSet Converters in the storage,
+// which may be shared with the other uses of the storage client by the component.
+const stateKeyPrefix = "ottl.state."
+
+// StateStorage is the storage persisting the values of the StateGet and StateSet Converters.
+// It is implemented by the storage.Client of the storage extensions.
+type StateStorage interface {
+	// Get returns the value of the key, or nil if it is not found.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Set sets the value of the key.
+	Set(ctx context.Context, key string, value []byte) error
+	// Delete deletes the key.
+	Delete(ctx context.Context, key string) error
+}
+
+// StateStore holds the storage of the StateGet and StateSet Converters. The storage clients
+// of the storage extensions are only available once the collector has started, so the
+// components create the store with their functions, and bind it to their storage client
+// with SetStorage when they start.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type StateStore struct {
+	mu      sync.RWMutex
+	storage StateStorage
+	now     func() time.Time
+}
+
+// NewStateStore returns a StateStore without storage, on which the StateGet and StateSet
+// Converters fail until a storage is set.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func NewStateStore() *StateStore {
+	return &StateStore{now: time.Now}
+}
+
+// SetStorage sets the storage of the store. It is meant to be called by the components when
+// they start, and with nil when they shut down, before closing the storage client.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func (s *StateStore) SetStorage(storage StateStorage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.storage = storage
+}
+
+func (s *StateStore) getStorage() (StateStorage, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.storage == nil {
+		return nil, errors.New("the state storage is not available")
+	}
+	return s.storage, nil
+}
+
+// get returns the value of the key, or nil if it is not found or expired. The expired
+// values are deleted when they are read.
+func (s *StateStore) get(ctx context.Context, key string) (any, error) {
+	storage, err := s.getStorage()
+	if err != nil {
+		return nil, err
+	}
+	content, err := storage.Get(ctx, stateKeyPrefix+key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the state of key %q: %w", key, err)
+	}
+	if content == nil {
+		return nil, nil
+	}
+	var entry stateEntry
+	if err = json.Unmarshal(content, &entry); err != nil {
+		return nil, fmt.Errorf("failed to decode the state of key %q: %w", key, err)
+	}
+	if !s.now().Before(time.Unix(0, entry.Expires)) {
+		if err = storage.Delete(ctx, stateKeyPrefix+key); err != nil {
+			return nil, fmt.Errorf("failed to delete the expired state of key %q: %w", key, err)
+		}
+		return nil, nil
+	}
+	value := pcommon.NewValueEmpty()
+	entry.Value.copyTo(value)
+	return stateValueResult(value), nil
+}
+
+// set sets the value of the key, expiring after the ttl.
+func (s *StateStore) set(ctx context.Context, key string, val any, ttl time.Duration) error {
+	storage, err := s.getStorage()
+	if err != nil {
+		return err
+	}
+	value := pcommon.NewValueEmpty()
+	if err = setStateValue(value, val); err != nil {
+		return err
+	}
+	content, err := json.Marshal(stateEntry{
+		Expires: s.now().Add(ttl).UnixNano(),
+		Value:   newStateValue(value),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode the state of key %q: %w", key, err)
+	}
+	if err = storage.Set(ctx, stateKeyPrefix+key, content); err != nil {
+		return fmt.Errorf("failed to set the state of key %q: %w", key, err)
+	}
+	return nil
+}
+
+// stateEntry is the value of a key persisted in the storage.
+type stateEntry struct {
+	Expires int64      `json:"expires"`
+	Value   stateValue `json:"value"`
+}
+
+// stateValue is the JSON representation of a pcommon.Value, which keeps its type. Only the
+// field of the type of the value is set, and none of them for an empty value.
+type stateValue struct {
+	Str    *string                `json:"s,omitempty"`
+	Bool   *bool                  `json:"b,omitempty"`
+	Int    *int64                 `json:"i,omitempty"`
+	Double *float64               `json:"d,omitempty"`
+	Bytes  *[]byte                `json:"y,omitempty"`
+	Map    *map[string]stateValue `json:"m,omitempty"`
+	Slice  *[]stateValue          `json:"a,omitempty"`
+}
+
+func newStateValue(value pcommon.Value) stateValue {
+	switch value.Type() {
+	case pcommon.ValueTypeStr:
+		s := value.Str()
+		return stateValue{Str: &s}
+	case pcommon.ValueTypeBool:
+		b := value.Bool()
+		return stateValue{Bool: &b}
+	case pcommon.ValueTypeInt:
+		i := value.Int()
+		return stateValue{Int: &i}
+	case pcommon.ValueTypeDouble:
+		d := value.Double()
+		return stateValue{Double: &d}
+	case pcommon.ValueTypeBytes:
+		// The empty byte slices must not be encoded as null, which would decode to an empty value.
+		b := append([]byte{}, value.Bytes().AsRaw()...)
+		return stateValue{Bytes: &b}
+	case pcommon.ValueTypeMap:
+		m := make(map[string]stateValue, value.Map().Len())
+		for k, v := range value.Map().All() {
+			m[k] = newStateValue(v)
+		}
+		return stateValue{Map: &m}
+	case pcommon.ValueTypeSlice:
+		s := make([]stateValue, 0, value.Slice().Len())
+		for _, v := range value.Slice().All() {
+			s = append(s, newStateValue(v))
+		}
+		return stateValue{Slice: &s}
+	default:
+		return stateValue{}
+	}
+}
+
+func (v stateValue) copyTo(dest pcommon.Value) {
+	switch {
+	case v.Str != nil:
+		dest.SetStr(*v.Str)
+	case v.Bool != nil:
+		dest.SetBool(*v.Bool)
+	case v.Int != nil:
+		dest.SetInt(*v.Int)
+	case v.Double != nil:
+		dest.SetDouble(*v.Double)
+	case v.Bytes != nil:
+		dest.SetEmptyBytes().FromRaw(*v.Bytes)
+	case v.Map != nil:
+		m := dest.SetEmptyMap()
+		m.EnsureCapacity(len(*v.Map))
+		// The keys are sorted, as the order of the JSON objects is not kept.
+		for _, k := range slices.Sorted(maps.Keys(*v.Map)) {
+			(*v.Map)[k].copyTo(m.PutEmpty(k))
+		}
+	case v.Slice != nil:
+		s := dest.SetEmptySlice()
+		s.EnsureCapacity(len(*v.Slice))
+		for _, item := range *v.Slice {
+			item.copyTo(s.AppendEmpty())
+		}
+	}
+}
+
+// setStateValue sets the value returned by a Getter into dest.
+func setStateValue(dest pcommon.Value, val any) error {
+	switch v := val.(type) {
+	case pcommon.Value:
+		v.CopyTo(dest)
+	case pcommon.Map:
+		v.CopyTo(dest.SetEmptyMap())
+	case pcommon.Slice:
+		v.CopyTo(dest.SetEmptySlice())
+	case pcommon.ByteSlice:
+		v.CopyTo(dest.SetEmptyBytes())
+	default:
+		if err := dest.FromRaw(val); err != nil {
+			return fmt.Errorf("unsupported state value: %w", err)
+		}
+	}
+	return nil
+}
+
+// stateValueResult returns the value as it is returned by the paths of the contexts.
+func stateValueResult(value pcommon.Value) any {
+	switch value.Type() {
+	case pcommon.ValueTypeMap:
+		return value.Map()
+	case pcommon.ValueTypeSlice:
+		return value.Slice()
+	case pcommon.ValueTypeBytes:
+		return value.Bytes().AsRaw()
+	case pcommon.ValueTypeEmpty:
+		return nil
+	default:
+		return value.AsRaw()
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// memoryStateStorage is a StateStorage keeping the values in memory.
+type memoryStateStorage struct {
+	values map[string][]byte
+	err    error
+}
+
+func newMemoryStateStorage() *memoryStateStorage {
+	return &memoryStateStorage{values: map[string][]byte{}}
+}
+
+func (s *memoryStateStorage) Get(_ context.Context, key string) ([]byte, error) {
+	return s.values[key], s.err
+}
+
+func (s *memoryStateStorage) Set(_ context.Context, key string, value []byte) error {
+	s.values[key] = value
+	return s.err
+}
+
+func (s *memoryStateStorage) Delete(_ context.Context, key string) error {
+	delete(s.values, key)
+	return s.err
+}
+
+func newTestStateStore(storage StateStorage, now *time.Time) *StateStore {
+	store := NewStateStore()
+	store.now = func() time.Time { return *now }
+	store.SetStorage(storage)
+	return store
+}
+
+func Test_StateStore_values(t *testing.T) {
+	m := pcommon.NewMap()
+	m.PutStr("str", "value")
+	m.PutEmptySlice("slice").FromRaw([]any{int64(1), 2.5, true, nil})
+	m.PutEmptyMap("map").PutEmptyBytes("bytes").FromRaw([]byte{1, 2})
+
+	tests := []struct {
+		name     string
+		value    any
+		expected any
+	}{
+		{
+			name:     "string",
+			value:    "value",
+			expected: "value",
+		},
+		{
+			name:     "bool",
+			value:    false,
+			expected: false,
+		},
+		{
+			name:     "int",
+			value:    int64(1),
+			expected: int64(1),
+		},
+		{
+			name:     "double",
+			value:    1.0,
+			expected: 1.0,
+		},
+		{
+			name:     "bytes",
+			value:    []byte{1, 2},
+			expected: []byte{1, 2},
+		},
+		{
+			name:     "map",
+			value:    m,
+			expected: m,
+		},
+		{
+			name: "slice",
+			value: func() pcommon.Slice {
+				s := pcommon.NewSlice()
+				s.AppendEmpty().SetStr("a")
+				return s
+			}(),
+			expected: func() pcommon.Slice {
+				s := pcommon.NewSlice()
+				s.AppendEmpty().SetStr("a")
+				return s
+			}(),
+		},
+		{
+			name:     "raw map",
+			value:    map[string]any{"key": "value"},
+			expected: func() pcommon.Map { m := pcommon.NewMap(); m.PutStr("key", "value"); return m }(),
+		},
+		{
+			name:     "value",
+			value:    pcommon.NewValueInt(3),
+			expected: int64(3),
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Unix(100, 0)
+			store := newTestStateStore(newMemoryStateStorage(), &now)
+			require.NoError(t, store.set(t.Context(), "key", tt.value, time.Minute))
+			got, err := store.get(t.Context(), "key")
+			require.NoError(t, err)
+			switch expected := tt.expected.(type) {
+			case pcommon.Map:
+				require.IsType(t, pcommon.Map{}, got)
+				assert.Equal(t, expected.AsRaw(), got.(pcommon.Map).AsRaw())
+			case pcommon.Slice:
+				require.IsType(t, pcommon.Slice{}, got)
+				assert.Equal(t, expected.AsRaw(), got.(pcommon.Slice).AsRaw())
+			default:
+				assert.Equal(t, tt.expected, got)
+			}
+		})
+	}
+}
+
+func Test_StateStore_expiration(t *testing.T) {
+	now := time.Unix(100, 0)
+	storage := newMemoryStateStorage()
+	store := newTestStateStore(storage, &now)
+
+	require.NoError(t, store.set(t.Context(), "key", "value", time.Minute))
+	assert.Contains(t, storage.values, "ottl.state.key")
+
+	now = now.Add(time.Minute - time.Nanosecond)
+	got, err := store.get(t.Context(), "key")
+	require.NoError(t, err)
+	assert.Equal(t, "value", got)
+
+	now = now.Add(time.Nanosecond)
+	got, err = store.get(t.Context(), "key")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+	assert.Empty(t, storage.values)
+}
+
+func Test_StateStore_errors(t *testing.T) {
+	store := NewStateStore()
+	_, err := store.get(t.Context(), "key")
+	assert.EqualError(t, err, "the state storage is not available")
+	assert.EqualError(t, store.set(t.Context(), "key", "value", time.Minute), "the state storage is not available")
+
+	storage := newMemoryStateStorage()
+	store.SetStorage(storage)
+	assert.ErrorContains(t, store.set(t.Context(), "key", map[string]any{"key": struct{}{}}, time.Minute), "unsupported state value")
+
+	storage.values["ottl.state.invalid"] = []byte("{")
+	_, err = store.get(t.Context(), "invalid")
+	assert.ErrorContains(t, err, `failed to decode the state of key "invalid"`)
+
+	storage.err = errors.New("storage error")
+	_, err = store.get(t.Context(), "key")
+	assert.EqualError(t, err, `failed to get the state of key "key": storage error`)
+	assert.EqualError(t, store.set(t.Context(), "key", "value", time.Minute), `failed to set the state of key "key": storage error`)
+
+	store.SetStorage(nil)
+	_, err = store.get(t.Context(), "key")
+	assert.EqualError(t, err, "the state storage is not available")
+}
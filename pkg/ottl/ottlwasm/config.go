@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlwasm // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlwasm"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/iancoleman/strcase"
+)
+
+var (
+	upperCamelCaseRegexp = regexp.MustCompile(`^[A-Z][a-zA-Z0-9_]*$`)
+	lowercaseRegexp      = regexp.MustCompile(`^[a-z][a-zA-Z0-9_]*$`)
+)
+
+// PluginConfig configures a WebAssembly module providing OTTL functions.
+type PluginConfig struct {
+	// Path is the path of the WebAssembly module.
+	Path string `mapstructure:"path"`
+	// Functions are the functions exported by the module to register.
+	Functions []FunctionConfig `mapstructure:"functions"`
+}
+
+// FunctionConfig configures an OTTL function implemented by a WebAssembly module.
+// Functions named in UpperCamelCase are registered as Converters, and functions
+// named in lowercase are registered as Editors.
+type FunctionConfig struct {
+	// Name is the name of the function in OTTL statements.
+	Name string `mapstructure:"name"`
+	// Export is the name of the function exported by the module. Defaults to Name.
+	Export string `mapstructure:"export"`
+	// Parameters are the names of the parameters of the function, which can be used
+	// to pass the arguments by name. The first parameter of an Editor is the path
+	// set to the value returned by the module.
+	Parameters []string `mapstructure:"parameters"`
+}
+
+func (c FunctionConfig) isEditor() bool {
+	return lowercaseRegexp.MatchString(c.Name)
+}
+
+func (c FunctionConfig) export() string {
+	if c.Export != "" {
+		return c.Export
+	}
+	return c.Name
+}
+
+// Validate checks if the plugin configuration is valid.
+func (c PluginConfig) Validate() error {
+	if c.Path == "" {
+		return errors.New("path must be specified")
+	}
+	if len(c.Functions) == 0 {
+		return errors.New("at least one function must be specified")
+	}
+	names := map[string]struct{}{}
+	var errs error
+	for _, f := range c.Functions {
+		if _, ok := names[f.Name]; ok {
+			errs = errors.Join(errs, fmt.Errorf("duplicate function %q", f.Name))
+		}
+		names[f.Name] = struct{}{}
+		errs = errors.Join(errs, f.validate())
+	}
+	return errs
+}
+
+func (c FunctionConfig) validate() error {
+	if !upperCamelCaseRegexp.MatchString(c.Name) && !lowercaseRegexp.MatchString(c.Name) {
+		return fmt.Errorf("invalid function name %q, converters must be UpperCamelCase and editors lowercase", c.Name)
+	}
+	if c.isEditor() && len(c.Parameters) == 0 {
+		return fmt.Errorf("editor %q must have at least one parameter for its target", c.Name)
+	}
+	params := map[string]struct{}{}
+	for _, p := range c.Parameters {
+		if !lowercaseRegexp.MatchString(p) {
+			return fmt.Errorf("invalid parameter name %q for function %q", p, c.Name)
+		}
+		// The arguments are matched to the parameters the same way as for the
+		// built-in functions, by converting their names to UpperCamelCase.
+		field := strcase.ToCamel(p)
+		if _, ok := params[field]; ok {
+			return fmt.Errorf("duplicate parameter %q for function %q", p, c.Name)
+		}
+		params[field] = struct{}{}
+	}
+	return nil
+}
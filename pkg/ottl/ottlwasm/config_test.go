@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlwasm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_PluginConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  PluginConfig
+		wantErr string
+	}{
+		{
+			name: "valid",
+			config: PluginConfig{
+				Path: "plugin.wasm",
+				Functions: []FunctionConfig{
+					{Name: "Converter", Parameters: []string{"value", "other_value"}},
+					{Name: "NoParameters"},
+					{Name: "set_value", Export: "set", Parameters: []string{"target"}},
+				},
+			},
+		},
+		{
+			name:    "missing path",
+			config:  PluginConfig{Functions: []FunctionConfig{{Name: "Converter"}}},
+			wantErr: "path must be specified",
+		},
+		{
+			name:    "no functions",
+			config:  PluginConfig{Path: "plugin.wasm"},
+			wantErr: "at least one function must be specified",
+		},
+		{
+			name: "invalid name",
+			config: PluginConfig{
+				Path:      "plugin.wasm",
+				Functions: []FunctionConfig{{Name: "9lives"}},
+			},
+			wantErr: `invalid function name "9lives", converters must be UpperCamelCase and editors lowercase`,
+		},
+		{
+			name: "duplicate function",
+			config: PluginConfig{
+				Path:      "plugin.wasm",
+				Functions: []FunctionConfig{{Name: "Converter"}, {Name: "Converter"}},
+			},
+			wantErr: `duplicate function "Converter"`,
+		},
+		{
+			name: "editor without target",
+			config: PluginConfig{
+				Path:      "plugin.wasm",
+				Functions: []FunctionConfig{{Name: "set_value"}},
+			},
+			wantErr: `editor "set_value" must have at least one parameter for its target`,
+		},
+		{
+			name: "invalid parameter",
+			config: PluginConfig{
+				Path:      "plugin.wasm",
+				Functions: []FunctionConfig{{Name: "Converter", Parameters: []string{"Value"}}},
+			},
+			wantErr: `invalid parameter name "Value" for function "Converter"`,
+		},
+		{
+			name: "duplicate parameter",
+			config: PluginConfig{
+				Path:      "plugin.wasm",
+				Functions: []FunctionConfig{{Name: "Converter", Parameters: []string{"other_value", "otherValue"}}},
+			},
+			wantErr: `duplicate parameter "otherValue" for function "Converter"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
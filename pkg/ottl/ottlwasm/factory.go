@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlwasm // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlwasm"
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/iancoleman/strcase"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// NewFactories returns the factories of the functions of the plugin, which can be
+// registered in an OTTL parser along with the other functions.
+//
+// Converters pass their arguments to the module and return the value it returned.
+// Editors pass the current value of their target and their other arguments to the
+// module, and set the target to the value it returned.
+func NewFactories[K any](p *Plugin) []ottl.Factory[K] {
+	factories := make([]ottl.Factory[K], 0, len(p.config.Functions))
+	for _, f := range p.config.Functions {
+		factories = append(factories, newFactory[K](p, f))
+	}
+	return factories
+}
+
+func newFactory[K any](p *Plugin, f FunctionConfig) ottl.Factory[K] {
+	var args ottl.Arguments
+	if len(f.Parameters) > 0 {
+		args = reflect.New(argumentsType[K](f)).Interface()
+	}
+	return ottl.NewFactory(f.Name, args, func(_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+		return createFunction[K](p, f, oArgs)
+	})
+}
+
+// argumentsType returns the type of the Arguments of the function, a struct with
+// a field per parameter named after it. Editors take a GetSetter as target.
+func argumentsType[K any](f FunctionConfig) reflect.Type {
+	fields := make([]reflect.StructField, len(f.Parameters))
+	for i, param := range f.Parameters {
+		fields[i] = reflect.StructField{
+			Name: strcase.ToCamel(param),
+			Type: reflect.TypeFor[ottl.Getter[K]](),
+		}
+	}
+	if f.isEditor() {
+		fields[0].Type = reflect.TypeFor[ottl.GetSetter[K]]()
+	}
+	return reflect.StructOf(fields)
+}
+
+func createFunction[K any](p *Plugin, f FunctionConfig, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	getters := make([]ottl.Getter[K], len(f.Parameters))
+	if len(f.Parameters) > 0 {
+		argsVal := reflect.ValueOf(oArgs)
+		if argsVal.Kind() != reflect.Pointer || argsVal.Elem().NumField() != len(f.Parameters) {
+			return nil, errors.New(f.Name + " args must be the arguments struct of its factory")
+		}
+		for i := range getters {
+			getters[i] = argsVal.Elem().Field(i).Interface().(ottl.Getter[K])
+		}
+	}
+
+	var target ottl.GetSetter[K]
+	if f.isEditor() {
+		target = getters[0].(ottl.GetSetter[K])
+	}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		args := make([]any, len(getters))
+		for i, getter := range getters {
+			val, err := getter.Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = val
+		}
+		result, err := p.call(ctx, f.Name, args)
+		if err != nil {
+			return nil, err
+		}
+		if target != nil {
+			return nil, target.Set(ctx, tCtx, result)
+		}
+		return result, nil
+	}, nil
+}
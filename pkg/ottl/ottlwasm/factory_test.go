@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlwasm
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type testContext map[string]any
+
+func parsePath(p ottl.Path[testContext]) (ottl.GetSetter[testContext], error) {
+	name := p.Name()
+	return &ottl.StandardGetSetter[testContext]{
+		Getter: func(_ context.Context, tCtx testContext) (any, error) {
+			return tCtx[name], nil
+		},
+		Setter: func(_ context.Context, tCtx testContext, val any) error {
+			tCtx[name] = val
+			return nil
+		},
+	}, nil
+}
+
+type setArguments struct {
+	Target ottl.Setter[testContext]
+	Value  ottl.Getter[testContext]
+}
+
+func newSetFactory() ottl.Factory[testContext] {
+	return ottl.NewFactory("set", &setArguments{}, func(_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[testContext], error) {
+		args := oArgs.(*setArguments)
+		return func(ctx context.Context, tCtx testContext) (any, error) {
+			val, err := args.Value.Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			return nil, args.Target.Set(ctx, tCtx, val)
+		}, nil
+	})
+}
+
+func Test_NewFactories(t *testing.T) {
+	plugin, err := LoadPlugin(t.Context(), PluginConfig{
+		Path: filepath.Join("testdata", "functions.wasm"),
+		Functions: []FunctionConfig{
+			{Name: "Echo", Export: "echo", Parameters: []string{"value", "other_value"}},
+			{Name: "echo_into", Export: "echo", Parameters: []string{"target", "value"}},
+			{Name: "Fail", Export: "fail"},
+		},
+	})
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, plugin.Close(t.Context()))
+	}()
+
+	parser, err := ottl.NewParser(
+		ottl.CreateFactoryMap(append(NewFactories[testContext](plugin), newSetFactory())...),
+		parsePath,
+		componenttest.NewNopTelemetrySettings(),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		statement string
+		want      testContext
+		wantErr   string
+	}{
+		{
+			name:      "converter",
+			statement: `set(result, Echo("a", {"key": [1, 1.5]}))`,
+			want: testContext{
+				"input":  "input",
+				"result": []any{"a", map[string]any{"key": []any{int64(1), 1.5}}},
+			},
+		},
+		{
+			name:      "named arguments",
+			statement: `set(result, Echo(other_value = input, value = "b"))`,
+			want: testContext{
+				"input":  "input",
+				"result": []any{"b", "input"},
+			},
+		},
+		{
+			name:      "editor",
+			statement: `echo_into(input, "b")`,
+			want: testContext{
+				"input": []any{"input", "b"},
+			},
+		},
+		{
+			name:      "error",
+			statement: `set(result, Fail())`,
+			wantErr:   "Fail: failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statement, err := parser.ParseStatement(tt.statement)
+			require.NoError(t, err)
+
+			tCtx := testContext{"input": "input"}
+			_, _, err = statement.Execute(t.Context(), tCtx)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			got := testContext{}
+			for k, v := range tCtx {
+				if s, ok := v.(pcommon.Slice); ok {
+					v = s.AsRaw()
+				}
+				got[k] = v
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
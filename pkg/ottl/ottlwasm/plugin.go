@@ -0,0 +1,237 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlwasm // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlwasm"
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/goccy/go-json"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+)
+
+const (
+	allocExport = "ottl_alloc"
+	freeExport  = "ottl_free"
+)
+
+// Plugin is a WebAssembly module providing OTTL functions.
+// Calls to the functions of a Plugin are serialized, as a module instance
+// cannot be used concurrently.
+type Plugin struct {
+	config   PluginConfig
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+	module   api.Module
+	alloc    api.Function
+	free     api.Function
+	funcs    map[string]api.Function
+	mu       sync.Mutex
+}
+
+// NewPlugin returns a Plugin whose WebAssembly module is not loaded, so the factories of its
+// functions can be created before the module is loaded with Load, for example by a component
+// loading it when it starts. Its functions fail until it is loaded.
+func NewPlugin(cfg PluginConfig) (*Plugin, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &Plugin{config: cfg}, nil
+}
+
+// LoadPlugin compiles and instantiates the WebAssembly module of the configuration,
+// and checks that it exports the configured functions. The Plugin must be closed
+// once its functions are no longer used.
+func LoadPlugin(ctx context.Context, cfg PluginConfig) (*Plugin, error) {
+	p, err := NewPlugin(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err = p.Load(ctx); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Load compiles and instantiates the WebAssembly module of a Plugin returned by NewPlugin,
+// and checks that it exports the configured functions. The Plugin must be closed once its
+// functions are no longer used.
+func (p *Plugin) Load(ctx context.Context) error {
+	binary, err := os.ReadFile(p.config.Path)
+	if err != nil {
+		return fmt.Errorf("failed to read WebAssembly module: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.runtime != nil {
+		return fmt.Errorf("WebAssembly module %q is already loaded", p.config.Path)
+	}
+	runtime := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().WithCloseOnContextDone(true))
+	compiled, err := compile(ctx, runtime, p.config, binary)
+	if err == nil {
+		p.runtime, p.compiled = runtime, compiled
+		err = p.instantiateModule(ctx)
+	}
+	if err != nil {
+		p.runtime, p.compiled, p.module = nil, nil, nil
+		return errors.Join(err, runtime.Close(ctx))
+	}
+	return nil
+}
+
+func compile(ctx context.Context, runtime wazero.Runtime, cfg PluginConfig, binary []byte) (wazero.CompiledModule, error) {
+	// Modules compiled from most languages import WASI, even if they do not use it.
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, runtime); err != nil {
+		return nil, fmt.Errorf("failed to instantiate WASI: %w", err)
+	}
+	compiled, err := runtime.CompileModule(ctx, binary)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile WebAssembly module %q: %w", cfg.Path, err)
+	}
+	return compiled, nil
+}
+
+// instantiateModule instantiates the compiled module, and resolves the functions it exports.
+func (p *Plugin) instantiateModule(ctx context.Context) error {
+	// Reactor modules export _initialize, which must be called before any other function.
+	module, err := p.runtime.InstantiateModule(ctx, p.compiled, wazero.NewModuleConfig().WithStartFunctions("_initialize"))
+	if err != nil {
+		return fmt.Errorf("failed to instantiate WebAssembly module %q: %w", p.config.Path, err)
+	}
+	alloc := module.ExportedFunction(allocExport)
+	if alloc == nil {
+		return fmt.Errorf("WebAssembly module %q must export %q", p.config.Path, allocExport)
+	}
+	if err = checkSignature(allocExport, alloc, []api.ValueType{api.ValueTypeI32}, []api.ValueType{api.ValueTypeI32}); err != nil {
+		return err
+	}
+	free := module.ExportedFunction(freeExport)
+	if free != nil {
+		if err = checkSignature(freeExport, free, []api.ValueType{api.ValueTypeI32, api.ValueTypeI32}, nil); err != nil {
+			return err
+		}
+	}
+	funcs := make(map[string]api.Function, len(p.config.Functions))
+	for _, f := range p.config.Functions {
+		fn := module.ExportedFunction(f.export())
+		if fn == nil {
+			return fmt.Errorf("WebAssembly module %q does not export function %q", p.config.Path, f.export())
+		}
+		if err = checkSignature(f.export(), fn, []api.ValueType{api.ValueTypeI32, api.ValueTypeI32}, []api.ValueType{api.ValueTypeI64}); err != nil {
+			return err
+		}
+		funcs[f.Name] = fn
+	}
+	p.module, p.alloc, p.free, p.funcs = module, alloc, free, funcs
+	return nil
+}
+
+func checkSignature(name string, fn api.Function, params, results []api.ValueType) error {
+	def := fn.Definition()
+	if !slices.Equal(def.ParamTypes(), params) || !slices.Equal(def.ResultTypes(), results) {
+		return fmt.Errorf("function %q must have the signature (%s) -> (%s)", name, valueTypeNames(params), valueTypeNames(results))
+	}
+	return nil
+}
+
+func valueTypeNames(types []api.ValueType) string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = api.ValueTypeName(t)
+	}
+	return strings.Join(names, ", ")
+}
+
+// Close releases the WebAssembly module. The Plugin can be loaded again once it is closed.
+func (p *Plugin) Close(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.runtime == nil {
+		return nil
+	}
+	err := p.runtime.Close(ctx)
+	p.runtime, p.compiled, p.module = nil, nil, nil
+	return err
+}
+
+// callResult is the JSON object returned by the functions of the module.
+type callResult struct {
+	Value any    `json:"value"`
+	Error string `json:"error"`
+}
+
+// call calls the function with the JSON encoded arguments, and returns the value
+// it returned. The input is written to memory allocated with ottl_alloc, and the
+// function returns the location of its result, packed as ptr<<32 | len.
+func (p *Plugin) call(ctx context.Context, name string, args []any) (any, error) {
+	input, err := json.Marshal(encodeValues(args))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the arguments of %q: %w", name, err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.module == nil {
+		return nil, fmt.Errorf("WebAssembly module %q of %q is not loaded", p.config.Path, name)
+	}
+	// The runtime closes the module when the context of a call is done, to stop it. The
+	// module is instantiated again for the next call, without the state of the previous one.
+	if p.module.IsClosed() {
+		if err = p.instantiateModule(context.WithoutCancel(ctx)); err != nil {
+			return nil, err
+		}
+	}
+
+	results, err := p.alloc.Call(ctx, uint64(len(input)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to allocate memory for %q: %w", name, err)
+	}
+	inputPtr := api.DecodeU32(results[0])
+	if !p.module.Memory().Write(inputPtr, input) {
+		return nil, fmt.Errorf("memory allocated for %q is out of range", name)
+	}
+	results, err = p.funcs[name].Call(ctx, uint64(inputPtr), uint64(len(input)))
+	p.release(ctx, inputPtr, uint32(len(input)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to call %q: %w", name, err)
+	}
+
+	outputPtr, outputLen := uint32(results[0]>>32), uint32(results[0])
+	output, ok := p.module.Memory().Read(outputPtr, outputLen)
+	if !ok {
+		return nil, fmt.Errorf("result of %q is out of range", name)
+	}
+	// The memory is reused once it is released, so the output is decoded before.
+	var result callResult
+	decoder := json.NewDecoder(bytes.NewReader(output))
+	decoder.UseNumber()
+	err = decoder.Decode(&result)
+	p.release(ctx, outputPtr, outputLen)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the result of %q: %w", name, err)
+	}
+	if result.Error != "" {
+		return nil, fmt.Errorf("%s: %s", name, result.Error)
+	}
+	return decodeValue(result.Value)
+}
+
+func (p *Plugin) release(ctx context.Context, ptr, size uint32) {
+	if p.free == nil {
+		return
+	}
+	// There is nothing to do if the module fails to release its memory,
+	// and the result of the call is still valid.
+	_, _ = p.free.Call(ctx, uint64(ptr), uint64(size))
+}
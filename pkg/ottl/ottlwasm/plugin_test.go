@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlwasm
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_LoadPlugin(t *testing.T) {
+	path := filepath.Join("testdata", "functions.wasm")
+	tests := []struct {
+		name    string
+		config  PluginConfig
+		wantErr string
+	}{
+		{
+			name: "valid",
+			config: PluginConfig{
+				Path:      path,
+				Functions: []FunctionConfig{{Name: "Echo", Export: "echo", Parameters: []string{"value"}}},
+			},
+		},
+		{
+			name:    "invalid config",
+			config:  PluginConfig{Path: path},
+			wantErr: "at least one function must be specified",
+		},
+		{
+			name: "missing module",
+			config: PluginConfig{
+				Path:      filepath.Join("testdata", "missing.wasm"),
+				Functions: []FunctionConfig{{Name: "Echo"}},
+			},
+			wantErr: "failed to read WebAssembly module",
+		},
+		{
+			name: "invalid module",
+			config: PluginConfig{
+				Path:      filepath.Join("testdata", "functions.wat"),
+				Functions: []FunctionConfig{{Name: "Echo"}},
+			},
+			wantErr: "failed to compile WebAssembly module",
+		},
+		{
+			name: "missing function",
+			config: PluginConfig{
+				Path:      path,
+				Functions: []FunctionConfig{{Name: "Echo"}},
+			},
+			wantErr: `does not export function "Echo"`,
+		},
+		{
+			name: "invalid signature",
+			config: PluginConfig{
+				Path:      path,
+				Functions: []FunctionConfig{{Name: "BadSignature", Export: "bad_signature"}},
+			},
+			wantErr: `function "bad_signature" must have the signature (i32, i32) -> (i64)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin, err := LoadPlugin(t.Context(), tt.config)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NoError(t, plugin.Close(t.Context()))
+		})
+	}
+}
+
+func Test_Plugin_call(t *testing.T) {
+	plugin, err := LoadPlugin(t.Context(), PluginConfig{
+		Path:      filepath.Join("testdata", "functions.wasm"),
+		Functions: []FunctionConfig{{Name: "Echo", Export: "echo"}},
+	})
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, plugin.Close(t.Context()))
+	}()
+
+	// The module instance is reused by the calls
+	for range 3 {
+		got, err := plugin.call(t.Context(), "Echo", []any{"value", int64(1)})
+		require.NoError(t, err)
+		require.IsType(t, pcommon.Slice{}, got)
+		assert.Equal(t, []any{"value", int64(1)}, got.(pcommon.Slice).AsRaw())
+	}
+}
+
+func Test_Plugin_call_canceled(t *testing.T) {
+	plugin, err := LoadPlugin(t.Context(), PluginConfig{
+		Path:      filepath.Join("testdata", "functions.wasm"),
+		Functions: []FunctionConfig{{Name: "Echo", Export: "echo"}},
+	})
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, plugin.Close(t.Context()))
+	}()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	_, err = plugin.call(ctx, "Echo", []any{"value"})
+	require.Error(t, err)
+	require.True(t, plugin.module.IsClosed())
+
+	// The module closed by the canceled call is instantiated again for the next one
+	got, err := plugin.call(t.Context(), "Echo", []any{"value"})
+	require.NoError(t, err)
+	require.IsType(t, pcommon.Slice{}, got)
+	assert.Equal(t, []any{"value"}, got.(pcommon.Slice).AsRaw())
+}
+
+func Test_NewPlugin(t *testing.T) {
+	plugin, err := NewPlugin(PluginConfig{
+		Path:      filepath.Join("testdata", "functions.wasm"),
+		Functions: []FunctionConfig{{Name: "Echo", Export: "echo"}},
+	})
+	require.NoError(t, err)
+
+	_, err = plugin.call(t.Context(), "Echo", []any{"value"})
+	assert.ErrorContains(t, err, "is not loaded")
+
+	// The plugin can be loaded again once it is closed
+	for range 2 {
+		require.NoError(t, plugin.Load(t.Context()))
+		assert.ErrorContains(t, plugin.Load(t.Context()), "is already loaded")
+		got, err := plugin.call(t.Context(), "Echo", []any{"value"})
+		require.NoError(t, err)
+		assert.Equal(t, []any{"value"}, got.(pcommon.Slice).AsRaw())
+		require.NoError(t, plugin.Close(t.Context()))
+	}
+
+	_, err = NewPlugin(PluginConfig{Path: "functions.wasm"})
+	assert.ErrorContains(t, err, "at least one function must be specified")
+}
@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlwasm // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlwasm"
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/goccy/go-json"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+)
+
+// encodeValues converts the arguments into values that can be encoded as JSON:
+// maps, slices and values are converted to their raw representation, times are
+// formatted as RFC 3339 strings, durations as Go duration strings, and IDs as
+// hex strings. Byte slices are encoded as base64 strings.
+func encodeValues(values []any) []any {
+	encoded := make([]any, len(values))
+	for i, v := range values {
+		encoded[i] = encodeValue(v)
+	}
+	return encoded
+}
+
+func encodeValue(val any) any {
+	switch v := val.(type) {
+	case pcommon.Map:
+		return v.AsRaw()
+	case pcommon.Slice:
+		return v.AsRaw()
+	case pcommon.Value:
+		return v.AsRaw()
+	case pcommon.ByteSlice:
+		return v.AsRaw()
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	case time.Duration:
+		return v.String()
+	case pcommon.TraceID:
+		return v.String()
+	case pcommon.SpanID:
+		return v.String()
+	case pprofile.ProfileID:
+		return v.String()
+	case []any:
+		return encodeValues(v)
+	default:
+		return val
+	}
+}
+
+// decodeValue converts a value decoded from JSON into a value used by OTTL:
+// integers are converted to int64, other numbers to float64, objects to
+// pcommon.Map and arrays to pcommon.Slice.
+func decodeValue(val any) (any, error) {
+	raw, err := decodeRaw(val)
+	if err != nil {
+		return nil, err
+	}
+	switch v := raw.(type) {
+	case map[string]any:
+		m := pcommon.NewMap()
+		if err = m.FromRaw(v); err != nil {
+			return nil, err
+		}
+		return m, nil
+	case []any:
+		s := pcommon.NewSlice()
+		if err = s.FromRaw(v); err != nil {
+			return nil, err
+		}
+		return s, nil
+	default:
+		return raw, nil
+	}
+}
+
+func decodeRaw(val any) (any, error) {
+	switch v := val.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q: %w", v, err)
+		}
+		return f, nil
+	case map[string]any:
+		for k, item := range v {
+			raw, err := decodeRaw(item)
+			if err != nil {
+				return nil, err
+			}
+			v[k] = raw
+		}
+		return v, nil
+	case []any:
+		for i, item := range v {
+			raw, err := decodeRaw(item)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = raw
+		}
+		return v, nil
+	default:
+		return val, nil
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlwasm
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_encodeValues(t *testing.T) {
+	m := pcommon.NewMap()
+	m.PutStr("key", "value")
+	s := pcommon.NewSlice()
+	s.AppendEmpty().SetInt(1)
+
+	encoded, err := json.Marshal(encodeValues([]any{
+		"string",
+		int64(1),
+		1.5,
+		true,
+		nil,
+		m,
+		s,
+		pcommon.NewValueStr("value"),
+		[]byte("bytes"),
+		time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		time.Second,
+		pcommon.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		pcommon.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+		[]any{m},
+	}))
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		"string",
+		1,
+		1.5,
+		true,
+		null,
+		{"key": "value"},
+		[1],
+		"value",
+		"Ynl0ZXM=",
+		"2025-01-02T03:04:05Z",
+		"1s",
+		"0102030405060708090a0b0c0d0e0f10",
+		"0102030405060708",
+		[{"key": "value"}]
+	]`, string(encoded))
+}
+
+func Test_decodeValue(t *testing.T) {
+	m := pcommon.NewMap()
+	m.PutInt("int", 1)
+	m.PutDouble("double", 1.5)
+	m.PutEmptySlice("slice").AppendEmpty().SetStr("value")
+	s := pcommon.NewSlice()
+	s.AppendEmpty().SetInt(1)
+	s.AppendEmpty().SetEmptyMap().PutBool("bool", true)
+
+	tests := []struct {
+		name  string
+		input string
+		want  any
+	}{
+		{name: "string", input: `"value"`, want: "value"},
+		{name: "int", input: `1`, want: int64(1)},
+		{name: "double", input: `1.5`, want: 1.5},
+		{name: "bool", input: `true`, want: true},
+		{name: "null", input: `null`, want: nil},
+		{name: "map", input: `{"int": 1, "double": 1.5, "slice": ["value"]}`, want: m},
+		{name: "slice", input: `[1, {"bool": true}]`, want: s},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoder := json.NewDecoder(bytes.NewReader([]byte(tt.input)))
+			decoder.UseNumber()
+			var val any
+			require.NoError(t, decoder.Decode(&val))
+			got, err := decodeValue(val)
+			require.NoError(t, err)
+			switch want := tt.want.(type) {
+			case pcommon.Map:
+				require.IsType(t, pcommon.Map{}, got)
+				assert.Equal(t, want.AsRaw(), got.(pcommon.Map).AsRaw())
+			case pcommon.Slice:
+				require.IsType(t, pcommon.Slice{}, got)
+				assert.Equal(t, want.AsRaw(), got.(pcommon.Slice).AsRaw())
+			default:
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
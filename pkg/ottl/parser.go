@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -23,7 +24,12 @@ type Statement[K any] struct {
 	function          Expr[K]
 	condition         boolExpr[K]
 	origText          string
+	errorMode         ErrorMode
 	telemetrySettings component.TelemetrySettings
+	// memoKeys are the cache keys of the memoizable converters called by the statement.
+	memoKeys []string
+	// letName is the name bound by the statement, if it is a let statement.
+	letName string
 }
 
 // Execute is a function that will execute the statement's function if the statement's condition is met.
@@ -31,20 +37,24 @@ type Statement[K any] struct {
 // If the statement contains no condition, the function will run and true will be returned.
 // In addition, the functions return value is always returned.
 func (s *Statement[K]) Execute(ctx context.Context, tCtx K) (any, bool, error) {
+	return s.execute(ctx, tCtx, s.telemetrySettings.Logger.Core().Enabled(zap.DebugLevel))
+}
+
+func (s *Statement[K]) execute(ctx context.Context, tCtx K, debug bool) (any, bool, error) {
 	condition, err := s.condition.Eval(ctx, tCtx)
 	defer func() {
-		if s.telemetrySettings.Logger.Core().Enabled(zap.DebugLevel) {
+		if debug {
 			s.telemetrySettings.Logger.Debug("TransformContext after statement execution", zap.String("statement", s.origText), zap.Bool("condition matched", condition), newTransformContextField(tCtx))
 		}
 	}()
 	if err != nil {
-		return nil, false, err
+		return nil, false, withSource(err, s.origText, -1)
 	}
 	var result any
 	if condition {
 		result, err = s.function.Eval(ctx, tCtx)
 		if err != nil {
-			return nil, true, err
+			return nil, true, withSource(err, s.origText, -1)
 		}
 	}
 	return result, condition, nil
@@ -58,7 +68,11 @@ type Condition[K any] struct {
 
 // Eval returns true if the condition was met for the given TransformContext and false otherwise.
 func (c *Condition[K]) Eval(ctx context.Context, tCtx K) (bool, error) {
-	return c.condition.Eval(ctx, tCtx)
+	match, err := c.condition.Eval(ctx, tCtx)
+	if err != nil {
+		return match, withSource(err, c.origText, -1)
+	}
+	return match, nil
 }
 
 // Parser provides the means to parse OTTL StatementSequence and Conditions given a specific set of functions,
@@ -69,6 +83,8 @@ type Parser[K any] struct {
 	enumParser        EnumParser
 	telemetrySettings component.TelemetrySettings
 	pathContextNames  map[string]struct{}
+	pathNamespaces    map[string]PathExpressionParser[K]
+	explain           bool
 }
 
 // NewParser creates a new Parser
@@ -121,19 +137,42 @@ func WithPathContextNames[K any](contexts []string) Option[K] {
 	}
 }
 
+// WithPathNamespace registers the PathExpressionParser of an additional top-level path namespace,
+// such as `myvendor`, so distributions and components can add their own paths to the existing
+// contexts. The paths starting with the namespace, such as `myvendor.region`, are parsed by the
+// given PathExpressionParser instead of the Parser's one, and the Path it receives starts at the
+// segment following the namespace. The namespace takes precedence over the paths of the context
+// with the same name. When path context names are enabled, it is accepted as the context of the
+// paths, but the statements can't be parsed with it as their context.
+//
+// Experimental: *NOTE* this option is subject to change or removal in the future.
+func WithPathNamespace[K any](namespace string, parser PathExpressionParser[K]) Option[K] {
+	return func(p *Parser[K]) {
+		if p.pathNamespaces == nil {
+			p.pathNamespaces = map[string]PathExpressionParser[K]{}
+		}
+		p.pathNamespaces[namespace] = parser
+	}
+}
+
 // ParseStatements parses string statements into ottl.Statement objects ready for execution.
 // Returns a slice of statements and a nil error on successful parsing.
 // If parsing fails, returns nil and a joined error containing each error per failed statement.
 func (p *Parser[K]) ParseStatements(statements []string) ([]*Statement[K], error) {
 	parsedStatements := make([]*Statement[K], 0, len(statements))
 	var parseErrs []error
+	// The names bound by the let statements can be used by the following statements.
+	bindings := localScopeFrame{}
 
-	for _, statement := range statements {
-		ps, err := p.ParseStatement(statement)
+	for i, statement := range statements {
+		ps, err := p.parseStatementWithBindings(statement, bindings)
 		if err != nil {
-			parseErrs = append(parseErrs, fmt.Errorf("unable to parse OTTL statement %q: %w", statement, err))
+			parseErrs = append(parseErrs, fmt.Errorf("unable to parse OTTL statement %q: %w", statement, withSource(err, statement, i)))
 			continue
 		}
+		if ps.letName != "" {
+			bindings[ps.letName] = struct{}{}
+		}
 		parsedStatements = append(parsedStatements, ps)
 	}
 
@@ -148,13 +187,33 @@ func (p *Parser[K]) ParseStatements(statements []string) ([]*Statement[K], error
 // Returns a Statement and a nil error on successful parsing.
 // If parsing fails, returns nil and an error.
 func (p *Parser[K]) ParseStatement(statement string) (*Statement[K], error) {
+	parsed, err := p.parseStatementWithBindings(statement, nil)
+	if err != nil {
+		return nil, withSource(err, statement, -1)
+	}
+	return parsed, nil
+}
+
+// parseStatementWithBindings parses the statement, in which the names bound by the
+// preceding let statements are local identifiers.
+func (p *Parser[K]) parseStatementWithBindings(statement string, bindings localScopeFrame) (*Statement[K], error) {
 	parsed, err := parseStatement(statement)
 	if err != nil {
 		return nil, err
 	}
 
 	pc := p.newParseContext()
-	function, err := pc.newFunctionCall(parsed.Editor)
+	if len(bindings) > 0 {
+		pc.localScopes.push(bindings)
+	}
+	var function Expr[K]
+	var letName string
+	if parsed.Let != nil {
+		letName = parsed.Let.Name
+		function, err = pc.newLetBinding(parsed.Let, bindings)
+	} else {
+		function, err = pc.newFunctionCall(parsed.Editor)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -162,11 +221,20 @@ func (p *Parser[K]) ParseStatement(statement string) (*Statement[K], error) {
 	if err != nil {
 		return nil, err
 	}
+	var errorMode ErrorMode
+	if parsed.ErrorMode != nil {
+		if err := errorMode.UnmarshalText([]byte(*parsed.ErrorMode)); err != nil {
+			return nil, err
+		}
+	}
 	return &Statement[K]{
 		function:          function,
 		condition:         expression,
 		origText:          statement,
+		errorMode:         errorMode,
 		telemetrySettings: p.telemetrySettings,
+		memoKeys:          pc.memoKeys,
+		letName:           letName,
 	}, nil
 }
 
@@ -177,10 +245,10 @@ func (p *Parser[K]) ParseConditions(conditions []string) ([]*Condition[K], error
 	parsedConditions := make([]*Condition[K], 0, len(conditions))
 	var parseErrs []error
 
-	for _, condition := range conditions {
+	for i, condition := range conditions {
 		ps, err := p.ParseCondition(condition)
 		if err != nil {
-			parseErrs = append(parseErrs, fmt.Errorf("unable to parse OTTL condition %q: %w", condition, err))
+			parseErrs = append(parseErrs, fmt.Errorf("unable to parse OTTL condition %q: %w", condition, withSource(err, condition, i)))
 			continue
 		}
 		parsedConditions = append(parsedConditions, ps)
@@ -199,12 +267,12 @@ func (p *Parser[K]) ParseConditions(conditions []string) ([]*Condition[K], error
 func (p *Parser[K]) ParseCondition(condition string) (*Condition[K], error) {
 	parsed, err := parseCondition(condition)
 	if err != nil {
-		return nil, err
+		return nil, withSource(err, condition, -1)
 	}
 
 	expression, err := p.newParseContext().newBoolExpr(parsed)
 	if err != nil {
-		return nil, err
+		return nil, withSource(err, condition, -1)
 	}
 	return &Condition[K]{
 		condition: expression,
@@ -226,7 +294,9 @@ func (p *Parser[K]) prependContextToPaths(context, ottl string, ottlPathsGetter
 
 	var missingContextOffsets []int
 	for _, it := range paths {
-		if _, ok := p.pathContextNames[it.Context]; !ok {
+		_, isContext := p.pathContextNames[it.Context]
+		_, isNamespace := p.pathNamespaces[it.Context]
+		if !isContext && !isNamespace {
 			missingContextOffsets = append(missingContextOffsets, it.Pos.Offset)
 		}
 	}
@@ -244,10 +314,36 @@ func (p *Parser[K]) prependContextToStatementPaths(context, statement string) (s
 		if err != nil {
 			return nil, err
 		}
-		return getParsedStatementPaths(parsed), nil
+		return getParsedStatementPaths(parsed, nil), nil
 	})
 }
 
+// prependContextToStatementsPaths changes the given OTTL statements like prependContextToStatementPaths,
+// except that the names bound by the let statements are not prefixed in the following statements.
+func (p *Parser[K]) prependContextToStatementsPaths(context string, statements []string) ([]string, error) {
+	bindings := localScopeFrame{}
+	prependedStatements := make([]string, 0, len(statements))
+	for _, statement := range statements {
+		var parsed *parsedStatement
+		prependedStatement, err := p.prependContextToPaths(context, statement, func(ottl string) ([]path, error) {
+			var err error
+			parsed, err = parseStatement(ottl)
+			if err != nil {
+				return nil, err
+			}
+			return getParsedStatementPaths(parsed, bindings), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if parsed.Let != nil {
+			bindings[parsed.Let.Name] = struct{}{}
+		}
+		prependedStatements = append(prependedStatements, prependedStatement)
+	}
+	return prependedStatements, nil
+}
+
 // prependContextToConditionPaths changes the given OTTL condition adding the context name prefix
 // to all context-less paths. No modifications are performed for paths which [Path.Context]
 // value matches any WithPathContextNames value.
@@ -285,7 +381,7 @@ var (
 func parseStatement(raw string) (*parsedStatement, error) {
 	parsed, err := parser().ParseString("", raw)
 	if err != nil {
-		return nil, fmt.Errorf("statement has invalid syntax: %w", err)
+		return nil, newSyntaxError(fmt.Errorf("statement has invalid syntax: %w", err))
 	}
 	err = parsed.checkForCustomError()
 	if err != nil {
@@ -298,7 +394,7 @@ func parseStatement(raw string) (*parsedStatement, error) {
 func parseCondition(raw string) (*booleanExpression, error) {
 	parsed, err := conditionParser().ParseString("", raw)
 	if err != nil {
-		return nil, fmt.Errorf("condition has invalid syntax: %w", err)
+		return nil, newSyntaxError(fmt.Errorf("condition has invalid syntax: %w", err))
 	}
 	err = parsed.checkForCustomError()
 	if err != nil {
@@ -311,7 +407,7 @@ func parseCondition(raw string) (*booleanExpression, error) {
 func parseValueExpression(raw string) (*value, error) {
 	parsed, err := valueExpressionParser().ParseString("", raw)
 	if err != nil {
-		return nil, fmt.Errorf("expression has invalid syntax: %w", err)
+		return nil, newSyntaxError(fmt.Errorf("expression has invalid syntax: %w", err))
 	}
 	err = parsed.checkForCustomError()
 	if err != nil {
@@ -352,7 +448,7 @@ func newParser[G any]() *participle.Parser[G] {
 	parser, err := participle.Build[G](
 		participle.Lexer(lex),
 		participle.Unquote("String"),
-		participle.Elide("whitespace"),
+		participle.Elide("whitespace", "comment", "continuation"),
 		participle.UseLookahead(participle.MaxLookahead), // Allows negative lookahead to work properly in 'value' for 'mathExprLiteral'.
 	)
 	if err != nil {
@@ -367,6 +463,13 @@ type StatementSequence[K any] struct {
 	statements        []*Statement[K]
 	errorMode         ErrorMode
 	telemetrySettings component.TelemetrySettings
+	explainHandler    func(Explanation)
+	// memoize is true if several statements call the same pure converters,
+	// whose results are then cached during the execution on a TransformContext.
+	memoize bool
+	// letBindings is true if the statements bind names with let statements,
+	// whose values are then held during the execution on a TransformContext.
+	letBindings bool
 }
 
 // StatementSequenceOption is an option for a StatementSequence
@@ -387,6 +490,8 @@ func NewStatementSequence[K any](statements []*Statement[K], telemetrySettings c
 		statements:        statements,
 		errorMode:         PropagateError,
 		telemetrySettings: telemetrySettings,
+		memoize:           hasRepeatedConverters(statements),
+		letBindings:       hasLetBindings(statements),
 	}
 	for _, op := range options {
 		op(&s)
@@ -398,25 +503,123 @@ func NewStatementSequence[K any](statements []*Statement[K], telemetrySettings c
 // When the ErrorMode of the StatementSequence is `propagate`, errors cause the execution to halt and the error is returned.
 // When the ErrorMode of the StatementSequence is `ignore`, errors are logged and execution continues to the next statement.
 // When the ErrorMode of the StatementSequence is `silent`, errors are not logged and execution continues to the next statement.
+// Statements with an `on_error` clause are handled with their own ErrorMode instead of the one of the StatementSequence.
 func (s *StatementSequence[K]) Execute(ctx context.Context, tCtx K) error {
-	if s.telemetrySettings.Logger.Core().Enabled(zap.DebugLevel) {
+	if s.explainHandler != nil {
+		explanation, err := s.Explain(ctx, tCtx)
+		s.explainHandler(explanation)
+		return err
+	}
+	return s.execute(ctx, tCtx, nil)
+}
+
+func (s *StatementSequence[K]) execute(ctx context.Context, tCtx K, recorder *explainRecorder) error {
+	debug := s.telemetrySettings.Logger.Core().Enabled(zap.DebugLevel)
+	if debug {
 		s.telemetrySettings.Logger.Debug("initial TransformContext before executing StatementSequence", zap.Any("TransformContext", tCtx))
 	}
+	// The cache is not used when explaining the execution, so the paths read by the
+	// converters are recorded as if the converters were called again.
+	if s.memoize && recorder == nil {
+		ctx = withConverterCache(ctx, newConverterCache())
+	}
+	if s.letBindings {
+		ctx = pushLocalActivation(ctx, &localActivation{bindings: map[string]any{}})
+	}
 	for _, statement := range s.statements {
-		_, _, err := statement.Execute(ctx, tCtx)
-		if err != nil {
-			if s.errorMode == PropagateError {
-				err = fmt.Errorf("failed to execute statement: %v, %w", statement.origText, err)
+		recorder.start(statement.origText)
+		_, conditionMatched, err := statement.execute(ctx, tCtx, debug)
+		recorder.finish(conditionMatched, err)
+		if err = s.handleError(statement, err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecuteBatch executes all the statements in the StatementSequence list for each of the TransformContexts,
+// in order, with the same result as calling Execute for each of them. When the ErrorMode is `propagate`,
+// the execution halts at the first error, and the TransformContexts after it are not processed.
+// The work which does not depend on the TransformContexts is only done once per batch: the statements
+// whose condition is always false are skipped for the whole batch, and the log level is checked once,
+// which reduces the overhead of executing the statements on many TransformContexts, such as the spans
+// of a scope.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func (s *StatementSequence[K]) ExecuteBatch(ctx context.Context, tCtxs []K) error {
+	if s.explainHandler != nil {
+		for _, tCtx := range tCtxs {
+			if err := s.Execute(ctx, tCtx); err != nil {
 				return err
 			}
-			if s.errorMode == IgnoreError {
-				s.telemetrySettings.Logger.Warn("failed to execute statement", zap.Error(err), zap.String("statement", statement.origText))
+		}
+		return nil
+	}
+
+	debug := s.telemetrySettings.Logger.Core().Enabled(zap.DebugLevel)
+	statements := s.statements
+	if !debug {
+		// The statements never executed are only needed to log the TransformContexts.
+		statements = make([]*Statement[K], 0, len(s.statements))
+		for _, statement := range s.statements {
+			if literal, ok := statement.condition.(*literalBoolExpr[K]); ok && !literal.getValue() {
+				continue
+			}
+			statements = append(statements, statement)
+		}
+	}
+
+	var cache *converterCache
+	if s.memoize {
+		cache = newConverterCache()
+		ctx = withConverterCache(ctx, cache)
+	}
+	var activation *localActivation
+	if s.letBindings {
+		activation = &localActivation{bindings: map[string]any{}}
+		ctx = pushLocalActivation(ctx, activation)
+	}
+
+	for _, tCtx := range tCtxs {
+		if cache != nil {
+			clear(cache.entries)
+		}
+		if activation != nil {
+			clear(activation.bindings)
+		}
+		if debug {
+			s.telemetrySettings.Logger.Debug("initial TransformContext before executing StatementSequence", zap.Any("TransformContext", tCtx))
+		}
+		for _, statement := range statements {
+			_, _, err := statement.execute(ctx, tCtx, debug)
+			if err = s.handleError(statement, err); err != nil {
+				return err
 			}
 		}
 	}
 	return nil
 }
 
+// handleError handles the error returned by the statement according to its ErrorMode,
+// and returns the error to propagate, if any.
+func (s *StatementSequence[K]) handleError(statement *Statement[K], err error) error {
+	if err == nil {
+		return nil
+	}
+	errorMode := s.errorMode
+	if statement.errorMode != "" {
+		errorMode = statement.errorMode
+	}
+	if errorMode == PropagateError {
+		return fmt.Errorf("failed to execute statement: %v, %w", statement.origText, withSource(err, statement.origText, slices.Index(s.statements, statement)))
+	}
+	if errorMode == IgnoreError {
+		err = withSource(err, statement.origText, slices.Index(s.statements, statement))
+		s.telemetrySettings.Logger.Warn("failed to execute statement", zap.Error(err), zap.String("statement", statement.origText), statementErrorField(err))
+	}
+	return nil
+}
+
 // ConditionSequence represents a list of Conditions that will be evaluated sequentially for a TransformContext
 // and will handle errors returned by conditions based on an ErrorMode.
 // By default, the conditions are ORed together, but they can be ANDed together using the WithLogicOperation option.
@@ -425,6 +628,8 @@ type ConditionSequence[K any] struct {
 	errorMode         ErrorMode
 	telemetrySettings component.TelemetrySettings
 	logicOp           LogicOperation
+	statistics        bool
+	stats             *conditionStatistics
 }
 
 // ConditionSequenceOption is an option for a ConditionSequence
@@ -459,6 +664,7 @@ func NewConditionSequence[K any](conditions []*Condition[K], telemetrySettings c
 	for _, op := range options {
 		op(&c)
 	}
+	c.initStatistics()
 	return c
 }
 
@@ -472,29 +678,40 @@ func NewConditionSequence[K any](conditions []*Condition[K], telemetrySettings c
 // When using the AND LogicOperation with the `ignore` ErrorMode the sequence will evaluate to false if all conditions error.
 func (c *ConditionSequence[K]) Eval(ctx context.Context, tCtx K) (bool, error) {
 	var atLeastOneMatch bool
-	for _, condition := range c.conditions {
+	for i, condition := range c.conditions {
 		match, err := condition.Eval(ctx, tCtx)
 		if c.telemetrySettings.Logger.Core().Enabled(zap.DebugLevel) {
 			c.telemetrySettings.Logger.Debug("condition evaluation result", zap.String("condition", condition.origText), zap.Bool("match", match), newTransformContextField(tCtx))
 		}
+		// The result of the last condition cannot short-circuit the sequence, as no condition remains.
+		last := i == len(c.conditions)-1
 		if err != nil {
 			if c.errorMode == PropagateError {
-				err = fmt.Errorf("failed to eval condition: %v, %w", condition.origText, err)
+				c.stats.record(ctx, i, conditionError, !last)
+				err = fmt.Errorf("failed to eval condition: %v, %w", condition.origText, withSource(err, condition.origText, i))
 				return false, err
 			}
+			c.stats.record(ctx, i, conditionError, false)
 			if c.errorMode == IgnoreError {
-				c.telemetrySettings.Logger.Warn("failed to eval condition", zap.Error(err), zap.String("condition", condition.origText))
+				err = withSource(err, condition.origText, i)
+				c.telemetrySettings.Logger.Warn("failed to eval condition", zap.Error(err), zap.String("condition", condition.origText), statementErrorField(err))
 			}
 			continue
 		}
 		if match {
 			if c.logicOp == Or {
+				c.stats.record(ctx, i, conditionMatch, !last)
 				return true, nil
 			}
+			c.stats.record(ctx, i, conditionMatch, false)
 			atLeastOneMatch = true
 		}
-		if !match && c.logicOp == And {
-			return false, nil
+		if !match {
+			if c.logicOp == And {
+				c.stats.record(ctx, i, conditionNoMatch, !last)
+				return false, nil
+			}
+			c.stats.record(ctx, i, conditionNoMatch, false)
 		}
 	}
 	// When ANDing it is possible to arrive here not because everything was true, but because everything errored and was ignored.
@@ -526,10 +743,10 @@ func (p *Parser[K]) ParseValueExpressions(expressions []string) ([]*ValueExpress
 	parsedValueExpressions := make([]*ValueExpression[K], 0, len(expressions))
 	var parseErrs []error
 
-	for _, expression := range expressions {
+	for i, expression := range expressions {
 		ps, err := p.ParseValueExpression(expression)
 		if err != nil {
-			parseErrs = append(parseErrs, fmt.Errorf("unable to parse OTTL value expression %q: %w", expression, err))
+			parseErrs = append(parseErrs, fmt.Errorf("unable to parse OTTL value expression %q: %w", expression, withSource(err, expression, i)))
 			continue
 		}
 		parsedValueExpressions = append(parsedValueExpressions, ps)
@@ -547,11 +764,11 @@ func (p *Parser[K]) ParseValueExpressions(expressions []string) ([]*ValueExpress
 func (p *Parser[K]) ParseValueExpression(raw string) (*ValueExpression[K], error) {
 	parsed, err := parseValueExpression(raw)
 	if err != nil {
-		return nil, err
+		return nil, withSource(err, raw, -1)
 	}
 	getter, err := p.newParseContext().newGetter(*parsed)
 	if err != nil {
-		return nil, err
+		return nil, withSource(err, raw, -1)
 	}
 
 	return &ValueExpression[K]{
@@ -582,6 +799,8 @@ func (p *Parser[K]) ParseValueExpression(raw string) (*ValueExpression[K], error
 type parseContext[K any] struct {
 	*Parser[K]
 	localScopes localScopeStack
+	// memoKeys are the cache keys of the memoizable converters parsed with this context.
+	memoKeys []string
 }
 
 func (p *Parser[K]) newParseContext() *parseContext[K] {
@@ -79,9 +79,11 @@ type ParserCollection[R any] struct {
 	contextInferrer           contextInferrer
 	contextInferrerCandidates map[string]*priorityContextInferrerCandidate
 	candidatesLowerContexts   map[string][]string
+	pathNamespaces            map[string]struct{}
 	modifiedLogging           bool
 	Settings                  component.TelemetrySettings
 	ErrorMode                 ErrorMode
+	Explain                   bool
 }
 
 // ParserCollectionOption is a configurable ParserCollection option.
@@ -97,12 +99,14 @@ func NewParserCollection[R any](
 	options ...ParserCollectionOption[R],
 ) (*ParserCollection[R], error) {
 	contextInferrerCandidates := map[string]*priorityContextInferrerCandidate{}
+	pathNamespaces := map[string]struct{}{}
 	pc := &ParserCollection[R]{
 		Settings:                  settings,
 		contextParsers:            map[string]*ParserCollectionContextParser[R]{},
-		contextInferrer:           newPriorityContextInferrer(settings, contextInferrerCandidates),
+		contextInferrer:           newPriorityContextInferrer(settings, contextInferrerCandidates, withContextInferrerPathNamespaces(pathNamespaces)),
 		contextInferrerCandidates: contextInferrerCandidates,
 		candidatesLowerContexts:   map[string][]string{},
+		pathNamespaces:            pathNamespaces,
 	}
 
 	for _, op := range options {
@@ -192,6 +196,8 @@ type (
 		parseStatements       parserCollectionContextParserFunc[R, StatementsGetter]
 		parseConditions       parserCollectionContextParserFunc[R, ConditionsGetter]
 		parseValueExpressions parserCollectionContextParserFunc[R, ValueExpressionsGetter]
+		validateStatements    func(statements []string) error
+		validateConditions    func(conditions []string) error
 	}
 )
 
@@ -276,15 +282,7 @@ func createStatementsParserWithConverter[K, R any](converter ParsedStatementsCon
 		var parsingStatements []string
 		if prependPathsContext {
 			originalStatements := statements.GetStatements()
-			parsingStatements = make([]string, 0, len(originalStatements))
-			for _, cond := range originalStatements {
-				prependedStatement, prependErr := parser.prependContextToStatementPaths(context, cond)
-				if prependErr != nil {
-					err = prependErr
-					break
-				}
-				parsingStatements = append(parsingStatements, prependedStatement)
-			}
+			parsingStatements, err = parser.prependContextToStatementsPaths(context, originalStatements)
 			if err != nil {
 				return *new(R), err
 			}
@@ -294,7 +292,13 @@ func createStatementsParserWithConverter[K, R any](converter ParsedStatementsCon
 		} else {
 			parsingStatements = statements.GetStatements()
 		}
-		parsedStatements, err := parser.ParseStatements(parsingStatements)
+		statementsParser := parser
+		if pc.Explain && !parser.explain {
+			explainParser := *parser
+			explainParser.explain = true
+			statementsParser = &explainParser
+		}
+		parsedStatements, err := statementsParser.ParseStatements(parsingStatements)
 		if err != nil {
 			return *new(R), err
 		}
@@ -353,12 +357,25 @@ func WithParserCollectionContext[K, R any](
 		if _, ok := parser.pathContextNames[context]; !ok {
 			return fmt.Errorf(`context "%s" must be a valid "%T" path context name`, context, parser)
 		}
-		pcp := &ParserCollectionContextParser[R]{}
+		pcp := &ParserCollectionContextParser[R]{
+			validateStatements: func(statements []string) error {
+				_, err := parser.ParseStatements(statements)
+				return err
+			},
+			validateConditions: func(conditions []string) error {
+				_, err := parser.ParseConditions(conditions)
+				return err
+			},
+		}
 		for _, o := range opts {
 			o(pcp, parser)
 		}
 		mp.contextParsers[context] = pcp
 
+		for namespace := range parser.pathNamespaces {
+			mp.pathNamespaces[namespace] = struct{}{}
+		}
+
 		for lowerContext := range parser.pathContextNames {
 			if lowerContext != context {
 				mp.candidatesLowerContexts[lowerContext] = append(mp.candidatesLowerContexts[lowerContext], context)
@@ -395,6 +412,18 @@ func WithParserCollectionErrorMode[R any](errorMode ErrorMode) ParserCollectionO
 	}
 }
 
+// WithParserCollectionExplain makes the ParserCollection parse statements as with EnableExplain,
+// so the paths report the values they read and write to StatementSequence.Explain. It might also be
+// used by the ParsedStatementsConverter functions to create StatementSequence explaining their executions.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func WithParserCollectionExplain[R any](enabled bool) ParserCollectionOption[R] {
+	return func(tp *ParserCollection[R]) error {
+		tp.Explain = enabled
+		return nil
+	}
+}
+
 // EnableParserCollectionModifiedPathsLogging controls the modification logs.
 // When enabled, it logs any modifications performed by the parsing operations,
 // instructing users to rewrite the statements accordingly.
@@ -442,8 +471,27 @@ func WithContextInferenceConditions(conditions []string) ParserCollectionContext
 //
 // Experimental: *NOTE* this API is subject to change or removal in the future.
 func (pc *ParserCollection[R]) ParseStatements(statements StatementsGetter, options ...ParserCollectionContextInferenceOption) (R, error) {
-	statementsValues := statements.GetStatements()
+	inferredContext, err := pc.inferStatementsContext(statements.GetStatements(), options)
+	if err != nil {
+		return *new(R), err
+	}
+	return pc.ParseStatementsWithContext(inferredContext, statements, false)
+}
 
+// ValidateStatements checks that the given statements are valid for the context inferred from
+// them, as ParseStatements, without calling the ParsedStatementsConverter function.
+// It is meant to be used by tools validating the OTTL of a configuration, such as linters.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func (pc *ParserCollection[R]) ValidateStatements(statements StatementsGetter, options ...ParserCollectionContextInferenceOption) error {
+	inferredContext, err := pc.inferStatementsContext(statements.GetStatements(), options)
+	if err != nil {
+		return err
+	}
+	return pc.contextParsers[inferredContext].validateStatements(statements.GetStatements())
+}
+
+func (pc *ParserCollection[R]) inferStatementsContext(statementsValues []string, options []ParserCollectionContextInferenceOption) (string, error) {
 	parseStatementsOpts := parseCollectionContextInferenceOptions{}
 	for _, opt := range options {
 		opt(&parseStatementsOpts)
@@ -460,19 +508,19 @@ func (pc *ParserCollection[R]) ParseStatements(statements StatementsGetter, opti
 	}
 
 	if err != nil {
-		return *new(R), fmt.Errorf("unable to infer a valid context (%+q) from statements %+q and conditions %+q: %w", pc.supportedContextNames(), statementsValues, conditionsValues, err)
+		return "", fmt.Errorf("unable to infer a valid context (%+q) from statements %+q and conditions %+q: %w", pc.supportedContextNames(), statementsValues, conditionsValues, err)
 	}
 
 	if inferredContext == "" {
-		return *new(R), fmt.Errorf("unable to infer context from statements %+q and conditions %+q, path's first segment must be a valid context name %+q, and at least one context must be capable of parsing all statements", pc.supportedContextNames(), statementsValues, conditionsValues)
+		return "", fmt.Errorf("unable to infer context from statements %+q and conditions %+q, path's first segment must be a valid context name %+q, and at least one context must be capable of parsing all statements", pc.supportedContextNames(), statementsValues, conditionsValues)
 	}
 
 	_, ok := pc.contextParsers[inferredContext]
 	if !ok {
-		return *new(R), fmt.Errorf(`context "%s" inferred from the statements %+q and conditions %+q is not a supported context: %+q`, inferredContext, statementsValues, conditionsValues, pc.supportedContextNames())
+		return "", fmt.Errorf(`context "%s" inferred from the statements %+q and conditions %+q is not a supported context: %+q`, inferredContext, statementsValues, conditionsValues, pc.supportedContextNames())
 	}
 
-	return pc.ParseStatementsWithContext(inferredContext, statements, false)
+	return inferredContext, nil
 }
 
 // ParseStatementsWithContext parses the given statements into [R] using the configured
@@ -512,22 +560,42 @@ func (pc *ParserCollection[R]) ParseStatementsWithContext(context string, statem
 //
 // Experimental: *NOTE* this API is subject to change or removal in the future.
 func (pc *ParserCollection[R]) ParseConditions(conditions ConditionsGetter) (R, error) {
-	conditionsValues := conditions.GetConditions()
-	inferredContext, err := pc.contextInferrer.inferFromConditions(conditionsValues)
+	inferredContext, err := pc.inferConditionsContext(conditions.GetConditions())
 	if err != nil {
 		return *new(R), err
 	}
+	return pc.ParseConditionsWithContext(inferredContext, conditions, false)
+}
+
+// ValidateConditions checks that the given conditions are valid for the context inferred from
+// them, as ParseConditions, without calling the ParsedConditionsConverter function.
+// It is meant to be used by tools validating the OTTL of a configuration, such as linters.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func (pc *ParserCollection[R]) ValidateConditions(conditions ConditionsGetter) error {
+	inferredContext, err := pc.inferConditionsContext(conditions.GetConditions())
+	if err != nil {
+		return err
+	}
+	return pc.contextParsers[inferredContext].validateConditions(conditions.GetConditions())
+}
+
+func (pc *ParserCollection[R]) inferConditionsContext(conditionsValues []string) (string, error) {
+	inferredContext, err := pc.contextInferrer.inferFromConditions(conditionsValues)
+	if err != nil {
+		return "", err
+	}
 
 	if inferredContext == "" {
-		return *new(R), fmt.Errorf("unable to infer context from conditions, path's first segment must be a valid context name: %+q, and at least one context must be capable of parsing all conditions: %+q", pc.supportedContextNames(), conditionsValues)
+		return "", fmt.Errorf("unable to infer context from conditions, path's first segment must be a valid context name: %+q, and at least one context must be capable of parsing all conditions: %+q", pc.supportedContextNames(), conditionsValues)
 	}
 
 	_, ok := pc.contextParsers[inferredContext]
 	if !ok {
-		return *new(R), fmt.Errorf(`context "%s" inferred from the conditions %+q is not a supported context: %+q`, inferredContext, conditionsValues, pc.supportedContextNames())
+		return "", fmt.Errorf(`context "%s" inferred from the conditions %+q is not a supported context: %+q`, inferredContext, conditionsValues, pc.supportedContextNames())
 	}
 
-	return pc.ParseConditionsWithContext(inferredContext, conditions, false)
+	return inferredContext, nil
 }
 
 // ParseConditionsWithContext parses the given conditions into [R] using the configured
@@ -316,6 +316,23 @@ func Test_ParseStatements_MultipleContexts_Success(t *testing.T) {
 	assert.NotNil(t, result)
 }
 
+func Test_ParseStatements_PathNamespace(t *testing.T) {
+	namespace := WithPathNamespace[any]("myvendor", testParsePath[any])
+	pc, err := NewParserCollection(
+		componenttest.NewNopTelemetrySettings(),
+		WithParserCollectionContext("foo", mockParser(t, WithPathContextNames[any]([]string{"foo"}), namespace), WithStatementConverter(newNopParsedStatementsConverter[any]())),
+		WithParserCollectionContext("bar", mockParser(t, WithPathContextNames[any]([]string{"bar"}), namespace), WithStatementConverter(newNopParsedStatementsConverter[any]())),
+	)
+	require.NoError(t, err)
+	assert.Contains(t, pc.pathNamespaces, "myvendor")
+
+	// The namespace paths don't take part in the context inference.
+	statements := mockGetter{values: []string{`set(bar.attributes["bar"], myvendor.name)`}}
+	result, err := pc.ParseStatements(statements)
+	require.NoError(t, err)
+	assert.Len(t, result.([]*Statement[any]), 1)
+}
+
 func Test_ParseStatements_NoContextInferredError(t *testing.T) {
 	pc, err := NewParserCollection[any](componenttest.NewNopTelemetrySettings())
 	require.NoError(t, err)
@@ -478,16 +495,62 @@ func Test_ParseStatementsWithContext_PrependPathContext(t *testing.T) {
 			`set(attributes["foo"], "foo")`,
 			`set(attributes["bar"], "bar")`,
 			`set(attributes["bar"], Lambda((attributes) => attributes["bar"] != nil and name != nil))`,
+			`set(attributes["bar"], [x["name"] for x in attributes["list"] where x["name"] != name])`,
 		}},
 		true,
 	)
 
 	require.NoError(t, err)
-	require.Len(t, result, 3)
+	require.Len(t, result, 4)
 	parsedStatements := result.([]*Statement[any])
 	assert.Equal(t, `set(dummy.attributes["foo"], "foo")`, parsedStatements[0].origText)
 	assert.Equal(t, `set(dummy.attributes["bar"], "bar")`, parsedStatements[1].origText)
 	assert.Equal(t, `set(dummy.attributes["bar"], Lambda((attributes) => attributes["bar"] != nil and dummy.name != nil))`, parsedStatements[2].origText)
+	assert.Equal(t, `set(dummy.attributes["bar"], [x["name"] for x in dummy.attributes["list"] where x["name"] != dummy.name])`, parsedStatements[3].origText)
+}
+
+func Test_ValidateStatements_ParserCollection(t *testing.T) {
+	failingConverter := func(
+		_ *ParserCollection[any],
+		_ StatementsGetter,
+		_ []*Statement[any],
+	) (any, error) {
+		return nil, errors.New("failing converter")
+	}
+
+	pc, err := NewParserCollection(
+		componenttest.NewNopTelemetrySettings(),
+		WithParserCollectionContext("foo", mockParser(t, WithPathContextNames[any]([]string{"foo"})), WithStatementConverter(failingConverter)),
+	)
+	require.NoError(t, err)
+
+	// The converter is not called when validating the statements.
+	err = pc.ValidateStatements(mockGetter{values: []string{`set(foo.attributes["bar"], "foo")`}})
+	require.NoError(t, err)
+
+	err = pc.ValidateStatements(mockGetter{values: []string{`set(foo.attributes["bar"], "foo")`, `set(foo.attributes["bar"])`}})
+	assert.ErrorContains(t, err, "incorrect number of arguments")
+
+	err = pc.ValidateStatements(mockGetter{values: []string{`set(bar.attributes["bar"], "foo")`}})
+	assert.ErrorContains(t, err, "unable to infer a valid context")
+}
+
+func Test_ValidateConditions_ParserCollection(t *testing.T) {
+	pc, err := NewParserCollection(
+		componenttest.NewNopTelemetrySettings(),
+		WithParserCollectionContext[any, any]("foo", mockParser(t, WithPathContextNames[any]([]string{"foo"}))),
+	)
+	require.NoError(t, err)
+
+	// Validating conditions does not require a converter.
+	err = pc.ValidateConditions(mockGetter{values: []string{`foo.attributes["bar"] == "foo"`}})
+	require.NoError(t, err)
+
+	err = pc.ValidateConditions(mockGetter{values: []string{`foo.attributes["bar"] ==`}})
+	assert.ErrorContains(t, err, "condition has invalid syntax")
+
+	err = pc.ValidateConditions(mockGetter{values: []string{`bar.attributes["bar"] == "foo"`}})
+	assert.ErrorContains(t, err, "is not a supported context")
 }
 
 func Test_NewStatementsGetter(t *testing.T) {
@@ -26,6 +26,41 @@ func booleanp(b boolean) *boolean {
 	return &b
 }
 
+// clearFunctionPositions zeroes the positions of the editors and converters of the parsed grammar,
+// so it can be compared to the expected grammar without spelling them out. They are tested by
+// Test_parseStatement_functionPositions.
+func clearFunctionPositions(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			clearFunctionPositions(v.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			clearFunctionPositions(v.Index(i))
+		}
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(editor{}) || v.Type() == reflect.TypeOf(converter{}) {
+			v.FieldByName("Pos").Set(reflect.ValueOf(lexer.Position{}))
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				clearFunctionPositions(v.Field(i))
+			}
+		}
+	}
+}
+
+func Test_parseStatement_functionPositions(t *testing.T) {
+	parsed, err := parseStatement(`set(attributes["test"], Concat([Trim(name), "x"], ""))`)
+	require.NoError(t, err)
+	assert.Equal(t, lexer.Position{Offset: 0, Line: 1, Column: 1}, parsed.Editor.Pos)
+	concat := parsed.Editor.Arguments[1].Value.Literal.Converter
+	assert.Equal(t, lexer.Position{Offset: 24, Line: 1, Column: 25}, concat.Pos)
+	trim := concat.Arguments[0].Value.List.Values[0].Literal.Converter
+	assert.Equal(t, lexer.Position{Offset: 32, Line: 1, Column: 33}, trim.Pos)
+}
+
 func Test_parse(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -300,6 +335,75 @@ func Test_parse(t *testing.T) {
 				WhereClause: nil,
 			},
 		},
+		{
+			name:      "path with range keys",
+			statement: `set(attributes["list"][1:3], attributes["list"][-2:])`,
+			expected: &parsedStatement{
+				Editor: editor{
+					Function: "set",
+					Arguments: []argument{
+						{
+							Value: value{
+								Literal: &mathExprLiteral{
+									Path: &path{
+										Pos: lexer.Position{
+											Offset: 4,
+											Line:   1,
+											Column: 5,
+										},
+										Fields: []field{
+											{
+												Name: "attributes",
+												Keys: []key{
+													{
+														String: ottltest.Strp("list"),
+													},
+													{
+														Range: &keyRange{
+															Start: ottltest.Intp(1),
+															End:   ottltest.Intp(3),
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						{
+							Value: value{
+								Literal: &mathExprLiteral{
+									Path: &path{
+										Pos: lexer.Position{
+											Offset: 29,
+											Line:   1,
+											Column: 30,
+										},
+										Fields: []field{
+											{
+												Name: "attributes",
+												Keys: []key{
+													{
+														String: ottltest.Strp("list"),
+													},
+													{
+														Range: &keyRange{
+															Start: ottltest.Intp(-2),
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				WhereClause: nil,
+			},
+		},
 		{
 			name:      "complex path",
 			statement: `set(foo.attributes["bar"].cat, "dog")`,
@@ -1410,6 +1514,73 @@ func Test_parse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:      "editor with boolean expressions",
+			statement: `fff(1 == 2, not true, name = true and false)`,
+			expected: &parsedStatement{
+				Editor: editor{
+					Function: "fff",
+					Arguments: []argument{
+						{
+							Expr: &booleanExpression{
+								Left: &term{
+									Left: &booleanValue{
+										Comparison: &comparison{
+											Left: value{
+												Literal: &mathExprLiteral{
+													Int: ottltest.Intp(1),
+												},
+											},
+											Op: eq,
+											Right: value{
+												Literal: &mathExprLiteral{
+													Int: ottltest.Intp(2),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						{
+							Expr: &booleanExpression{
+								Left: &term{
+									Left: &booleanValue{
+										Negation: ottltest.Strp("not"),
+										ConstExpr: &constExpr{
+											Boolean: (*boolean)(ottltest.Boolp(true)),
+										},
+									},
+								},
+							},
+						},
+						{
+							Name: "name",
+							Expr: &booleanExpression{
+								Left: &term{
+									Left: &booleanValue{
+										ConstExpr: &constExpr{
+											Boolean: (*boolean)(ottltest.Boolp(true)),
+										},
+									},
+									Right: []*opAndBooleanValue{
+										{
+											Operator: "and",
+											Value: &booleanValue{
+												ConstExpr: &constExpr{
+													Boolean: (*boolean)(ottltest.Boolp(false)),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				WhereClause: nil,
+			},
+		},
 		{
 			name:      "editor with named arg",
 			statement: `set(name="foo")`,
@@ -1434,6 +1605,7 @@ func Test_parse(t *testing.T) {
 		t.Run(tt.statement, func(t *testing.T) {
 			parsed, err := parseStatement(tt.statement)
 			require.NoError(t, err)
+			clearFunctionPositions(reflect.ValueOf(parsed))
 			assert.Equal(t, tt.expected, parsed)
 		})
 	}
@@ -1595,6 +1767,7 @@ func Test_parseCondition_full(t *testing.T) {
 		t.Run(tt.condition, func(t *testing.T) {
 			parsed, err := parseCondition(tt.condition)
 			require.NoError(t, err)
+			clearFunctionPositions(reflect.ValueOf(parsed))
 			assert.Equal(t, tt.expected, parsed)
 		})
 	}
@@ -2186,6 +2359,82 @@ func Test_parseWhere(t *testing.T) {
 				},
 			}),
 		},
+		{
+			statement: `name not in ["a", "b"]`,
+			expected: setNameTest(&booleanExpression{
+				Left: &term{
+					Left: &booleanValue{
+						Test: &valueTest{
+							Left: value{
+								Literal: &mathExprLiteral{
+									Path: &path{
+										Pos: lexer.Position{
+											Offset: 24,
+											Line:   1,
+											Column: 25,
+										},
+										Fields: []field{
+											{
+												Name: "name",
+											},
+										},
+									},
+								},
+							},
+							In: &membership{
+								Negation: ottltest.Strp("not"),
+								List: value{
+									List: &list{
+										Values: []value{
+											{String: ottltest.Strp("a")},
+											{String: ottltest.Strp("b")},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}),
+		},
+		{
+			statement: `1 between 0 and 2 and true`,
+			expected: setNameTest(&booleanExpression{
+				Left: &term{
+					Left: &booleanValue{
+						Test: &valueTest{
+							Left: value{
+								Literal: &mathExprLiteral{
+									Int: ottltest.Intp(1),
+								},
+							},
+							Between: &valueRange{
+								Low: value{
+									Literal: &mathExprLiteral{
+										Int: ottltest.Intp(0),
+									},
+								},
+								High: value{
+									Literal: &mathExprLiteral{
+										Int: ottltest.Intp(2),
+									},
+								},
+							},
+						},
+					},
+					Right: []*opAndBooleanValue{
+						{
+							Operator: "and",
+							Value: &booleanValue{
+								ConstExpr: &constExpr{
+									Boolean: booleanp(true),
+								},
+							},
+						},
+					},
+				},
+			}),
+		},
 	}
 
 	// create a test name that doesn't confuse vscode so we can rerun tests with one click
@@ -2196,6 +2445,7 @@ func Test_parseWhere(t *testing.T) {
 			statement := `set(name, "test") where ` + tt.statement
 			parsed, err := parseStatement(statement)
 			require.NoError(t, err)
+			clearFunctionPositions(reflect.ValueOf(parsed))
 			assert.Equal(t, tt.expected, parsed)
 		})
 	}
@@ -2314,6 +2564,33 @@ func Test_ParseValueExpression_full(t *testing.T) {
 				return []any{"list", "of", "strings"}
 			},
 		},
+		{
+			name:            "list comprehension",
+			valueExpression: `[x["name"] for x in attributes where x["level"] == "error"]`,
+			expected: func() any {
+				return []any{"checkout", "payment"}
+			},
+			tCtx: []any{
+				map[string]any{"name": "checkout", "level": "error"},
+				map[string]any{"name": "cart", "level": "info"},
+				map[string]any{"name": "payment", "level": "error"},
+			},
+		},
+		{
+			name:            "map comprehension",
+			valueExpression: `{v: k for k, v in attributes where k != "skip"}`,
+			expected: func() any {
+				m := pcommon.NewMap()
+				m.PutStr("2", "bar")
+				m.PutStr("1", "foo")
+				return m
+			},
+			tCtx: map[string]any{
+				"foo":  "1",
+				"bar":  "2",
+				"skip": "3",
+			},
+		},
 		{
 			name:            "nested list",
 			valueExpression: `[{"list":[{"foo":"bar"}]}, {"bar":"baz"}]`,
@@ -2502,6 +2779,18 @@ func Test_parseStatement(t *testing.T) {
 		{statement: `Test()`, wantErr: true},
 		{statement: `set() where test(foo)["key"] == "bar"`, wantErrContaining: converterNameErrorPrefix},
 		{statement: `set() where test(foo)["key"] == "bar"`, wantErrContaining: editorWithIndexErrorPrefix},
+		{statement: "set(foo.attributes[\"bar\"], \"dog\")\nwhere animal == \"cat\""},
+		{statement: "set(foo.attributes[\"bar\"], \"dog\") \\\n  where animal == \"cat\""},
+		{statement: "// rename the animal\nset(foo.attributes[\"bar\"], \"dog\") // to dog\n# only cats\nwhere animal == \"cat\""},
+		{statement: "set(foo.attributes[\"url\"], \"https://example.com#anchor\") # strings are not comments"},
+		{statement: "set(foo.attributes[\"bar\"], 1 // 2)", wantErr: true},
+		{statement: "// set(foo.attributes[\"bar\"], \"dog\")", wantErr: true},
+		{statement: "set(foo.attributes[\"bar\"], \"dog\") \\ where animal == \"cat\"", wantErr: true},
+		{statement: `set(foo.attributes["bar"], "dog") on_error ignore`},
+		{statement: `set(foo.attributes["bar"], "dog") where animal == "cat" on_error ignore`},
+		{statement: `set(foo.attributes["bar"], "dog") on_error ignore where animal == "cat"`, wantErr: true},
+		{statement: `set(foo.attributes["bar"], "dog") on_error`, wantErr: true},
+		{statement: `set(foo.attributes["bar"], "dog") on_error "ignore"`, wantErr: true},
 	}
 	pat := regexp.MustCompile("[^a-zA-Z0-9]+")
 	for _, tt := range tests {
@@ -2790,6 +3079,268 @@ func Test_Statements_Execute_Error(t *testing.T) {
 	}
 }
 
+func Test_Statements_Execute_StatementErrorMode(t *testing.T) {
+	tests := []struct {
+		name               string
+		sequenceErrorMode  ErrorMode
+		statementErrorMode ErrorMode
+		wantErr            bool
+	}{
+		{
+			name:               "ignore overrides propagate",
+			sequenceErrorMode:  PropagateError,
+			statementErrorMode: IgnoreError,
+		},
+		{
+			name:               "silent overrides propagate",
+			sequenceErrorMode:  PropagateError,
+			statementErrorMode: SilentError,
+		},
+		{
+			name:               "propagate overrides ignore",
+			sequenceErrorMode:  IgnoreError,
+			statementErrorMode: PropagateError,
+			wantErr:            true,
+		},
+		{
+			name:              "sequence error mode by default",
+			sequenceErrorMode: PropagateError,
+			wantErr:           true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executed := false
+			statements := StatementSequence[any]{
+				statements: []*Statement[any]{
+					{
+						condition: newAlwaysTrue[any](),
+						function: Expr[any]{exprFunc: func(context.Context, any) (any, error) {
+							return nil, errors.New("test")
+						}},
+						errorMode:         tt.statementErrorMode,
+						telemetrySettings: componenttest.NewNopTelemetrySettings(),
+					},
+					{
+						condition: newAlwaysTrue[any](),
+						function: Expr[any]{exprFunc: func(context.Context, any) (any, error) {
+							executed = true
+							return nil, nil
+						}},
+						telemetrySettings: componenttest.NewNopTelemetrySettings(),
+					},
+				},
+				errorMode:         tt.sequenceErrorMode,
+				telemetrySettings: componenttest.NewNopTelemetrySettings(),
+			}
+
+			err := statements.Execute(t.Context(), nil)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.False(t, executed)
+			} else {
+				require.NoError(t, err)
+				assert.True(t, executed)
+			}
+		})
+	}
+}
+
+func Test_Statements_ExecuteBatch(t *testing.T) {
+	tests := []struct {
+		name          string
+		errorMode     ErrorMode
+		condition     boolExpr[any]
+		expectedCalls []any
+		wantErr       bool
+	}{
+		{
+			name:          "executes each context in order",
+			errorMode:     PropagateError,
+			condition:     newAlwaysTrue[any](),
+			expectedCalls: []any{1, 1, 2, 2, 3, 3},
+		},
+		{
+			name:          "skips statements never matching",
+			errorMode:     PropagateError,
+			condition:     newAlwaysFalse[any](),
+			expectedCalls: []any{1, 2, 3},
+		},
+		{
+			name:          "propagate halts at the first error",
+			errorMode:     PropagateError,
+			condition:     newAlwaysTrue[any](),
+			expectedCalls: []any{1, 1, 2, 2},
+			wantErr:       true,
+		},
+		{
+			name:          "ignore continues after errors",
+			errorMode:     IgnoreError,
+			condition:     newAlwaysTrue[any](),
+			expectedCalls: []any{1, 1, 2, 2, 3, 3},
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []any
+			newStatement := func(condition boolExpr[any], fails bool) *Statement[any] {
+				return &Statement[any]{
+					condition: condition,
+					function: Expr[any]{exprFunc: func(_ context.Context, tCtx any) (any, error) {
+						calls = append(calls, tCtx)
+						if fails && tCtx == 2 {
+							return nil, errors.New("test")
+						}
+						return nil, nil
+					}},
+					telemetrySettings: componenttest.NewNopTelemetrySettings(),
+				}
+			}
+			statements := StatementSequence[any]{
+				statements: []*Statement[any]{
+					newStatement(tt.condition, false),
+					newStatement(newAlwaysTrue[any](), tt.wantErr),
+				},
+				errorMode:         tt.errorMode,
+				telemetrySettings: componenttest.NewNopTelemetrySettings(),
+			}
+
+			err := statements.ExecuteBatch(t.Context(), []any{1, 2, 3})
+			if tt.wantErr && tt.errorMode == PropagateError {
+				assert.ErrorContains(t, err, "failed to execute statement")
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
+
+func Test_Statements_ExecuteBatch_SameAsExecute(t *testing.T) {
+	// The statements only use the contexts through their functions, so sequential and batch
+	// executions are compared on the functions calls.
+	var sequential, batch []string
+	newSequence := func(calls *[]string) StatementSequence[any] {
+		statement := func(name string, condition boolExpr[any]) *Statement[any] {
+			return &Statement[any]{
+				origText:  name,
+				condition: condition,
+				function: Expr[any]{exprFunc: func(_ context.Context, tCtx any) (any, error) {
+					*calls = append(*calls, fmt.Sprintf("%s(%v)", name, tCtx))
+					return nil, nil
+				}},
+				telemetrySettings: componenttest.NewNopTelemetrySettings(),
+			}
+		}
+		return NewStatementSequence([]*Statement[any]{
+			statement("first", newAlwaysTrue[any]()),
+			statement("never", newAlwaysFalse[any]()),
+			statement("last", newAlwaysTrue[any]()),
+		}, componenttest.NewNopTelemetrySettings())
+	}
+
+	tCtxs := []any{"a", "b"}
+	sequentialSequence := newSequence(&sequential)
+	for _, tCtx := range tCtxs {
+		require.NoError(t, sequentialSequence.Execute(t.Context(), tCtx))
+	}
+	batchSequence := newSequence(&batch)
+	require.NoError(t, batchSequence.ExecuteBatch(t.Context(), tCtxs))
+	assert.Equal(t, sequential, batch)
+	assert.Equal(t, []string{"first(a)", "last(a)", "first(b)", "last(b)"}, batch)
+}
+
+func Benchmark_StatementSequence_ExecuteBatch(b *testing.B) {
+	statement := func(condition boolExpr[any]) *Statement[any] {
+		return &Statement[any]{
+			condition: condition,
+			function: Expr[any]{exprFunc: func(context.Context, any) (any, error) {
+				return nil, nil
+			}},
+			telemetrySettings: componenttest.NewNopTelemetrySettings(),
+		}
+	}
+	statements := NewStatementSequence([]*Statement[any]{
+		statement(newAlwaysTrue[any]()),
+		statement(newAlwaysFalse[any]()),
+		statement(newAlwaysFalse[any]()),
+	}, componenttest.NewNopTelemetrySettings())
+	tCtxs := make([]any, 1000)
+
+	b.Run("Execute", func(b *testing.B) {
+		for b.Loop() {
+			for _, tCtx := range tCtxs {
+				_ = statements.Execute(b.Context(), tCtx)
+			}
+		}
+	})
+	b.Run("ExecuteBatch", func(b *testing.B) {
+		for b.Loop() {
+			_ = statements.ExecuteBatch(b.Context(), tCtxs)
+		}
+	})
+}
+
+func Test_ParseStatement_ErrorMode(t *testing.T) {
+	type mockSetArguments[K any] struct {
+		Target Setter[K]
+		Value  Getter[K]
+	}
+
+	mockSetFactory := NewFactory("set", &mockSetArguments[any]{}, func(_ FunctionContext, _ Arguments) (ExprFunc[any], error) {
+		return func(context.Context, any) (any, error) {
+			return nil, nil
+		}, nil
+	})
+
+	p, err := NewParser(
+		CreateFactoryMap[any](mockSetFactory),
+		testParsePath[any],
+		componenttest.NewNopTelemetrySettings(),
+		WithEnumParser[any](testParseEnum),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		statement string
+		expected  ErrorMode
+		wantErr   string
+	}{
+		{
+			statement: `set(name, "foo")`,
+			expected:  "",
+		},
+		{
+			statement: `set(name, "foo") on_error ignore`,
+			expected:  IgnoreError,
+		},
+		{
+			statement: `set(name, "foo") where name == "bar" on_error silent`,
+			expected:  SilentError,
+		},
+		{
+			statement: "set(name, \"foo\")\n  where name == \"bar\"\n  on_error propagate",
+			expected:  PropagateError,
+		},
+		{
+			statement: `set(name, "foo") on_error retry`,
+			wantErr:   "unknown error mode retry",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.statement, func(t *testing.T) {
+			statement, err := p.ParseStatement(tt.statement)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, statement.errorMode)
+		})
+	}
+}
+
 func Test_ConditionSequence_Eval(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -3105,6 +3656,13 @@ func Test_prependContextToStatementPaths_Success(t *testing.T) {
 			pathContextNames: []string{"log", "resource"},
 			expected:         `set(log.attributes["test"], "pass") where Split("pass|fail", "|")[log.attributes["bar"]] == nil`,
 		},
+		{
+			name:             "multi-line with comments",
+			statement:        "set(value, 1) // set value\n  # where value is nil\n  where value == nil",
+			context:          "log",
+			pathContextNames: []string{"log"},
+			expected:         "set(log.value, 1) // set value\n  # where value is nil\n  where log.value == nil",
+		},
 	}
 
 	for _, tt := range tests {
@@ -3401,6 +3959,147 @@ func Test_prependContextToValueExpressionPaths_Success(t *testing.T) {
 	}
 }
 
+func newPathNamespaceTestParser(t *testing.T, options ...Option[map[string]any]) Parser[map[string]any] {
+	t.Helper()
+	regionParser := func(path Path[map[string]any]) (GetSetter[map[string]any], error) {
+		if path.Name() != "region" || path.Next() != nil {
+			return nil, fmt.Errorf("invalid myvendor path %q", path.String())
+		}
+		return &StandardGetSetter[map[string]any]{
+			Getter: func(context.Context, map[string]any) (any, error) {
+				return "eu-west-1", nil
+			},
+			Setter: func(context.Context, map[string]any, any) error {
+				return errors.New("myvendor.region is read-only")
+			},
+		}, nil
+	}
+	p, err := NewParser(
+		CreateFactoryMap(NewFactory("set", &statementErrorSetArguments{}, func(_ FunctionContext, args Arguments) (ExprFunc[map[string]any], error) {
+			a := args.(*statementErrorSetArguments)
+			return func(ctx context.Context, tCtx map[string]any) (any, error) {
+				val, err := a.Value.Get(ctx, tCtx)
+				if err != nil {
+					return nil, err
+				}
+				return nil, a.Target.Set(ctx, tCtx, val)
+			}, nil
+		})),
+		func(path Path[map[string]any]) (GetSetter[map[string]any], error) {
+			return &StandardGetSetter[map[string]any]{
+				Getter: func(_ context.Context, tCtx map[string]any) (any, error) {
+					return tCtx[path.Name()], nil
+				},
+				Setter: func(_ context.Context, tCtx map[string]any, val any) error {
+					tCtx[path.Name()] = val
+					return nil
+				},
+			}, nil
+		},
+		componenttest.NewNopTelemetrySettings(),
+		append([]Option[map[string]any]{WithPathNamespace[map[string]any]("myvendor", regionParser)}, options...)...,
+	)
+	require.NoError(t, err)
+	return p
+}
+
+func Test_WithPathNamespace(t *testing.T) {
+	tests := []struct {
+		name             string
+		pathContextNames []string
+		statement        string
+		expected         map[string]any
+	}{
+		{
+			name:      "namespace path",
+			statement: `set(name, myvendor.region)`,
+			expected:  map[string]any{"name": "eu-west-1"},
+		},
+		{
+			name:      "namespace path in where clause",
+			statement: `set(name, "a") where myvendor.region == "eu-west-1"`,
+			expected:  map[string]any{"name": "a"},
+		},
+		{
+			name:      "namespace without segments",
+			statement: `set(name, myvendor)`,
+			expected:  map[string]any{"name": nil},
+		},
+		{
+			name:             "namespace path with path context names",
+			pathContextNames: []string{"log"},
+			statement:        `set(log.name, myvendor.region)`,
+			expected:         map[string]any{"name": "eu-west-1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var options []Option[map[string]any]
+			if tt.pathContextNames != nil {
+				options = append(options, WithPathContextNames[map[string]any](tt.pathContextNames))
+			}
+			p := newPathNamespaceTestParser(t, options...)
+			statement, err := p.ParseStatement(tt.statement)
+			require.NoError(t, err)
+
+			tCtx := map[string]any{}
+			_, _, err = statement.Execute(t.Context(), tCtx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, tCtx)
+		})
+	}
+}
+
+func Test_WithPathNamespace_Error(t *testing.T) {
+	tests := []struct {
+		name             string
+		pathContextNames []string
+		statement        string
+		wantErr          string
+	}{
+		{
+			name:      "invalid namespace path",
+			statement: `set(name, myvendor.zone)`,
+			wantErr:   `invalid myvendor path "myvendor.zone"`,
+		},
+		{
+			name:      "indexed namespace",
+			statement: `set(name, myvendor["a"].region)`,
+			wantErr:   `the path namespace "myvendor" cannot be indexed`,
+		},
+		{
+			name:             "unknown context with path context names",
+			pathContextNames: []string{"log"},
+			statement:        `set(log.name, othervendor.region)`,
+			wantErr:          `context "othervendor" from path "othervendor.region" is not valid`,
+		},
+		{
+			name:             "let binding",
+			pathContextNames: []string{"log"},
+			statement:        `let myvendor = log.name`,
+			wantErr:          `"myvendor" is a path namespace and cannot be bound by a let statement`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var options []Option[map[string]any]
+			if tt.pathContextNames != nil {
+				options = append(options, WithPathContextNames[map[string]any](tt.pathContextNames))
+			}
+			p := newPathNamespaceTestParser(t, options...)
+			_, err := p.ParseStatements([]string{tt.statement})
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_prependContextToStatementPaths_PathNamespace(t *testing.T) {
+	p := newPathNamespaceTestParser(t, WithPathContextNames[map[string]any]([]string{"log"}))
+	result, err := p.prependContextToStatementPaths("log", `set(attributes["region"], myvendor.region) where name != nil`)
+	require.NoError(t, err)
+	assert.Equal(t, `set(log.attributes["region"], myvendor.region) where log.name != nil`, result)
+}
+
 type errExpr[K any] struct {
 	err error
 }
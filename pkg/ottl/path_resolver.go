@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// maxResolvedPaths is the maximum number of paths cached by a PathResolver. Once it is reached,
+// the other paths are parsed every time they are resolved, so paths built from telemetry values
+// cannot grow the cache indefinitely.
+const maxResolvedPaths = 1000
+
+// PathResolver resolves paths given as strings at runtime, such as `log.attributes["key"]`, into
+// GetSetters, so functions can access paths which are not known when the statements are parsed.
+// The paths are parsed by the Parser which created the function, and must be written as they
+// would be in its statements, including their context name if the Parser requires it.
+// The resolved paths are cached, and a PathResolver is safe for concurrent use.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+type PathResolver[K any] struct {
+	parser *Parser[K]
+	mu     sync.RWMutex
+	paths  map[string]GetSetter[K]
+}
+
+// NewPathResolver returns a PathResolver using the Parser which created the function with the
+// FunctionContext. It must be called from the CreateFunction method of a Factory.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func NewPathResolver[K any](fCtx FunctionContext) (*PathResolver[K], error) {
+	parser, ok := fCtx.parser.(*Parser[K])
+	if !ok {
+		return nil, errors.New("paths can only be resolved by functions created by a Parser")
+	}
+	return &PathResolver[K]{
+		parser: parser,
+		paths:  map[string]GetSetter[K]{},
+	}, nil
+}
+
+// Resolve returns the GetSetter of the path.
+func (r *PathResolver[K]) Resolve(path string) (GetSetter[K], error) {
+	r.mu.RLock()
+	getSetter, ok := r.paths[path]
+	r.mu.RUnlock()
+	if ok {
+		return getSetter, nil
+	}
+
+	parsed, err := parseValueExpression(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path %q: %w", path, err)
+	}
+	if parsed.Literal == nil || parsed.Literal.Path == nil {
+		return nil, fmt.Errorf("invalid path %q: must be a path", path)
+	}
+	getSetter, err = r.parser.newParseContext().newPathGetSetter(parsed.Literal.Path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path %q: %w", path, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.paths) < maxResolvedPaths {
+		r.paths[path] = getSetter
+	}
+	return getSetter, nil
+}
@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+type pathResolverGetArguments struct {
+	Path StringGetter[map[string]any]
+}
+
+// newPathResolverTestParser returns a parser whose paths are the keys of the TransformContext,
+// prefixed by the "test" context name, with a Get converter resolving the path it is given.
+func newPathResolverTestParser(t *testing.T) Parser[map[string]any] {
+	functions := CreateFactoryMap(
+		NewFactory("Get", &pathResolverGetArguments{}, func(fCtx FunctionContext, args Arguments) (ExprFunc[map[string]any], error) {
+			a := args.(*pathResolverGetArguments)
+			resolver, err := NewPathResolver[map[string]any](fCtx)
+			if err != nil {
+				return nil, err
+			}
+			return func(ctx context.Context, tCtx map[string]any) (any, error) {
+				path, err := a.Path.Get(ctx, tCtx)
+				if err != nil {
+					return nil, err
+				}
+				getSetter, err := resolver.Resolve(path)
+				if err != nil {
+					return nil, err
+				}
+				return getSetter.Get(ctx, tCtx)
+			}, nil
+		}),
+	)
+	p, err := NewParser(
+		functions,
+		func(path Path[map[string]any]) (GetSetter[map[string]any], error) {
+			if path.Name() == "invalid" {
+				return nil, fmt.Errorf("invalid path %q", path.String())
+			}
+			return &StandardGetSetter[map[string]any]{
+				Getter: func(_ context.Context, tCtx map[string]any) (any, error) {
+					return tCtx[path.Name()], nil
+				},
+				Setter: func(_ context.Context, tCtx map[string]any, val any) error {
+					tCtx[path.Name()] = val
+					return nil
+				},
+			}, nil
+		},
+		componenttest.NewNopTelemetrySettings(),
+		WithPathContextNames[map[string]any]([]string{"test"}),
+	)
+	require.NoError(t, err)
+	return p
+}
+
+func Test_PathResolver(t *testing.T) {
+	p := newPathResolverTestParser(t)
+	tCtx := map[string]any{"name": "test.field", "field": "value"}
+
+	expr, err := p.ParseValueExpression(`Get(test.name)`)
+	require.NoError(t, err)
+	got, err := expr.Eval(t.Context(), tCtx)
+	require.NoError(t, err)
+	assert.Equal(t, "value", got)
+
+	tCtx["name"] = "test.missing"
+	got, err = expr.Eval(t.Context(), tCtx)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func Test_PathResolver_Resolve(t *testing.T) {
+	p := newPathResolverTestParser(t)
+	resolver, err := NewPathResolver[map[string]any](FunctionContext{parser: &p})
+	require.NoError(t, err)
+	tCtx := map[string]any{}
+
+	getSetter, err := resolver.Resolve("test.field")
+	require.NoError(t, err)
+	require.NoError(t, getSetter.Set(t.Context(), tCtx, "value"))
+	assert.Equal(t, map[string]any{"field": "value"}, tCtx)
+
+	cached, err := resolver.Resolve("test.field")
+	require.NoError(t, err)
+	assert.Same(t, getSetter, cached)
+	assert.Len(t, resolver.paths, 1)
+}
+
+func Test_PathResolver_Resolve_error(t *testing.T) {
+	p := newPathResolverTestParser(t)
+	resolver, err := NewPathResolver[map[string]any](FunctionContext{parser: &p})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		path          string
+		expectedError string
+	}{
+		{
+			name:          "not a path",
+			path:          `"test.field"`,
+			expectedError: `invalid path "\"test.field\"": must be a path`,
+		},
+		{
+			name:          "invalid syntax",
+			path:          `test.field[`,
+			expectedError: `invalid path "test.field[": expression has invalid syntax`,
+		},
+		{
+			name:          "missing context",
+			path:          `field`,
+			expectedError: `invalid path "field": missing context name for path "field"`,
+		},
+		{
+			name:          "rejected by the path parser",
+			path:          `test.invalid`,
+			expectedError: `invalid path "test.invalid": invalid path "test.invalid"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := resolver.Resolve(tt.path)
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+	assert.Empty(t, resolver.paths)
+}
+
+func Test_NewPathResolver_withoutParser(t *testing.T) {
+	_, err := NewPathResolver[map[string]any](FunctionContext{})
+	assert.EqualError(t, err, "paths can only be resolved by functions created by a Parser")
+}
@@ -32,12 +32,12 @@ func (v *grammarPathVisitor) visitPath(value *path) {
 	}
 }
 
-func getParsedStatementPaths(ps *parsedStatement) []path {
+// getParsedStatementPaths returns the paths of the statement, except the ones referencing
+// the names bound by the let statements preceding it.
+func getParsedStatementPaths(ps *parsedStatement, bindings localScopeFrame) []path {
 	visitor := &grammarPathVisitor{}
-	ps.Editor.accept(visitor)
-	if ps.WhereClause != nil {
-		ps.WhereClause.accept(visitor)
-	}
+	visitor.scopes.push(bindings)
+	ps.accept(visitor)
 	return visitor.paths
 }
 
@@ -414,7 +414,7 @@ func Test_getParsedStatementPaths(t *testing.T) {
 			ps, err := parseStatement(tt.statement)
 			require.NoError(t, err)
 
-			paths := getParsedStatementPaths(ps)
+			paths := getParsedStatementPaths(ps, nil)
 			require.Equal(t, tt.expected, paths)
 		})
 	}
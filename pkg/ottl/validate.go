@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
+)
+
+// ValidateStatements checks that the statements are valid for the given functions and paths, without
+// requiring the component.TelemetrySettings of a component. It is meant to be used by tools validating
+// the OTTL of a configuration, such as linters, before it is used by a component.
+// The statements are checked as by Parser.ParseStatements: their grammar, paths, enums, function names
+// and arguments are validated, and the functions are created, so the errors returned when creating them,
+// such as an invalid regex, are also reported. The created statements are discarded.
+// Returns a joined error containing each error per invalid statement.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func ValidateStatements[K any](statements []string, functions map[string]Factory[K], pathParser PathExpressionParser[K], options ...Option[K]) error {
+	p, err := NewParser(functions, pathParser, validationTelemetrySettings(), options...)
+	if err != nil {
+		return err
+	}
+	_, err = p.ParseStatements(statements)
+	return err
+}
+
+// ValidateConditions checks that the conditions are valid for the given functions and paths, without
+// requiring the component.TelemetrySettings of a component. See ValidateStatements for details.
+//
+// Experimental: *NOTE* this API is subject to change or removal in the future.
+func ValidateConditions[K any](conditions []string, functions map[string]Factory[K], pathParser PathExpressionParser[K], options ...Option[K]) error {
+	p, err := NewParser(functions, pathParser, validationTelemetrySettings(), options...)
+	if err != nil {
+		return err
+	}
+	_, err = p.ParseConditions(conditions)
+	return err
+}
+
+// validationTelemetrySettings returns the settings used to create the functions while validating
+// statements, which discard the logs of the functions.
+func validationTelemetrySettings() component.TelemetrySettings {
+	return component.TelemetrySettings{Logger: zap.NewNop()}
+}
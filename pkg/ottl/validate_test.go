@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateStatements(t *testing.T) {
+	type mockSetArguments[K any] struct {
+		Target Setter[K]
+		Value  Getter[K]
+	}
+
+	mockSetFactory := NewFactory("set", &mockSetArguments[any]{}, func(fCtx FunctionContext, _ Arguments) (ExprFunc[any], error) {
+		if fCtx.Set.Logger == nil {
+			return nil, errors.New("the functions must be created with a logger")
+		}
+		return func(context.Context, any) (any, error) {
+			return nil, nil
+		}, nil
+	})
+	functions := CreateFactoryMap[any](mockSetFactory)
+
+	err := ValidateStatements([]string{`set(name, "foo")`, `set(name, "bar") where name == "foo"`}, functions, testParsePath[any], WithEnumParser[any](testParseEnum))
+	require.NoError(t, err)
+
+	err = ValidateStatements([]string{`set(name, "foo")`, `set(name`, `unknown(name)`, `set(name, "foo", "bar")`}, functions, testParsePath[any], WithEnumParser[any](testParseEnum))
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), `"set(name, \"foo\")"`)
+	assert.ErrorContains(t, err, `unable to parse OTTL statement "set(name"`)
+	assert.ErrorContains(t, err, `undefined function "unknown"`)
+	assert.ErrorContains(t, err, "incorrect number of arguments")
+}
+
+func Test_ValidateConditions(t *testing.T) {
+	err := ValidateConditions([]string{`name == "foo"`, `name != nil`}, CreateFactoryMap[any](), testParsePath[any], WithEnumParser[any](testParseEnum))
+	require.NoError(t, err)
+
+	err = ValidateConditions([]string{`name == "foo"`, `name ==`, `Unknown(name)`}, CreateFactoryMap[any](), testParsePath[any], WithEnumParser[any](testParseEnum))
+	assert.ErrorContains(t, err, `unable to parse OTTL condition "name =="`)
+	assert.ErrorContains(t, err, `undefined function "Unknown"`)
+}
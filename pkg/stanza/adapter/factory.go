@@ -92,6 +92,11 @@ func createLogsReceiver(logReceiverType LogReceiverType) rcvr.CreateLogsFunc {
 
 		rcv.emitter = emitter
 		rcv.pipe = pipe
+		for _, op := range pipe.Operators() {
+			if h, ok := op.(operator.BackpressureHandler); ok {
+				rcv.backpressureHandlers = append(rcv.backpressureHandlers, h)
+			}
+		}
 
 		return rcv, nil
 	}
@@ -9,6 +9,7 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/extension/xextension/storage"
 	rcvr "go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
@@ -16,6 +17,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/pipeline"
 )
@@ -31,6 +33,9 @@ type receiver struct {
 
 	storageID     *component.ID
 	storageClient storage.Client
+
+	// backpressureHandlers are the operators which are told when the consumer refuses data.
+	backpressureHandlers []operator.BackpressureHandler
 }
 
 // Ensure this receiver adheres to required interface
@@ -51,6 +56,11 @@ func (r *receiver) Start(ctx context.Context, host component.Host) error {
 	return nil
 }
 
+// Operators returns the operators of the pipeline of the receiver
+func (r *receiver) Operators() []operator.Operator {
+	return r.pipe.Operators()
+}
+
 func (r *receiver) consumeEntries(ctx context.Context, entries []*entry.Entry) {
 	obsrecvCtx := r.obsrecv.StartLogsOp(ctx)
 	pLogs := ConvertEntries(entries)
@@ -63,6 +73,10 @@ func (r *receiver) consumeEntries(ctx context.Context, entries []*entry.Entry) {
 	if cErr != nil {
 		r.set.Logger.Error("ConsumeLogs() failed", zap.Error(cErr))
 	}
+	refused := cErr != nil && !consumererror.IsPermanent(cErr)
+	for _, h := range r.backpressureHandlers {
+		h.HandleBackpressure(ctx, refused)
+	}
 	r.obsrecv.EndLogsOp(obsrecvCtx, "stanza", logRecordCount, cErr)
 }
 
@@ -20,6 +20,17 @@ const (
 	LogFilePermissions    = "log.file.permissions"
 	LogFileRecordNumber   = "log.file.record_number"
 	LogFileRecordOffset   = "log.file.record_offset"
+	// LogFileSequence is the number of a record within its logical file, which continues
+	// across rotations of the file and restarts.
+	LogFileSequence = "log.file.sequence"
+	// LogFileRecordContinuation flags the records which continue a log entry that was split
+	// because it exceeded the max log size.
+	LogFileRecordContinuation = "log.file.record_continuation"
+	// LogFileArchiveMember is the name of the member of an archive which a record was read from.
+	LogFileArchiveMember = "log.file.archive_member"
+	// LogFileResource holds the resource attributes of the records of a file, which were read
+	// from its header. Unlike the other keys, it is not a record attribute itself.
+	LogFileResource = "log.file.resource"
 )
 
 type Resolver struct {
@@ -5,11 +5,13 @@ package attrs
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
 	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/internal/filetest"
 )
@@ -97,3 +99,25 @@ func TestResolver(t *testing.T) {
 		})
 	}
 }
+
+func TestResolverUnknownOwner(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("owner info is not supported on windows")
+	}
+	if os.Getuid() != 0 {
+		t.Skip("changing the owner of a file requires root")
+	}
+
+	temp := filetest.OpenTemp(t, t.TempDir())
+	// IDs without a user or group name
+	require.NoError(t, os.Chown(temp.Name(), 54321, 54321))
+
+	r := Resolver{
+		IncludeFileOwnerName:      true,
+		IncludeFileOwnerGroupName: true,
+	}
+	attributes, err := r.Resolve(temp)
+	require.NoError(t, err)
+	assert.Equal(t, "54321", attributes[LogFileOwnerName])
+	assert.Equal(t, "54321", attributes[LogFileOwnerGroupName])
+}
@@ -6,6 +6,7 @@
 package attrs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"os/user"
@@ -25,18 +26,29 @@ func (r *Resolver) addPermissionInfo(file *os.File, attributes map[string]any) e
 		attributes[LogFilePermissions] = perms
 	}
 	if r.IncludeFileOwnerName {
-		fileOwner, errFileUser := user.LookupId(fmt.Sprint(fileStat.Uid))
-		if errFileUser != nil {
+		uid := fmt.Sprint(fileStat.Uid)
+		fileOwner, errFileUser := user.LookupId(uid)
+		switch {
+		case errFileUser == nil:
+			attributes[LogFileOwnerName] = fileOwner.Username
+		case errors.As(errFileUser, new(user.UnknownUserIdError)):
+			// Users such as the service accounts of containers may have no name on the host
+			attributes[LogFileOwnerName] = uid
+		default:
 			return fmt.Errorf("resolve file owner name: %w", errFileUser)
 		}
-		attributes[LogFileOwnerName] = fileOwner.Username
 	}
 	if r.IncludeFileOwnerGroupName {
-		fileGroup, errFileGroup := user.LookupGroupId(fmt.Sprint(fileStat.Gid))
-		if errFileGroup != nil {
+		gid := fmt.Sprint(fileStat.Gid)
+		fileGroup, errFileGroup := user.LookupGroupId(gid)
+		switch {
+		case errFileGroup == nil:
+			attributes[LogFileOwnerGroupName] = fileGroup.Name
+		case errors.As(errFileGroup, new(user.UnknownGroupIdError)):
+			attributes[LogFileOwnerGroupName] = gid
+		default:
 			return fmt.Errorf("resolve file group name: %w", errFileGroup)
 		}
-		attributes[LogFileOwnerGroupName] = fileGroup.Name
 	}
 	return nil
 }
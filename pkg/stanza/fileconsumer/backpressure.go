@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+)
+
+const (
+	defaultPauseInitialInterval = time.Second
+	defaultPauseMaxInterval     = 30 * time.Second
+)
+
+// BackpressureConfig defines how reading is paused while the consumer refuses data.
+type BackpressureConfig struct {
+	// Pause stops polling and reading files while the consumer refuses data with a
+	// retryable error, rather than reading data which is then buffered or dropped.
+	Pause bool `mapstructure:"pause,omitempty"`
+	// InitialInterval is the time for which reading is paused when the consumer first refuses data.
+	InitialInterval time.Duration `mapstructure:"initial_interval,omitempty"`
+	// MaxInterval is the time up to which the pause doubles while the consumer keeps refusing data.
+	MaxInterval time.Duration `mapstructure:"max_interval,omitempty"`
+}
+
+func (c BackpressureConfig) validate() error {
+	if c.InitialInterval < 0 || c.MaxInterval < 0 {
+		return errors.New("intervals must not be negative")
+	}
+	if c.InitialInterval > 0 && c.MaxInterval > 0 && c.InitialInterval > c.MaxInterval {
+		return errors.New("'initial_interval' must not be greater than 'max_interval'")
+	}
+	return nil
+}
+
+func (c BackpressureConfig) build() *backpressure {
+	if !c.Pause {
+		return nil
+	}
+	b := &backpressure{
+		initialInterval: c.InitialInterval,
+		maxInterval:     c.MaxInterval,
+	}
+	if b.initialInterval == 0 {
+		b.initialInterval = defaultPauseInitialInterval
+		if b.maxInterval > 0 {
+			b.initialInterval = min(b.initialInterval, b.maxInterval)
+		}
+	}
+	if b.maxInterval == 0 {
+		b.maxInterval = max(defaultPauseMaxInterval, b.initialInterval)
+	}
+	return b
+}
+
+// backpressure tracks whether reading is paused because the consumer refuses data.
+type backpressure struct {
+	initialInterval time.Duration
+	maxInterval     time.Duration
+
+	mu sync.Mutex
+	// interval is the length of the last pause, or 0 if the consumer accepted data since.
+	interval    time.Duration
+	pausedUntil time.Time
+}
+
+// HandleBackpressure pauses polling and reading while the consumer refuses data with a
+// retryable error, if enabled. Reading resumes once the pause is over, and the pause is
+// extended each time the consumer refuses data again. The refused tokens are read again
+// once reading resumes, if they were emitted with ctx.
+func (m *Manager) HandleBackpressure(ctx context.Context, refused bool) {
+	b := m.backpressure
+	if b == nil {
+		return
+	}
+	if refused {
+		reader.MarkRefused(ctx)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !refused {
+		b.interval, b.pausedUntil = 0, time.Time{}
+		return
+	}
+	now := time.Now()
+	if now.Before(b.pausedUntil) {
+		// Data read before the pause started was refused as well
+		return
+	}
+	if b.interval == 0 {
+		b.interval = b.initialInterval
+	} else {
+		b.interval = min(2*b.interval, b.maxInterval)
+	}
+	b.pausedUntil = now.Add(b.interval)
+	m.set.Logger.Warn("Consumer refused data. Pausing reading", zap.Duration("pause", b.interval))
+}
+
+// paused reports whether reading is paused because the consumer refuses data.
+func (m *Manager) paused() bool {
+	b := m.backpressure
+	if b == nil {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return time.Now().Before(b.pausedUntil)
+}
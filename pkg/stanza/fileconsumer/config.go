@@ -7,9 +7,13 @@ package fileconsumer // import "github.com/open-telemetry/opentelemetry-collecto
 
 import (
 	"bufio"
+	"cmp"
 	"errors"
 	"fmt"
+	"regexp"
 	"runtime"
+	"slices"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -17,8 +21,11 @@ import (
 	"golang.org/x/text/encoding"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/textutils"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/emit"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/archive"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/compression"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/header"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/metadata"
@@ -35,11 +42,18 @@ const (
 	defaultMaxConcurrentFiles = 1024
 	defaultEncoding           = "utf-8"
 	defaultPollInterval       = 200 * time.Millisecond
+	defaultReadQuantum        = 1024 * 1024 // 1MiB
 
 	// MaxLogSizeBehaviorSplit splits oversized log entries into multiple log entries.
 	MaxLogSizeBehaviorSplit = "split"
 	// MaxLogSizeBehaviorTruncate truncates oversized log entries and drops the remainder.
 	MaxLogSizeBehaviorTruncate = "truncate"
+	// MaxLogSizeBehaviorDrop drops oversized log entries entirely.
+	MaxLogSizeBehaviorDrop = "drop"
+
+	// EncodingAuto detects the encoding of each file from its byte order mark or its content,
+	// and falls back to the default encoding.
+	EncodingAuto = "auto"
 )
 
 // OnTruncate defines the behavior when a file with the same fingerprint
@@ -57,19 +71,77 @@ const (
 
 const defaultOnTruncate = OnTruncateIgnore
 
+// FSLockMode defines the kind of filesystem lock acquired before reading a file.
+const (
+	// FSLockModeShared acquires a shared lock, which only conflicts with exclusive locks.
+	FSLockModeShared = "shared"
+	// FSLockModeExclusive acquires an exclusive lock, which conflicts with any other lock.
+	FSLockModeExclusive = "exclusive"
+)
+
+// FSLockOnFailure defines the behavior when the filesystem lock of a file cannot be acquired.
+const (
+	// FSLockOnFailureSkip skips the file until the next poll.
+	FSLockOnFailureSkip = "skip"
+	// FSLockOnFailureRead reads the file without the lock.
+	FSLockOnFailureRead = "read"
+)
+
+// Ordering defines the order in which the files of a poll are read.
+const (
+	// OrderingMtime reads files one at a time, oldest modification time first,
+	// instead of concurrently.
+	OrderingMtime = "mtime"
+)
+
+// Tracking defines how the files which were read are tracked.
+const (
+	// TrackingFingerprint tracks files by their fingerprint across polls, and checkpoints
+	// their offsets, so that appended lines and rotated files are read.
+	TrackingFingerprint = "fingerprint"
+	// TrackingNone reads each file once to EOF. Neither fingerprints nor offsets are kept,
+	// and a file is not read again once it has been read.
+	TrackingNone = "none"
+)
+
+// FollowSymlinks defines how matched symlinks are read.
+const (
+	// FollowSymlinksAlways opens the target of a symlink on every poll, and uses the path of
+	// the symlink for attributes. A symlink which is retargeted is read like a rotated file.
+	FollowSymlinksAlways = "always"
+	// FollowSymlinksNever skips matched symlinks.
+	FollowSymlinksNever = "never"
+	// FollowSymlinksResolveOnce resolves a symlink the first time it is matched, and reads
+	// its target under the resolved path. A symlink which is retargeted keeps being read from
+	// its first target, until that target no longer exists.
+	FollowSymlinksResolveOnce = "resolve_once"
+)
+
+// FingerprintStrategy defines how the fingerprints of files are keyed and persisted.
+const (
+	// FingerprintStrategyBytes uses the first bytes of a file as its fingerprint.
+	FingerprintStrategyBytes = "bytes"
+	// FingerprintStrategyHash uses a hash of the first bytes of a file as its fingerprint,
+	// which reduces the size of the checkpoint state.
+	FingerprintStrategyHash = "hash"
+)
+
 // NewConfig creates a new input config with default values
 func NewConfig() *Config {
 	return &Config{
-		PollInterval:       defaultPollInterval,
-		MaxConcurrentFiles: defaultMaxConcurrentFiles,
-		StartAt:            "end",
-		FingerprintSize:    fingerprint.DefaultSize,
-		InitialBufferSize:  scanner.DefaultBufferSize,
-		MaxLogSize:         reader.DefaultMaxLogSize,
-		MaxLogSizeBehavior: MaxLogSizeBehaviorSplit,
-		Encoding:           defaultEncoding,
-		FlushPeriod:        reader.DefaultFlushPeriod,
-		OnTruncate:         defaultOnTruncate,
+		PollInterval:        defaultPollInterval,
+		MaxConcurrentFiles:  defaultMaxConcurrentFiles,
+		StartAt:             "end",
+		FingerprintSize:     fingerprint.DefaultSize,
+		FingerprintStrategy: FingerprintStrategyBytes,
+		InitialBufferSize:   scanner.DefaultBufferSize,
+		MaxLogSize:          reader.DefaultMaxLogSize,
+		MaxLogSizeBehavior:  MaxLogSizeBehaviorSplit,
+		Encoding:            defaultEncoding,
+		FlushPeriod:         reader.DefaultFlushPeriod,
+		OnTruncate:          defaultOnTruncate,
+		FSLockMode:          FSLockModeShared,
+		FSLockOnFailure:     FSLockOnFailureSkip,
 		Resolver: attrs.Resolver{
 			IncludeFileName: true,
 		},
@@ -80,34 +152,136 @@ func NewConfig() *Config {
 type Config struct {
 	matcher.Criteria        `mapstructure:",squash"`
 	attrs.Resolver          `mapstructure:",squash"`
-	PollInterval            time.Duration   `mapstructure:"poll_interval,omitempty"`
-	MaxConcurrentFiles      int             `mapstructure:"max_concurrent_files,omitempty"`
-	MaxBatches              int             `mapstructure:"max_batches,omitempty"`
-	StartAt                 string          `mapstructure:"start_at,omitempty"`
-	FingerprintSize         helper.ByteSize `mapstructure:"fingerprint_size,omitempty"`
-	InitialBufferSize       helper.ByteSize `mapstructure:"initial_buffer_size,omitempty"`
-	MaxLogSize              helper.ByteSize `mapstructure:"max_log_size,omitempty"`
-	MaxLogSizeBehavior      string          `mapstructure:"max_log_size_behavior,omitempty"`
-	Encoding                string          `mapstructure:"encoding,omitempty"`
-	SplitConfig             split.Config    `mapstructure:"multiline,omitempty"`
-	TrimConfig              trim.Config     `mapstructure:",squash,omitempty"`
-	FlushPeriod             time.Duration   `mapstructure:"force_flush_period,omitempty"`
-	Header                  *HeaderConfig   `mapstructure:"header,omitempty"`
-	DeleteAfterRead         bool            `mapstructure:"delete_after_read,omitempty"`
-	IncludeFileRecordNumber bool            `mapstructure:"include_file_record_number,omitempty"`
-	IncludeFileRecordOffset bool            `mapstructure:"include_file_record_offset,omitempty"`
-	Compression             string          `mapstructure:"compression,omitempty"`
-	PollsToArchive          int             `mapstructure:"polls_to_archive,omitempty"`
-	AcquireFSLock           bool            `mapstructure:"acquire_fs_lock,omitempty"`
-	FileCacheAdvise         bool            `mapstructure:"file_cache_advise,omitempty"`
-	OnTruncate              string          `mapstructure:"on_truncate,omitempty"`
+	PollInterval            time.Duration           `mapstructure:"poll_interval,omitempty"`
+	MaxPollInterval         time.Duration           `mapstructure:"max_poll_interval,omitempty"`
+	MaxConcurrentFiles      int                     `mapstructure:"max_concurrent_files,omitempty"`
+	MaxBatches              int                     `mapstructure:"max_batches,omitempty"`
+	StartAt                 string                  `mapstructure:"start_at,omitempty"`
+	FingerprintSize         helper.ByteSize         `mapstructure:"fingerprint_size,omitempty"`
+	FingerprintStrategy     string                  `mapstructure:"fingerprint_strategy,omitempty"`
+	InitialBufferSize       helper.ByteSize         `mapstructure:"initial_buffer_size,omitempty"`
+	MaxBufferSize           helper.ByteSize         `mapstructure:"max_buffer_size,omitempty"`
+	MaxLogSize              helper.ByteSize         `mapstructure:"max_log_size,omitempty"`
+	MaxLogSizeBehavior      string                  `mapstructure:"max_log_size_behavior,omitempty"`
+	MaxBatchBytes           helper.ByteSize         `mapstructure:"max_batch_bytes,omitempty"`
+	Encoding                string                  `mapstructure:"encoding,omitempty"`
+	DefaultEncoding         string                  `mapstructure:"default_encoding,omitempty"`
+	SplitConfig             split.Config            `mapstructure:"multiline,omitempty"`
+	TrimConfig              trim.Config             `mapstructure:",squash,omitempty"`
+	Normalize               []string                `mapstructure:"normalize,omitempty"`
+	FlushPeriod             time.Duration           `mapstructure:"force_flush_period,omitempty"`
+	Header                  *HeaderConfig           `mapstructure:"header,omitempty"`
+	StartAtTimestamp        *StartAtTimestampConfig `mapstructure:"start_at_timestamp,omitempty"`
+	DeleteAfterRead         bool                    `mapstructure:"delete_after_read,omitempty"`
+	ArchiveTo               string                  `mapstructure:"archive_to,omitempty"`
+	ArchiveCompression      string                  `mapstructure:"archive_compression,omitempty"`
+	IncludeFileRecordNumber bool                    `mapstructure:"include_file_record_number,omitempty"`
+	IncludeFileRecordOffset bool                    `mapstructure:"include_file_record_offset,omitempty"`
+	IncludeFileSequence     bool                    `mapstructure:"include_file_sequence,omitempty"`
+	Compression             string                  `mapstructure:"compression,omitempty"`
+	PollsToArchive          int                     `mapstructure:"polls_to_archive,omitempty"`
+	LostFileGracePolls      int                     `mapstructure:"lost_file_grace_polls,omitempty"`
+	AcquireFSLock           bool                    `mapstructure:"acquire_fs_lock,omitempty"`
+	FSLockMode              string                  `mapstructure:"fs_lock_mode,omitempty"`
+	FSLockTimeout           time.Duration           `mapstructure:"fs_lock_timeout,omitempty"`
+	FSLockOnFailure         string                  `mapstructure:"fs_lock_on_failure,omitempty"`
+	FileCacheAdvise         bool                    `mapstructure:"file_cache_advise,omitempty"`
+	ReadArchives            bool                    `mapstructure:"read_archives,omitempty"`
+	MaxBytesPerSecond       helper.ByteSize         `mapstructure:"max_bytes_per_second,omitempty"`
+	MaxFileBytesPerSecond   helper.ByteSize         `mapstructure:"max_file_bytes_per_second,omitempty"`
+	OnTruncate              string                  `mapstructure:"on_truncate,omitempty"`
+	OnTruncateRereadWindow  time.Duration           `mapstructure:"on_truncate_reread_window,omitempty"`
+	Ordering                string                  `mapstructure:"ordering,omitempty"`
+	Priority                PriorityConfig          `mapstructure:"priority,omitempty"`
+	Backpressure            BackpressureConfig      `mapstructure:"backpressure,omitempty"`
+	Dedup                   DedupConfig             `mapstructure:"dedup,omitempty"`
+	Tracking                string                  `mapstructure:"tracking,omitempty"`
+	FollowSymlinks          string                  `mapstructure:"follow_symlinks,omitempty"`
+	ExcludeContentRegex     string                  `mapstructure:"exclude_content_regex,omitempty"`
+	MaxReadWorkers          int                     `mapstructure:"max_read_workers,omitempty"`
+	CheckpointMaxAge        time.Duration           `mapstructure:"checkpoint_max_age,omitempty"`
+	CheckpointMaxFiles      int                     `mapstructure:"checkpoint_max_files,omitempty"`
+	PendingBytesWarning     helper.ByteSize         `mapstructure:"pending_bytes_warning,omitempty"`
+	ReadQuantum             helper.ByteSize         `mapstructure:"read_quantum,omitempty"`
+	WatchFileEvents         bool                    `mapstructure:"watch_file_events,omitempty"`
+	RotatedBackups          []string                `mapstructure:"rotated_backups,omitempty"`
+	Groups                  []GroupConfig           `mapstructure:"groups,omitempty"`
+	Rules                   []RuleConfig            `mapstructure:"rules,omitempty"`
+}
+
+// GroupConfig configures a group of files which are read with their own settings.
+// The settings left unset are taken from the top-level configuration.
+type GroupConfig struct {
+	matcher.Criteria  `mapstructure:",squash"`
+	StartAt           string          `mapstructure:"start_at,omitempty"`
+	Encoding          string          `mapstructure:"encoding,omitempty"`
+	SplitConfig       *split.Config   `mapstructure:"multiline,omitempty"`
+	Resolver          *attrs.Resolver `mapstructure:"file_attributes,omitempty"`
+	InitialBufferSize helper.ByteSize `mapstructure:"initial_buffer_size,omitempty"`
+	MaxBufferSize     helper.ByteSize `mapstructure:"max_buffer_size,omitempty"`
+	PollInterval      time.Duration   `mapstructure:"poll_interval,omitempty"`
 }
 
 type HeaderConfig struct {
 	Pattern           string            `mapstructure:"pattern"`
 	MetadataOperators []operator.Config `mapstructure:"metadata_operators"`
+	// ResourceAttributes maps the named capture groups of Pattern to resource attributes.
+	ResourceAttributes map[string]string `mapstructure:"resource_attributes,omitempty"`
+}
+
+// StartAtTimestampConfig configures where the files found on the first poll are read
+// from when 'start_at' is set to 'timestamp'.
+type StartAtTimestampConfig struct {
+	// Cutoff is the RFC 3339 time before which records are skipped.
+	Cutoff string `mapstructure:"cutoff"`
+	// Regex must capture the timestamp of a line in a subexpression named 'timestamp'.
+	Regex      string `mapstructure:"regex"`
+	Layout     string `mapstructure:"layout"`
+	LayoutType string `mapstructure:"layout_type"`
+	Location   string `mapstructure:"location"`
 }
 
+func (c StartAtTimestampConfig) build() (*reader.TimestampSeeker, error) {
+	cutoff, err := time.Parse(time.RFC3339, c.Cutoff)
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'cutoff': %w", err)
+	}
+
+	re, err := regexp.Compile(c.Regex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'regex': %w", err)
+	}
+	if re.SubexpIndex("timestamp") < 0 {
+		return nil, errors.New("'regex' must have a subexpression named 'timestamp'")
+	}
+
+	timeParser := helper.NewTimeParser()
+	body := entry.NewBodyField()
+	timeParser.ParseFrom = &body
+	timeParser.Layout = c.Layout
+	if c.LayoutType != "" {
+		timeParser.LayoutType = c.LayoutType
+	}
+	timeParser.Location = c.Location
+	if err = timeParser.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &reader.TimestampSeeker{
+		Cutoff: cutoff,
+		Regex:  re,
+		Parse: func(value string) (time.Time, error) {
+			e := entry.New()
+			e.Body = value
+			if err := timeParser.Parse(e); err != nil {
+				return time.Time{}, err
+			}
+			return e.Timestamp, nil
+		},
+	}, nil
+}
+
+// Build validates the config and creates a Manager which emits the tokens read from files with the callback.
 func (c Config) Build(set component.TelemetrySettings, emit emit.Callback, opts ...Option) (*Manager, error) {
 	if err := c.validate(); err != nil {
 		return nil, err
@@ -121,16 +295,205 @@ func (c Config) Build(set component.TelemetrySettings, emit emit.Callback, opts
 		opt(o)
 	}
 
-	enc, err := textutils.LookupEncoding(c.Encoding)
+	var err error
+	var fileMatcher *matcher.Matcher
+	if len(c.Groups) == 0 || len(c.Include) > 0 {
+		fileMatcher, err = matcher.New(c.Criteria)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var backupMatcher *matcher.Matcher
+	if len(c.RotatedBackups) > 0 {
+		backupMatcher, err = matcher.New(matcher.Criteria{Include: c.RotatedBackups, Exclude: c.Exclude})
+		if err != nil {
+			return nil, fmt.Errorf("rotated_backups: %w", err)
+		}
+	}
+
+	// The groups with their own poll interval are read by their own Manager
+	var pollers []*groupPoller
+	for i, g := range c.Groups {
+		if g.PollInterval == 0 {
+			continue
+		}
+		groupManager, err := c.groupManagerConfig(g).Build(set, emit, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("groups[%d]: %w", i, err)
+		}
+		pollers = append(pollers, &groupPoller{scope: fmt.Sprintf("groups.%d", i), manager: groupManager})
+	}
+
+	set.Logger = set.Logger.With(zap.String("component", "fileconsumer"))
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find encoding: %w", err)
+		return nil, err
+	}
+
+	// The files of all groups share the limit on the rate at which they are read
+	var rateLimiter *reader.RateLimiter
+	if c.MaxBytesPerSecond > 0 {
+		rateLimiter = reader.NewRateLimiter(int(c.MaxBytesPerSecond))
+	}
+	// A file and its copy may be read with the settings of different groups
+	deduplicator := c.Dedup.build()
+	var sequencer *reader.Sequencer
+	if c.IncludeFileSequence {
+		sequencer = reader.NewSequencer()
 	}
 
-	splitFunc := o.splitFunc
-	if splitFunc == nil {
-		splitFunc, err = c.SplitConfig.Func(enc, false, int(c.MaxLogSize))
+	readerFactory, err := c.newReaderFactory(set, emit, telemetryBuilder, GroupConfig{}, o)
+	if err != nil {
+		return nil, err
+	}
+	readerFactory.RateLimiter = rateLimiter
+	readerFactory.Deduplicator = deduplicator
+	readerFactory.Sequencer = sequencer
+	for _, p := range pollers {
+		p.manager.readerFactory.RateLimiter = rateLimiter
+		p.manager.readerFactory.Deduplicator = deduplicator
+	}
+
+	include := slices.Clone(c.Include)
+	groups := make([]*group, 0, len(c.Groups))
+	for i, g := range c.Groups {
+		if g.PollInterval != 0 {
+			continue
+		}
+		groupMatcher, err := matcher.New(g.Criteria)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("groups[%d]: %w", i, err)
+		}
+		groupFactory, err := c.newReaderFactory(set, emit, telemetryBuilder, g, o)
+		if err != nil {
+			return nil, fmt.Errorf("groups[%d]: %w", i, err)
+		}
+		groupFactory.RateLimiter = rateLimiter
+		groupFactory.Deduplicator = deduplicator
+		groupFactory.Sequencer = sequencer
+		groups = append(groups, &group{matcher: groupMatcher, readerFactory: groupFactory})
+		include = append(include, g.Include...)
+	}
+
+	rules := make([]*rule, 0, len(c.Rules))
+	for i, r := range c.Rules {
+		ruleFactory, err := c.newReaderFactory(set, emit, telemetryBuilder, r.groupConfig(), o)
+		if err != nil {
+			return nil, fmt.Errorf("rules[%d]: %w", i, err)
+		}
+		ruleFactory.RateLimiter = rateLimiter
+		ruleFactory.Deduplicator = deduplicator
+		ruleFactory.Sequencer = sequencer
+		rules = append(rules, &rule{match: r.Match, readerFactory: ruleFactory})
+	}
+
+	priority, err := c.Priority.build()
+	if err != nil {
+		return nil, fmt.Errorf("priority: %w", err)
+	}
+
+	var excludeContent *regexp.Regexp
+	if c.ExcludeContentRegex != "" {
+		if excludeContent, err = regexp.Compile(c.ExcludeContentRegex); err != nil {
+			return nil, fmt.Errorf("exclude_content_regex: %w", err)
+		}
+	}
+
+	maxBatchFiles := c.MaxConcurrentFiles / 2
+	if maxBatchFiles == 0 {
+		maxBatchFiles = 1
+	}
+
+	readQuantum := int64(c.ReadQuantum)
+	if readQuantum == 0 {
+		readQuantum = defaultReadQuantum
+	}
+
+	m := &Manager{
+		set:                set,
+		readerFactory:      readerFactory,
+		fileMatcher:        fileMatcher,
+		backupMatcher:      backupMatcher,
+		groups:             groups,
+		groupPollers:       pollers,
+		rules:              rules,
+		pollInterval:       c.PollInterval,
+		maxPollInterval:    c.MaxPollInterval,
+		maxBatchFiles:      maxBatchFiles,
+		maxBatches:         c.MaxBatches,
+		telemetryBuilder:   telemetryBuilder,
+		fileStats:          newFileStats(),
+		noTracking:         o.noTracking,
+		pollsToArchive:     c.PollsToArchive,
+		retention:          archive.Retention{MaxAge: c.CheckpointMaxAge, MaxFiles: c.CheckpointMaxFiles, Migrate: readerFactory.MigrateFingerprint},
+		lostFileGracePolls: c.LostFileGracePolls,
+		onTruncate:         c.OnTruncate,
+		truncateReread:     c.OnTruncateRereadWindow,
+		ordering:           c.Ordering,
+		readOnce:           c.Tracking == TrackingNone,
+		readPaths:          make(map[string]struct{}),
+		pollFileIDs:        make(map[string]struct{}),
+		streams:            make(map[string]struct{}),
+		followSymlinks:     c.FollowSymlinks,
+		excludeContent:     excludeContent,
+		maxReadWorkers:     c.MaxReadWorkers,
+		readQuantum:        readQuantum,
+		priority:           priority,
+		backpressure:       c.Backpressure.build(),
+		watchFileEvents:    c.WatchFileEvents,
+		include:            include,
+		readOffsets:        make(map[string]int64),
+		sequencer:          sequencer,
+		pendingWarning:     int64(c.PendingBytesWarning),
+	}
+	// The files which are still being read are not excluded by their age
+	if fileMatcher != nil {
+		fileMatcher.SetKeepFunc(m.readingFile)
+	}
+	for _, g := range groups {
+		g.matcher.SetKeepFunc(m.readingFile)
+	}
+	return m, nil
+}
+
+// newReaderFactory creates the reader factory of a group. The settings which are
+// not overridden by the group are taken from the top-level configuration.
+func (c Config) newReaderFactory(set component.TelemetrySettings, emit emit.Callback, telemetryBuilder *metadata.TelemetryBuilder, g GroupConfig, o *options) (*reader.Factory, error) {
+	encodingName := c.Encoding
+	if g.Encoding != "" {
+		encodingName = g.Encoding
+	}
+	enc, detectEncoding, err := c.lookupEncoding(encodingName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find encoding: %w", err)
+	}
+
+	splitConfig := c.SplitConfig
+	if g.SplitConfig != nil {
+		splitConfig = *g.SplitConfig
+	}
+	encodingConfig, err := c.newEncodingConfig(set, enc, splitConfig, o)
+	if err != nil {
+		return nil, err
+	}
+
+	var detectedEncodings map[string]reader.EncodingConfig
+	var fallbackEncoding string
+	if detectEncoding {
+		fallbackEncoding = strings.ToLower(c.DefaultEncoding)
+		if fallbackEncoding == "" {
+			fallbackEncoding = defaultEncoding
+		}
+		detectedEncodings = make(map[string]reader.EncodingConfig)
+		for _, name := range []string{reader.EncodingUTF8, reader.EncodingUTF16LE, reader.EncodingUTF16BE} {
+			detectedEnc, err := textutils.LookupEncoding(name)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find encoding: %w", err)
+			}
+			if detectedEncodings[name], err = c.newEncodingConfig(set, detectedEnc, splitConfig, o); err != nil {
+				return nil, fmt.Errorf("encoding '%s': %w", name, err)
+			}
 		}
 	}
 
@@ -138,89 +501,154 @@ func (c Config) Build(set component.TelemetrySettings, emit emit.Callback, opts
 	if enc != encoding.Nop {
 		trimFunc = c.TrimConfig.Func()
 	}
+	// The tokens are normalized once they are decoded, so that multi-byte encodings are not broken
+	normalizeFunc, err := trim.Normalizer(c.Normalize)
+	if err != nil {
+		return nil, err
+	}
 
+	startAt := c.StartAt
+	if g.StartAt != "" {
+		startAt = g.StartAt
+	}
 	var startAtBeginning bool
-	switch c.StartAt {
+	var timestampSeeker *reader.TimestampSeeker
+	switch startAt {
 	case "beginning":
 		startAtBeginning = true
 	case "end":
 		startAtBeginning = false
-	default:
-		return nil, fmt.Errorf("invalid start_at location '%s'", c.StartAt)
-	}
-
-	var hCfg *header.Config
-	if c.Header != nil {
-		hCfg, err = header.NewConfig(set, c.Header.Pattern, c.Header.MetadataOperators, enc)
-		if err != nil {
-			return nil, fmt.Errorf("failed to build header config: %w", err)
+	case "timestamp":
+		if timestampSeeker, err = c.StartAtTimestamp.build(); err != nil {
+			return nil, fmt.Errorf("invalid config for 'start_at_timestamp': %w", err)
 		}
+	default:
+		return nil, fmt.Errorf("invalid start_at location '%s'", startAt)
 	}
 
-	fileMatcher, err := matcher.New(c.Criteria)
-	if err != nil {
-		return nil, err
+	resolver := c.Resolver
+	if g.Resolver != nil {
+		resolver = *g.Resolver
 	}
 
-	set.Logger = set.Logger.With(zap.String("component", "fileconsumer"))
-	readerFactory := &reader.Factory{
+	return &reader.Factory{
 		TelemetrySettings:       set,
 		FromBeginning:           startAtBeginning,
+		TimestampSeeker:         timestampSeeker,
 		FingerprintSize:         int(c.FingerprintSize),
-		InitialBufferSize:       int(c.InitialBufferSize),
+		HashFingerprints:        c.FingerprintStrategy == FingerprintStrategyHash,
+		InitialBufferSize:       int(cmp.Or(g.InitialBufferSize, c.InitialBufferSize)),
+		MaxBufferSize:           int(cmp.Or(g.MaxBufferSize, c.MaxBufferSize)),
 		MaxLogSize:              int(c.MaxLogSize),
 		TruncateOnMaxLogSize:    c.MaxLogSizeBehavior == MaxLogSizeBehaviorTruncate,
+		DropOnMaxLogSize:        c.MaxLogSizeBehavior == MaxLogSizeBehaviorDrop,
+		MaxBatchBytes:           int(c.MaxBatchBytes),
 		Encoding:                enc,
-		SplitFunc:               splitFunc,
+		SplitFunc:               encodingConfig.SplitFunc,
+		DetectedEncodings:       detectedEncodings,
+		FallbackEncoding:        fallbackEncoding,
 		TrimFunc:                trimFunc,
+		NormalizeFunc:           normalizeFunc,
 		FlushTimeout:            c.FlushPeriod,
 		EmitFunc:                emit,
-		Attributes:              c.Resolver,
-		HeaderConfig:            hCfg,
+		TelemetryBuilder:        telemetryBuilder,
+		Attributes:              resolver,
+		HeaderConfig:            encodingConfig.HeaderConfig,
 		DeleteAtEOF:             c.DeleteAfterRead,
+		ArchiveDir:              c.ArchiveTo,
+		ArchiveCompression:      c.ArchiveCompression,
 		IncludeFileRecordNumber: c.IncludeFileRecordNumber,
 		Compression:             c.Compression,
 		AcquireFSLock:           c.AcquireFSLock,
+		FSLockExclusive:         c.FSLockMode == FSLockModeExclusive,
+		FSLockTimeout:           c.FSLockTimeout,
+		FSLockReadOnFailure:     c.FSLockOnFailure == FSLockOnFailureRead,
 		FileCacheAdvise:         c.FileCacheAdvise,
-	}
+		ReadArchives:            c.ReadArchives,
+		MaxFileBytesPerSecond:   int(c.MaxFileBytesPerSecond),
+		RereadRefused:           c.Backpressure.Pause,
+	}, nil
+}
 
-	telemetryBuilder, err := metadata.NewTelemetryBuilder(set)
-	if err != nil {
-		return nil, err
+// lookupEncoding returns the encoding with the given name, and whether the encoding is
+// detected for each file instead. In that case, the default encoding is returned.
+func (c Config) lookupEncoding(name string) (encoding.Encoding, bool, error) {
+	if strings.EqualFold(name, EncodingAuto) {
+		enc, err := textutils.LookupEncoding(c.DefaultEncoding)
+		return enc, true, err
 	}
+	enc, err := textutils.LookupEncoding(name)
+	return enc, false, err
+}
 
-	maxBatchFiles := c.MaxConcurrentFiles / 2
-	if maxBatchFiles == 0 {
-		maxBatchFiles = 1
+// newEncodingConfig creates the settings of the reader factory which depend on the encoding.
+func (c Config) newEncodingConfig(set component.TelemetrySettings, enc encoding.Encoding, splitConfig split.Config, o *options) (reader.EncodingConfig, error) {
+	ec := reader.EncodingConfig{Encoding: enc, SplitFunc: o.splitFunc}
+	var err error
+	if ec.SplitFunc == nil {
+		ec.SplitFunc, err = splitConfig.Func(enc, false, int(c.MaxLogSize))
+		if err != nil {
+			return ec, err
+		}
 	}
-
-	return &Manager{
-		set:              set,
-		readerFactory:    readerFactory,
-		fileMatcher:      fileMatcher,
-		pollInterval:     c.PollInterval,
-		maxBatchFiles:    maxBatchFiles,
-		maxBatches:       c.MaxBatches,
-		telemetryBuilder: telemetryBuilder,
-		noTracking:       o.noTracking,
-		pollsToArchive:   c.PollsToArchive,
-		onTruncate:       c.OnTruncate,
-	}, nil
+	if c.Header != nil {
+		ec.HeaderConfig, err = header.NewConfig(set, c.Header.Pattern, c.Header.MetadataOperators, c.Header.ResourceAttributes, enc)
+		if err != nil {
+			return ec, fmt.Errorf("failed to build header config: %w", err)
+		}
+	}
+	return ec, nil
 }
 
 func (c Config) validate() error {
-	if _, err := matcher.New(c.Criteria); err != nil {
-		return err
+	if len(c.Groups) == 0 || len(c.Include) > 0 {
+		if _, err := matcher.New(c.Criteria); err != nil {
+			return err
+		}
 	}
 
 	if c.FingerprintSize < fingerprint.MinSize {
 		return fmt.Errorf("'fingerprint_size' must be at least %d bytes", fingerprint.MinSize)
 	}
 
+	switch c.FingerprintStrategy {
+	case FingerprintStrategyBytes, FingerprintStrategyHash:
+	default:
+		return fmt.Errorf("'fingerprint_strategy' must be either '%s' or '%s'", FingerprintStrategyBytes, FingerprintStrategyHash)
+	}
+
 	if c.MaxLogSize <= 0 {
 		return errors.New("'max_log_size' must be positive")
 	}
 
+	if c.PendingBytesWarning < 0 {
+		return errors.New("'pending_bytes_warning' must not be negative")
+	}
+
+	if c.MaxBufferSize < 0 {
+		return errors.New("'max_buffer_size' must not be negative")
+	}
+
+	if c.MaxBatchBytes < 0 {
+		return errors.New("'max_batch_bytes' must not be negative")
+	}
+
+	if _, err := trim.Normalizer(c.Normalize); err != nil {
+		return fmt.Errorf("'normalize': %w", err)
+	}
+
+	if c.MaxBytesPerSecond < 0 {
+		return errors.New("'max_bytes_per_second' must not be negative")
+	}
+
+	if c.MaxFileBytesPerSecond < 0 {
+		return errors.New("'max_file_bytes_per_second' must not be negative")
+	}
+
+	if c.MaxPollInterval != 0 && c.MaxPollInterval < c.PollInterval {
+		return errors.New("'max_poll_interval' must not be less than 'poll_interval'")
+	}
+
 	if c.MaxConcurrentFiles < 1 {
 		return errors.New("'max_concurrent_files' must be positive")
 	}
@@ -229,17 +657,25 @@ func (c Config) validate() error {
 		return errors.New("'max_batches' must not be negative")
 	}
 
+	if c.LostFileGracePolls < 0 {
+		return errors.New("'lost_file_grace_polls' must not be negative")
+	}
+
 	switch c.MaxLogSizeBehavior {
 	case MaxLogSizeBehaviorSplit:
 	case MaxLogSizeBehaviorTruncate:
+	case MaxLogSizeBehaviorDrop:
 	default:
-		return fmt.Errorf("'max_log_size_behavior' must be either '%s' or '%s'", MaxLogSizeBehaviorSplit, MaxLogSizeBehaviorTruncate)
+		return fmt.Errorf("'max_log_size_behavior' must be one of: %s, %s, %s", MaxLogSizeBehaviorSplit, MaxLogSizeBehaviorTruncate, MaxLogSizeBehaviorDrop)
 	}
 
-	enc, err := textutils.LookupEncoding(c.Encoding)
+	enc, detectEncoding, err := c.lookupEncoding(c.Encoding)
 	if err != nil {
 		return err
 	}
+	if err = c.validateEncoding(enc, detectEncoding); err != nil {
+		return err
+	}
 
 	if c.DeleteAfterRead {
 		if !metadata.FilelogAllowFileDeletionFeatureGate.IsEnabled() {
@@ -250,6 +686,33 @@ func (c Config) validate() error {
 		}
 	}
 
+	if c.ArchiveTo != "" {
+		if !metadata.FilelogAllowFileDeletionFeatureGate.IsEnabled() {
+			return fmt.Errorf("'archive_to' requires feature gate '%s'", metadata.FilelogAllowFileDeletionFeatureGate.ID())
+		}
+		if c.DeleteAfterRead {
+			return errors.New("'archive_to' cannot be used with 'delete_after_read'")
+		}
+		if c.StartAt == "end" {
+			return errors.New("'archive_to' cannot be used with 'start_at: end'")
+		}
+	}
+
+	if c.ArchiveCompression != "" {
+		if c.ArchiveTo == "" {
+			return errors.New("'archive_compression' requires 'archive_to' to be set")
+		}
+		if compression.Extension(c.ArchiveCompression) == "" {
+			return fmt.Errorf("'archive_compression' must be one of: %s, %s", compression.Gzip, compression.Zstd)
+		}
+	}
+
+	if c.StartAt == "timestamp" {
+		if err := c.validateStartAtTimestamp(enc, detectEncoding); err != nil {
+			return err
+		}
+	}
+
 	if c.Header != nil {
 		if !metadata.FilelogAllowHeaderMetadataParsingFeatureGate.IsEnabled() {
 			return fmt.Errorf("'header' requires feature gate '%s'", metadata.FilelogAllowHeaderMetadataParsingFeatureGate.ID())
@@ -258,11 +721,21 @@ func (c Config) validate() error {
 			return errors.New("'header' cannot be specified with 'start_at: end'")
 		}
 		set := component.TelemetrySettings{Logger: zap.NewNop()}
-		if _, errConfig := header.NewConfig(set, c.Header.Pattern, c.Header.MetadataOperators, enc); errConfig != nil {
+		if _, errConfig := header.NewConfig(set, c.Header.Pattern, c.Header.MetadataOperators, c.Header.ResourceAttributes, enc); errConfig != nil {
 			return fmt.Errorf("invalid config for 'header': %w", errConfig)
 		}
 	}
 
+	if c.ReadArchives {
+		if detectEncoding {
+			return errors.New("'read_archives' cannot be used with 'encoding: auto'")
+		}
+		// Each member of an archive would need to be parsed for a header
+		if c.Header != nil {
+			return errors.New("'read_archives' cannot be used with 'header'")
+		}
+	}
+
 	if runtime.GOOS == "windows" && (c.IncludeFileOwnerName || c.IncludeFileOwnerGroupName) {
 		return errors.New("'include_file_owner_name' or 'include_file_owner_group_name' it's not supported on Windows")
 	}
@@ -274,10 +747,246 @@ func (c Config) validate() error {
 		return fmt.Errorf("'on_truncate' must be one of: %s, %s, %s", OnTruncateIgnore, OnTruncateReadWholeFile, OnTruncateReadNew)
 	}
 
+	if c.OnTruncateRereadWindow < 0 {
+		return errors.New("'on_truncate_reread_window' must not be negative")
+	}
+
+	switch c.FSLockMode {
+	case FSLockModeShared, FSLockModeExclusive:
+	default:
+		return fmt.Errorf("'fs_lock_mode' must be one of: %s, %s", FSLockModeShared, FSLockModeExclusive)
+	}
+
+	if c.FSLockTimeout < 0 {
+		return errors.New("'fs_lock_timeout' must not be negative")
+	}
+
+	switch c.FSLockOnFailure {
+	case FSLockOnFailureSkip, FSLockOnFailureRead:
+	default:
+		return fmt.Errorf("'fs_lock_on_failure' must be one of: %s, %s", FSLockOnFailureSkip, FSLockOnFailureRead)
+	}
+
+	switch c.Ordering {
+	case "", OrderingMtime:
+	default:
+		return fmt.Errorf("'ordering' must be either empty or '%s'", OrderingMtime)
+	}
+
+	switch c.Tracking {
+	case "", TrackingFingerprint:
+	case TrackingNone:
+		if c.PollsToArchive > 0 {
+			return errors.New("'polls_to_archive' cannot be used with 'tracking: none'")
+		}
+		// Rotated backups are read for the files which are tracked
+		if len(c.RotatedBackups) > 0 {
+			return errors.New("'rotated_backups' cannot be used with 'tracking: none'")
+		}
+	default:
+		return fmt.Errorf("'tracking' must be one of: %s, %s", TrackingFingerprint, TrackingNone)
+	}
+
+	switch c.FollowSymlinks {
+	case "", FollowSymlinksAlways, FollowSymlinksNever, FollowSymlinksResolveOnce:
+	default:
+		return fmt.Errorf("'follow_symlinks' must be one of: %s, %s, %s", FollowSymlinksAlways, FollowSymlinksNever, FollowSymlinksResolveOnce)
+	}
+
+	if c.CheckpointMaxAge < 0 {
+		return errors.New("'checkpoint_max_age' must not be negative")
+	}
+	if c.CheckpointMaxFiles < 0 {
+		return errors.New("'checkpoint_max_files' must not be negative")
+	}
+	// Only the offsets archived by polls_to_archive accumulate
+	if (c.CheckpointMaxAge > 0 || c.CheckpointMaxFiles > 0) && c.PollsToArchive <= 0 {
+		return errors.New("'checkpoint_max_age' and 'checkpoint_max_files' require 'polls_to_archive' to be set")
+	}
+
+	if c.MaxReadWorkers < 0 {
+		return errors.New("'max_read_workers' must not be negative")
+	}
+	if c.ReadQuantum < 0 {
+		return errors.New("'read_quantum' must not be negative")
+	}
+
+	if _, err := regexp.Compile(c.ExcludeContentRegex); err != nil {
+		return fmt.Errorf("'exclude_content_regex': %w", err)
+	}
+
+	if err := c.Backpressure.validate(); err != nil {
+		return fmt.Errorf("'backpressure': %w", err)
+	}
+
+	if err := c.Dedup.validate(); err != nil {
+		return fmt.Errorf("'dedup': %w", err)
+	}
+
+	if _, err := c.Priority.build(); err != nil {
+		return fmt.Errorf("'priority': %w", err)
+	}
+	// Both define the order in which the files of a poll are read
+	if c.Ordering != "" && (len(c.Priority.Tiers) > 0 || c.Priority.NewestFirst) {
+		return errors.New("'priority' cannot be used with 'ordering'")
+	}
+
+	if len(c.RotatedBackups) > 0 {
+		if c.Compression == "" {
+			return errors.New("'rotated_backups' requires 'compression' to be set")
+		}
+		if _, err := matcher.New(matcher.Criteria{Include: c.RotatedBackups}); err != nil {
+			return fmt.Errorf("'rotated_backups': %w", err)
+		}
+	}
+
 	if runtime.GOOS == "windows" && c.IncludeFilePermissions {
 		return errors.New("'include_file_permissions' is not supported on Windows")
 	}
 
+	for i, g := range c.Groups {
+		if err := c.validateGroup(g); err != nil {
+			return fmt.Errorf("'groups[%d]': %w", i, err)
+		}
+	}
+
+	if len(c.Rules) > 0 && len(c.Include) == 0 {
+		return errors.New("'rules' require 'include' to be specified")
+	}
+	for i, r := range c.Rules {
+		if err := c.validateRule(r); err != nil {
+			return fmt.Errorf("'rules[%d]': %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (c Config) validateEncoding(enc encoding.Encoding, detectEncoding bool) error {
+	if !detectEncoding {
+		return nil
+	}
+	if enc == encoding.Nop {
+		return errors.New("'default_encoding' cannot be 'nop'")
+	}
+	// The start of compressed files is not available to detect the encoding from
+	if c.Compression != "" {
+		return errors.New("'encoding: auto' cannot be used with 'compression'")
+	}
+	return nil
+}
+
+func (c Config) validateStartAtTimestamp(enc encoding.Encoding, detectEncoding bool) error {
+	if c.StartAtTimestamp == nil {
+		return errors.New("'start_at: timestamp' requires 'start_at_timestamp' to be set")
+	}
+	if _, err := c.StartAtTimestamp.build(); err != nil {
+		return fmt.Errorf("invalid config for 'start_at_timestamp': %w", err)
+	}
+	if detectEncoding {
+		return errors.New("'start_at: timestamp' cannot be used with 'encoding: auto'")
+	}
+	// Lines are matched without being decoded
+	if newline, err := enc.NewEncoder().String("\n"); enc == encoding.Nop || err != nil || newline != "\n" {
+		return errors.New("'start_at: timestamp' requires an ASCII compatible encoding")
+	}
+	if c.Compression != "" {
+		return errors.New("'start_at: timestamp' cannot be used with 'compression'")
+	}
+	if c.Header != nil {
+		return errors.New("'header' cannot be specified with 'start_at: timestamp'")
+	}
+	return nil
+}
+
+func (c Config) validateGroup(g GroupConfig) error {
+	if _, err := matcher.New(g.Criteria); err != nil {
+		return err
+	}
+
+	startAt := c.StartAt
+	switch g.StartAt {
+	case "":
+	case "beginning", "end", "timestamp":
+		startAt = g.StartAt
+	default:
+		return fmt.Errorf("invalid start_at location '%s'", g.StartAt)
+	}
+	if c.DeleteAfterRead && startAt == "end" {
+		return errors.New("'delete_after_read' cannot be used with 'start_at: end'")
+	}
+	if c.ArchiveTo != "" && startAt == "end" {
+		return errors.New("'archive_to' cannot be used with 'start_at: end'")
+	}
+	if c.Header != nil && startAt == "end" {
+		return errors.New("'header' cannot be specified with 'start_at: end'")
+	}
+
+	if err := c.validateEncodingOverride(g.Encoding, startAt); err != nil {
+		return err
+	}
+
+	if g.InitialBufferSize < 0 {
+		return errors.New("'initial_buffer_size' must not be negative")
+	}
+	if g.MaxBufferSize < 0 {
+		return errors.New("'max_buffer_size' must not be negative")
+	}
+	if g.PollInterval < 0 {
+		return errors.New("'poll_interval' must not be negative")
+	}
+
+	if g.Resolver != nil {
+		if runtime.GOOS == "windows" && (g.Resolver.IncludeFileOwnerName || g.Resolver.IncludeFileOwnerGroupName) {
+			return errors.New("'include_file_owner_name' or 'include_file_owner_group_name' it's not supported on Windows")
+		}
+		if runtime.GOOS == "windows" && g.Resolver.IncludeFilePermissions {
+			return errors.New("'include_file_permissions' is not supported on Windows")
+		}
+	}
+
+	return nil
+}
+
+func (c Config) validateRule(r RuleConfig) error {
+	if err := r.validate(); err != nil {
+		return err
+	}
+	if r.Split == RuleSplitJSON && textutils.IsNop(cmp.Or(r.Encoding, c.Encoding)) {
+		return errors.New("'split: json' cannot be used with 'nop' encoding")
+	}
+	return c.validateEncodingOverride(r.Encoding, c.StartAt)
+}
+
+// validateEncodingOverride validates the encoding of a group or rule, if any,
+// along with the start_at location of its files.
+func (c Config) validateEncodingOverride(encodingName, startAt string) error {
+	if encodingName != "" {
+		enc, detectEncoding, err := c.lookupEncoding(encodingName)
+		if err != nil {
+			return err
+		}
+		if err := c.validateEncoding(enc, detectEncoding); err != nil {
+			return err
+		}
+		// The body of the entries is bytes or a string for all files alike
+		if textutils.IsNop(encodingName) != textutils.IsNop(c.Encoding) {
+			return errors.New("'encoding' cannot switch to or from 'nop'")
+		}
+	}
+
+	if startAt == "timestamp" {
+		if encodingName == "" {
+			encodingName = c.Encoding
+		}
+		enc, detectEncoding, err := c.lookupEncoding(encodingName)
+		if err != nil {
+			return err
+		}
+		if err := c.validateStartAtTimestamp(enc, detectEncoding); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -286,6 +995,7 @@ type options struct {
 	noTracking bool
 }
 
+// Option changes how a Manager is built.
 type Option func(*options)
 
 // WithSplitFunc overrides the split func which is normally built from other settings on the config
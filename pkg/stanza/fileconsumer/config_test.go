@@ -13,15 +13,21 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/featuregate"
+	"golang.org/x/text/encoding/charmap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/emittest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/scanner"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/matcher"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/operatortest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/regex"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/split"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/trim"
 )
 
 func TestNewConfig(t *testing.T) {
@@ -42,6 +48,8 @@ func TestNewConfig(t *testing.T) {
 	assert.False(t, cfg.IncludeFilePermissions)
 	assert.False(t, cfg.IncludeFileRecordNumber)
 	assert.False(t, cfg.AcquireFSLock)
+	assert.Equal(t, FSLockModeShared, cfg.FSLockMode)
+	assert.Equal(t, FSLockOnFailureSkip, cfg.FSLockOnFailure)
 }
 
 func TestUnmarshal(t *testing.T) {
@@ -492,6 +500,285 @@ func TestBuild(t *testing.T) {
 			require.Error,
 			nil,
 		},
+		{
+			"MaxPollInterval",
+			func(cfg *Config) {
+				cfg.MaxPollInterval = 10 * time.Second
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Equal(t, 10*time.Second, m.maxPollInterval)
+			},
+		},
+		{
+			"MaxPollIntervalLessThanPollInterval",
+			func(cfg *Config) {
+				cfg.MaxPollInterval = cfg.PollInterval / 2
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"OrderingMtime",
+			func(cfg *Config) {
+				cfg.Ordering = OrderingMtime
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Equal(t, OrderingMtime, m.ordering)
+			},
+		},
+		{
+			"InvalidOrdering",
+			func(cfg *Config) {
+				cfg.Ordering = "invalid"
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"Groups",
+			func(cfg *Config) {
+				cfg.Groups = []GroupConfig{
+					{
+						Criteria: matcher.Criteria{Include: []string{"/var/log/other.*"}},
+						StartAt:  "beginning",
+						Encoding: "utf-16le",
+					},
+				}
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.NotNil(t, m.fileMatcher)
+				require.Len(t, m.groups, 1)
+				require.False(t, m.readerFactory.FromBeginning)
+				require.True(t, m.groups[0].readerFactory.FromBeginning)
+				require.Equal(t, []string{"/var/log/testpath.*", "/var/log/other.*"}, m.include)
+			},
+		},
+		{
+			"GroupsWithoutTopLevelInclude",
+			func(cfg *Config) {
+				cfg.Include = nil
+				cfg.Groups = []GroupConfig{
+					{Criteria: matcher.Criteria{Include: []string{"/var/log/other.*"}}},
+				}
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Nil(t, m.fileMatcher)
+				require.Len(t, m.groups, 1)
+			},
+		},
+		{
+			"GroupWithoutInclude",
+			func(cfg *Config) {
+				cfg.Groups = []GroupConfig{{StartAt: "beginning"}}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"GroupInvalidStartAt",
+			func(cfg *Config) {
+				cfg.Groups = []GroupConfig{
+					{
+						Criteria: matcher.Criteria{Include: []string{"/var/log/other.*"}},
+						StartAt:  "middle",
+					},
+				}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"GroupNopEncoding",
+			func(cfg *Config) {
+				cfg.Groups = []GroupConfig{
+					{
+						Criteria: matcher.Criteria{Include: []string{"/var/log/other.*"}},
+						Encoding: "nop",
+					},
+				}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"GroupBufferSizes",
+			func(cfg *Config) {
+				cfg.MaxBufferSize = helper.ByteSize(256 * 1024)
+				cfg.Groups = []GroupConfig{
+					{
+						Criteria:          matcher.Criteria{Include: []string{"/var/log/other.*"}},
+						InitialBufferSize: helper.ByteSize(64 * 1024),
+						MaxBufferSize:     helper.ByteSize(1024 * 1024),
+					},
+					{
+						Criteria: matcher.Criteria{Include: []string{"/var/log/another.*"}},
+					},
+				}
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Equal(t, scanner.DefaultBufferSize, m.readerFactory.InitialBufferSize)
+				require.Equal(t, 256*1024, m.readerFactory.MaxBufferSize)
+				require.Equal(t, 64*1024, m.groups[0].readerFactory.InitialBufferSize)
+				require.Equal(t, 1024*1024, m.groups[0].readerFactory.MaxBufferSize)
+				require.Equal(t, scanner.DefaultBufferSize, m.groups[1].readerFactory.InitialBufferSize)
+				require.Equal(t, 256*1024, m.groups[1].readerFactory.MaxBufferSize)
+			},
+		},
+		{
+			"GroupNegativeMaxBufferSize",
+			func(cfg *Config) {
+				cfg.Groups = []GroupConfig{
+					{
+						Criteria:      matcher.Criteria{Include: []string{"/var/log/other.*"}},
+						MaxBufferSize: helper.ByteSize(-1),
+					},
+				}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"GroupPollInterval",
+			func(cfg *Config) {
+				cfg.Groups = []GroupConfig{
+					{
+						Criteria:     matcher.Criteria{Include: []string{"/mnt/archive/*.log"}},
+						PollInterval: 5 * time.Minute,
+					},
+				}
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Empty(t, m.groups)
+				require.Len(t, m.groupPollers, 1)
+				require.Equal(t, 5*time.Minute, m.groupPollers[0].manager.pollInterval)
+				require.Equal(t, "groups.0", m.groupPollers[0].scope)
+			},
+		},
+		{
+			"GroupNegativePollInterval",
+			func(cfg *Config) {
+				cfg.Groups = []GroupConfig{
+					{
+						Criteria:     matcher.Criteria{Include: []string{"/mnt/archive/*.log"}},
+						PollInterval: -time.Second,
+					},
+				}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"Rules",
+			func(cfg *Config) {
+				cfg.Rules = []RuleConfig{
+					{Match: "*.json.log", Split: RuleSplitJSON},
+					{Match: "/var/log/legacy/*", Encoding: "utf-16le"},
+				}
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Len(t, m.rules, 2)
+				require.Same(t, m.rules[0].readerFactory, m.ruleFactory("/var/log/app.json.log"))
+				require.Same(t, m.rules[1].readerFactory, m.ruleFactory("/var/log/legacy/app.log"))
+				require.Same(t, m.readerFactory, m.ruleFactory("/var/log/app.log"))
+			},
+		},
+		{
+			"RuleWithoutMatch",
+			func(cfg *Config) {
+				cfg.Rules = []RuleConfig{{Split: RuleSplitJSON}}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"RuleInvalidSplit",
+			func(cfg *Config) {
+				cfg.Rules = []RuleConfig{{Match: "*.log", Split: "xml"}}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"RuleSplitAndMultiline",
+			func(cfg *Config) {
+				cfg.Rules = []RuleConfig{{Match: "*.log", Split: RuleSplitLine, SplitConfig: &split.Config{LineStartPattern: "^START"}}}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"RuleNopEncoding",
+			func(cfg *Config) {
+				cfg.Rules = []RuleConfig{{Match: "*.bin", Encoding: "nop"}}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"RulesWithoutTopLevelInclude",
+			func(cfg *Config) {
+				cfg.Include = nil
+				cfg.Groups = []GroupConfig{
+					{Criteria: matcher.Criteria{Include: []string{"/var/log/other.*"}}},
+				}
+				cfg.Rules = []RuleConfig{{Match: "*.json.log", Split: RuleSplitJSON}}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"HashFingerprintStrategy",
+			func(cfg *Config) {
+				cfg.FingerprintStrategy = FingerprintStrategyHash
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.True(t, m.readerFactory.HashFingerprints)
+			},
+		},
+		{
+			"InvalidFingerprintStrategy",
+			func(cfg *Config) {
+				cfg.FingerprintStrategy = "invalid"
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"RotatedBackups",
+			func(cfg *Config) {
+				cfg.RotatedBackups = []string{"/var/log/testpath.*.gz"}
+				cfg.Compression = "auto"
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.NotNil(t, m.backupMatcher)
+			},
+		},
+		{
+			"RotatedBackupsWithoutCompression",
+			func(cfg *Config) {
+				cfg.RotatedBackups = []string{"/var/log/testpath.*.gz"}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"BadRotatedBackupsGlob",
+			func(cfg *Config) {
+				cfg.RotatedBackups = []string{"["}
+				cfg.Compression = "auto"
+			},
+			require.Error,
+			nil,
+		},
 		{
 			"MultilineConfiguredStartAndEndPatterns",
 			func(cfg *Config) {
@@ -518,49 +805,256 @@ func TestBuild(t *testing.T) {
 			func(_ *testing.T, _ *Manager) {},
 		},
 		{
-			"InvalidEncoding",
+			"InvalidEncoding",
+			func(cfg *Config) {
+				cfg.Encoding = "UTF-3233"
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"EncodingAuto",
+			func(cfg *Config) {
+				cfg.Encoding = EncodingAuto
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Len(t, m.readerFactory.DetectedEncodings, 3)
+				require.Equal(t, "utf-8", m.readerFactory.FallbackEncoding)
+			},
+		},
+		{
+			"EncodingAutoDefaultEncoding",
+			func(cfg *Config) {
+				cfg.Encoding = EncodingAuto
+				cfg.DefaultEncoding = "Windows-1252"
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Equal(t, "windows-1252", m.readerFactory.FallbackEncoding)
+				require.Equal(t, charmap.Windows1252, m.readerFactory.Encoding)
+			},
+		},
+		{
+			"EncodingAutoNopDefaultEncoding",
+			func(cfg *Config) {
+				cfg.Encoding = EncodingAuto
+				cfg.DefaultEncoding = "nop"
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"EncodingAutoInvalidDefaultEncoding",
+			func(cfg *Config) {
+				cfg.Encoding = EncodingAuto
+				cfg.DefaultEncoding = "UTF-3233"
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"EncodingAutoCompression",
+			func(cfg *Config) {
+				cfg.Encoding = EncodingAuto
+				cfg.Compression = "gzip"
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"LineStartAndEnd",
+			func(cfg *Config) {
+				cfg.SplitConfig.LineStartPattern = ".*"
+				cfg.SplitConfig.LineEndPattern = ".*"
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"NoLineStartOrEnd",
+			func(_ *Config) {},
+			require.NoError,
+			func(_ *testing.T, _ *Manager) {},
+		},
+		{
+			"InvalidLineStartRegex",
+			func(cfg *Config) {
+				cfg.SplitConfig.LineStartPattern = "("
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"InvalidLineEndRegex",
+			func(cfg *Config) {
+				cfg.SplitConfig.LineEndPattern = "("
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"StartAtTimestamp",
+			func(cfg *Config) {
+				cfg.StartAt = "timestamp"
+				cfg.StartAtTimestamp = &StartAtTimestampConfig{
+					Cutoff:     "2024-05-01T00:00:00Z",
+					Regex:      `^(?P<timestamp>\S+)`,
+					Layout:     "%Y-%m-%dT%H:%M:%S%z",
+					LayoutType: "strptime",
+				}
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.False(t, m.readerFactory.FromBeginning)
+				require.NotNil(t, m.readerFactory.TimestampSeeker)
+			},
+		},
+		{
+			"StartAtTimestampMissingConfig",
+			func(cfg *Config) {
+				cfg.StartAt = "timestamp"
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"StartAtTimestampInvalidCutoff",
+			func(cfg *Config) {
+				cfg.StartAt = "timestamp"
+				cfg.StartAtTimestamp = &StartAtTimestampConfig{
+					Cutoff: "yesterday",
+					Regex:  `^(?P<timestamp>\S+)`,
+					Layout: "%Y-%m-%dT%H:%M:%S%z",
+				}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"StartAtTimestampRegexWithoutTimestamp",
+			func(cfg *Config) {
+				cfg.StartAt = "timestamp"
+				cfg.StartAtTimestamp = &StartAtTimestampConfig{
+					Cutoff: "2024-05-01T00:00:00Z",
+					Regex:  `^(\S+)`,
+					Layout: "%Y-%m-%dT%H:%M:%S%z",
+				}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"StartAtTimestampWithCompression",
+			func(cfg *Config) {
+				cfg.StartAt = "timestamp"
+				cfg.StartAtTimestamp = &StartAtTimestampConfig{
+					Cutoff: "2024-05-01T00:00:00Z",
+					Regex:  `^(?P<timestamp>\S+)`,
+					Layout: "%Y-%m-%dT%H:%M:%S%z",
+				}
+				cfg.Compression = "gzip"
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"InvalidStartAtDelete",
+			func(cfg *Config) {
+				cfg.StartAt = "end"
+				cfg.DeleteAfterRead = true
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"ArchiveTo",
+			func(cfg *Config) {
+				require.NoError(t, featuregate.GlobalRegistry().Set(metadata.FilelogAllowFileDeletionFeatureGate.ID(), true))
+				cfg.StartAt = "beginning"
+				cfg.ArchiveTo = t.TempDir()
+				cfg.ArchiveCompression = "zstd"
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Equal(t, "zstd", m.readerFactory.ArchiveCompression)
+			},
+		},
+		{
+			"InvalidStartAtArchive",
+			func(cfg *Config) {
+				require.NoError(t, featuregate.GlobalRegistry().Set(metadata.FilelogAllowFileDeletionFeatureGate.ID(), true))
+				cfg.StartAt = "end"
+				cfg.ArchiveTo = t.TempDir()
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"InvalidArchiveWithDelete",
+			func(cfg *Config) {
+				require.NoError(t, featuregate.GlobalRegistry().Set(metadata.FilelogAllowFileDeletionFeatureGate.ID(), true))
+				cfg.StartAt = "beginning"
+				cfg.ArchiveTo = t.TempDir()
+				cfg.DeleteAfterRead = true
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"InvalidArchiveCompression",
 			func(cfg *Config) {
-				cfg.Encoding = "UTF-3233"
+				require.NoError(t, featuregate.GlobalRegistry().Set(metadata.FilelogAllowFileDeletionFeatureGate.ID(), true))
+				cfg.StartAt = "beginning"
+				cfg.ArchiveTo = t.TempDir()
+				cfg.ArchiveCompression = "bzip2"
 			},
 			require.Error,
 			nil,
 		},
 		{
-			"LineStartAndEnd",
+			"ArchiveCompressionWithoutArchiveTo",
 			func(cfg *Config) {
-				cfg.SplitConfig.LineStartPattern = ".*"
-				cfg.SplitConfig.LineEndPattern = ".*"
+				cfg.ArchiveCompression = "gzip"
 			},
 			require.Error,
 			nil,
 		},
 		{
-			"NoLineStartOrEnd",
-			func(_ *Config) {},
+			"FSLockOptions",
+			func(cfg *Config) {
+				cfg.AcquireFSLock = true
+				cfg.FSLockMode = FSLockModeExclusive
+				cfg.FSLockTimeout = time.Second
+				cfg.FSLockOnFailure = FSLockOnFailureRead
+			},
 			require.NoError,
-			func(_ *testing.T, _ *Manager) {},
+			func(t *testing.T, m *Manager) {
+				require.True(t, m.readerFactory.FSLockExclusive)
+				require.Equal(t, time.Second, m.readerFactory.FSLockTimeout)
+				require.True(t, m.readerFactory.FSLockReadOnFailure)
+			},
 		},
 		{
-			"InvalidLineStartRegex",
+			"InvalidFSLockMode",
 			func(cfg *Config) {
-				cfg.SplitConfig.LineStartPattern = "("
+				cfg.FSLockMode = "advisory"
 			},
 			require.Error,
 			nil,
 		},
 		{
-			"InvalidLineEndRegex",
+			"InvalidFSLockTimeout",
 			func(cfg *Config) {
-				cfg.SplitConfig.LineEndPattern = "("
+				cfg.FSLockTimeout = -time.Second
 			},
 			require.Error,
 			nil,
 		},
 		{
-			"InvalidStartAtDelete",
+			"InvalidFSLockOnFailure",
 			func(cfg *Config) {
-				cfg.StartAt = "end"
-				cfg.DeleteAfterRead = true
+				cfg.FSLockOnFailure = "wait"
 			},
 			require.Error,
 			nil,
@@ -655,6 +1149,16 @@ func TestBuild(t *testing.T) {
 			require.NoError,
 			func(_ *testing.T, _ *Manager) {},
 		},
+		{
+			"MaxLogSizeBehaviorDrop",
+			func(cfg *Config) {
+				cfg.MaxLogSizeBehavior = MaxLogSizeBehaviorDrop
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.True(t, m.readerFactory.DropOnMaxLogSize)
+			},
+		},
 		{
 			"InvalidMaxLogSizeBehavior",
 			func(cfg *Config) {
@@ -663,6 +1167,329 @@ func TestBuild(t *testing.T) {
 			require.Error,
 			nil,
 		},
+		{
+			"MaxBatchBytes",
+			func(cfg *Config) {
+				cfg.MaxBatchBytes = helper.ByteSize(1024)
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Equal(t, 1024, m.readerFactory.MaxBatchBytes)
+			},
+		},
+		{
+			"NegativeMaxBatchBytes",
+			func(cfg *Config) {
+				cfg.MaxBatchBytes = helper.ByteSize(-1)
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"TrackingNone",
+			func(cfg *Config) {
+				cfg.Tracking = TrackingNone
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.True(t, m.readOnce)
+			},
+		},
+		{
+			"InvalidTracking",
+			func(cfg *Config) {
+				cfg.Tracking = "invalid"
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"TrackingNoneWithPollsToArchive",
+			func(cfg *Config) {
+				cfg.Tracking = TrackingNone
+				cfg.PollsToArchive = 10
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"Priority",
+			func(cfg *Config) {
+				cfg.Priority = PriorityConfig{Tiers: []string{`^/var/log/app/`}, NewestFirst: true}
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Len(t, m.priority.tiers, 1)
+				require.True(t, m.priority.newestFirst)
+			},
+		},
+		{
+			"InvalidPriorityTier",
+			func(cfg *Config) {
+				cfg.Priority = PriorityConfig{Tiers: []string{`(`}}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"PriorityWithOrdering",
+			func(cfg *Config) {
+				cfg.Priority = PriorityConfig{NewestFirst: true}
+				cfg.Ordering = OrderingMtime
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"MaxBytesPerSecond",
+			func(cfg *Config) {
+				cfg.MaxBytesPerSecond = helper.ByteSize(1024)
+				cfg.MaxFileBytesPerSecond = helper.ByteSize(512)
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.NotNil(t, m.readerFactory.RateLimiter)
+				require.Equal(t, 512, m.readerFactory.MaxFileBytesPerSecond)
+			},
+		},
+		{
+			"NegativeMaxBytesPerSecond",
+			func(cfg *Config) {
+				cfg.MaxBytesPerSecond = helper.ByteSize(-1)
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"NegativeMaxFileBytesPerSecond",
+			func(cfg *Config) {
+				cfg.MaxFileBytesPerSecond = helper.ByteSize(-1)
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"PauseOnBackpressure",
+			func(cfg *Config) {
+				cfg.Backpressure = BackpressureConfig{Pause: true, InitialInterval: time.Second, MaxInterval: time.Minute}
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.NotNil(t, m.backpressure)
+				require.Equal(t, time.Second, m.backpressure.initialInterval)
+				require.Equal(t, time.Minute, m.backpressure.maxInterval)
+			},
+		},
+		{
+			"NegativeBackpressureInterval",
+			func(cfg *Config) {
+				cfg.Backpressure = BackpressureConfig{Pause: true, InitialInterval: -time.Second}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"BackpressureInitialIntervalAboveMax",
+			func(cfg *Config) {
+				cfg.Backpressure = BackpressureConfig{Pause: true, InitialInterval: time.Minute, MaxInterval: time.Second}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"Dedup",
+			func(cfg *Config) {
+				cfg.Dedup = DedupConfig{Enabled: true, MaxLines: 1000}
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.NotNil(t, m.readerFactory.Deduplicator)
+			},
+		},
+		{
+			"NegativeDedupMaxLines",
+			func(cfg *Config) {
+				cfg.Dedup = DedupConfig{Enabled: true, MaxLines: -1}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"CheckpointRetention",
+			func(cfg *Config) {
+				cfg.PollsToArchive = 10
+				cfg.CheckpointMaxAge = time.Hour
+				cfg.CheckpointMaxFiles = 100
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Equal(t, time.Hour, m.retention.MaxAge)
+				require.Equal(t, 100, m.retention.MaxFiles)
+			},
+		},
+		{
+			"CheckpointRetentionWithoutArchive",
+			func(cfg *Config) {
+				cfg.CheckpointMaxFiles = 100
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"NegativeCheckpointMaxAge",
+			func(cfg *Config) {
+				cfg.PollsToArchive = 10
+				cfg.CheckpointMaxAge = -time.Hour
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"NegativeCheckpointMaxFiles",
+			func(cfg *Config) {
+				cfg.PollsToArchive = 10
+				cfg.CheckpointMaxFiles = -1
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"PendingBytesWarning",
+			func(cfg *Config) {
+				cfg.PendingBytesWarning = helper.ByteSize(1024 * 1024 * 1024)
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Equal(t, int64(1024*1024*1024), m.pendingWarning)
+			},
+		},
+		{
+			"NegativePendingBytesWarning",
+			func(cfg *Config) {
+				cfg.PendingBytesWarning = helper.ByteSize(-1)
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"MaxReadWorkers",
+			func(cfg *Config) {
+				cfg.MaxReadWorkers = 4
+				cfg.ReadQuantum = helper.ByteSize(64 * 1024)
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Equal(t, 4, m.maxReadWorkers)
+				require.Equal(t, int64(64*1024), m.readQuantum)
+			},
+		},
+		{
+			"NegativeMaxReadWorkers",
+			func(cfg *Config) {
+				cfg.MaxReadWorkers = -1
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"NegativeReadQuantum",
+			func(cfg *Config) {
+				cfg.ReadQuantum = helper.ByteSize(-1)
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"ExcludeContentRegex",
+			func(cfg *Config) {
+				cfg.ExcludeContentRegex = `^\x7fELF`
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.True(t, m.excludeContent.MatchString("\x7fELF"))
+			},
+		},
+		{
+			"InvalidExcludeContentRegex",
+			func(cfg *Config) {
+				cfg.ExcludeContentRegex = "["
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"Normalize",
+			func(cfg *Config) {
+				cfg.Normalize = []string{trim.NormalizeStripANSI, trim.NormalizeCollapseWhitespace, trim.NormalizeRemoveNUL}
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.NotNil(t, m.readerFactory.NormalizeFunc)
+			},
+		},
+		{
+			"InvalidNormalize",
+			func(cfg *Config) {
+				cfg.Normalize = []string{"lowercase"}
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"FollowSymlinksResolveOnce",
+			func(cfg *Config) {
+				cfg.FollowSymlinks = FollowSymlinksResolveOnce
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Equal(t, FollowSymlinksResolveOnce, m.followSymlinks)
+			},
+		},
+		{
+			"InvalidFollowSymlinks",
+			func(cfg *Config) {
+				cfg.FollowSymlinks = "sometimes"
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"OnTruncateRereadWindow",
+			func(cfg *Config) {
+				cfg.OnTruncateRereadWindow = time.Second
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Equal(t, time.Second, m.truncateReread)
+			},
+		},
+		{
+			"NegativeOnTruncateRereadWindow",
+			func(cfg *Config) {
+				cfg.OnTruncateRereadWindow = -time.Second
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"ReadArchives",
+			func(cfg *Config) {
+				cfg.ReadArchives = true
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.True(t, m.readerFactory.ReadArchives)
+			},
+		},
+		{
+			"ReadArchivesWithAutoEncoding",
+			func(cfg *Config) {
+				cfg.ReadArchives = true
+				cfg.Encoding = EncodingAuto
+			},
+			require.Error,
+			nil,
+		},
 	}
 
 	for _, tc := range cases {
@@ -793,6 +1620,12 @@ func (c *Config) withGzip() *Config {
 	return c
 }
 
+// withZstd is a builder-like helper for quickly setting up support for zstd compressed log files
+func (c *Config) withZstd() *Config {
+	c.Compression = "zstd"
+	return c
+}
+
 const mockOperatorType = "mock"
 
 func init() {
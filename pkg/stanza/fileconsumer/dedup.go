@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+)
+
+const defaultDedupMaxLines = 100_000
+
+// DedupConfig defines how lines which are read again from a copy of a file are dropped.
+type DedupConfig struct {
+	// Enabled drops the lines which were already emitted from a file which starts with the same
+	// content, at the same offset. This avoids emitting lines twice when both a rotated file and
+	// its copy match the include patterns.
+	Enabled bool `mapstructure:"enabled,omitempty"`
+	// MaxLines is the number of recently emitted lines which are remembered.
+	MaxLines int `mapstructure:"max_lines,omitempty"`
+}
+
+func (c DedupConfig) validate() error {
+	if c.MaxLines < 0 {
+		return errors.New("'max_lines' must not be negative")
+	}
+	return nil
+}
+
+func (c DedupConfig) build() *reader.Deduplicator {
+	if !c.Enabled {
+		return nil
+	}
+	if c.MaxLines == 0 {
+		return reader.NewDeduplicator(defaultDedupMaxLines)
+	}
+	return reader.NewDeduplicator(c.MaxLines)
+}
@@ -0,0 +1,28 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fileconsumer finds, tails and tracks files, and emits their content
+// one batch of tokens at a time. It is the file reading library behind the
+// filelog receiver and the file_input operator, and can be embedded by other
+// components which read logs from files.
+//
+// A Manager is built from a Config, which is usually created with NewConfig
+// so that its settings have their default values:
+//
+//	cfg := fileconsumer.NewConfig()
+//	cfg.Include = []string{"/var/log/app/*.log"}
+//	m, err := cfg.Build(set, callback)
+//
+// The callback, an emit.Callback, is called with each batch of tokens along
+// with the attributes of their file. The context which it is called with
+// carries an emit.Snapshot of the read, which is returned by
+// emit.FromContext. The callback may be called concurrently for different
+// files, and the tokens must not be retained after it returns. Options such
+// as WithSplitFunc change how the Manager is built.
+//
+// Start begins polling for files, and Stop waits for the files being read
+// and releases them. The offsets of the files are saved with the
+// operator.Persister given to Start, so that reading resumes where it
+// stopped when the Manager is started again. If the persister is nil, the
+// offsets are only kept in memory.
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
@@ -7,16 +7,56 @@ import (
 	"context"
 )
 
+// Callback is called with each batch of tokens read from a file, along with the attributes of the
+// file, the record number of the last token and the offsets of the tokens. offsets[i] is the offset
+// of the i-th token, and offsets[len(tokens)] the offset right after the last one. The tokens must
+// not be retained after it returns.
 type Callback func(ctx context.Context, tokens [][]byte, attributes map[string]any, lastRecordNumber int64, offsets []int64) error
 
+// Token is a token read from a file, along with the attributes of the file.
 type Token struct {
 	Body       []byte
 	Attributes map[string]any
+	Snapshot   Snapshot
 }
 
+// NewToken creates a token without a snapshot.
 func NewToken(body []byte, attrs map[string]any) Token {
 	return Token{
 		Body:       body,
 		Attributes: attrs,
 	}
 }
+
+// Snapshot describes the read of a file from which a batch of tokens was emitted. It is stable
+// across restarts, so that it can be used to build keys which identify the tokens of a file.
+type Snapshot struct {
+	// StartOffset is the offset of the first token, and EndOffset the offset right after the last one.
+	// The offsets of compressed files refer to their decompressed content.
+	StartOffset int64
+	EndOffset   int64
+	// FirstRecordNumber and LastRecordNumber are the record numbers of the first and last tokens.
+	FirstRecordNumber int64
+	LastRecordNumber  int64
+	// Generation is the number of times the file was truncated before the tokens were read,
+	// e.g. by copytruncate rotation, so that the offsets of its new content can be told apart.
+	Generation int64
+	// FirstSequence and LastSequence are the sequence numbers of the first and last tokens within
+	// their logical file, which continue across rotations and restarts, or 0 if tokens are not numbered.
+	FirstSequence int64
+	LastSequence  int64
+}
+
+type snapshotKey struct{}
+
+// NewContext returns a copy of ctx which carries the snapshot.
+func NewContext(ctx context.Context, snapshot Snapshot) context.Context {
+	return context.WithValue(ctx, snapshotKey{}, snapshot)
+}
+
+// FromContext returns the snapshot of the read from which the tokens being emitted with ctx
+// were read, if any.
+func FromContext(ctx context.Context) (Snapshot, bool) {
+	snapshot, ok := ctx.Value(snapshotKey{}).(Snapshot)
+	return snapshot, ok
+}
@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
+)
+
+func ExampleConfig_Build() {
+	dir, err := os.MkdirTemp("", "fileconsumer")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+	if err = os.WriteFile(filepath.Join(dir, "app.log"), []byte("first log\nsecond log\n"), 0o600); err != nil {
+		panic(err)
+	}
+
+	cfg := fileconsumer.NewConfig()
+	cfg.Include = []string{filepath.Join(dir, "*.log")}
+	cfg.StartAt = "beginning"
+
+	logs := make(chan string, 10)
+	callback := func(_ context.Context, tokens [][]byte, attributes map[string]any, _ int64, _ []int64) error {
+		for _, token := range tokens {
+			logs <- fmt.Sprintf("%s: %s", attributes[attrs.LogFileName], token)
+		}
+		return nil
+	}
+	m, err := cfg.Build(componenttest.NewNopTelemetrySettings(), callback)
+	if err != nil {
+		panic(err)
+	}
+
+	// The offsets are only kept in memory without a persister
+	if err = m.Start(nil); err != nil {
+		panic(err)
+	}
+	fmt.Println(<-logs)
+	fmt.Println(<-logs)
+	if err = m.Stop(); err != nil {
+		panic(err)
+	}
+
+	// Output:
+	// app.log: first log
+	// app.log: second log
+}
@@ -7,19 +7,29 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
+	"maps"
 	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/archive"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/checkpoint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/tracker"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/watcher"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/matcher"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 )
@@ -30,6 +40,7 @@ const (
 	maxUnreadableEntries = 10000
 )
 
+// Manager polls for the files matching its config, and reads them. It is created with Config.Build.
 type Manager struct {
 	set    component.TelemetrySettings
 	wg     sync.WaitGroup
@@ -37,6 +48,10 @@ type Manager struct {
 
 	readerFactory *reader.Factory
 	fileMatcher   *matcher.Matcher
+	backupMatcher *matcher.Matcher
+	groups        []*group
+	groupPollers  []*groupPoller
+	rules         []*rule
 	tracker       tracker.Tracker
 	noTracking    bool
 
@@ -45,13 +60,72 @@ type Manager struct {
 	maxBatches     int
 	maxBatchFiles  int
 	pollsToArchive int
-	onTruncate     string
+	// retention limits the offsets which are kept in the archive.
+	retention  archive.Retention
+	onTruncate string
+	ordering   string
+	// truncateReread is the time for which the rest of a truncated file is read from its copy
+	// before its offset is adjusted, or 0 if it is not read again.
+	truncateReread time.Duration
+	// priority orders the files of a poll, so that the files read first are in the first batches.
+	priority *priority
+	// backpressure pauses polling and reading while the consumer refuses data, or is nil if disabled.
+	backpressure *backpressure
+	// readOnce reads each file once, without tracking it or checkpointing its offset.
+	readOnce bool
+	// readPaths holds the paths of the files which were read, if each file is read once.
+	readPaths map[string]struct{}
+	// followSymlinks defines how matched symlinks are read.
+	followSymlinks string
+	// maxReadWorkers bounds the number of files read concurrently, or 0 to read each file
+	// of a batch in its own goroutine.
+	maxReadWorkers int
+	// readQuantum is the number of bytes of a file which a worker reads before taking the next file.
+	readQuantum int64
+	// excludeContent excludes the files whose first fingerprint bytes match it.
+	excludeContent *regexp.Regexp
+	// readOffsets holds the offset of each file read by the last poll, by path.
+	readOffsets map[string]int64
+	// streams holds the paths of the named pipes and character devices which are being read.
+	streams   map[string]struct{}
+	streamsMu sync.Mutex
+	// pollFileIDs holds the IDs of the files matched by the current poll, where the platform provides them.
+	pollFileIDs map[string]struct{}
+	// symlinkTargets holds the target which each symlink was resolved to, if symlinks are resolved once.
+	symlinkTargets map[string]string
+
+	// lostFileGracePolls is the number of polls which may miss a file before it is read as lost.
+	lostFileGracePolls int
+
+	// maxPollInterval is the interval up to which polls back off while no data is read.
+	maxPollInterval time.Duration
+	// readData is set when a poll reads data from a file.
+	readData atomic.Bool
+
+	// watchFileEvents triggers a poll as soon as a file matching include changes.
+	watchFileEvents bool
+	include         []string
+	watcher         *watcher.Watcher
 
 	telemetryBuilder *metadata.TelemetryBuilder
+	fileStats        *fileStats
+
+	// groupFactories holds the reader factory of each file matched by the last poll.
+	groupFactories map[string]*reader.Factory
 
 	unreadable map[string]struct{}
+
+	// sequencer numbers the tokens of each logical file, or is nil if they are not numbered.
+	sequencer *reader.Sequencer
+
+	// pendingWarning is the number of bytes left to read above which a warning is logged, or 0.
+	pendingWarning int64
+	// pendingExceeded is set while the bytes left to read exceed the threshold.
+	pendingExceeded bool
 }
 
+// Start begins polling for files. The offsets of the files are loaded from the persister and
+// saved to it after each poll, or are only kept in memory if the persister is nil.
 func (m *Manager) Start(persister operator.Persister) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	m.cancel = cancel
@@ -59,6 +133,11 @@ func (m *Manager) Start(persister operator.Persister) error {
 	// initialize runtime-only tracking of unreadable paths
 	m.unreadable = make(map[string]struct{})
 
+	if m.readOnce {
+		// There are no offsets to resume from
+		persister = nil
+	}
+
 	// instantiate the tracker
 	m.instantiateTracker(ctx, persister)
 
@@ -70,36 +149,73 @@ func (m *Manager) Start(persister operator.Persister) error {
 		}
 		if len(offsets) > 0 {
 			m.set.Logger.Info("Resuming from previously known offset(s). 'start_at' setting is not applicable.")
-			m.readerFactory.FromBeginning = true
+			m.migrateFingerprints(ctx, offsets)
+			m.setFromBeginning()
 			m.tracker.LoadMetadata(offsets)
+			if m.sequencer != nil {
+				m.sequencer.Restore(offsets)
+			}
 		}
 	} else if m.pollsToArchive > 0 {
 		m.set.Logger.Error("archiving is not supported in memory, please use a storage extension")
 	}
 
+	if err := m.startGroupPollers(persister); err != nil {
+		return err
+	}
+
+	if err := m.fileStats.register(m.telemetryBuilder); err != nil {
+		m.set.Logger.Warn("Failed to register per-file metrics", zap.Error(err))
+	}
+
+	if m.watchFileEvents {
+		m.startWatcher(ctx)
+	}
+
 	// Start polling goroutine
 	m.startPoller(ctx)
 
 	return nil
 }
 
+// migrateFingerprints adapts the fingerprints of the offsets loaded from the persister to
+// fingerprint_size, in case it was reconfigured, and saves them if any of them changed.
+func (m *Manager) migrateFingerprints(ctx context.Context, offsets []*reader.Metadata) {
+	migrated := 0
+	for _, md := range offsets {
+		if m.readerFactory.MigrateFingerprint(md) {
+			migrated++
+		}
+	}
+	if migrated == 0 {
+		return
+	}
+	m.set.Logger.Info("Migrated fingerprints to the configured fingerprint_size", zap.Int("files", migrated))
+	if err := checkpoint.Save(ctx, m.persister, offsets); err != nil {
+		m.set.Logger.Error("save offsets", zap.Error(err))
+	}
+}
+
 func (m *Manager) instantiateTracker(ctx context.Context, persister operator.Persister) {
 	var t tracker.Tracker
-	if m.noTracking {
+	if m.noTracking || m.readOnce {
 		t = tracker.NewNoStateTracker(m.set, m.maxBatchFiles)
 	} else {
-		t = tracker.NewFileTracker(ctx, m.set, m.maxBatchFiles, m.pollsToArchive, persister)
+		t = tracker.NewFileTracker(ctx, m.set, m.maxBatchFiles, m.pollsToArchive, m.lostFileGracePolls, m.retention, persister)
 	}
 	m.tracker = t
 }
 
 // Stop will stop the file monitoring process
 func (m *Manager) Stop() error {
+	err := m.stopGroupPollers()
 	if m.cancel != nil {
 		m.cancel()
 		m.cancel = nil
 	}
 	m.wg.Wait()
+	m.watcher = nil
+	m.telemetryBuilder.Shutdown()
 	if m.tracker != nil {
 		m.telemetryBuilder.FileconsumerOpenFiles.Add(context.TODO(), int64(0-m.tracker.ClosePreviousFiles()))
 	}
@@ -108,7 +224,37 @@ func (m *Manager) Stop() error {
 			m.set.Logger.Error("save offsets", zap.Error(err))
 		}
 	}
-	return nil
+	return err
+}
+
+// Files returns the files read during the last poll, sorted by path.
+// It is safe to call while the Manager is polling.
+func (m *Manager) Files() []FileInfo {
+	files := m.fileStats.list()
+	if len(m.groupPollers) == 0 {
+		return files
+	}
+	for _, p := range m.groupPollers {
+		files = append(files, p.manager.Files()...)
+	}
+	slices.SortFunc(files, func(a, b FileInfo) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return files
+}
+
+// startWatcher kicks off a goroutine that will watch for file system events,
+// falling back to polling only if events are not available
+func (m *Manager) startWatcher(ctx context.Context) {
+	w, err := watcher.New(m.set.Logger, m.include)
+	if err != nil {
+		m.set.Logger.Warn("Failed to watch file events, falling back to polling", zap.Error(err))
+		return
+	}
+	m.watcher = w
+	m.wg.Go(func() {
+		w.Run(ctx)
+	})
 }
 
 // startPoller kicks off a goroutine that will poll the filesystem periodically,
@@ -118,29 +264,81 @@ func (m *Manager) startPoller(ctx context.Context) {
 		globTicker := time.NewTicker(m.pollInterval)
 		defer globTicker.Stop()
 
+		// A nil channel never receives, so only the ticker triggers polls without a watcher.
+		var fileEvents <-chan struct{}
+		if m.watcher != nil {
+			fileEvents = m.watcher.Triggers()
+		}
+
+		interval := m.pollInterval
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			case <-globTicker.C:
+			case <-fileEvents:
+				// Delay the next regular poll, since files were just read.
+				globTicker.Reset(interval)
 			}
 
+			if m.paused() {
+				continue
+			}
+			m.readData.Store(false)
 			m.poll(ctx)
+
+			if next := m.nextPollInterval(interval, m.readData.Load()); next != interval {
+				interval = next
+				globTicker.Reset(interval)
+			}
 		}
 	})
 }
 
+// nextPollInterval backs off the poll interval exponentially while polls read no data,
+// and tightens it back as soon as they do.
+func (m *Manager) nextPollInterval(interval time.Duration, readData bool) time.Duration {
+	if readData || m.maxPollInterval <= m.pollInterval {
+		return m.pollInterval
+	}
+	return min(2*interval, m.maxPollInterval)
+}
+
 // poll checks all the watched paths for new entries
 func (m *Manager) poll(ctx context.Context) {
 	// Used to keep track of the number of batches processed in this poll cycle
 	batchesProcessed := 0
 
 	// Get the list of paths on disk
-	matches, err := m.fileMatcher.MatchFiles()
+	matches, err := m.matchFiles()
 	if err != nil {
 		m.set.Logger.Debug("finding files", zap.Error(err))
 	}
+	clear(m.readOffsets)
 	m.set.Logger.Debug("matched files", zap.Strings("paths", matches))
+	matches = m.resolveSymlinks(matches)
+	matches = m.startStreams(ctx, matches)
+	if m.readOnce {
+		m.pruneReadPaths(matches)
+		matches = slices.DeleteFunc(matches, func(path string) bool {
+			_, ok := m.readPaths[path]
+			return ok
+		})
+	}
+	if m.watcher != nil {
+		m.watcher.WatchDirs(matches)
+	}
+
+	m.readRotatedBackups(ctx, matches)
+
+	if m.ordering == OrderingMtime {
+		// Batches must also be read oldest first
+		sortByModTime(matches, func(path string) string { return path })
+	}
+	if m.priority != nil {
+		// The files read first are those of the first batches
+		m.priority.sort(matches)
+	}
 
 	for len(matches) > m.maxBatchFiles {
 		m.consume(ctx, matches[:m.maxBatchFiles])
@@ -156,9 +354,10 @@ func (m *Manager) poll(ctx context.Context) {
 		matches = matches[m.maxBatchFiles:]
 	}
 	m.consume(ctx, matches)
+	m.releaseIdleBuffers()
 
 	// Any new files that appear should be consumed entirely
-	m.readerFactory.FromBeginning = true
+	m.setFromBeginning()
 	if m.persister != nil {
 		metadata := m.tracker.GetMetadata()
 		if metadata != nil {
@@ -167,8 +366,17 @@ func (m *Manager) poll(ctx context.Context) {
 			}
 		}
 	}
+	if m.sequencer != nil {
+		// The numbers of the logical files which are no longer tracked are not kept
+		m.sequencer.Retain(m.tracker.GetMetadata())
+	}
 	// rotate at end of every poll()
 	m.tracker.EndPoll(ctx)
+	if m.persister != nil {
+		m.telemetryBuilder.FileconsumerCheckpointFiles.Record(ctx, int64(m.tracker.CheckpointedFiles()))
+	}
+	m.fileStats.endPoll()
+	m.checkPendingBytes()
 }
 
 func (m *Manager) consume(ctx context.Context, paths []string) {
@@ -178,31 +386,205 @@ func (m *Manager) consume(ctx context.Context, paths []string) {
 	m.readLostFiles(ctx)
 
 	// read new readers to end
-	var wg sync.WaitGroup
+	if m.ordering == OrderingMtime {
+		// Read the oldest files first, one at a time, so that their lines
+		// are emitted in approximate time order across files.
+		readers := slices.Clone(m.tracker.CurrentPollFiles())
+		sortByModTime(readers, (*reader.Reader).GetFileName)
+		for _, r := range readers {
+			m.readToEnd(ctx, r)
+		}
+	} else if m.maxReadWorkers > 0 {
+		m.readWithWorkers(ctx, m.tracker.CurrentPollFiles())
+	} else {
+		var wg sync.WaitGroup
+		for _, r := range m.tracker.CurrentPollFiles() {
+			wg.Add(1)
+			go func(r *reader.Reader) {
+				defer wg.Done()
+				m.readToEnd(ctx, r)
+			}(r)
+		}
+		wg.Wait()
+	}
+
 	for _, r := range m.tracker.CurrentPollFiles() {
+		m.readOffsets[r.GetFileName()] = r.Offset
+		// The files which were skipped, such as empty ones, or which were not read to
+		// their end are read again by the next poll.
+		if m.readOnce && r.ReachedEOF() {
+			m.readPaths[r.GetFileName()] = struct{}{}
+		}
+	}
+	m.telemetryBuilder.FileconsumerOpenFiles.Add(ctx, int64(0-m.tracker.EndConsume()))
+}
+
+// pruneReadPaths forgets the files which were read once and are no longer matched,
+// so that a file created later at the same path is read.
+func (m *Manager) pruneReadPaths(matches []string) {
+	matched := make(map[string]struct{}, len(matches))
+	for _, path := range matches {
+		matched[path] = struct{}{}
+	}
+	maps.DeleteFunc(m.readPaths, func(path string, _ struct{}) bool {
+		_, ok := matched[path]
+		return !ok
+	})
+}
+
+// readingFile reports whether the file was read by the last poll and has grown since,
+// so that it is read to its end even if it is old enough to be excluded by its age.
+func (m *Manager) readingFile(path string) bool {
+	offset, ok := m.readOffsets[path]
+	if !ok {
+		return false
+	}
+	info, err := os.Stat(path)
+	return err == nil && info.Size() > offset
+}
+
+func (m *Manager) readToEnd(ctx context.Context, r *reader.Reader) {
+	if m.backpressure == nil {
+		m.read(ctx, r, 0)
+		return
+	}
+	// Read one quantum at a time, so that reading stops soon after the consumer refuses data
+	for !m.paused() {
+		if !m.read(ctx, r, m.readQuantum) {
+			return
+		}
+	}
+}
+
+// read reads up to quantum bytes of the file, or to its end if quantum is 0, and returns
+// whether the file was left to be read further.
+func (m *Manager) read(ctx context.Context, r *reader.Reader, quantum int64) bool {
+	offset := r.Offset
+	m.telemetryBuilder.FileconsumerReadingFiles.Add(ctx, 1)
+	more := r.ReadQuantum(ctx, quantum)
+	m.telemetryBuilder.FileconsumerReadingFiles.Add(ctx, -1)
+	if r.Offset != offset {
+		m.readData.Store(true)
+	}
+	m.fileStats.record(r)
+	return more
+}
+
+// readWithWorkers reads the files to end with a bounded pool of workers. The files are read
+// round-robin, one quantum at a time, so that a large file cannot occupy the workers for a whole
+// poll while the other files wait.
+func (m *Manager) readWithWorkers(ctx context.Context, readers []*reader.Reader) {
+	// Each file is queued at most once, so sending to the queue never blocks
+	queue := make(chan *reader.Reader, len(readers))
+	var pending sync.WaitGroup
+	pending.Add(len(readers))
+	for _, r := range readers {
+		queue <- r
+	}
+
+	var workers sync.WaitGroup
+	for range min(m.maxReadWorkers, len(readers)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for r := range queue {
+				if m.read(ctx, r, m.readQuantum) && ctx.Err() == nil && !m.paused() {
+					queue <- r
+					continue
+				}
+				pending.Done()
+			}
+		}()
+	}
+	pending.Wait()
+	close(queue)
+	workers.Wait()
+}
+
+// readRotatedBackups reads the remaining content of known files that have been rotated
+// and compressed into a path matching the rotated backups patterns. This runs before
+// the matched files are read, so that the lines of a rotated file precede those of the
+// file replacing it. Backups that do not match a known file are never read.
+func (m *Manager) readRotatedBackups(ctx context.Context, matches []string) {
+	if m.backupMatcher == nil {
+		return
+	}
+	paths, err := m.backupMatcher.MatchFiles()
+	if err != nil {
+		m.set.Logger.Debug("finding rotated backups", zap.Error(err))
+	}
+
+	var readers []*reader.Reader
+	for _, path := range paths {
+		if slices.Contains(matches, path) {
+			// read as a regular file
+			continue
+		}
+		fp, file := m.makeFingerprint(path)
+		if fp == nil {
+			continue
+		}
+
+		if r := m.tracker.GetCurrentFile(fp); r != nil {
+			// re-add the reader as Match() removes duplicates
+			m.tracker.Add(r)
+			if err := file.Close(); err != nil {
+				m.set.Logger.Debug("problem closing file", zap.Error(err))
+			}
+			continue
+		}
+
+		r, err := m.newReader(ctx, file, fp)
+		if err != nil {
+			m.set.Logger.Error("Failed to create reader", zap.Error(err))
+			continue
+		}
+		if r == nil {
+			if err := file.Close(); err != nil {
+				m.set.Logger.Debug("problem closing file", zap.Error(err))
+			}
+			continue
+		}
+		m.set.Logger.Debug("Reading rotated backup", zap.String("path", path))
+		m.tracker.Add(r)
+		readers = append(readers, r)
+	}
+
+	var wg sync.WaitGroup
+	for _, r := range readers {
 		wg.Add(1)
 		go func(r *reader.Reader) {
 			defer wg.Done()
-			m.telemetryBuilder.FileconsumerReadingFiles.Add(ctx, 1)
-			r.ReadToEnd(ctx)
-			m.telemetryBuilder.FileconsumerReadingFiles.Add(ctx, -1)
+			m.readToEnd(ctx, r)
 		}(r)
 	}
 	wg.Wait()
-
-	m.telemetryBuilder.FileconsumerOpenFiles.Add(ctx, int64(0-m.tracker.EndConsume()))
 }
 
 // makeReader take a file path, then creates reader,
 // discarding any that have a duplicate fingerprint to other files that have already
 // been read this polling interval
 func (m *Manager) makeReaders(ctx context.Context, paths []string) {
+	// The files of the previous poll may have grown since they were read, and a new file which
+	// starts with the same bytes as one of them must not be matched to it by a short fingerprint.
+	m.tracker.ExtendFingerprints()
+
+	files := make([]*os.File, 0, len(paths))
+	fps := make([]*fingerprint.Fingerprint, 0, len(paths))
+	clear(m.pollFileIDs)
 	for _, path := range paths {
 		fp, file := m.makeFingerprint(path)
 		if fp == nil {
 			continue
 		}
+		files, fps = append(files, file), append(fps, fp)
+		if id := reader.FileID(file); id != "" {
+			m.pollFileIDs[id] = struct{}{}
+		}
+	}
 
+	for i, file := range files {
+		fp := fps[i]
 		// Exclude duplicate paths with the same content. This can happen when files are
 		// being rotated with copy/truncate strategy. (After copy, prior to truncate.)
 		if r := m.tracker.GetCurrentFile(fp); r != nil {
@@ -278,9 +660,86 @@ func (m *Manager) makeFingerprint(path string) (*fingerprint.Fingerprint, *os.Fi
 		}
 		return nil, nil
 	}
+
+	if m.excludedByContent(file) {
+		m.set.Logger.Debug("Skipping file excluded by its content", zap.String("path", path))
+		if err = file.Close(); err != nil {
+			m.set.Logger.Debug("problem closing file", zap.Error(err))
+		}
+		return nil, nil
+	}
 	return fp, file
 }
 
+// excludedByContent reports whether the first fingerprint bytes of the file match exclude_content_regex.
+// The bytes are read as they are on disk, so that compressed or binary files are recognized by their header.
+func (m *Manager) excludedByContent(file *os.File) bool {
+	if m.excludeContent == nil {
+		return false
+	}
+	buf := make([]byte, m.readerFactory.FingerprintSize)
+	n, err := file.ReadAt(buf, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		m.set.Logger.Debug("Failed to read the start of the file", zap.String("path", file.Name()), zap.Error(err))
+		return false
+	}
+	return m.excludeContent.Match(buf[:n])
+}
+
+// readTruncatedCopy reads the rest of a truncated file from its copy, which is a file of the
+// same directory that is not matched by include and starts with the content read from the file.
+func (m *Manager) readTruncatedCopy(ctx context.Context, r *reader.Reader) {
+	if r.FileType != "" || r.ArchiveFormat != "" {
+		// The offsets of compressed files do not apply to their copies
+		return
+	}
+	dir := filepath.Dir(r.GetFileName())
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		m.set.Logger.Debug("Failed to list the copies of the truncated file", zap.String("path", r.GetFileName()), zap.Error(err))
+		return
+	}
+	var matches []string
+	if m.fileMatcher != nil {
+		matches, _ = m.fileMatcher.MatchFiles()
+	}
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		if _, ok := m.groupFactories[path]; ok || slices.Contains(matches, path) || !entry.Type().IsRegular() {
+			// The files matched by include are read as regular files
+			continue
+		}
+		if info, infoErr := entry.Info(); infoErr != nil || info.Size() <= r.Offset {
+			continue
+		}
+		if m.readCopy(ctx, r, path) {
+			return
+		}
+	}
+}
+
+// readCopy reads the rest of a truncated file from the file at path, if it is a copy of it.
+func (m *Manager) readCopy(ctx context.Context, r *reader.Reader, path string) bool {
+	file, err := openFile(path) // #nosec - the copy is in the directory of a file defined by user
+	if err != nil {
+		return false
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			m.set.Logger.Debug("problem closing file", zap.Error(err))
+		}
+	}()
+	fp, err := m.readerFactory.NewFingerprint(file)
+	if err != nil || !fp.StartsWith(r.Fingerprint) {
+		return false
+	}
+	m.set.Logger.Debug("Reading the copy of the truncated file",
+		zap.String("path", r.GetFileName()),
+		zap.String("copy_path", path))
+	r.ReadCopyToEnd(ctx, file)
+	return true
+}
+
 func (m *Manager) newReader(ctx context.Context, file *os.File, fp *fingerprint.Fingerprint) (*reader.Reader, error) {
 	// Check previous poll cycle for match
 	if oldReader := m.tracker.GetOpenFile(fp); oldReader != nil {
@@ -297,10 +756,20 @@ func (m *Manager) newReader(ctx context.Context, file *os.File, fp *fingerprint.
 					zap.String("rotated_path", file.Name()))
 			}
 		}
+		info, statErr := file.Stat()
+		truncated := statErr == nil && oldReader.Offset > info.Size() && !m.becameCompressed(file, oldReader.Metadata)
+		if truncated && m.truncateReread > 0 {
+			// With copytruncate rotation, the lines which were not read before the truncation
+			// can only be read from the copy of the file.
+			rereadCtx, cancel := context.WithTimeout(ctx, m.truncateReread)
+			m.readTruncatedCopy(rereadCtx, oldReader)
+			cancel()
+		}
 		// Close old reader and adjust offset if needed.
 		md := oldReader.Close()
-		if info, err := file.Stat(); err == nil && md.Offset > info.Size() {
+		if truncated {
 			// Stored offset exceeds current file size
+			md.Generation++
 			switch m.onTruncate {
 			case OnTruncateReadWholeFile:
 				m.set.Logger.Debug("Stored offset exceeds current file size. Resetting offset to 0",
@@ -326,14 +795,15 @@ func (m *Manager) newReader(ctx context.Context, file *os.File, fp *fingerprint.
 				)
 			}
 		}
-		return m.readerFactory.NewReaderFromMetadata(file, md)
+		return m.factoryFor(file.Name()).NewReaderFromMetadata(file, md)
 	}
 
 	// Check for closed files for match
-	if oldMetadata := m.tracker.GetClosedFile(fp); oldMetadata != nil {
+	if oldMetadata := m.getClosedFile(file, fp); oldMetadata != nil {
 		// Check if stored offset exceeds current file size
-		if info, statErr := file.Stat(); statErr == nil && oldMetadata.Offset > info.Size() {
+		if info, statErr := file.Stat(); statErr == nil && oldMetadata.Offset > info.Size() && !m.becameCompressed(file, oldMetadata) {
 			// Stored offset exceeds current file size
+			oldMetadata.Generation++
 			switch m.onTruncate {
 			case OnTruncateReadWholeFile:
 				m.set.Logger.Debug("Stored offset exceeds current file size. Resetting offset to 0",
@@ -359,7 +829,7 @@ func (m *Manager) newReader(ctx context.Context, file *os.File, fp *fingerprint.
 				)
 			}
 		}
-		r, err := m.readerFactory.NewReaderFromMetadata(file, oldMetadata)
+		r, err := m.factoryFor(file.Name()).NewReaderFromMetadata(file, oldMetadata)
 		if err != nil {
 			return nil, err
 		}
@@ -371,6 +841,33 @@ func (m *Manager) newReader(ctx context.Context, file *os.File, fp *fingerprint.
 	return nil, nil
 }
 
+// getClosedFile returns the metadata of the closed file which the file continues. Where the platform
+// provides file IDs, the file is matched by its ID first. A file which only starts like another file
+// of the poll, e.g. a new file with the same header as the file it replaced by rotation, does not
+// take the offset of that file, which would otherwise be read again from the start.
+func (m *Manager) getClosedFile(file *os.File, fp *fingerprint.Fingerprint) *reader.Metadata {
+	id := reader.FileID(file)
+	if id == "" {
+		return m.tracker.GetClosedFile(fp)
+	}
+	if md := m.tracker.GetClosedFileFunc(fp, func(md *reader.Metadata) bool { return md.FileID == id }); md != nil {
+		if name, ok := md.FileAttributes[attrs.LogFilePath].(string); ok && name != file.Name() {
+			m.set.Logger.Debug("File has been rotated(moved)", zap.String("original_path", name), zap.String("rotated_path", file.Name()))
+		}
+		return md
+	}
+	return m.tracker.GetClosedFileFunc(fp, func(md *reader.Metadata) bool {
+		_, ok := m.pollFileIDs[md.FileID]
+		return !ok
+	})
+}
+
+// becameCompressed reports whether a file known as plain text has since been compressed.
+// Its offset then refers to the decompressed content and cannot be compared to the file size.
+func (m *Manager) becameCompressed(file *os.File, md *reader.Metadata) bool {
+	return md.FileType == "" && m.readerFactory.FileType(file) != ""
+}
+
 func (m *Manager) handleUnmatchedFiles(ctx context.Context) {
 	// Notes:
 	// 1. fp[i] is the fingerprint of file[i], and matchedMetadata[i] (if present) is the corresponding metadata retrieved from the archive.
@@ -387,8 +884,9 @@ func (m *Manager) handleUnmatchedFiles(ctx context.Context) {
 
 		if md != nil {
 			// Check if stored offset exceeds current file size
-			if info, statErr := file.Stat(); statErr == nil && md.Offset > info.Size() {
+			if info, statErr := file.Stat(); statErr == nil && md.Offset > info.Size() && !m.becameCompressed(file, md) {
 				// Stored offset exceeds current file size
+				md.Generation++
 				switch m.onTruncate {
 				case OnTruncateReadWholeFile:
 					m.set.Logger.Debug("Stored offset exceeds current file size. Resetting offset to 0",
@@ -414,7 +912,7 @@ func (m *Manager) handleUnmatchedFiles(ctx context.Context) {
 					)
 				}
 			}
-			reader, err = m.readerFactory.NewReaderFromMetadata(file, md)
+			reader, err = m.factoryFor(file.Name()).NewReaderFromMetadata(file, md)
 			if m.tracker.Name() != tracker.NoStateTracker {
 				m.set.Logger.Info("File found in archive. Started watching file again", zap.String("path", file.Name()))
 			}
@@ -422,7 +920,7 @@ func (m *Manager) handleUnmatchedFiles(ctx context.Context) {
 			if m.tracker.Name() != tracker.NoStateTracker {
 				m.set.Logger.Info("Started watching file", zap.String("path", file.Name()))
 			}
-			reader, err = m.readerFactory.NewReader(file, fp)
+			reader, err = m.factoryFor(file.Name()).NewReader(file, fp)
 		}
 
 		if err != nil {
@@ -434,3 +932,29 @@ func (m *Manager) handleUnmatchedFiles(ctx context.Context) {
 		m.tracker.Add(reader)
 	}
 }
+
+// sortByModTime sorts items by the modification time of their path, oldest first.
+// Items whose path cannot be stat'ed are sorted last.
+func sortByModTime[T any](items []T, path func(T) string) {
+	modTimes := make(map[string]time.Time, len(items))
+	for _, item := range items {
+		p := path(item)
+		if info, err := os.Stat(p); err == nil {
+			modTimes[p] = info.ModTime()
+		}
+	}
+	slices.SortStableFunc(items, func(a, b T) int {
+		ta, okA := modTimes[path(a)]
+		tb, okB := modTimes[path(b)]
+		switch {
+		case !okA && !okB:
+			return 0
+		case !okA:
+			return 1
+		case !okB:
+			return -1
+		default:
+			return ta.Compare(tb)
+		}
+	})
+}
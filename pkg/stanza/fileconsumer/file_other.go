@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"syscall"
 
 	"go.uber.org/zap"
 
@@ -20,12 +21,13 @@ import (
 // this can mean either files which were removed, or rotated into a name not matching the pattern
 // we do this before reading existing files to ensure we emit older log lines before newer ones
 func (m *Manager) readLostFiles(ctx context.Context) {
-	if m.readerFactory.DeleteAtEOF {
-		// Lost files are not expected when delete_at_eof is enabled
-		// since we are deleting the files before they can become lost.
+	if m.readerFactory.DeleteAtEOF || m.readerFactory.ArchiveDir != "" {
+		// Lost files are not expected when delete_at_eof or archive_to is enabled
+		// since we are removing the files before they can become lost.
 		return
 	}
-	previousPollFiles := m.tracker.PreviousPollFiles()
+	// Files which are not matched for the first time may still be within their grace period
+	previousPollFiles := m.tracker.LostFiles()
 	lostReaders := make([]*reader.Reader, 0, len(previousPollFiles))
 OUTER:
 	for _, oldReader := range previousPollFiles {
@@ -76,3 +78,8 @@ func normalizePath(path string) (string, bool) {
 func openFile(path string) (*os.File, error) {
 	return os.Open(path) // #nosec - operator must read in files defined by user
 }
+
+// openStream opens a named pipe or a character device without blocking until a writer opens it.
+func openStream(path string) (*os.File, error) {
+	return os.OpenFile(path, os.O_RDONLY|syscall.O_NONBLOCK, 0) // #nosec - operator must read in files defined by user
+}
@@ -6,6 +6,9 @@
 package fileconsumer
 
 import (
+	"os"
+	"path/filepath"
+	"syscall"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -51,3 +54,33 @@ func TestNormalizePath(t *testing.T) {
 		})
 	}
 }
+
+func TestReadNamedPipe(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	path := filepath.Join(tempDir, "pipe")
+	require.NoError(t, syscall.Mkfifo(path, 0o600))
+	// The pipe is opened for reading as well, so that it can be written before it is polled
+	pipe, err := os.OpenFile(path, os.O_RDWR, 0)
+	require.NoError(t, err)
+
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	operator, sink := testManager(t, cfg)
+
+	_, err = pipe.WriteString("testlog1\ntestlog2\n")
+	require.NoError(t, err)
+	operator.poll(t.Context())
+	sink.ExpectTokens(t, []byte("testlog1"), []byte("testlog2"))
+
+	// The pipe is read as it is written, and its last token is flushed once it is closed
+	_, err = pipe.WriteString("testlog3")
+	require.NoError(t, err)
+	require.NoError(t, pipe.Close())
+	sink.ExpectToken(t, []byte("testlog3"))
+
+	operator.wg.Wait()
+	require.Empty(t, operator.streams)
+	sink.ExpectNoCalls(t)
+}
@@ -0,0 +1,186 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+)
+
+// FileInfo describes a file read by the Manager.
+type FileInfo struct {
+	// Path is the path of the file.
+	Path string
+	// Offset is the offset up to which the file has been read.
+	Offset int64
+	// RecordNum is the number of records read from the file.
+	RecordNum int64
+	// Attributes are the attributes set on the records read from the file.
+	Attributes map[string]any
+	// LastRead is the time at which the file was last read.
+	LastRead time.Time
+}
+
+// fileStat is the state of a file after it was last read.
+type fileStat struct {
+	offset     int64
+	recordNum  int64
+	attributes map[string]any
+	lastRead   time.Time
+	seen       bool
+}
+
+// fileStats tracks the files read during the last poll, which are reported
+// through the per-file gauges. The gauges are observed concurrently to polling.
+type fileStats struct {
+	mu    sync.Mutex
+	files map[string]*fileStat
+}
+
+func newFileStats() *fileStats {
+	return &fileStats{files: make(map[string]*fileStat)}
+}
+
+// record updates the state of a file after it was read to the end.
+func (s *fileStats) record(r *reader.Reader) {
+	if r.Metadata == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.files[r.GetFileName()] = &fileStat{
+		offset:     r.Offset,
+		recordNum:  r.RecordNum,
+		attributes: maps.Clone(r.FileAttributes),
+		lastRead:   time.Now(),
+		seen:       true,
+	}
+}
+
+// list returns the state of every file, sorted by path.
+func (s *fileStats) list() []FileInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	files := make([]FileInfo, 0, len(s.files))
+	for path, stat := range s.files {
+		files = append(files, FileInfo{
+			Path:       path,
+			Offset:     stat.offset,
+			RecordNum:  stat.recordNum,
+			Attributes: maps.Clone(stat.attributes),
+			LastRead:   stat.lastRead,
+		})
+	}
+	slices.SortFunc(files, func(a, b FileInfo) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return files
+}
+
+// endPoll forgets files that were not read during the poll.
+func (s *fileStats) endPoll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for path, stat := range s.files {
+		if !stat.seen {
+			delete(s.files, path)
+			continue
+		}
+		stat.seen = false
+	}
+}
+
+// pending returns the number of bytes of the files that have not been read yet.
+func (s *fileStats) pending() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var pending int64
+	for path, stat := range s.files {
+		if size, ok := fileSize(path); ok {
+			pending += max(size-stat.offset, 0)
+		}
+	}
+	return pending
+}
+
+// observe reports value(path, stat) for every file, skipping files for which it is not known.
+func (s *fileStats) observe(value func(path string, stat *fileStat) (int64, bool)) metric.Int64Callback {
+	return func(_ context.Context, o metric.Int64Observer) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for path, stat := range s.files {
+			if v, ok := value(path, stat); ok {
+				o.Observe(v, metric.WithAttributes(attribute.String(attrs.LogFilePath, path)))
+			}
+		}
+		return nil
+	}
+}
+
+// register registers the per-file gauges with the telemetry builder.
+// Sizes are observed when the gauges are collected, so that the lag
+// includes what has been written since the last poll.
+func (s *fileStats) register(tb *metadata.TelemetryBuilder) error {
+	return errors.Join(
+		tb.RegisterFileconsumerFileOffsetCallback(s.observe(func(_ string, stat *fileStat) (int64, bool) {
+			return stat.offset, true
+		})),
+		tb.RegisterFileconsumerFileSizeCallback(s.observe(func(path string, _ *fileStat) (int64, bool) {
+			return fileSize(path)
+		})),
+		tb.RegisterFileconsumerFileLagCallback(s.observe(func(path string, stat *fileStat) (int64, bool) {
+			size, ok := fileSize(path)
+			// the file may be smaller than the offset after a truncation
+			return max(size-stat.offset, 0), ok
+		})),
+		tb.RegisterFileconsumerFileLastReadCallback(s.observe(func(_ string, stat *fileStat) (int64, bool) {
+			return stat.lastRead.Unix(), true
+		})),
+		tb.RegisterFileconsumerPendingBytesCallback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(s.pending())
+			return nil
+		}),
+	)
+}
+
+// checkPendingBytes warns once the files read during the last poll have more bytes left to read
+// than the threshold, as the files are then written faster than they are read.
+func (m *Manager) checkPendingBytes() {
+	if m.pendingWarning <= 0 {
+		return
+	}
+	pending := m.fileStats.pending()
+	switch {
+	case pending > m.pendingWarning && !m.pendingExceeded:
+		m.pendingExceeded = true
+		m.set.Logger.Warn("Files have more bytes left to read than the threshold. Reading may not keep up with the files being written",
+			zap.Int64("pending_bytes", pending), zap.Int64("threshold", m.pendingWarning))
+	case pending <= m.pendingWarning && m.pendingExceeded:
+		m.pendingExceeded = false
+		m.set.Logger.Info("Files have fewer bytes left to read than the threshold again",
+			zap.Int64("pending_bytes", pending), zap.Int64("threshold", m.pendingWarning))
+	}
+}
+
+func fileSize(path string) (int64, bool) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, false
+	}
+	return info.Size(), true
+}
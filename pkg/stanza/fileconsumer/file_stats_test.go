@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/archive"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/emittest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/metadatatest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/tracker"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/internal/filetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func TestFileMetrics(t *testing.T) {
+	if runtime.GOOS == windowsOS {
+		t.Skip("Deleting open files is unsupported on Windows")
+	}
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+	set := tel.NewTelemetrySettings()
+	sink := emittest.NewSink()
+	operator, err := cfg.Build(set, sink.Callback)
+	require.NoError(t, err)
+	operator.tracker = tracker.NewFileTracker(t.Context(), set, cfg.MaxBatches, cfg.PollsToArchive, cfg.LostFileGracePolls, archive.Retention{}, testutil.NewUnscopedMockPersister())
+	t.Cleanup(func() { operator.tracker.ClosePreviousFiles() })
+	require.NoError(t, operator.fileStats.register(operator.telemetryBuilder))
+
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "testlog1\n")
+
+	operator.poll(t.Context())
+	sink.ExpectToken(t, []byte("testlog1"))
+
+	// Written after the poll, so not read yet
+	filetest.WriteString(t, temp, "testlog2\n")
+
+	path := attribute.NewSet(attribute.String(attrs.LogFilePath, temp.Name()))
+	metadatatest.AssertEqualFileconsumerFileOffset(t, tel,
+		[]metricdata.DataPoint[int64]{{Attributes: path, Value: 9}},
+		metricdatatest.IgnoreTimestamp())
+	metadatatest.AssertEqualFileconsumerFileSize(t, tel,
+		[]metricdata.DataPoint[int64]{{Attributes: path, Value: 18}},
+		metricdatatest.IgnoreTimestamp())
+	metadatatest.AssertEqualFileconsumerFileLag(t, tel,
+		[]metricdata.DataPoint[int64]{{Attributes: path, Value: 9}},
+		metricdatatest.IgnoreTimestamp())
+	metadatatest.AssertEqualFileconsumerPendingBytes(t, tel,
+		[]metricdata.DataPoint[int64]{{Value: 9}},
+		metricdatatest.IgnoreTimestamp())
+	got, err := tel.GetMetric("otelcol_fileconsumer_file_last_read")
+	require.NoError(t, err)
+	require.Len(t, got.Data.(metricdata.Gauge[int64]).DataPoints, 1)
+
+	files := operator.Files()
+	require.Len(t, files, 1)
+	require.Equal(t, temp.Name(), files[0].Path)
+	require.Equal(t, int64(9), files[0].Offset)
+	require.Equal(t, int64(1), files[0].RecordNum)
+	require.Equal(t, filepath.Base(temp.Name()), files[0].Attributes[attrs.LogFileName])
+	require.False(t, files[0].LastRead.IsZero())
+
+	operator.poll(t.Context())
+	sink.ExpectToken(t, []byte("testlog2"))
+	metadatatest.AssertEqualFileconsumerFileLag(t, tel,
+		[]metricdata.DataPoint[int64]{{Attributes: path, Value: 0}},
+		metricdatatest.IgnoreTimestamp())
+
+	// Files that are no longer read are not reported
+	require.NoError(t, temp.Close())
+	require.NoError(t, os.Remove(temp.Name()))
+	operator.poll(t.Context())
+	operator.poll(t.Context())
+	_, err = tel.GetMetric("otelcol_fileconsumer_file_offset")
+	require.Error(t, err)
+	require.Empty(t, operator.Files())
+}
+
+func TestPendingBytesWarning(t *testing.T) {
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.PendingBytesWarning = 10
+	operator, sink := testManager(t, cfg)
+	core, observedLogs := observer.New(zap.InfoLevel)
+	operator.set.Logger = zap.New(core)
+
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "testlog1\n")
+	operator.poll(t.Context())
+	sink.ExpectToken(t, []byte("testlog1"))
+	require.Zero(t, observedLogs.FilterLevelExact(zap.WarnLevel).Len())
+
+	// Written after the poll, so not read yet
+	filetest.WriteString(t, temp, "testlog2\ntestlog3\n")
+	operator.checkPendingBytes()
+	operator.checkPendingBytes()
+	warnings := observedLogs.FilterLevelExact(zap.WarnLevel).All()
+	require.Len(t, warnings, 1)
+	require.Equal(t, int64(18), warnings[0].ContextMap()["pending_bytes"])
+
+	operator.poll(t.Context())
+	sink.ExpectTokens(t, []byte("testlog2"), []byte("testlog3"))
+	require.Equal(t, 1, observedLogs.FilterMessageSnippet("fewer bytes left to read").Len())
+}
@@ -7,17 +7,22 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/ulikunitz/xz"
 	"go.opentelemetry.io/collector/featuregate"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
@@ -28,7 +33,9 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/matcher"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/internal/filetest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/split"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/trim"
 )
 
 // TestDefaultBehaviors
@@ -380,6 +387,181 @@ func TestSymlinkedFiles(t *testing.T) {
 	}
 }
 
+// TestMaxReadWorkers tests that the files are read round-robin by the workers, so that
+// a small file is read before a large file is read to the end.
+func TestMaxReadWorkers(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.MaxReadWorkers = 1
+	cfg.ReadQuantum = 1
+	sink := emittest.NewSink(emittest.WithCallBuffer(1001))
+	operator := testManagerWithSink(t, cfg, sink)
+
+	large := filetest.OpenTemp(t, tempDir)
+	for i := range 1000 {
+		filetest.WriteString(t, large, fmt.Sprintf("large%03d\n", i))
+	}
+	small := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, small, "small\n")
+
+	operator.poll(t.Context())
+	tokens := sink.NextTokens(t, 1001)
+	sink.ExpectNoCalls(t)
+	smallIndex := slices.IndexFunc(tokens, func(token []byte) bool { return string(token) == "small" })
+	require.NotEqual(t, -1, smallIndex)
+	assert.Less(t, smallIndex, 200)
+}
+
+// TestPauseOnBackpressure tests that files are not read while the consumer refuses data,
+// and that reading resumes from the refused tokens once the consumer accepts data again.
+func TestPauseOnBackpressure(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.ReadQuantum = 1
+	cfg.Backpressure = BackpressureConfig{Pause: true, InitialInterval: time.Hour}
+
+	var operator *Manager
+	var refuse atomic.Bool
+	sink := emittest.NewSink(emittest.WithCallBuffer(1000))
+	callback := sink.Callback
+	sink.Callback = func(ctx context.Context, tokens [][]byte, attributes map[string]any, lastRecordNumber int64, offsets []int64) error {
+		err := callback(ctx, tokens, attributes, lastRecordNumber, offsets)
+		operator.HandleBackpressure(ctx, refuse.Load())
+		return err
+	}
+	operator = testManagerWithSink(t, cfg, sink)
+
+	temp := filetest.OpenTemp(t, tempDir)
+	for i := range 1000 {
+		filetest.WriteString(t, temp, fmt.Sprintf("testlog%03d\n", i))
+	}
+
+	// Reading stops after the first batch is refused
+	refuse.Store(true)
+	operator.poll(t.Context())
+	require.Len(t, sink.NextTokens(t, reader.DefaultMaxBatchSize), reader.DefaultMaxBatchSize)
+	sink.ExpectNoCalls(t)
+	require.True(t, operator.paused())
+
+	operator.poll(t.Context())
+	sink.ExpectNoCalls(t)
+
+	// Reading resumes from the refused tokens once the consumer accepts data
+	refuse.Store(false)
+	operator.HandleBackpressure(t.Context(), false)
+	require.False(t, operator.paused())
+	operator.poll(t.Context())
+	tokens := sink.NextTokens(t, 1000)
+	require.Equal(t, []byte("testlog000"), tokens[0])
+	require.Equal(t, []byte("testlog999"), tokens[999])
+	sink.ExpectNoCalls(t)
+}
+
+// TestExcludeContentRegex tests that files whose first bytes match exclude_content_regex are not read.
+func TestExcludeContentRegex(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.ExcludeContentRegex = `^LPKSHHRH`
+	operator, sink := testManager(t, cfg)
+
+	journal := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, journal, "LPKSHHRH\x00\x00binary\n")
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "testlog1\n")
+
+	operator.poll(t.Context())
+	sink.ExpectToken(t, []byte("testlog1"))
+	sink.ExpectNoCalls(t)
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Encoding = "utf-16le"
+	cfg.Normalize = []string{trim.NormalizeStripANSI, trim.NormalizeRemoveNUL, trim.NormalizeCollapseWhitespace}
+	operator, sink := testManager(t, cfg)
+
+	// The NUL bytes of the encoding are not removed, as the tokens are normalized once decoded
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "\x1b\x00[\x003\x001\x00m\x00e\x00r\x00r\x00o\x00r\x00\x1b\x00[\x000\x00m\x00 \x00\x00\x00 \x00o\x00k\x00\n\x00")
+
+	operator.poll(t.Context())
+	sink.ExpectToken(t, []byte("error ok"))
+	sink.ExpectNoCalls(t)
+}
+
+// TestFollowSymlinks tests how matched symlinks are read with each follow_symlinks setting.
+func TestFollowSymlinks(t *testing.T) {
+	if runtime.GOOS == windowsOS {
+		t.Skip("Creating symlinks requires elevated privileges on Windows")
+	}
+	t.Parallel()
+
+	t.Run("Never", func(t *testing.T) {
+		t.Parallel()
+		targetDir, tempDir := t.TempDir(), t.TempDir()
+		target := filetest.OpenTemp(t, targetDir)
+		filetest.WriteString(t, target, "testlog1\n")
+		require.NoError(t, os.Symlink(target.Name(), filepath.Join(tempDir, "sym.log")))
+		temp := filetest.OpenTemp(t, tempDir)
+		filetest.WriteString(t, temp, "testlog2\n")
+
+		cfg := NewConfig().includeDir(tempDir)
+		cfg.StartAt = "beginning"
+		cfg.FollowSymlinks = FollowSymlinksNever
+		operator, sink := testManager(t, cfg)
+
+		operator.poll(t.Context())
+		sink.ExpectToken(t, []byte("testlog2"))
+		sink.ExpectNoCalls(t)
+	})
+
+	t.Run("ResolveOnce", func(t *testing.T) {
+		t.Parallel()
+		targetDir, tempDir := t.TempDir(), t.TempDir()
+		target1 := filetest.OpenTemp(t, targetDir)
+		filetest.WriteString(t, target1, "testlog1\n")
+		symlink := filepath.Join(tempDir, "sym.log")
+		require.NoError(t, os.Symlink(target1.Name(), symlink))
+
+		cfg := NewConfig().includeDir(tempDir)
+		cfg.StartAt = "beginning"
+		cfg.FollowSymlinks = FollowSymlinksResolveOnce
+		operator, sink := testManager(t, cfg)
+
+		// The target is read under its own name
+		operator.poll(t.Context())
+		sink.ExpectCall(t, []byte("testlog1"), map[string]any{attrs.LogFileName: filepath.Base(target1.Name())})
+
+		// A retargeted symlink keeps being read from its first target
+		target2 := filetest.OpenTemp(t, targetDir)
+		filetest.WriteString(t, target2, "testlog2\n")
+		require.NoError(t, os.Remove(symlink))
+		require.NoError(t, os.Symlink(target2.Name(), symlink))
+		filetest.WriteString(t, target1, "testlog3\n")
+		operator.poll(t.Context())
+		sink.ExpectCall(t, []byte("testlog3"), map[string]any{attrs.LogFileName: filepath.Base(target1.Name())})
+		sink.ExpectNoCalls(t)
+
+		// The symlink is resolved again once its first target no longer exists
+		require.NoError(t, os.Remove(target1.Name()))
+		operator.poll(t.Context())
+		sink.ExpectCall(t, []byte("testlog2"), map[string]any{attrs.LogFileName: filepath.Base(target2.Name())})
+	})
+}
+
 // StartAtEndNewFile tests that when `start_at` is configured to `end`,
 // a file created after the operator has been started is read from the
 // beginning
@@ -799,6 +981,55 @@ func TestRestartOffsets(t *testing.T) {
 	}
 }
 
+func TestRestartWithFingerprintSizeChange(t *testing.T) {
+	testCases := []struct {
+		name       string
+		beforeSize helper.ByteSize
+		afterSize  helper.ByteSize
+		hashed     bool
+	}{
+		{"shrink", 100, 20, false},
+		{"grow", 20, 100, false},
+		{"shrink_hashed", 100, 20, true},
+		{"grow_hashed", 20, 100, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tempDir := t.TempDir()
+			cfg := NewConfig().includeDir(tempDir)
+			cfg.StartAt = "beginning"
+			cfg.FingerprintSize = tc.beforeSize
+			if tc.hashed {
+				cfg.FingerprintStrategy = FingerprintStrategyHash
+			}
+			persister := testutil.NewUnscopedMockPersister()
+
+			logFile := filetest.OpenTemp(t, tempDir)
+			before := filetest.TokenWithLength(200)
+			after := filetest.TokenWithLength(200)
+
+			operatorOne, sink1 := testManager(t, cfg)
+			operatorOne.persister = persister
+			filetest.WriteString(t, logFile, string(before)+"\n")
+			operatorOne.poll(t.Context())
+			sink1.ExpectToken(t, before)
+			require.NoError(t, operatorOne.Stop())
+
+			// The file is recognized with the new fingerprint size, so it is not read again
+			filetest.WriteString(t, logFile, string(after)+"\n")
+			cfg.FingerprintSize = tc.afterSize
+			operatorTwo, sink2 := testManager(t, cfg)
+			require.NoError(t, operatorTwo.Start(persister))
+			sink2.ExpectToken(t, after)
+			sink2.ExpectNoCalls(t)
+			require.NoError(t, operatorTwo.Stop())
+		})
+	}
+}
+
 func TestManyLogsDelivered(t *testing.T) {
 	t.Parallel()
 
@@ -1050,6 +1281,82 @@ func TestEncodings(t *testing.T) {
 	}
 }
 
+// TestStartAtTimestamp tests that the files found on the first poll are read from
+// the first record which is not before the cutoff, and new files from the beginning.
+func TestStartAtTimestamp(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "timestamp"
+	cfg.StartAtTimestamp = &StartAtTimestampConfig{
+		Cutoff:     "2024-05-01T00:00:00Z",
+		Regex:      `^(?P<timestamp>\S+) `,
+		Layout:     time.RFC3339,
+		LayoutType: "gotime",
+	}
+	operator, sink := testManager(t, cfg)
+
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "2024-04-30T23:59:00Z old\n2024-04-30T23:59:30Z older continued\n  continuation\n2024-05-01T00:00:00Z new1\n2024-05-01T00:01:00Z new2\n")
+
+	operator.poll(t.Context())
+	sink.ExpectTokens(t, []byte("2024-05-01T00:00:00Z new1"), []byte("2024-05-01T00:01:00Z new2"))
+
+	filetest.WriteString(t, temp, "2024-05-01T00:02:00Z new3\n")
+	operator.poll(t.Context())
+	sink.ExpectToken(t, []byte("2024-05-01T00:02:00Z new3"))
+
+	// Files created after the first poll are read from the beginning
+	temp2 := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp2, "2024-04-30T00:00:00Z other\n")
+	operator.poll(t.Context())
+	sink.ExpectToken(t, []byte("2024-04-30T00:00:00Z other"))
+}
+
+// TestLostFileGracePolls tests that a file which briefly disappears from the
+// matched files is not read as lost while it is within its grace period.
+func TestLostFileGracePolls(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("lost files are not read on windows")
+	}
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig()
+	cfg.Include = []string{filepath.Join(tempDir, "*.log")}
+	cfg.StartAt = "beginning"
+	cfg.LostFileGracePolls = 1
+	operator, sink := testManager(t, cfg)
+	operator.persister = testutil.NewUnscopedMockPersister()
+
+	temp := filetest.OpenTempWithPattern(t, tempDir, "*.log")
+	filetest.WriteString(t, temp, "testlog1\n")
+	operator.poll(t.Context())
+	sink.ExpectToken(t, []byte("testlog1"))
+
+	// The file is missed by a poll, so it is not read yet
+	hidden := temp.Name() + ".tmp"
+	require.NoError(t, os.Rename(temp.Name(), hidden))
+	filetest.WriteString(t, temp, "testlog2\n")
+	operator.poll(t.Context())
+	sink.ExpectNoCalls(t)
+
+	// The file is found again, and read from where it was left
+	require.NoError(t, os.Rename(hidden, temp.Name()))
+	operator.poll(t.Context())
+	sink.ExpectToken(t, []byte("testlog2"))
+
+	// The file is read as lost once its grace period expires
+	require.NoError(t, os.Rename(temp.Name(), hidden))
+	filetest.WriteString(t, temp, "testlog3\n")
+	operator.poll(t.Context())
+	sink.ExpectNoCalls(t)
+	operator.poll(t.Context())
+	sink.ExpectToken(t, []byte("testlog3"))
+	sink.ExpectNoCalls(t)
+}
+
 func TestDeleteAfterRead(t *testing.T) {
 	t.Parallel()
 
@@ -1124,6 +1431,76 @@ func TestDeleteAfterRead(t *testing.T) {
 	}
 }
 
+func TestArchiveAfterRead(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, featuregate.GlobalRegistry().Set(metadata.FilelogAllowFileDeletionFeatureGate.ID(), true))
+
+	testCases := []struct {
+		name        string
+		compression string
+		read        func(t *testing.T, path string) []byte
+	}{
+		{
+			name: "uncompressed",
+			read: func(t *testing.T, path string) []byte {
+				content, err := os.ReadFile(path)
+				require.NoError(t, err)
+				return content
+			},
+		},
+		{
+			name:        "gzip",
+			compression: "gzip",
+			read: func(t *testing.T, path string) []byte {
+				f, err := os.Open(path + ".gz")
+				require.NoError(t, err)
+				defer f.Close()
+				r, err := gzip.NewReader(f)
+				require.NoError(t, err)
+				var buf bytes.Buffer
+				_, err = buf.ReadFrom(r)
+				require.NoError(t, err)
+				return buf.Bytes()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tempDir := t.TempDir()
+			archiveDir := filepath.Join(t.TempDir(), "archive")
+			temp := filetest.OpenTemp(t, tempDir)
+			filetest.WriteString(t, temp, "testlog1\ntestlog2\n")
+			require.NoError(t, temp.Close())
+
+			cfg := NewConfig().includeDir(tempDir)
+			cfg.StartAt = "beginning"
+			cfg.ArchiveTo = archiveDir
+			cfg.ArchiveCompression = tc.compression
+			operator, sink := testManager(t, cfg)
+			operator.persister = testutil.NewUnscopedMockPersister()
+			operator.poll(t.Context())
+			sink.ExpectTokens(t, []byte("testlog1"), []byte("testlog2"))
+
+			_, err := os.Stat(temp.Name())
+			require.True(t, os.IsNotExist(err))
+			archived := filepath.Join(archiveDir, filepath.Base(temp.Name()))
+			require.Equal(t, []byte("testlog1\ntestlog2\n"), tc.read(t, archived))
+
+			// A file archived under the same name must not overwrite the previous one
+			require.NoError(t, os.WriteFile(temp.Name(), []byte("testlog3\n"), 0o600))
+			operator.poll(t.Context())
+			sink.ExpectToken(t, []byte("testlog3"))
+			entries, err := os.ReadDir(archiveDir)
+			require.NoError(t, err)
+			require.Len(t, entries, 2)
+		})
+	}
+}
+
 func TestMaxBatching(t *testing.T) {
 	t.Parallel()
 
@@ -1341,6 +1718,205 @@ func TestHeaderPersistanceInHeader(t *testing.T) {
 	require.NoError(t, op2.Stop())
 }
 
+func TestAdaptivePollInterval(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.PollInterval = 100 * time.Millisecond
+	cfg.MaxPollInterval = time.Second
+	operator, _ := testManager(t, cfg)
+
+	interval := cfg.PollInterval
+	for _, expected := range []time.Duration{200, 400, 800, 1000, 1000} {
+		interval = operator.nextPollInterval(interval, false)
+		require.Equal(t, expected*time.Millisecond, interval)
+	}
+	require.Equal(t, cfg.PollInterval, operator.nextPollInterval(interval, true))
+
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "testlog1\n")
+	operator.poll(t.Context())
+	require.True(t, operator.readData.Load())
+
+	operator.readData.Store(false)
+	operator.poll(t.Context())
+	require.False(t, operator.readData.Load())
+}
+
+func TestOrderingMtime(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Ordering = OrderingMtime
+	// Batches are ordered too
+	cfg.MaxConcurrentFiles = 2
+	operator, sink := testManager(t, cfg)
+
+	now := time.Now()
+	for i := range 4 {
+		temp := filetest.OpenTemp(t, tempDir)
+		filetest.WriteString(t, temp, fmt.Sprintf("file%d\n", i))
+		// the first file is the newest
+		modTime := now.Add(-time.Duration(i) * time.Minute)
+		require.NoError(t, os.Chtimes(temp.Name(), modTime, modTime))
+	}
+
+	operator.poll(t.Context())
+	for i := 3; i >= 0; i-- {
+		sink.ExpectToken(t, fmt.Appendf(nil, "file%d", i))
+	}
+	sink.ExpectNoCalls(t)
+}
+
+func TestPriority(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Priority = PriorityConfig{Tiers: []string{`app-.*\.log$`}, NewestFirst: true}
+	// Each batch holds a single file
+	cfg.MaxConcurrentFiles = 2
+	operator, sink := testManager(t, cfg)
+
+	now := time.Now()
+	for i, name := range []string{"dump-old.txt", "app-old.log", "dump-new.txt", "app-new.log"} {
+		path := filepath.Join(tempDir, name)
+		require.NoError(t, os.WriteFile(path, []byte(name+"\n"), 0o600))
+		modTime := now.Add(time.Duration(i) * time.Minute)
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	operator.poll(t.Context())
+	for _, name := range []string{"app-new.log", "app-old.log", "dump-new.txt", "dump-old.txt"} {
+		sink.ExpectToken(t, []byte(name))
+	}
+	sink.ExpectNoCalls(t)
+}
+
+func TestTrackingNone(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Tracking = TrackingNone
+	operator, sink := testManager(t, cfg)
+
+	// Offsets are never loaded nor saved
+	persister := testutil.NewErrPersister(map[string]error{
+		"knownFiles": errors.New("unexpected checkpoint"),
+	})
+	require.NoError(t, operator.Start(persister))
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "testlog1\n")
+	sink.ExpectToken(t, []byte("testlog1"))
+
+	// Each file is read once
+	filetest.WriteString(t, temp, "testlog2\n")
+	sink.ExpectNoCallsUntil(t, 3*cfg.PollInterval)
+
+	temp2 := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp2, "testlog3\n")
+	sink.ExpectToken(t, []byte("testlog3"))
+	sink.ExpectNoCalls(t)
+}
+
+func TestTrackingNoneEmptyFile(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Tracking = TrackingNone
+	operator, sink := testManager(t, cfg)
+
+	temp := filetest.OpenTemp(t, tempDir)
+	require.NoError(t, operator.Start(testutil.NewUnscopedMockPersister()))
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+	sink.ExpectNoCallsUntil(t, 3*cfg.PollInterval)
+
+	// The file is read once it has content
+	filetest.WriteString(t, temp, "testlog1\n")
+	sink.ExpectToken(t, []byte("testlog1"))
+	sink.ExpectNoCalls(t)
+}
+
+func TestTrackingNoneRemovedFile(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Tracking = TrackingNone
+	operator, sink := testManager(t, cfg)
+
+	require.NoError(t, operator.Start(testutil.NewUnscopedMockPersister()))
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "testlog1\n")
+	sink.ExpectToken(t, []byte("testlog1"))
+	require.NoError(t, temp.Close())
+	require.NoError(t, os.Remove(temp.Name()))
+	sink.ExpectNoCallsUntil(t, 3*cfg.PollInterval)
+
+	// A file created later at the same path is read
+	temp2, err := os.Create(temp.Name())
+	require.NoError(t, err)
+	defer temp2.Close()
+	filetest.WriteString(t, temp2, "testlog2\n")
+	sink.ExpectToken(t, []byte("testlog2"))
+	sink.ExpectNoCalls(t)
+}
+
+func TestHashFingerprintPersistence(t *testing.T) {
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.FingerprintStrategy = FingerprintStrategyHash
+
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "log line 1\n")
+
+	persister := testutil.NewUnscopedMockPersister()
+
+	op1, sink1 := testManager(t, cfg)
+	require.NoError(t, op1.Start(persister))
+	sink1.ExpectToken(t, []byte("log line 1"))
+	require.NoError(t, op1.Stop())
+
+	filetest.WriteString(t, temp, "log line 2\n")
+
+	op2, sink2 := testManager(t, cfg)
+	require.NoError(t, op2.Start(persister))
+	sink2.ExpectToken(t, []byte("log line 2"))
+	sink2.ExpectNoCalls(t)
+	require.NoError(t, op2.Stop())
+
+	// Files are still recognized after switching back to raw fingerprints
+	filetest.WriteString(t, temp, "log line 3\n")
+
+	cfg.FingerprintStrategy = FingerprintStrategyBytes
+	op3, sink3 := testManager(t, cfg)
+	require.NoError(t, op3.Start(persister))
+	sink3.ExpectToken(t, []byte("log line 3"))
+	sink3.ExpectNoCalls(t)
+	require.NoError(t, op3.Stop())
+}
+
 func TestStalePartialFingerprintDiscarded(t *testing.T) {
 	t.Parallel()
 	tempDir := t.TempDir()
@@ -1440,6 +2016,39 @@ func TestDelayedDisambiguation(t *testing.T) {
 	sink.ExpectCalls(t, sameTokenOtherFile, newFromFile1, newFromFile2)
 }
 
+func TestFingerprintExtendedBeforeMatching(t *testing.T) {
+	if runtime.GOOS == windowsOS {
+		t.Skip("Files are closed after each poll on windows")
+	}
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	operator, sink := testManager(t, cfg)
+
+	// The file is read while it is smaller than the fingerprint size
+	old := filetest.OpenFile(t, filepath.Join(tempDir, "b.log"))
+	filetest.WriteString(t, old, "header\n")
+	operator.poll(t.Context())
+	oldAttrs := map[string]any{attrs.LogFileName: "b.log"}
+	sink.ExpectCall(t, []byte("header"), oldAttrs)
+
+	// A new file which starts with the same bytes is matched before the file which grew,
+	// but it does not continue from the offset of that file
+	filetest.WriteString(t, old, "old log\n")
+	newFile := filetest.OpenFile(t, filepath.Join(tempDir, "a.log"))
+	filetest.WriteString(t, newFile, "header\nnew log\n")
+	operator.poll(t.Context())
+	newAttrs := map[string]any{attrs.LogFileName: "a.log"}
+	sink.ExpectCalls(t,
+		emit.NewToken([]byte("header"), newAttrs),
+		emit.NewToken([]byte("new log"), newAttrs),
+		emit.NewToken([]byte("old log"), oldAttrs),
+	)
+	sink.ExpectNoCalls(t)
+}
+
 func TestNoLostPartial(t *testing.T) {
 	t.Parallel()
 	tempDir := t.TempDir()
@@ -1613,6 +2222,130 @@ func TestReadGzipCompressedLogsFromEnd(t *testing.T) {
 	sink.ExpectToken(t, []byte("testlog4"))
 }
 
+// TestReadZstdCompressedLogsFromBeginning tests that, when starting from beginning of a zstd compressed file, we
+// read all the lines that are already there
+func TestReadZstdCompressedLogsFromBeginning(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir).withZstd()
+	cfg.StartAt = "beginning"
+	operator, sink := testManager(t, cfg)
+
+	// Create a file, then start
+	temp := filetest.OpenTempWithPattern(t, tempDir, "*.zst")
+	writer, err := zstd.NewWriter(temp)
+	require.NoError(t, err)
+
+	_, err = writer.Write([]byte("testlog1\ntestlog2\n"))
+	require.NoError(t, err)
+
+	require.NoError(t, writer.Close())
+
+	require.NoError(t, operator.Start(testutil.NewUnscopedMockPersister()))
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	sink.ExpectToken(t, []byte("testlog1"))
+	sink.ExpectToken(t, []byte("testlog2"))
+}
+
+// TestReadZstdCompressedLogsFromEnd tests that, when starting at the end of a zstd compressed file, we
+// read all the lines that are added afterward as new frames
+func TestReadZstdCompressedLogsFromEnd(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir).withZstd()
+	cfg.StartAt = "end"
+	operator, sink := testManager(t, cfg)
+
+	// Create a file, then start
+	temp := filetest.OpenTempWithPattern(t, tempDir, "*.zst")
+
+	appendToLog := func(t *testing.T, content string) {
+		writer, err := zstd.NewWriter(temp)
+		require.NoError(t, err)
+		_, err = writer.Write([]byte(content))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+	}
+
+	appendToLog(t, "testlog1\ntestlog2\n")
+
+	// poll for the first time - this should not lead to emitted
+	// logs as those were already in the existing file
+	operator.poll(t.Context())
+
+	// append new content to the log and poll again - this should be picked up
+	appendToLog(t, "testlog3\n")
+	operator.poll(t.Context())
+	sink.ExpectToken(t, []byte("testlog3"))
+
+	// do another iteration to verify correct setting of compressed reader offset
+	appendToLog(t, "testlog4\n")
+	operator.poll(t.Context())
+	sink.ExpectToken(t, []byte("testlog4"))
+}
+
+// TestReadXzCompressedLogsAutoDetected tests that xz compressed files are detected regardless
+// of their name, and that streams appended afterward are read as well
+func TestReadXzCompressedLogsAutoDetected(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.Compression = "auto"
+	cfg.StartAt = "beginning"
+	operator, sink := testManager(t, cfg)
+
+	// Create a file without a compression extension
+	temp := filetest.OpenTempWithPattern(t, tempDir, "*.log")
+
+	appendToLog := func(t *testing.T, content string) {
+		writer, err := xz.NewWriter(temp)
+		require.NoError(t, err)
+		_, err = writer.Write([]byte(content))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+	}
+
+	appendToLog(t, "testlog1\ntestlog2\n")
+	operator.poll(t.Context())
+	sink.ExpectToken(t, []byte("testlog1"))
+	sink.ExpectToken(t, []byte("testlog2"))
+
+	appendToLog(t, "testlog3\n")
+	operator.poll(t.Context())
+	sink.ExpectToken(t, []byte("testlog3"))
+}
+
+// TestWatchFileEvents tests that, with file events enabled, new files and new lines are
+// read without waiting for the poll interval
+func TestWatchFileEvents(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.PollInterval = 1000 * time.Hour
+	cfg.WatchFileEvents = true
+	operator, sink := testManager(t, cfg)
+
+	require.NoError(t, operator.Start(testutil.NewUnscopedMockPersister()))
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "testlog1\n")
+	sink.ExpectToken(t, []byte("testlog1"))
+
+	filetest.WriteString(t, temp, "testlog2\n")
+	sink.ExpectToken(t, []byte("testlog2"))
+}
+
 func TestArchive(t *testing.T) {
 	if runtime.GOOS == "windows" {
 		t.Skip("Time sensitive tests disabled for now on Windows. See https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/32715#issuecomment-2107737828")
@@ -1730,3 +2463,119 @@ func TestCopyTruncateResetsOffsetOnRestart_IdenticalFirstKB(t *testing.T) {
 	}
 	sink2.ExpectNoCalls(t)
 }
+
+func TestGroups(t *testing.T) {
+	t.Parallel()
+
+	plainDir := t.TempDir()
+	multilineDir := t.TempDir()
+	cfg := NewConfig().includeDir(plainDir)
+	cfg.StartAt = "beginning"
+	cfg.Groups = []GroupConfig{
+		{
+			Criteria:    matcher.Criteria{Include: []string{filepath.Join(multilineDir, "*")}},
+			SplitConfig: &split.Config{LineStartPattern: "^START"},
+			Resolver:    &attrs.Resolver{IncludeFilePath: true},
+		},
+	}
+	operator, sink := testManager(t, cfg)
+
+	plain := filetest.OpenTemp(t, plainDir)
+	filetest.WriteString(t, plain, "plain 1\nplain 2\n")
+	multiline := filetest.OpenTemp(t, multilineDir)
+	filetest.WriteString(t, multiline, "START 1\ncontinued\nSTART 2\n")
+
+	operator.poll(t.Context())
+	plainAttrs := map[string]any{attrs.LogFileName: filepath.Base(plain.Name())}
+	multilineAttrs := map[string]any{attrs.LogFilePath: multiline.Name()}
+	sink.ExpectCalls(t,
+		emit.NewToken([]byte("plain 1"), plainAttrs),
+		emit.NewToken([]byte("plain 2"), plainAttrs),
+		emit.NewToken([]byte("START 1\ncontinued"), multilineAttrs),
+	)
+	sink.ExpectNoCalls(t)
+}
+
+func TestGroupPollInterval(t *testing.T) {
+	t.Parallel()
+
+	liveDir := t.TempDir()
+	archiveDir := t.TempDir()
+	cfg := NewConfig().includeDir(liveDir)
+	cfg.StartAt = "beginning"
+	cfg.PollInterval = time.Hour
+	cfg.Groups = []GroupConfig{
+		{
+			Criteria:     matcher.Criteria{Include: []string{filepath.Join(archiveDir, "*")}},
+			PollInterval: 10 * time.Millisecond,
+		},
+	}
+	operator, sink := testManager(t, cfg)
+	require.Empty(t, operator.groups)
+	require.Len(t, operator.groupPollers, 1)
+
+	live := filetest.OpenTemp(t, liveDir)
+	filetest.WriteString(t, live, "live 1\n")
+	archived := filetest.OpenTemp(t, archiveDir)
+	filetest.WriteString(t, archived, "archived 1\n")
+
+	// The top-level files are only polled once an hour
+	persister := testutil.NewUnscopedMockPersister()
+	require.NoError(t, operator.Start(persister))
+	sink.ExpectToken(t, []byte("archived 1"))
+	sink.ExpectNoCalls(t)
+
+	operator.poll(t.Context())
+	sink.ExpectToken(t, []byte("live 1"))
+	require.Len(t, operator.Files(), 2)
+	require.NoError(t, operator.Stop())
+
+	// The offsets of the group are stored in its own scope
+	offsets, err := persister.Get(t.Context(), "groups.0.knownFiles")
+	require.NoError(t, err)
+	require.NotEmpty(t, offsets)
+}
+
+func TestRules(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.Rules = []RuleConfig{{Match: "*.json.log", Split: RuleSplitJSON}}
+	operator, sink := testManager(t, cfg)
+
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "app.json.log"), []byte("{\n  \"a\": 1\n}\n{\"b\": 2}\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "app.log"), []byte("line 1\nline 2\n"), 0o600))
+
+	operator.poll(t.Context())
+	sink.ExpectTokens(t, []byte("{\n  \"a\": 1\n}"), []byte("{\"b\": 2}"), []byte("line 1"), []byte("line 2"))
+	sink.ExpectNoCalls(t)
+}
+
+func TestExcludeOlderThanKeepsFilesBeingRead(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.ExcludeOlderThan = time.Hour
+	operator, sink := testManager(t, cfg)
+
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "testlog1\n")
+	operator.poll(t.Context())
+	sink.ExpectToken(t, []byte("testlog1"))
+
+	// The file is read to its end although its modification time is older than the age
+	filetest.WriteString(t, temp, "testlog2\n")
+	twoHoursAgo := time.Now().Add(-2 * time.Hour)
+	require.NoError(t, os.Chtimes(temp.Name(), twoHoursAgo, twoHoursAgo))
+	operator.poll(t.Context())
+	sink.ExpectToken(t, []byte("testlog2"))
+
+	// Once it has been read to its end, it is excluded
+	operator.poll(t.Context())
+	require.Empty(t, operator.readOffsets)
+	sink.ExpectNoCalls(t)
+}
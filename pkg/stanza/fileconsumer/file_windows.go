@@ -74,3 +74,8 @@ func openFile(path string) (*os.File, error) {
 
 	return os.NewFile(uintptr(handle), path), nil
 }
+
+// openStream opens a character device like any other file, as named pipes are not matched on windows.
+func openStream(path string) (*os.File, error) {
+	return openFile(path)
+}
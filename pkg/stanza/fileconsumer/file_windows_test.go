@@ -12,6 +12,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
 )
 
 func TestNormalizePath(t *testing.T) {
@@ -212,3 +214,43 @@ func TestReadAfterFileDeleted(t *testing.T) {
 	_, err = file.Read(buf)
 	require.ErrorIs(t, err, io.EOF, "Should reach EOF after reading all content")
 }
+
+// TestRotationByFileID verifies that a rotated file keeps its offset when it is matched by its
+// file ID, even though a new file which starts with the same bytes was created in its place.
+func TestRotationByFileID(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	operator, sink := testManager(t, cfg)
+
+	logPath := filepath.Join(tempDir, "app.log")
+	rotatedPath := filepath.Join(tempDir, "app.log.1")
+	require.NoError(t, os.WriteFile(logPath, []byte("header\n"), 0o600))
+
+	operator.poll(t.Context())
+	sink.ExpectCall(t, []byte("header"), map[string]any{attrs.LogFileName: "app.log"})
+
+	// Rotate the file with MoveFileEx, and create a new file with the same header
+	f, err := os.OpenFile(logPath, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.WriteString("old1\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.NoError(t, os.Rename(logPath, rotatedPath))
+	require.NoError(t, os.WriteFile(logPath, []byte("header\nnew1\n"), 0o600))
+
+	operator.poll(t.Context())
+	tokens := map[string][]string{}
+	for range 3 {
+		token, attributes := sink.NextCall(t)
+		name := attributes[attrs.LogFileName].(string)
+		tokens[name] = append(tokens[name], string(token))
+	}
+	sink.ExpectNoCalls(t)
+	require.Equal(t, map[string][]string{
+		"app.log":   {"header", "new1"},
+		"app.log.1": {"old1"},
+	}, tokens)
+}
@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/matcher"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+)
+
+// group reads the files matching its criteria with its own reader settings.
+type group struct {
+	matcher       *matcher.Matcher
+	readerFactory *reader.Factory
+}
+
+// groupPoller reads the files of a group which has its own poll interval, with a Manager
+// of its own. Its files are not matched by the polls of the other files.
+type groupPoller struct {
+	// scope prefixes the keys under which the offsets of the group are stored.
+	scope   string
+	manager *Manager
+}
+
+// groupManagerConfig returns the configuration of the Manager which reads the files of a group
+// with its own poll interval. The rules and rotated backups only apply to the top-level files.
+func (c Config) groupManagerConfig(g GroupConfig) Config {
+	gc := c
+	gc.Criteria = g.Criteria
+	gc.Groups = nil
+	gc.Rules = nil
+	gc.RotatedBackups = nil
+	gc.PollInterval = g.PollInterval
+	gc.StartAt = cmp.Or(g.StartAt, c.StartAt)
+	gc.Encoding = cmp.Or(g.Encoding, c.Encoding)
+	if g.SplitConfig != nil {
+		gc.SplitConfig = *g.SplitConfig
+	}
+	if g.Resolver != nil {
+		gc.Resolver = *g.Resolver
+	}
+	gc.InitialBufferSize = cmp.Or(g.InitialBufferSize, c.InitialBufferSize)
+	gc.MaxBufferSize = cmp.Or(g.MaxBufferSize, c.MaxBufferSize)
+	return gc
+}
+
+// startGroupPollers starts the Managers of the groups which have their own poll interval.
+// Their offsets are stored in the scope of their group.
+func (m *Manager) startGroupPollers(persister operator.Persister) error {
+	for i, p := range m.groupPollers {
+		var scoped operator.Persister
+		if persister != nil {
+			scoped = operator.NewScopedPersister(p.scope, persister)
+		}
+		if err := p.manager.Start(scoped); err != nil {
+			for _, started := range m.groupPollers[:i] {
+				_ = started.manager.Stop()
+			}
+			return fmt.Errorf("%s: %w", p.scope, err)
+		}
+	}
+	return nil
+}
+
+func (m *Manager) stopGroupPollers() error {
+	var errs error
+	for _, p := range m.groupPollers {
+		errs = errors.Join(errs, p.manager.Stop())
+	}
+	return errs
+}
+
+// matchFiles returns the files matched by the top-level criteria and by each group,
+// and records the reader factory of each file. A file matched by more than one set
+// of criteria is read with the settings of the first one. The files matched by the
+// top-level criteria are read with the settings of the first rule which matches them.
+func (m *Manager) matchFiles() ([]string, error) {
+	if len(m.groups) == 0 && len(m.rules) == 0 && m.fileMatcher != nil {
+		return m.fileMatcher.MatchFiles()
+	}
+
+	var matches []string
+	var errs error
+	factories := make(map[string]*reader.Factory)
+	add := func(path string, factory *reader.Factory) {
+		if _, ok := factories[path]; ok {
+			return
+		}
+		factories[path] = factory
+		matches = append(matches, path)
+	}
+
+	if m.fileMatcher != nil {
+		paths, err := m.fileMatcher.MatchFiles()
+		errs = errors.Join(errs, err)
+		for _, path := range paths {
+			add(path, m.ruleFactory(path))
+		}
+	}
+	for _, g := range m.groups {
+		paths, err := g.matcher.MatchFiles()
+		errs = errors.Join(errs, err)
+		for _, path := range paths {
+			add(path, g.readerFactory)
+		}
+	}
+	m.groupFactories = factories
+	return matches, errs
+}
+
+// factoryFor returns the reader factory of the group which matched the path.
+// Paths that were not matched by a group, such as rotated backups, are read
+// with the top-level settings.
+func (m *Manager) factoryFor(path string) *reader.Factory {
+	if f, ok := m.groupFactories[path]; ok {
+		return f
+	}
+	return m.readerFactory
+}
+
+// releaseIdleBuffers releases the buffers kept for large tokens by the readers of all
+// groups and rules, if they were not used during the poll.
+func (m *Manager) releaseIdleBuffers() {
+	m.readerFactory.LargeBufPool.ReleaseIdle()
+	for _, g := range m.groups {
+		g.readerFactory.LargeBufPool.ReleaseIdle()
+	}
+	for _, r := range m.rules {
+		r.readerFactory.LargeBufPool.ReleaseIdle()
+	}
+}
+
+// setFromBeginning makes the readers of all groups and rules read new files from the beginning.
+func (m *Manager) setFromBeginning() {
+	m.readerFactory.FromBeginning = true
+	for _, g := range m.groups {
+		g.readerFactory.FromBeginning = true
+	}
+	for _, r := range m.rules {
+		r.readerFactory.FromBeginning = true
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"time"
 
 	"go.opentelemetry.io/collector/extension/xextension/storage"
 	"go.uber.org/zap"
@@ -26,9 +28,22 @@ const (
 type Archive interface {
 	FindFiles(context.Context, []*fingerprint.Fingerprint) []*reader.Metadata
 	WriteFiles(context.Context, *fileset.Fileset[*reader.Metadata])
+	// Len returns the number of files whose offsets are archived.
+	Len() int
 }
 
-func New(ctx context.Context, logger *zap.Logger, pollsToArchive int, persister operator.Persister) Archive {
+// Retention limits the offsets which are kept in the archive. Zero values do not limit it.
+type Retention struct {
+	// MaxAge is the time after which the offsets of a file which was last seen before are discarded.
+	MaxAge time.Duration
+	// MaxFiles is the number of files whose offsets are kept, the most recently archived first.
+	MaxFiles int
+	// Migrate adapts the offsets of a file to the current configuration when the archive is
+	// loaded, and reports whether they were changed.
+	Migrate func(*reader.Metadata) bool
+}
+
+func New(ctx context.Context, logger *zap.Logger, pollsToArchive int, retention Retention, persister operator.Persister) Archive {
 	if pollsToArchive <= 0 || persister == nil {
 		logger.Debug("archiving is disabled. enable pollsToArchive and storage settings to save offsets on disk.")
 		return &nopArchive{}
@@ -47,12 +62,17 @@ func New(ctx context.Context, logger *zap.Logger, pollsToArchive int, persister
 		// archiveIndex should point to index for the next write, hence increment it from last known value.
 		archiveIndex = (archiveIndex + 1) % pollsToArchive
 	}
-	return &archive{
+	a := &archive{
 		pollsToArchive: pollsToArchive,
 		persister:      persister,
 		archiveIndex:   archiveIndex,
 		logger:         logger,
+		retention:      retention,
+		sizes:          make([]int, pollsToArchive),
+		lastSeen:       make([]time.Time, pollsToArchive),
 	}
+	a.compact(ctx)
+	return a
 }
 
 type archive struct {
@@ -63,6 +83,12 @@ type archive struct {
 	// archiveIndex points to the index for the next write.
 	archiveIndex int
 	logger       *zap.Logger
+
+	retention Retention
+	// sizes holds the number of files archived under each index, and lastSeen the time at which
+	// the most recently seen of them was last seen, or the zero time if it is unknown.
+	sizes    []int
+	lastSeen []time.Time
 }
 
 func (a *archive) FindFiles(ctx context.Context, fps []*fingerprint.Fingerprint) []*reader.Metadata {
@@ -107,6 +133,7 @@ func (a *archive) FindFiles(ctx context.Context, fps []*fingerprint.Fingerprint)
 		if err := a.writeArchive(ctx, nextIndex, data); err != nil {
 			a.logger.Error("failed to write archive", zap.Error(err))
 		}
+		a.sizes[nextIndex] = data.Len()
 		// Check if all metadata have been found
 		if numMatched == len(fps) {
 			return matchedMetadata
@@ -141,7 +168,121 @@ func (a *archive) WriteFiles(ctx context.Context, metadata *fileset.Fileset[*rea
 	if err := a.writeArchive(ctx, a.archiveIndex, metadata, indexOp); err != nil {
 		a.logger.Error("failed to write archive", zap.Error(err))
 	}
+	a.sizes[a.archiveIndex], a.lastSeen[a.archiveIndex] = metadata.Len(), newestLastSeen(metadata.Get())
 	a.archiveIndex = (a.archiveIndex + 1) % a.pollsToArchive
+	a.collectGarbage(ctx)
+}
+
+func (a *archive) Len() int {
+	total := 0
+	for _, size := range a.sizes {
+		total += size
+	}
+	return total
+}
+
+// compact loads the size of each index of the archive, drops the offsets which are past
+// their retention, and deletes the indexes which are left empty.
+func (a *archive) compact(ctx context.Context) {
+	cutoff := a.cutoff()
+	for i := range a.pollsToArchive {
+		data, err := a.readArchive(ctx, i)
+		if err != nil {
+			a.logger.Error("failed to read archive", zap.Error(err))
+			continue
+		}
+		metadata := data.Get()
+		migrated := false
+		if a.retention.Migrate != nil {
+			for _, md := range metadata {
+				migrated = a.retention.Migrate(md) || migrated
+			}
+		}
+		kept := slices.DeleteFunc(slices.Clone(metadata), func(md *reader.Metadata) bool {
+			return !cutoff.IsZero() && !md.LastSeen.IsZero() && md.LastSeen.Before(cutoff)
+		})
+		if migrated || len(kept) != len(metadata) {
+			a.writeIndex(ctx, i, kept)
+		}
+		a.sizes[i], a.lastSeen[i] = len(kept), newestLastSeen(kept)
+	}
+	a.collectGarbage(ctx)
+}
+
+// collectGarbage deletes the indexes whose files were all last seen before the max age, and
+// the offsets of the least recently archived files which exceed the max number of files.
+func (a *archive) collectGarbage(ctx context.Context) {
+	if cutoff := a.cutoff(); !cutoff.IsZero() {
+		for i := range a.pollsToArchive {
+			if a.sizes[i] > 0 && !a.lastSeen[i].IsZero() && a.lastSeen[i].Before(cutoff) {
+				a.writeIndex(ctx, i, nil)
+			}
+		}
+	}
+
+	if a.retention.MaxFiles <= 0 {
+		return
+	}
+	// The index for the next write holds the least recently archived files
+	for i := range a.pollsToArchive {
+		excess := a.Len() - a.retention.MaxFiles
+		if excess <= 0 {
+			return
+		}
+		index := (a.archiveIndex + i) % a.pollsToArchive
+		if a.sizes[index] == 0 {
+			continue
+		}
+		if a.sizes[index] <= excess {
+			a.writeIndex(ctx, index, nil)
+			continue
+		}
+		data, err := a.readArchive(ctx, index)
+		if err != nil {
+			a.logger.Error("failed to read archive", zap.Error(err))
+			return
+		}
+		// Keep the most recently seen files of the index
+		metadata := data.Get()
+		slices.SortStableFunc(metadata, func(x, y *reader.Metadata) int {
+			return y.LastSeen.Compare(x.LastSeen)
+		})
+		a.writeIndex(ctx, index, metadata[:len(metadata)-excess])
+	}
+}
+
+// writeIndex replaces the files archived under the index, and deletes the index if none are left.
+func (a *archive) writeIndex(ctx context.Context, index int, metadata []*reader.Metadata) {
+	var err error
+	if len(metadata) == 0 {
+		err = a.persister.Delete(ctx, archiveKey(index))
+	} else {
+		err = checkpoint.SaveKey(ctx, a.persister, metadata, archiveKey(index))
+	}
+	if err != nil {
+		a.logger.Error("failed to write archive", zap.Error(err))
+		return
+	}
+	a.sizes[index], a.lastSeen[index] = len(metadata), newestLastSeen(metadata)
+}
+
+// cutoff returns the time before which files are past their retention, or the zero time
+// if there is no max age.
+func (a *archive) cutoff() time.Time {
+	if a.retention.MaxAge <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(-a.retention.MaxAge)
+}
+
+func newestLastSeen(metadata []*reader.Metadata) time.Time {
+	var newest time.Time
+	for _, md := range metadata {
+		if md.LastSeen.After(newest) {
+			newest = md.LastSeen
+		}
+	}
+	return newest
 }
 
 func (a *archive) readArchive(ctx context.Context, index int) (*fileset.Fileset[*reader.Metadata], error) {
@@ -185,3 +326,7 @@ func (*nopArchive) FindFiles(_ context.Context, fps []*fingerprint.Fingerprint)
 
 func (*nopArchive) WriteFiles(context.Context, *fileset.Fileset[*reader.Metadata]) {
 }
+
+func (*nopArchive) Len() int {
+	return 0
+}
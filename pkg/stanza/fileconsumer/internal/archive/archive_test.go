@@ -5,6 +5,7 @@ package archive_test // import "github.com/open-telemetry/opentelemetry-collecto
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -18,7 +19,7 @@ import (
 
 func TestArchiveNoRollover(t *testing.T) {
 	persister := testutil.NewUnscopedMockPersister()
-	a := archive.New(t.Context(), zap.L(), 3, persister)
+	a := archive.New(t.Context(), zap.L(), 3, archive.Retention{}, persister)
 
 	fp1 := fingerprint.New([]byte("fp1"))
 	fp2 := fingerprint.New([]byte("fp2"))
@@ -45,7 +46,7 @@ func TestArchiveNoRollover(t *testing.T) {
 
 func TestArchiveRollOver(t *testing.T) {
 	persister := testutil.NewUnscopedMockPersister()
-	a := archive.New(t.Context(), zap.L(), 3, persister)
+	a := archive.New(t.Context(), zap.L(), 3, archive.Retention{}, persister)
 
 	fp1 := fingerprint.New([]byte("fp1"))
 	fp2 := fingerprint.New([]byte("fp2"))
@@ -80,7 +81,7 @@ func TestArchiveRollOver(t *testing.T) {
 }
 
 func TestNopArchive(t *testing.T) {
-	a := archive.New(t.Context(), zap.L(), 3, nil)
+	a := archive.New(t.Context(), zap.L(), 3, archive.Retention{}, nil)
 
 	fp1 := fingerprint.New([]byte("fp1"))
 	fp2 := fingerprint.New([]byte("fp2"))
@@ -102,8 +103,105 @@ func TestNopArchive(t *testing.T) {
 	require.Nil(t, foundMetadata[0], "fingerprint should not be in nopArchive")
 }
 
+func TestArchiveMaxFiles(t *testing.T) {
+	persister := testutil.NewUnscopedMockPersister()
+	a := archive.New(t.Context(), zap.L(), 3, archive.Retention{MaxFiles: 3}, persister)
+
+	now := time.Now()
+	fp1 := fingerprint.New([]byte("fp1"))
+	fp2 := fingerprint.New([]byte("fp2"))
+	fp3 := fingerprint.New([]byte("fp3"))
+	fp4 := fingerprint.New([]byte("fp4"))
+	fp5 := fingerprint.New([]byte("fp5"))
+
+	a.WriteFiles(t.Context(), getFilesetLastSeen(now, fp1, fp2))
+	require.Equal(t, 2, a.Len())
+	a.WriteFiles(t.Context(), getFilesetLastSeen(now.Add(time.Second), fp3))
+	require.Equal(t, 3, a.Len())
+
+	// The least recently archived files are evicted first
+	a.WriteFiles(t.Context(), getFilesetLastSeen(now.Add(2*time.Second), fp4, fp5))
+	require.Equal(t, 3, a.Len())
+
+	foundMetadata := a.FindFiles(t.Context(), []*fingerprint.Fingerprint{fp1, fp2, fp3, fp4, fp5})
+	require.Nil(t, foundMetadata[0], "Expected fp1 to be evicted from archive")
+	require.Nil(t, foundMetadata[1], "Expected fp2 to be evicted from archive")
+	require.NotNil(t, foundMetadata[2])
+	require.NotNil(t, foundMetadata[3])
+	require.NotNil(t, foundMetadata[4])
+}
+
+func TestArchiveMaxAge(t *testing.T) {
+	persister := testutil.NewUnscopedMockPersister()
+	a := archive.New(t.Context(), zap.L(), 3, archive.Retention{MaxAge: time.Hour}, persister)
+
+	now := time.Now()
+	fp1 := fingerprint.New([]byte("fp1"))
+	fp2 := fingerprint.New([]byte("fp2"))
+
+	a.WriteFiles(t.Context(), getFilesetLastSeen(now.Add(-2*time.Hour), fp1))
+	require.Equal(t, 0, a.Len())
+	a.WriteFiles(t.Context(), getFilesetLastSeen(now, fp2))
+	require.Equal(t, 1, a.Len())
+
+	foundMetadata := a.FindFiles(t.Context(), []*fingerprint.Fingerprint{fp1, fp2})
+	require.Nil(t, foundMetadata[0], "Expected fp1 to be expired from archive")
+	require.True(t, fp2.Equal(foundMetadata[1].GetFingerprint()), "Expected fp2 to match")
+}
+
+func TestArchiveRetentionOnRestart(t *testing.T) {
+	persister := testutil.NewUnscopedMockPersister()
+	a := archive.New(t.Context(), zap.L(), 3, archive.Retention{}, persister)
+
+	now := time.Now()
+	fp1 := fingerprint.New([]byte("fp1"))
+	fp2 := fingerprint.New([]byte("fp2"))
+	fp3 := fingerprint.New([]byte("fp3"))
+
+	set := getFilesetLastSeen(now, fp1)
+	set.Add(&reader.Metadata{Fingerprint: fp2, LastSeen: now.Add(-2 * time.Hour)})
+	a.WriteFiles(t.Context(), set)
+	a.WriteFiles(t.Context(), getFilesetLastSeen(now, fp3))
+	require.Equal(t, 3, a.Len())
+
+	// The offsets archived before the restart are subject to the new retention
+	a = archive.New(t.Context(), zap.L(), 3, archive.Retention{MaxAge: time.Hour}, persister)
+	require.Equal(t, 2, a.Len())
+
+	foundMetadata := a.FindFiles(t.Context(), []*fingerprint.Fingerprint{fp1, fp2, fp3})
+	require.True(t, fp1.Equal(foundMetadata[0].GetFingerprint()), "Expected fp1 to match")
+	require.Nil(t, foundMetadata[1], "Expected fp2 to be expired from archive")
+	require.True(t, fp3.Equal(foundMetadata[2].GetFingerprint()), "Expected fp3 to match")
+}
+
+func TestArchiveMigrateOnRestart(t *testing.T) {
+	persister := testutil.NewUnscopedMockPersister()
+	a := archive.New(t.Context(), zap.L(), 3, archive.Retention{}, persister)
+	a.WriteFiles(t.Context(), getFileset(fingerprint.New([]byte("fp1 with a long fingerprint"))))
+
+	// The offsets archived before the restart are migrated, e.g. to a shorter fingerprint
+	migrate := func(md *reader.Metadata) bool {
+		md.Fingerprint = fingerprint.New(md.Fingerprint.Bytes()[:3])
+		return true
+	}
+	a = archive.New(t.Context(), zap.L(), 3, archive.Retention{Migrate: migrate}, persister)
+	require.Equal(t, 1, a.Len())
+
+	foundMetadata := a.FindFiles(t.Context(), []*fingerprint.Fingerprint{fingerprint.New([]byte("fp1 with another fingerprint"))})
+	require.NotNil(t, foundMetadata[0], "Expected the migrated fp1 to match")
+	require.Equal(t, 3, foundMetadata[0].GetFingerprint().Len())
+}
+
 func getFileset(fp *fingerprint.Fingerprint) *fileset.Fileset[*reader.Metadata] {
 	set := fileset.New[*reader.Metadata](0)
 	set.Add(&reader.Metadata{Fingerprint: fp})
 	return set
 }
+
+func getFilesetLastSeen(lastSeen time.Time, fps ...*fingerprint.Fingerprint) *fileset.Fileset[*reader.Metadata] {
+	set := fileset.New[*reader.Metadata](0)
+	for _, fp := range fps {
+		set.Add(&reader.Metadata{Fingerprint: fp, LastSeen: lastSeen})
+	}
+	return set
+}
@@ -78,7 +78,14 @@ func metadataToPb(rmd *reader.Metadata) (*pb.Metadata, error) {
 	}
 
 	// Convert Fingerprint — Bytes() already returns a copy
-	if rmd.Fingerprint != nil {
+	switch {
+	case rmd.Fingerprint == nil:
+	case rmd.Fingerprint.IsHashed():
+		pbMeta.Fingerprint = &pb.Fingerprint{
+			Hash:         rmd.Fingerprint.Hash(),
+			HashedLength: int64(rmd.Fingerprint.Len()),
+		}
+	default:
 		pbMeta.Fingerprint = &pb.Fingerprint{
 			FirstBytes: rmd.Fingerprint.Bytes(),
 		}
@@ -121,7 +128,11 @@ func pbToMetadata(pbMeta *pb.Metadata) (*reader.Metadata, error) {
 	}
 
 	// Convert Fingerprint
-	if pbMeta.Fingerprint != nil && len(pbMeta.Fingerprint.FirstBytes) > 0 {
+	switch {
+	case pbMeta.Fingerprint == nil:
+	case pbMeta.Fingerprint.HashedLength > 0:
+		rmd.Fingerprint = fingerprint.NewFromHash(pbMeta.Fingerprint.Hash, int(pbMeta.Fingerprint.HashedLength))
+	case len(pbMeta.Fingerprint.FirstBytes) > 0:
 		rmd.Fingerprint = fingerprint.New(pbMeta.Fingerprint.FirstBytes)
 	}
 
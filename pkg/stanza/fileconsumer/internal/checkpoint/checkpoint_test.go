@@ -385,6 +385,35 @@ func TestProtobufWithComplexMetadata(t *testing.T) {
 	require.Error(t, err, "Data should be protobuf, not JSON")
 }
 
+func TestHashedFingerprint(t *testing.T) {
+	fp := fingerprint.NewHashed([]byte("hashed-test-data"))
+	testData := []*reader.Metadata{
+		{
+			Fingerprint:    fp,
+			Offset:         16,
+			FileAttributes: map[string]any{},
+		},
+	}
+	expected := []*reader.Metadata{
+		{
+			Fingerprint:    fingerprint.NewFromHash(fp.Hash(), fp.Len()),
+			Offset:         16,
+			FileAttributes: map[string]any{},
+		},
+	}
+
+	for _, protobuf := range []bool{false, true} {
+		setProtobufEncoding(t, protobuf)
+		p := testutil.NewUnscopedMockPersister()
+		require.NoError(t, Save(t.Context(), p, testData))
+
+		reloaded, err := Load(t.Context(), p, zap.NewNop())
+		require.NoError(t, err)
+		require.Equal(t, expected, reloaded)
+		assert.True(t, fp.Equal(reloaded[0].Fingerprint))
+	}
+}
+
 func saveDeprecated(t *testing.T, persister operator.Persister, dep *deprecatedMetadata) {
 	var buf bytes.Buffer
 	enc := json.NewEncoder(&buf)
@@ -184,8 +184,13 @@ func (x *Metadata) GetFileType() string {
 
 // Fingerprint identifies a file by its first N bytes
 type Fingerprint struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FirstBytes    []byte                 `protobuf:"bytes,1,opt,name=first_bytes,json=firstBytes,proto3" json:"first_bytes,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	FirstBytes []byte                 `protobuf:"bytes,1,opt,name=first_bytes,json=firstBytes,proto3" json:"first_bytes,omitempty"`
+	// xxHash64 of the first hashed_length bytes, set instead of first_bytes
+	// for hashed fingerprints
+	Hash uint64 `protobuf:"fixed64,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Number of bytes covered by hash
+	HashedLength  int64 `protobuf:"varint,3,opt,name=hashed_length,json=hashedLength,proto3" json:"hashed_length,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -227,6 +232,20 @@ func (x *Fingerprint) GetFirstBytes() []byte {
 	return nil
 }
 
+func (x *Fingerprint) GetHash() uint64 {
+	if x != nil {
+		return x.Hash
+	}
+	return 0
+}
+
+func (x *Fingerprint) GetHashedLength() int64 {
+	if x != nil {
+		return x.HashedLength
+	}
+	return 0
+}
+
 // FlushState tracks timing for flush operations
 type FlushState struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -345,10 +364,12 @@ const file_checkpoint_proto_rawDesc = "" +
 	"\vflush_state\x18\x06 \x01(\v2\x16.checkpoint.FlushStateR\n" +
 	"flushState\x12A\n" +
 	"\x0ftoken_len_state\x18\a \x01(\v2\x19.checkpoint.TokenLenStateR\rtokenLenState\x12\x1b\n" +
-	"\tfile_type\x18\b \x01(\tR\bfileType\".\n" +
+	"\tfile_type\x18\b \x01(\tR\bfileType\"g\n" +
 	"\vFingerprint\x12\x1f\n" +
 	"\vfirst_bytes\x18\x01 \x01(\fR\n" +
-	"firstBytes\"r\n" +
+	"firstBytes\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\x06R\x04hash\x12#\n" +
+	"\rhashed_length\x18\x03 \x01(\x03R\fhashedLength\"r\n" +
 	"\n" +
 	"FlushState\x12:\n" +
 	"\x1alast_data_change_unix_nano\x18\x01 \x01(\x03R\x16lastDataChangeUnixNano\x12(\n" +
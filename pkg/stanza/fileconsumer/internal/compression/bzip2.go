@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package compression // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/compression"
+
+import (
+	"bytes"
+	"os"
+
+	"go.uber.org/zap"
+)
+
+const bzip2Header = "BZh" // bzip2 stream magic bytes, followed by the block size
+
+// The first block of a bzip2 stream starts with the BCD digits of pi, or the
+// end of stream marker with the BCD digits of sqrt(pi) for an empty stream.
+const (
+	bzip2BlockMagic     = "\x31\x41\x59\x26\x53\x59"
+	bzip2EndStreamMagic = "\x17\x72\x45\x38\x50\x90"
+)
+
+// IsBzip2File checks if a file is of bzip2 type by reading its header
+func IsBzip2File(f *os.File, logger *zap.Logger) bool {
+	return isBzip2Header(readHeader(f, len(bzip2Header)+1+len(bzip2BlockMagic), logger))
+}
+
+// isBzip2Header checks the block size and first block magic bytes as well, since
+// the three bytes of the stream magic alone could be the start of a plain text log.
+func isBzip2Header(header []byte) bool {
+	if len(header) < len(bzip2Header)+1+len(bzip2BlockMagic) || !bytes.HasPrefix(header, []byte(bzip2Header)) {
+		return false
+	}
+	if blockSize := header[len(bzip2Header)]; blockSize < '1' || blockSize > '9' {
+		return false
+	}
+	block := header[len(bzip2Header)+1:]
+	return bytes.HasPrefix(block, []byte(bzip2BlockMagic)) || bytes.HasPrefix(block, []byte(bzip2EndStreamMagic))
+}
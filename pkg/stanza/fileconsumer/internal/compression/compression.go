@@ -0,0 +1,185 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package compression // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/compression"
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
+	"go.uber.org/zap"
+)
+
+// Values of the compression setting.
+const (
+	Gzip  = "gzip"
+	Zstd  = "zstd"
+	Bzip2 = "bzip2"
+	Xz    = "xz"
+	Auto  = "auto"
+)
+
+// File types recorded in the reader metadata of compressed files.
+const (
+	GzipExtension  = ".gz"
+	ZstdExtension  = ".zst"
+	Bzip2Extension = ".bz2"
+	XzExtension    = ".xz"
+)
+
+// format describes a compression format recognized by its magic bytes.
+type format struct {
+	fileType  string
+	magic     string
+	newReader func(r io.Reader) (io.ReadCloser, error)
+	// match optionally replaces the magic bytes prefix check.
+	match func(header []byte) bool
+}
+
+var formats = []format{
+	{fileType: GzipExtension, magic: gzipHeader, newReader: newGzipReader},
+	{fileType: ZstdExtension, magic: zstdHeader, newReader: newZstdReader},
+	{fileType: Bzip2Extension, magic: bzip2Header + "1" + bzip2BlockMagic, match: isBzip2Header, newReader: newBzip2Reader},
+	{fileType: XzExtension, magic: xzHeader, newReader: newXzReader},
+}
+
+func (f format) matches(header []byte) bool {
+	if f.match != nil {
+		return f.match(header)
+	}
+	return bytes.HasPrefix(header, []byte(f.magic))
+}
+
+// maxHeaderLen is the number of bytes needed to recognize any of the formats.
+var maxHeaderLen = func() int {
+	n := 0
+	for _, f := range formats {
+		n = max(n, len(f.magic))
+	}
+	return n
+}()
+
+// FileType returns the file type of f for the given compression setting,
+// or "" if the file should be read as plain text.
+func FileType(f *os.File, compression string, logger *zap.Logger) string {
+	switch compression {
+	case Gzip:
+		if IsGzipFile(f, logger) {
+			return GzipExtension
+		}
+	case Zstd:
+		if IsZstdFile(f, logger) {
+			return ZstdExtension
+		}
+	case Bzip2:
+		if IsBzip2File(f, logger) {
+			return Bzip2Extension
+		}
+	case Xz:
+		if IsXzFile(f, logger) {
+			return XzExtension
+		}
+	case Auto:
+		return Detect(f, logger)
+	}
+	return ""
+}
+
+// Detect returns the file type of f based on the magic bytes at its start,
+// regardless of the file name, or "" if it is not of a known compressed type.
+func Detect(f *os.File, logger *zap.Logger) string {
+	header := readHeader(f, maxHeaderLen, logger)
+	for _, format := range formats {
+		if format.matches(header) {
+			return format.fileType
+		}
+	}
+	return ""
+}
+
+// NewReader returns a reader that decompresses r according to fileType.
+// The returned reader must be closed to release its resources.
+func NewReader(fileType string, r io.Reader) (io.ReadCloser, error) {
+	for _, format := range formats {
+		if format.fileType != fileType {
+			continue
+		}
+		return format.newReader(r)
+	}
+	return nil, fmt.Errorf("unsupported file type %q", fileType)
+}
+
+// Extension returns the file extension of the given compression, or "" if files
+// cannot be written with it.
+func Extension(compression string) string {
+	switch compression {
+	case Gzip:
+		return GzipExtension
+	case Zstd:
+		return ZstdExtension
+	}
+	return ""
+}
+
+// NewWriter returns a writer that compresses the data written to w with the given
+// compression, which must be one that has an Extension.
+// The returned writer must be closed to flush the compressed stream.
+func NewWriter(compression string, w io.Writer) (io.WriteCloser, error) {
+	switch compression {
+	case Gzip:
+		return gzip.NewWriter(w), nil
+	case Zstd:
+		zstdWriter, err := zstd.NewWriter(w)
+		if err != nil {
+			return nil, err
+		}
+		return zstdWriter, nil
+	}
+	return nil, fmt.Errorf("unsupported compression %q", compression)
+}
+
+func newZstdReader(r io.Reader) (io.ReadCloser, error) {
+	// A single goroutine is enough for log files and avoids leaking
+	// background workers for every ReadToEnd call.
+	zstdReader, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	return zstdReader.IOReadCloser(), nil
+}
+
+func newBzip2Reader(r io.Reader) (io.ReadCloser, error) {
+	return io.NopCloser(bzip2.NewReader(r)), nil
+}
+
+func newXzReader(r io.Reader) (io.ReadCloser, error) {
+	xzReader, err := xz.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(xzReader), nil
+}
+
+// hasHeader checks if a file starts with the given magic bytes
+func hasHeader(f *os.File, magic string, logger *zap.Logger) bool {
+	return bytes.Equal(readHeader(f, len(magic), logger), []byte(magic))
+}
+
+// readHeader returns up to n bytes from the start of a file
+func readHeader(f *os.File, n int, logger *zap.Logger) []byte {
+	header := make([]byte, n)
+	read, err := f.ReadAt(header, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		logger.Error(fmt.Sprintf("error reading file: %s: %s", f.Name(), err))
+		return nil
+	}
+	// an empty or too short file only matches shorter magic bytes
+	return header[:read]
+}
@@ -0,0 +1,185 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package compression
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ulikunitz/xz"
+	"go.uber.org/zap"
+)
+
+const testData = "this is test data\n"
+
+func gzipData(t *testing.T) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write([]byte(testData))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func zstdData(t *testing.T) []byte {
+	var buf bytes.Buffer
+	w, err := zstd.NewWriter(&buf)
+	require.NoError(t, err)
+	_, err = w.Write([]byte(testData))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+// bzip2Data is testData compressed with the bzip2 command line tool, since the
+// standard library only implements decompression.
+const bzip2Data = "\x42\x5a\x68\x39\x31\x41\x59\x26\x53\x59\x3e\x46\x7f\x15\x00\x00\x08\xd1\x80\x00\x10\x40\x00\x26\x60\x0c\x00\x20\x00\x31\x06\x4c\x40\xd1\xa0\x7a\x8c\x14\xe6\x35\xcc\x46\xf5\x9e\x2e\xe4\x8a\x70\xa1\x20\x7c\x8c\xfe\x2a"
+
+func xzData(t *testing.T) []byte {
+	var buf bytes.Buffer
+	w, err := xz.NewWriter(&buf)
+	require.NoError(t, err)
+	_, err = w.Write([]byte(testData))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func writeTempFile(t *testing.T, data []byte) *os.File {
+	f, err := os.Create(filepath.Join(t.TempDir(), "test.log"))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, f.Close()) })
+	_, err = f.Write(data)
+	require.NoError(t, err)
+	return f
+}
+
+func TestFileType(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        []byte
+		compression string
+		expected    string
+	}{
+		{name: "gzip_none", data: gzipData(t), compression: "", expected: ""},
+		{name: "gzip_gzip", data: gzipData(t), compression: Gzip, expected: GzipExtension},
+		{name: "gzip_zstd", data: gzipData(t), compression: Zstd, expected: ""},
+		{name: "gzip_auto", data: gzipData(t), compression: Auto, expected: GzipExtension},
+		{name: "zstd_none", data: zstdData(t), compression: "", expected: ""},
+		{name: "zstd_gzip", data: zstdData(t), compression: Gzip, expected: ""},
+		{name: "zstd_zstd", data: zstdData(t), compression: Zstd, expected: ZstdExtension},
+		{name: "zstd_auto", data: zstdData(t), compression: Auto, expected: ZstdExtension},
+		{name: "bzip2_gzip", data: []byte(bzip2Data), compression: Gzip, expected: ""},
+		{name: "bzip2_bzip2", data: []byte(bzip2Data), compression: Bzip2, expected: Bzip2Extension},
+		{name: "bzip2_auto", data: []byte(bzip2Data), compression: Auto, expected: Bzip2Extension},
+		{name: "xz_gzip", data: xzData(t), compression: Gzip, expected: ""},
+		{name: "xz_xz", data: xzData(t), compression: Xz, expected: XzExtension},
+		{name: "xz_auto", data: xzData(t), compression: Auto, expected: XzExtension},
+		{name: "plain_auto", data: []byte(testData), compression: Auto, expected: ""},
+		{name: "empty_auto", data: nil, compression: Auto, expected: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := writeTempFile(t, tc.data)
+			assert.Equal(t, tc.expected, FileType(f, tc.compression, zap.NewNop()))
+		})
+	}
+}
+
+func TestDetect(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     []byte
+		expected string
+	}{
+		{name: "gzip", data: gzipData(t), expected: GzipExtension},
+		{name: "zstd", data: zstdData(t), expected: ZstdExtension},
+		{name: "bzip2", data: []byte(bzip2Data), expected: Bzip2Extension},
+		{name: "bzip2_empty", data: []byte("BZh9\x17\x72\x45\x38\x50\x90\x00\x00\x00\x00"), expected: Bzip2Extension},
+		{name: "xz", data: xzData(t), expected: XzExtension},
+		{name: "plain", data: []byte(testData), expected: ""},
+		{name: "plain_bzip2_prefix", data: []byte("BZh is not a bzip2 stream\n"), expected: ""},
+		{name: "short", data: []byte("BZ"), expected: ""},
+		{name: "empty", data: nil, expected: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// The file name is irrelevant, only the content is inspected.
+			f := writeTempFile(t, tc.data)
+			assert.Equal(t, tc.expected, Detect(f, zap.NewNop()))
+		})
+	}
+}
+
+func TestNewReader(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     []byte
+		fileType string
+	}{
+		{name: "gzip", data: gzipData(t), fileType: GzipExtension},
+		{name: "zstd", data: zstdData(t), fileType: ZstdExtension},
+		{name: "bzip2", data: []byte(bzip2Data), fileType: Bzip2Extension},
+		{name: "xz", data: xzData(t), fileType: XzExtension},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := NewReader(tc.fileType, bytes.NewReader(tc.data))
+			require.NoError(t, err)
+			defer r.Close()
+
+			decompressed, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, testData, string(decompressed))
+		})
+	}
+
+	_, err := NewReader(".lz4", bytes.NewReader(nil))
+	require.ErrorContains(t, err, "unsupported file type")
+}
+
+func TestNewWriter(t *testing.T) {
+	testCases := []struct {
+		compression string
+		fileType    string
+	}{
+		{compression: Gzip, fileType: GzipExtension},
+		{compression: Zstd, fileType: ZstdExtension},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.compression, func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := NewWriter(tc.compression, &buf)
+			require.NoError(t, err)
+			_, err = w.Write([]byte(testData))
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+
+			fileType := Extension(tc.compression)
+			assert.Equal(t, tc.fileType, fileType)
+			r, err := NewReader(fileType, &buf)
+			require.NoError(t, err)
+			defer r.Close()
+
+			decompressed, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, testData, string(decompressed))
+		})
+	}
+
+	assert.Empty(t, Extension(Bzip2))
+	_, err := NewWriter(Bzip2, io.Discard)
+	require.ErrorContains(t, err, "unsupported compression")
+}
@@ -4,28 +4,181 @@
 package compression // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/compression"
 
 import (
-	"bytes"
+	"bufio"
+	"compress/gzip"
 	"errors"
-	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"go.uber.org/zap"
 )
 
 const gzipHeader = "\x1f\x8b" // RFC 1952 magic bytes
 
+// gzipMemberHeader starts every member of a gzip stream compressed with deflate.
+const gzipMemberHeader = gzipHeader + "\x08"
+
 // IsGzipFile checks if a file is of gzip type by reading its header
 func IsGzipFile(f *os.File, logger *zap.Logger) bool {
-	header := make([]byte, len(gzipHeader))
-	if _, err := f.ReadAt(header, 0); err != nil {
-		if errors.Is(err, io.EOF) {
-			return false // empty or too short file
+	return hasHeader(f, gzipHeader, logger)
+}
+
+// MemberReader is implemented by the decompressing readers which read a stream one member
+// at a time, so that reading can resume after the last member which was read completely.
+type MemberReader interface {
+	io.ReadCloser
+	// Resume returns the offset in the compressed stream after the last member which was
+	// read completely, and the number of bytes which were decompressed from the member after it.
+	Resume() (offset, read int64)
+	// CorruptMembers returns the number of corrupt members which were skipped.
+	CorruptMembers() int
+}
+
+// gzipMemberReader reads the concatenated members of a gzip stream. The trailer of each
+// member is validated, and a corrupt member is skipped rather than ending the stream.
+// A member which is cut short is left to be read again once the rest of it is written.
+type gzipMemberReader struct {
+	counter  *countingReader
+	gz       gzip.Reader
+	inMember bool
+	end      int64
+	read     int64
+	corrupt  int
+}
+
+func newGzipReader(r io.Reader) (io.ReadCloser, error) {
+	g := &gzipMemberReader{counter: &countingReader{r: bufio.NewReader(r)}}
+	// The header of the first member is read eagerly, so that data which is not
+	// gzip is rejected, as by gzip.NewReader
+	if err := g.gz.Reset(g.counter); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			// The header has not been written completely yet
+			return nil, io.EOF
 		}
+		return nil, err
+	}
+	g.gz.Multistream(false)
+	g.inMember = true
+	return g, nil
+}
 
-		logger.Error(fmt.Sprintf("error reading file: %s: %s", f.Name(), err))
-		return false
+func (g *gzipMemberReader) Read(p []byte) (int, error) {
+	for {
+		if !g.inMember {
+			if err := g.nextMember(); err != nil {
+				return 0, err
+			}
+		}
+
+		n, err := g.gz.Read(p)
+		g.read += int64(n)
+		switch {
+		case err == nil:
+			return n, nil
+		case errors.Is(err, io.EOF):
+			// The checksum and size in the trailer of the member matched its data
+			g.inMember = false
+			g.end, g.read = g.counter.n, 0
+		case errors.Is(err, io.ErrUnexpectedEOF):
+			// The rest of the member has not been written yet
+			return n, io.EOF
+		default:
+			g.corrupt++
+			g.skipMember()
+		}
+		if n > 0 {
+			return n, nil
+		}
 	}
+}
 
-	return bytes.Equal(header, []byte(gzipHeader))
+// nextMember reads the header of the next member. It returns io.EOF at the end of the
+// stream, or if the header has not been written completely yet.
+func (g *gzipMemberReader) nextMember() error {
+	for {
+		header, _ := g.counter.r.Peek(len(gzipMemberHeader))
+		switch {
+		case len(header) == 0:
+			return io.EOF
+		case !strings.HasPrefix(gzipMemberHeader, string(header)):
+			// Data which is not a gzip member follows the previous member
+			g.corrupt++
+			g.skipMember()
+			continue
+		case len(header) < len(gzipMemberHeader):
+			return io.EOF
+		}
+
+		err := g.gz.Reset(g.counter)
+		switch {
+		case err == nil:
+			g.gz.Multistream(false)
+			g.inMember = true
+			return nil
+		case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+			return io.EOF
+		default:
+			g.corrupt++
+			g.skipMember()
+		}
+	}
+}
+
+// skipMember skips the data up to the header of the next member, or up to the end of the stream.
+func (g *gzipMemberReader) skipMember() {
+	g.inMember = false
+	// The header of the corrupt member itself is never skipped to
+	if g.counter.n == g.end {
+		_, _ = g.counter.r.Discard(1)
+		g.counter.n++
+	}
+	for {
+		header, err := g.counter.r.Peek(len(gzipMemberHeader))
+		if string(header) == gzipMemberHeader {
+			break
+		}
+		if err != nil {
+			// Discard what remains of the stream, as a corrupt member is not read again
+			n, _ := g.counter.r.Discard(len(header))
+			g.counter.n += int64(n)
+			break
+		}
+		_, _ = g.counter.r.Discard(1)
+		g.counter.n++
+	}
+	g.end, g.read = g.counter.n, 0
+}
+
+func (g *gzipMemberReader) Close() error {
+	return g.gz.Close()
+}
+
+func (g *gzipMemberReader) Resume() (offset, read int64) {
+	return g.end, g.read
+}
+
+func (g *gzipMemberReader) CorruptMembers() int {
+	return g.corrupt
+}
+
+// countingReader counts the bytes read from r. It implements io.ByteReader, so that
+// the gzip reader does not read past the end of a member.
+type countingReader struct {
+	r *bufio.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func (c *countingReader) ReadByte() (byte, error) {
+	b, err := c.r.ReadByte()
+	if err == nil {
+		c.n++
+	}
+	return b, err
 }
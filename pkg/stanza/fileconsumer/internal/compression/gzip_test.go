@@ -4,12 +4,15 @@
 package compression
 
 import (
+	"bytes"
 	"compress/gzip"
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
@@ -47,3 +50,89 @@ func TestIsGzipFile(t *testing.T) {
 		require.False(t, IsGzipFile(tempFile, zap.NewNop()), "expected file to not be detected as gzip compressed")
 	})
 }
+
+func gzipMember(t *testing.T, content string) []byte {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	_, err := writer.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func TestGzipMemberReader(t *testing.T) {
+	first := gzipMember(t, "testlog1\n")
+	second := gzipMember(t, "testlog2\n")
+	corrupt := slices.Clone(second)
+	// The checksum in the trailer does not match the data
+	corrupt[len(corrupt)-8] ^= 0xff
+
+	testCases := []struct {
+		name           string
+		stream         []byte
+		expected       string
+		offset         int64
+		read           int64
+		corruptMembers int
+	}{
+		{
+			name:     "Complete",
+			stream:   slices.Concat(first, second),
+			expected: "testlog1\ntestlog2\n",
+			offset:   int64(len(first) + len(second)),
+		},
+		{
+			name:     "CutShort",
+			stream:   slices.Concat(first, second[:len(second)-4]),
+			expected: "testlog1\ntestlog2\n",
+			offset:   int64(len(first)),
+			read:     9,
+		},
+		{
+			name:     "CutShortHeader",
+			stream:   slices.Concat(first, second[:5]),
+			expected: "testlog1\n",
+			offset:   int64(len(first)),
+		},
+		{
+			name:           "CorruptTrailer",
+			stream:         slices.Concat(first, corrupt, second),
+			expected:       "testlog1\ntestlog2\ntestlog2\n",
+			offset:         int64(len(first) + len(corrupt) + len(second)),
+			corruptMembers: 1,
+		},
+		{
+			name:           "Garbage",
+			stream:         slices.Concat(first, []byte("garbage"), second),
+			expected:       "testlog1\ntestlog2\n",
+			offset:         int64(len(first) + len("garbage") + len(second)),
+			corruptMembers: 1,
+		},
+		{
+			name:           "TrailingGarbage",
+			stream:         slices.Concat(first, []byte("garbage")),
+			expected:       "testlog1\n",
+			offset:         int64(len(first) + len("garbage")),
+			corruptMembers: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reader, err := NewReader(GzipExtension, bytes.NewReader(tc.stream))
+			require.NoError(t, err)
+			defer reader.Close()
+
+			data, err := io.ReadAll(reader)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, string(data))
+
+			members, ok := reader.(MemberReader)
+			require.True(t, ok)
+			offset, read := members.Resume()
+			assert.Equal(t, tc.offset, offset)
+			assert.Equal(t, tc.read, read)
+			assert.Equal(t, tc.corruptMembers, members.CorruptMembers())
+		})
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package compression // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/compression"
+
+import (
+	"os"
+
+	"go.uber.org/zap"
+)
+
+const xzHeader = "\xfd7zXZ\x00" // xz stream header magic bytes
+
+// IsXzFile checks if a file is of xz type by reading its header
+func IsXzFile(f *os.File, logger *zap.Logger) bool {
+	return hasHeader(f, xzHeader, logger)
+}
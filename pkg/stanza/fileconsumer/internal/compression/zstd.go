@@ -0,0 +1,17 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package compression // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/compression"
+
+import (
+	"os"
+
+	"go.uber.org/zap"
+)
+
+const zstdHeader = "\x28\xb5\x2f\xfd" // RFC 8878 frame magic number
+
+// IsZstdFile checks if a file is of zstd type by reading its header
+func IsZstdFile(f *os.File, logger *zap.Logger) bool {
+	return hasHeader(f, zstdHeader, logger)
+}
@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package compression
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestIsZstdFile(t *testing.T) {
+	t.Run("file is zstd compressed", func(t *testing.T) {
+		temp, err := os.Create(filepath.Join(t.TempDir(), "test.log"))
+		require.NoError(t, err)
+		defer temp.Close()
+
+		tempWrite, err := zstd.NewWriter(temp)
+		require.NoError(t, err)
+		_, err = tempWrite.Write([]byte("this is test data and the header should prove this is zstd"))
+		require.NoError(t, err)
+		require.NoError(t, tempWrite.Close())
+
+		_, err = temp.Seek(0, io.SeekStart)
+		require.NoError(t, err)
+
+		require.True(t, IsZstdFile(temp, zap.NewNop()), "expected file to be detected as zstd compressed")
+		require.False(t, IsGzipFile(temp, zap.NewNop()), "expected file to not be detected as gzip compressed")
+	})
+
+	t.Run("file is NOT zstd compressed", func(t *testing.T) {
+		tempFile, err := os.Create(filepath.Join(t.TempDir(), "test1.log"))
+		require.NoError(t, err)
+		defer tempFile.Close()
+
+		_, err = tempFile.WriteString(
+			"this is test data and the header should prove this is not zstd compressed")
+		require.NoError(t, err)
+
+		require.False(t, IsZstdFile(tempFile, zap.NewNop()), "expected file to not be detected as zstd compressed")
+	})
+}
@@ -53,11 +53,14 @@ func NewSink(opts ...SinkOpt) *Sink {
 		emitChan: emitChan,
 		timeout:  cfg.timeout,
 		Callback: func(ctx context.Context, tokens [][]byte, attributes map[string]any, _ int64, _ []int64) error {
+			snapshot, _ := emit.FromContext(ctx)
 			for _, token := range tokens {
+				emitToken := emit.NewToken(token, attributes)
+				emitToken.Snapshot = snapshot
 				select {
 				case <-ctx.Done():
 					return ctx.Err()
-				case emitChan <- emit.NewToken(token, attributes):
+				case emitChan <- emitToken:
 				}
 			}
 			return nil
@@ -94,6 +97,16 @@ func (s *Sink) NextCall(t *testing.T) ([]byte, map[string]any) {
 	}
 }
 
+func (s *Sink) NextSnapshot(t *testing.T) ([]byte, emit.Snapshot) {
+	select {
+	case token := <-s.emitChan:
+		return token.Body, token.Snapshot
+	case <-time.After(s.timeout):
+		assert.Fail(t, "Timed out waiting for message")
+		return nil, emit.Snapshot{}
+	}
+}
+
 func (s *Sink) ExpectToken(t *testing.T, expected []byte) {
 	select {
 	case token := <-s.emitChan:
@@ -132,6 +145,8 @@ func (s *Sink) ExpectCalls(t *testing.T, expected ...emit.Token) {
 	for i := range expected {
 		select {
 		case call := <-s.emitChan:
+			// Snapshots are checked with NextSnapshot
+			call.Snapshot = emit.Snapshot{}
 			actual = append(actual, call)
 		case <-time.After(s.timeout):
 			assert.Fail(t, fmt.Sprintf("timeout: expected: %d, actual: %d", len(expected), i))
@@ -31,19 +31,31 @@ type bucketPos struct {
 	idx    int
 }
 
+// bucketIndex groups fingerprints by length and key
+type bucketIndex struct {
+	buckets map[int]map[string]*bucket
+	lengths []int
+}
+
+func (bi *bucketIndex) reset() {
+	clear(bi.buckets)
+	bi.lengths = bi.lengths[:0]
+}
+
 type Fileset[T Matchable] struct {
-	readers       []T
-	positions     []bucketPos
-	buckets       map[int]map[string]*bucket
-	bucketLengths []int
+	readers   []T
+	positions []bucketPos
+	// raw and hashed fingerprints have different keys, so they are indexed separately
+	raw    bucketIndex
+	hashed bucketIndex
 }
 
 func New[T Matchable](capacity int) *Fileset[T] {
 	return &Fileset[T]{
-		readers:       make([]T, 0, capacity),
-		positions:     make([]bucketPos, 0, capacity),
-		buckets:       make(map[int]map[string]*bucket),
-		bucketLengths: make([]int, 0),
+		readers:   make([]T, 0, capacity),
+		positions: make([]bucketPos, 0, capacity),
+		raw:       bucketIndex{buckets: make(map[int]map[string]*bucket), lengths: make([]int, 0)},
+		hashed:    bucketIndex{buckets: make(map[int]map[string]*bucket), lengths: make([]int, 0)},
 	}
 }
 
@@ -65,20 +77,17 @@ func (set *Fileset[T]) Reset() {
 		set.positions[i] = bucketPos{}
 	}
 	set.positions = set.positions[:0]
-	clear(set.buckets)
-	set.bucketLengths = set.bucketLengths[:0]
+	set.raw.reset()
+	set.hashed.reset()
 }
 
 func (set *Fileset[T]) Reindex() {
+	set.raw.reset()
+	set.hashed.reset()
 	if len(set.readers) == 0 {
-		clear(set.buckets)
-		set.bucketLengths = set.bucketLengths[:0]
 		return
 	}
 
-	clear(set.buckets)
-	set.bucketLengths = set.bucketLengths[:0]
-
 	for idx, reader := range set.readers {
 		pos := bucketPos{}
 		if fp := reader.GetFingerprint(); fp != nil && fp.Len() > 0 {
@@ -113,7 +122,7 @@ func (set *Fileset[T]) MatchEqual(fp *fingerprint.Fingerprint) T {
 	if fp == nil || fp.Len() == 0 {
 		return zero
 	}
-	bucketsByLength, ok := set.buckets[fp.Len()]
+	bucketsByLength, ok := set.indexFor(fp).buckets[fp.Len()]
 	if !ok {
 		return zero
 	}
@@ -134,26 +143,40 @@ func (set *Fileset[T]) MatchStartsWith(fp *fingerprint.Fingerprint) T {
 	if fp == nil || fp.Len() == 0 {
 		return zero
 	}
-	fpKey := fp.Key()
-	for _, length := range set.bucketLengths {
-		if length > len(fpKey) {
+	if match, ok := set.matchStartsWith(fp, &set.raw, fp.RawPrefixKey); ok {
+		return match
+	}
+	if match, ok := set.matchStartsWith(fp, &set.hashed, fp.HashPrefixKey); ok {
+		return match
+	}
+	return zero
+}
+
+func (set *Fileset[T]) matchStartsWith(fp *fingerprint.Fingerprint, bi *bucketIndex, prefixKey func(int) (string, bool)) (T, bool) {
+	var zero T
+	for _, length := range bi.lengths {
+		if length > fp.Len() {
+			continue
+		}
+		bucketsByLength, ok := bi.buckets[length]
+		if !ok {
 			continue
 		}
-		bucketsByLength, ok := set.buckets[length]
+		key, ok := prefixKey(length)
 		if !ok {
 			continue
 		}
-		bucket := bucketsByLength[fpKey[:length]]
+		bucket := bucketsByLength[key]
 		if bucket == nil {
 			continue
 		}
 		for _, idx := range slices.Backward(bucket.indices) {
 			if fp.StartsWith(set.readers[idx].GetFingerprint()) {
-				return set.removeAt(idx)
+				return set.removeAt(idx), true
 			}
 		}
 	}
-	return zero
+	return zero, false
 }
 
 func (set *Fileset[T]) Match(fp *fingerprint.Fingerprint, cmp func(a, b *fingerprint.Fingerprint) bool) T {
@@ -166,16 +189,31 @@ func (set *Fileset[T]) Match(fp *fingerprint.Fingerprint, cmp func(a, b *fingerp
 	return val
 }
 
+// MatchFunc removes and returns the first item whose fingerprint is a prefix of fp and which satisfies keep.
+func (set *Fileset[T]) MatchFunc(fp *fingerprint.Fingerprint, keep func(T) bool) T {
+	var val T
+	if fp == nil || fp.Len() == 0 {
+		return val
+	}
+	for idx, r := range set.readers {
+		if fp.StartsWith(r.GetFingerprint()) && keep(r) {
+			return set.removeAt(idx)
+		}
+	}
+	return val
+}
+
 func (set *Fileset[T]) insertIntoBucket(idx int, fp *fingerprint.Fingerprint) bucketPos {
 	keyLen := fp.Len()
 	key := fp.Key()
 
-	bucketsByLength := set.buckets[keyLen]
+	bi := set.indexFor(fp)
+	bucketsByLength := bi.buckets[keyLen]
 	if bucketsByLength == nil {
 		bucketsByLength = make(map[string]*bucket)
-		set.buckets[keyLen] = bucketsByLength
-		set.bucketLengths = append(set.bucketLengths, keyLen)
-		slices.SortFunc(set.bucketLengths, func(a, b int) int {
+		bi.buckets[keyLen] = bucketsByLength
+		bi.lengths = append(bi.lengths, keyLen)
+		slices.SortFunc(bi.lengths, func(a, b int) int {
 			return b - a
 		})
 	}
@@ -193,6 +231,13 @@ func (set *Fileset[T]) insertIntoBucket(idx int, fp *fingerprint.Fingerprint) bu
 	}
 }
 
+func (set *Fileset[T]) indexFor(fp *fingerprint.Fingerprint) *bucketIndex {
+	if fp.IsHashed() {
+		return &set.hashed
+	}
+	return &set.raw
+}
+
 func (set *Fileset[T]) removeAt(idx int) T {
 	val := set.readers[idx]
 	set.removeFromBucket(idx)
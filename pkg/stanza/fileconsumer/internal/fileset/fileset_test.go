@@ -186,3 +186,20 @@ func TestFilesetReindexAfterFingerprintChange(t *testing.T) {
 	matched := fs.MatchStartsWith(fingerprint.New([]byte("ABCDEFGH")))
 	require.Equal(t, r, matched)
 }
+
+func TestFilesetHashedFingerprints(t *testing.T) {
+	fs := New[*reader.Reader](10)
+	raw := newReader([]byte("ABC"))
+	hashed := &reader.Reader{
+		Metadata: &reader.Metadata{
+			Fingerprint: fingerprint.NewFromHash(fingerprint.NewHashed([]byte("XYZ")).Hash(), 3),
+		},
+	}
+	fs.Add(raw, hashed)
+
+	require.Equal(t, hashed, fs.MatchEqual(fingerprint.NewHashed([]byte("XYZ"))))
+	fs.Add(hashed)
+	require.Equal(t, hashed, fs.MatchStartsWith(fingerprint.NewHashed([]byte("XYZW"))))
+	require.Equal(t, raw, fs.MatchStartsWith(fingerprint.NewHashed([]byte("ABCD"))))
+	require.Equal(t, 0, fs.Len())
+}
@@ -5,13 +5,14 @@ package fingerprint // import "github.com/open-telemetry/opentelemetry-collector
 
 import (
 	"bytes"
-	"compress/gzip"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 
+	"github.com/cespare/xxhash/v2"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/compression"
@@ -23,29 +24,49 @@ const MinSize = 16 // bytes
 
 // Fingerprint is used to identify a file
 // A file's fingerprint is the first N bytes of the file
+//
+// A hashed fingerprint is keyed and persisted by the xxHash64 of the first
+// N bytes instead. Its bytes are kept while it is computed from a file, so that
+// the hash of any prefix can be compared to older fingerprints, but hashed
+// fingerprints loaded from a checkpoint only know the hash of their N bytes.
 type Fingerprint struct {
 	firstBytes []byte
 	key        string
+	hashed     bool
+	hash       uint64
+	hashLen    int
 }
 
 func New(first []byte) *Fingerprint {
 	return &Fingerprint{firstBytes: first}
 }
 
+// NewHashed creates a hashed fingerprint of the first bytes of a file
+func NewHashed(first []byte) *Fingerprint {
+	return &Fingerprint{firstBytes: first, hashed: true, hash: xxhash.Sum64(first), hashLen: len(first)}
+}
+
+// NewFromHash creates a hashed fingerprint from the hash of the first 'length' bytes of a file
+func NewFromHash(hash uint64, length int) *Fingerprint {
+	return &Fingerprint{hashed: true, hash: hash, hashLen: length}
+}
+
 // NewFromFile computes fingerprint of the given file using first 'N' bytes
 // Set decompressData to true to compute fingerprint of compressed files by decompressing its data first
 func NewFromFile(file *os.File, size int, decompressData bool, logger *zap.Logger) (*Fingerprint, error) {
 	buf := make([]byte, size)
-	if decompressData && compression.IsGzipFile(file, logger) {
+	if fileType := detectFileType(file, decompressData, logger); fileType != "" {
 		// If the file is of compressed type, uncompress the data before creating its fingerprint
-		uncompressedData, err := gzip.NewReader(file)
+		uncompressedData, err := compression.NewReader(fileType, file)
 		if err != nil {
-			return nil, fmt.Errorf("error uncompressing gzip file: %w", err)
+			return nil, fmt.Errorf("error uncompressing %s file: %w", fileType, err)
 		}
 		defer uncompressedData.Close()
 
-		n, err := uncompressedData.Read(buf)
-		if err != nil && !errors.Is(err, io.EOF) {
+		// Decompressors may return less than a full buffer per call, e.g. at
+		// frame boundaries, so read until the buffer is full or the data ends.
+		n, err := io.ReadFull(uncompressedData, buf)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
 			return nil, fmt.Errorf("error reading fingerprint bytes: %w", err)
 		}
 		return New(buf[:n]), nil
@@ -58,33 +79,119 @@ func NewFromFile(file *os.File, size int, decompressData bool, logger *zap.Logge
 	return New(buf[:n]), nil
 }
 
+func detectFileType(file *os.File, decompressData bool, logger *zap.Logger) string {
+	if !decompressData {
+		return ""
+	}
+	return compression.Detect(file, logger)
+}
+
 // Copy creates a new copy of the fingerprint
 func (f Fingerprint) Copy() *Fingerprint {
 	buf := make([]byte, len(f.firstBytes), cap(f.firstBytes))
 	n := copy(buf, f.firstBytes)
-	return &Fingerprint{firstBytes: buf[:n]}
+	return &Fingerprint{firstBytes: buf[:n], hashed: f.hashed, hash: f.hash, hashLen: f.hashLen}
+}
+
+// Hashed returns a hashed fingerprint of the same bytes
+func (f *Fingerprint) Hashed() *Fingerprint {
+	if f.hashed {
+		return f
+	}
+	return NewHashed(f.firstBytes)
+}
+
+// IsHashed returns true if the fingerprint is keyed and persisted by its hash
+func (f *Fingerprint) IsHashed() bool {
+	return f.hashed
+}
+
+// Hash returns the hash of a hashed fingerprint
+func (f *Fingerprint) Hash() uint64 {
+	return f.hash
 }
 
 func (f *Fingerprint) Len() int {
+	if f.hashed {
+		return f.hashLen
+	}
 	return len(f.firstBytes)
 }
 
 func (f *Fingerprint) Key() string {
-	if f == nil || len(f.firstBytes) == 0 {
+	if f == nil || f.Len() == 0 {
 		return ""
 	}
 	if f.key == "" {
-		f.key = string(f.firstBytes)
+		if f.hashed {
+			f.key = hashKey(f.hash)
+		} else {
+			f.key = string(f.firstBytes)
+		}
 	}
 	return f.key
 }
 
+// RawPrefixKey returns the key of an unhashed fingerprint of the first n bytes,
+// or false if the bytes are not known
+func (f *Fingerprint) RawPrefixKey(n int) (string, bool) {
+	if !f.HasBytes() || n > len(f.firstBytes) {
+		return "", false
+	}
+	if !f.hashed {
+		return f.Key()[:n], true
+	}
+	return string(f.firstBytes[:n]), true
+}
+
+// HashPrefixKey returns the key of a hashed fingerprint of the first n bytes,
+// or false if the bytes are not known
+func (f *Fingerprint) HashPrefixKey(n int) (string, bool) {
+	if f.hashed && n == f.hashLen {
+		return f.Key(), true
+	}
+	h, ok := f.prefixHash(n)
+	if !ok {
+		return "", false
+	}
+	return hashKey(h), true
+}
+
+// HasBytes returns false for hashed fingerprints loaded from a checkpoint,
+// which only know the hash of their bytes
+func (f *Fingerprint) HasBytes() bool {
+	return !f.hashed || len(f.firstBytes) == f.hashLen
+}
+
+// prefixHash returns the hash of the first n bytes, or false if it is not known
+func (f *Fingerprint) prefixHash(n int) (uint64, bool) {
+	if f.hashed && n == f.hashLen {
+		return f.hash, true
+	}
+	if !f.HasBytes() || n > len(f.firstBytes) {
+		return 0, false
+	}
+	return xxhash.Sum64(f.firstBytes[:n]), true
+}
+
+func hashKey(hash uint64) string {
+	return string(binary.BigEndian.AppendUint64(nil, hash))
+}
+
 // Equal returns true if the fingerprints have the same FirstBytes,
 // false otherwise. This does not compare other aspects of the fingerprints
 // because the primary purpose of a fingerprint is to convey a unique
 // identity, and only the FirstBytes field contributes to this goal.
 func (f Fingerprint) Equal(other *Fingerprint) bool {
-	return bytes.Equal(f.firstBytes, other.firstBytes)
+	if f.HasBytes() && other.HasBytes() {
+		return bytes.Equal(f.firstBytes, other.firstBytes)
+	}
+	if f.Len() != other.Len() {
+		return false
+	}
+	h0, ok0 := f.prefixHash(f.Len())
+	h1, ok1 := other.prefixHash(other.Len())
+	return ok0 && ok1 && h0 == h1
 }
 
 // StartsWith returns true if the fingerprints are the same
@@ -94,19 +201,29 @@ func (f Fingerprint) Equal(other *Fingerprint) bool {
 // a fingerprint. As the file grows, its fingerprint is updated
 // until it reaches a maximum size, as configured on the operator
 func (f Fingerprint) StartsWith(old *Fingerprint) bool {
-	l0 := len(old.firstBytes)
+	l0 := old.Len()
 	if l0 == 0 {
 		return false
 	}
-	l1 := len(f.firstBytes)
+	l1 := f.Len()
 	if l0 > l1 {
 		return false
 	}
-	return bytes.Equal(old.firstBytes[:l0], f.firstBytes[:l0])
+	if f.HasBytes() && old.HasBytes() {
+		return bytes.Equal(old.firstBytes[:l0], f.firstBytes[:l0])
+	}
+	// Compare the hashes of the common prefix, which is only known
+	// if the newer fingerprint has its bytes or the lengths are equal.
+	h0, ok0 := old.prefixHash(l0)
+	h1, ok1 := f.prefixHash(l0)
+	return ok0 && ok1 && h0 == h1
 }
 
 func (f *Fingerprint) MarshalJSON() ([]byte, error) {
 	m := marshal{FirstBytes: f.firstBytes}
+	if f.hashed {
+		m = marshal{Hash: f.hash, HashedLength: f.hashLen}
+	}
 	return json.Marshal(&m)
 }
 
@@ -115,15 +232,22 @@ func (f *Fingerprint) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, m); err != nil {
 		return err
 	}
+	if m.HashedLength > 0 {
+		*f = *NewFromHash(m.Hash, m.HashedLength)
+		return nil
+	}
 	f.firstBytes = m.FirstBytes
 	return nil
 }
 
 type marshal struct {
-	FirstBytes []byte `json:"first_bytes"`
+	FirstBytes   []byte `json:"first_bytes"`
+	Hash         uint64 `json:"hash,omitempty"`
+	HashedLength int    `json:"hashed_length,omitempty"`
 }
 
 // Bytes returns a copy of the raw fingerprint bytes.
+// Hashed fingerprints loaded from a checkpoint have no bytes.
 func (f *Fingerprint) Bytes() []byte {
 	buf := make([]byte, len(f.firstBytes))
 	copy(buf, f.firstBytes)
@@ -220,6 +220,38 @@ func TestStartsWith(t *testing.T) {
 	require.False(t, helloworld.StartsWith(world))
 }
 
+func TestHashedEqual(t *testing.T) {
+	hello := NewHashed([]byte("hello"))
+	world := NewHashed([]byte("world"))
+	loaded := NewFromHash(hello.Hash(), hello.Len())
+
+	require.True(t, hello.IsHashed())
+	require.Equal(t, 5, hello.Len())
+	require.True(t, hello.Equal(New([]byte("hello"))))
+	require.True(t, hello.Equal(loaded))
+	require.True(t, loaded.Equal(hello))
+	require.True(t, loaded.Equal(New([]byte("hello"))))
+
+	require.False(t, hello.Equal(world))
+	require.False(t, loaded.Equal(world))
+	require.False(t, loaded.Equal(NewHashed([]byte("helloworld"))))
+	require.Empty(t, loaded.Bytes())
+}
+
+func TestHashedStartsWith(t *testing.T) {
+	hello := NewFromHash(NewHashed([]byte("hello")).Hash(), 5)
+	helloworld := NewHashed([]byte("helloworld"))
+
+	require.True(t, helloworld.StartsWith(hello))
+	require.True(t, helloworld.StartsWith(New([]byte("hello"))))
+	require.True(t, hello.StartsWith(hello))
+	require.False(t, helloworld.StartsWith(NewHashed([]byte("world"))))
+
+	// The prefix of a loaded fingerprint is unknown
+	require.False(t, NewFromHash(helloworld.Hash(), 10).StartsWith(hello))
+	require.False(t, hello.StartsWith(NewFromHash(0, 0)))
+}
+
 // Generates a file filled with many random bytes, then
 // writes the same bytes to a second file, one byte at a time.
 // Validates, after each byte is written, that fingerprint
@@ -314,6 +346,19 @@ func TestMarshalUnmarshal(t *testing.T) {
 	require.Equal(t, fp, fp2)
 }
 
+func TestMarshalUnmarshalHashed(t *testing.T) {
+	fp := NewHashed([]byte("hello"))
+	b, err := fp.MarshalJSON()
+	require.NoError(t, err)
+
+	fp2 := new(Fingerprint)
+	require.NoError(t, fp2.UnmarshalJSON(b))
+
+	require.Equal(t, NewFromHash(fp.Hash(), 5), fp2)
+	require.True(t, fp.Equal(fp2))
+	require.Equal(t, fp.Key(), fp2.Key())
+}
+
 // Test compressed and uncompressed file with same content have equal fingerprint
 func TestCompressionFingerprint(t *testing.T) {
 	tmp := t.TempDir()
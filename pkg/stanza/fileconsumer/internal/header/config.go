@@ -23,24 +23,61 @@ type Config struct {
 	regex             *regexp.Regexp
 	SplitFunc         bufio.SplitFunc
 	metadataOperators []operator.Config
+	// resourceAttributes maps the named capture groups of regex to resource attributes.
+	resourceAttributes map[string]string
 }
 
-func NewConfig(set component.TelemetrySettings, matchRegex string, metadataOperators []operator.Config, enc encoding.Encoding) (*Config, error) {
+func NewConfig(set component.TelemetrySettings, matchRegex string, metadataOperators []operator.Config, resourceAttributes map[string]string, enc encoding.Encoding) (*Config, error) {
 	var err error
-	if len(metadataOperators) == 0 {
-		return nil, errors.New("at least one operator must be specified for `metadata_operators`")
+	if len(metadataOperators) == 0 && len(resourceAttributes) == 0 {
+		return nil, errors.New("at least one operator must be specified for `metadata_operators`, unless `resource_attributes` is set")
 	}
 
 	if enc == nil {
 		return nil, errors.New("encoding must be specified")
 	}
 
+	if len(metadataOperators) > 0 {
+		if err = validateMetadataOperators(set, metadataOperators); err != nil {
+			return nil, err
+		}
+	}
+
+	regex, err := regexp.Compile(matchRegex)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile `pattern`: %w", err)
+	}
+	for group := range resourceAttributes {
+		if regex.SubexpIndex(group) < 0 {
+			return nil, fmt.Errorf("`pattern` has no capture group named '%s' for `resource_attributes`", group)
+		}
+	}
+
+	splitFunc, err := split.NewlineSplitFunc(enc, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create split func: %w", err)
+	}
+
+	var trimFunc trim.Func = func(b []byte) []byte {
+		return bytes.Trim(b, "\r\n")
+	}
+	splitFunc = trim.WithFunc(splitFunc, trimFunc)
+
+	return &Config{
+		regex:              regex,
+		SplitFunc:          splitFunc,
+		metadataOperators:  metadataOperators,
+		resourceAttributes: resourceAttributes,
+	}, nil
+}
+
+func validateMetadataOperators(set component.TelemetrySettings, metadataOperators []operator.Config) error {
 	p, err := pipeline.Config{
 		Operators:     metadataOperators,
 		DefaultOutput: newPipelineOutput(set),
 	}.Build(set)
 	if err != nil {
-		return nil, fmt.Errorf("failed to build pipelines: %w", err)
+		return fmt.Errorf("failed to build pipelines: %w", err)
 	}
 
 	for _, op := range p.Operators() {
@@ -50,37 +87,17 @@ func NewConfig(set component.TelemetrySettings, matchRegex string, metadataOpera
 		}
 
 		if !op.CanProcess() {
-			return nil, fmt.Errorf("operator '%s' in `metadata_operators` cannot process entries", op.ID())
+			return fmt.Errorf("operator '%s' in `metadata_operators` cannot process entries", op.ID())
 		}
 
 		if !op.CanOutput() {
-			return nil, fmt.Errorf("operator '%s' in `metadata_operators` does not propagate entries", op.ID())
+			return fmt.Errorf("operator '%s' in `metadata_operators` does not propagate entries", op.ID())
 		}
 
 		// Filter processor also may fail to propagate some entries
 		if op.Type() == "filter" {
-			return nil, errors.New("operator of type filter is not allowed in `metadata_operators`")
+			return errors.New("operator of type filter is not allowed in `metadata_operators`")
 		}
 	}
-
-	regex, err := regexp.Compile(matchRegex)
-	if err != nil {
-		return nil, fmt.Errorf("failed to compile `pattern`: %w", err)
-	}
-
-	splitFunc, err := split.NewlineSplitFunc(enc, false)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create split func: %w", err)
-	}
-
-	var trimFunc trim.Func = func(b []byte) []byte {
-		return bytes.Trim(b, "\r\n")
-	}
-	splitFunc = trim.WithFunc(splitFunc, trimFunc)
-
-	return &Config{
-		regex:             regex,
-		SplitFunc:         splitFunc,
-		metadataOperators: metadataOperators,
-	}, nil
+	return nil
 }
@@ -32,11 +32,12 @@ func TestBuild(t *testing.T) {
 	filterConfg.Expression = "true"
 
 	testCases := []struct {
-		name        string
-		enc         encoding.Encoding
-		pattern     string
-		ops         []operator.Config
-		expectedErr string
+		name               string
+		enc                encoding.Encoding
+		pattern            string
+		ops                []operator.Config
+		resourceAttributes map[string]string
+		expectedErr        string
 	}{
 		{
 			name:    "valid config",
@@ -74,7 +75,20 @@ func TestBuild(t *testing.T) {
 			enc:         unicode.UTF8,
 			pattern:     "^#",
 			ops:         []operator.Config{},
-			expectedErr: "at least one operator must be specified for `metadata_operators`",
+			expectedErr: "at least one operator must be specified for `metadata_operators`, unless `resource_attributes` is set",
+		},
+		{
+			name:               "Only resource attributes specified",
+			enc:                unicode.UTF8,
+			pattern:            "^#Software: (?P<software>.*)",
+			resourceAttributes: map[string]string{"software": "service.name"},
+		},
+		{
+			name:               "Unknown capture group",
+			enc:                unicode.UTF8,
+			pattern:            "^#Software: (?P<software>.*)",
+			resourceAttributes: map[string]string{"version": "service.version"},
+			expectedErr:        "`pattern` has no capture group named 'version' for `resource_attributes`",
 		},
 		{
 			name:    "No encoding specified",
@@ -136,7 +150,7 @@ func TestBuild(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			set := componenttest.NewNopTelemetrySettings()
 			set.Logger = zaptest.NewLogger(t)
-			h, err := NewConfig(set, tc.pattern, tc.ops, tc.enc)
+			h, err := NewConfig(set, tc.pattern, tc.ops, tc.resourceAttributes, tc.enc)
 			if tc.expectedErr != "" {
 				require.ErrorContains(t, err, tc.expectedErr)
 			} else {
@@ -14,6 +14,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/pipeline"
 )
 
@@ -28,6 +29,10 @@ type Reader struct {
 
 func NewReader(set component.TelemetrySettings, cfg Config) (*Reader, error) {
 	r := &Reader{set: set, cfg: cfg}
+	if len(cfg.metadataOperators) == 0 && len(cfg.resourceAttributes) > 0 {
+		// The header lines are only matched against the pattern
+		return r, nil
+	}
 	var err error
 	r.output = newPipelineOutput(set)
 	r.pipeline, err = pipeline.Config{
@@ -46,10 +51,20 @@ func NewReader(set component.TelemetrySettings, cfg Config) (*Reader, error) {
 // Process checks if the given token is a line of the header, and consumes it if it is.
 // An EndOfHeaderError is returned if the given line was not a header line.
 func (r *Reader) Process(ctx context.Context, token string, fileAttributes map[string]any) error {
-	if !r.cfg.regex.MatchString(token) {
+	matches := r.cfg.regex.FindStringSubmatch(token)
+	if matches == nil {
 		return ErrEndOfHeader
 	}
 
+	for group, key := range r.cfg.resourceAttributes {
+		if value := matches[r.cfg.regex.SubexpIndex(group)]; value != "" {
+			resource(fileAttributes)[key] = value
+		}
+	}
+	if r.pipeline == nil {
+		return nil
+	}
+
 	firstOperator := r.pipeline.Operators()[0]
 
 	newEntry := entry.New()
@@ -68,9 +83,25 @@ func (r *Reader) Process(ctx context.Context, token string, fileAttributes map[s
 	// Copy resultant attributes over current set of attributes (upsert)
 	// fileAttributes is an output parameter
 	maps.Copy(fileAttributes, ent.Attributes)
+	if len(ent.Resource) > 0 {
+		maps.Copy(resource(fileAttributes), ent.Resource)
+	}
 	return nil
 }
 
 func (r *Reader) Stop() error {
+	if r.pipeline == nil {
+		return nil
+	}
 	return r.pipeline.Stop()
 }
+
+// resource returns the resource attributes held in fileAttributes, adding them if needed.
+func resource(fileAttributes map[string]any) map[string]any {
+	res, ok := fileAttributes[attrs.LogFileResource].(map[string]any)
+	if !ok {
+		res = make(map[string]any)
+		fileAttributes[attrs.LogFileResource] = res
+	}
+	return res
+}
@@ -13,9 +13,11 @@ import (
 	"golang.org/x/text/encoding/unicode"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/keyvalue"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/regex"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/transformer/move"
 )
 
 func TestReader(t *testing.T) {
@@ -32,7 +34,7 @@ func TestReader(t *testing.T) {
 	cfg, err := NewConfig(set, "^#", []operator.Config{
 		{Builder: regexConf},
 		{Builder: kvConf},
-	}, unicode.UTF8)
+	}, nil, unicode.UTF8)
 	require.NoError(t, err)
 
 	reader, err := NewReader(set, *cfg)
@@ -63,7 +65,7 @@ func TestSkipUnmatchedHeaderLine(t *testing.T) {
 	cfg, err := NewConfig(set, "^#", []operator.Config{
 		{Builder: regexConf},
 		{Builder: kvConf},
-	}, unicode.UTF8)
+	}, nil, unicode.UTF8)
 	require.NoError(t, err)
 
 	reader, err := NewReader(set, *cfg)
@@ -81,6 +83,64 @@ func TestSkipUnmatchedHeaderLine(t *testing.T) {
 	assert.NoError(t, reader.Stop())
 }
 
+func TestReaderResourceAttributes(t *testing.T) {
+	regexConf := regex.NewConfig()
+	regexConf.Regex = "^#(?P<key>[^:]+): (?P<value>.*)"
+	regexConf.ParseTo = entry.RootableField{Field: entry.NewBodyField()}
+
+	moveConf := move.NewConfig()
+	moveConf.From = entry.NewBodyField("value")
+	moveConf.To = entry.NewResourceField("header.value")
+
+	// W3C extended log headers, as written by IIS
+	pattern := "^#(?:Software: (?P<software>.*)|Version: (?P<version>.*)|.*)"
+	resourceAttributes := map[string]string{"software": "w3c.software", "version": "w3c.version"}
+
+	testCases := []struct {
+		name     string
+		ops      []operator.Config
+		expected map[string]any
+	}{
+		{
+			name: "OnlyResourceAttributes",
+			expected: map[string]any{
+				"w3c.software": "Microsoft Internet Information Services 10.0",
+				"w3c.version":  "1.0",
+			},
+		},
+		{
+			name: "WithMetadataOperators",
+			ops:  []operator.Config{{Builder: regexConf}, {Builder: moveConf}},
+			expected: map[string]any{
+				"w3c.software": "Microsoft Internet Information Services 10.0",
+				"w3c.version":  "1.0",
+				"header.value": "2024-01-01 00:00:00",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			set := componenttest.NewNopTelemetrySettings()
+			set.Logger = zaptest.NewLogger(t)
+			cfg, err := NewConfig(set, pattern, tc.ops, resourceAttributes, unicode.UTF8)
+			require.NoError(t, err)
+
+			reader, err := NewReader(set, *cfg)
+			require.NoError(t, err)
+
+			attributes := make(map[string]any)
+			assert.NoError(t, reader.Process(t.Context(), "#Software: Microsoft Internet Information Services 10.0", attributes))
+			assert.NoError(t, reader.Process(t.Context(), "#Version: 1.0", attributes))
+			assert.NoError(t, reader.Process(t.Context(), "#Date: 2024-01-01 00:00:00", attributes))
+			assert.ErrorIs(t, reader.Process(t.Context(), "2024-01-01 00:00:01 GET /", attributes), ErrEndOfHeader)
+			assert.Equal(t, map[string]any{attrs.LogFileResource: tc.expected}, attributes)
+
+			assert.NoError(t, reader.Stop())
+		})
+	}
+}
+
 func TestNewReaderErr(t *testing.T) {
 	set := componenttest.NewNopTelemetrySettings()
 	set.Logger = zaptest.NewLogger(t)
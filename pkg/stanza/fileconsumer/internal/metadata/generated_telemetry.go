@@ -3,11 +3,13 @@
 package metadata
 
 import (
+	"context"
 	"errors"
 	"sync"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/embedded"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -22,11 +24,19 @@ func Tracer(settings component.TelemetrySettings) trace.Tracer {
 // TelemetryBuilder provides an interface for components to report telemetry
 // as defined in metadata and user config.
 type TelemetryBuilder struct {
-	meter                    metric.Meter
-	mu                       sync.Mutex
-	registrations            []metric.Registration
-	FileconsumerOpenFiles    metric.Int64UpDownCounter
-	FileconsumerReadingFiles metric.Int64UpDownCounter
+	meter                                metric.Meter
+	mu                                   sync.Mutex
+	registrations                        []metric.Registration
+	FileconsumerCheckpointFiles          metric.Int64Gauge
+	FileconsumerCorruptCompressedMembers metric.Int64Counter
+	FileconsumerDroppedLogRecords        metric.Int64Counter
+	FileconsumerFileLag                  metric.Int64ObservableGauge
+	FileconsumerFileLastRead             metric.Int64ObservableGauge
+	FileconsumerFileOffset               metric.Int64ObservableGauge
+	FileconsumerFileSize                 metric.Int64ObservableGauge
+	FileconsumerOpenFiles                metric.Int64UpDownCounter
+	FileconsumerPendingBytes             metric.Int64ObservableGauge
+	FileconsumerReadingFiles             metric.Int64UpDownCounter
 }
 
 // TelemetryBuilderOption applies changes to default builder.
@@ -40,6 +50,91 @@ func (tbof telemetryBuilderOptionFunc) apply(mb *TelemetryBuilder) {
 	tbof(mb)
 }
 
+// RegisterFileconsumerFileLagCallback sets callback for observable FileconsumerFileLag metric.
+func (builder *TelemetryBuilder) RegisterFileconsumerFileLagCallback(cb metric.Int64Callback) error {
+	reg, err := builder.meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		cb(ctx, &observerInt64{inst: builder.FileconsumerFileLag, obs: o})
+		return nil
+	}, builder.FileconsumerFileLag)
+	if err != nil {
+		return err
+	}
+	builder.mu.Lock()
+	defer builder.mu.Unlock()
+	builder.registrations = append(builder.registrations, reg)
+	return nil
+}
+
+// RegisterFileconsumerFileLastReadCallback sets callback for observable FileconsumerFileLastRead metric.
+func (builder *TelemetryBuilder) RegisterFileconsumerFileLastReadCallback(cb metric.Int64Callback) error {
+	reg, err := builder.meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		cb(ctx, &observerInt64{inst: builder.FileconsumerFileLastRead, obs: o})
+		return nil
+	}, builder.FileconsumerFileLastRead)
+	if err != nil {
+		return err
+	}
+	builder.mu.Lock()
+	defer builder.mu.Unlock()
+	builder.registrations = append(builder.registrations, reg)
+	return nil
+}
+
+// RegisterFileconsumerFileOffsetCallback sets callback for observable FileconsumerFileOffset metric.
+func (builder *TelemetryBuilder) RegisterFileconsumerFileOffsetCallback(cb metric.Int64Callback) error {
+	reg, err := builder.meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		cb(ctx, &observerInt64{inst: builder.FileconsumerFileOffset, obs: o})
+		return nil
+	}, builder.FileconsumerFileOffset)
+	if err != nil {
+		return err
+	}
+	builder.mu.Lock()
+	defer builder.mu.Unlock()
+	builder.registrations = append(builder.registrations, reg)
+	return nil
+}
+
+// RegisterFileconsumerFileSizeCallback sets callback for observable FileconsumerFileSize metric.
+func (builder *TelemetryBuilder) RegisterFileconsumerFileSizeCallback(cb metric.Int64Callback) error {
+	reg, err := builder.meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		cb(ctx, &observerInt64{inst: builder.FileconsumerFileSize, obs: o})
+		return nil
+	}, builder.FileconsumerFileSize)
+	if err != nil {
+		return err
+	}
+	builder.mu.Lock()
+	defer builder.mu.Unlock()
+	builder.registrations = append(builder.registrations, reg)
+	return nil
+}
+
+// RegisterFileconsumerPendingBytesCallback sets callback for observable FileconsumerPendingBytes metric.
+func (builder *TelemetryBuilder) RegisterFileconsumerPendingBytesCallback(cb metric.Int64Callback) error {
+	reg, err := builder.meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		cb(ctx, &observerInt64{inst: builder.FileconsumerPendingBytes, obs: o})
+		return nil
+	}, builder.FileconsumerPendingBytes)
+	if err != nil {
+		return err
+	}
+	builder.mu.Lock()
+	defer builder.mu.Unlock()
+	builder.registrations = append(builder.registrations, reg)
+	return nil
+}
+
+type observerInt64 struct {
+	embedded.Int64Observer
+	inst metric.Int64Observable
+	obs  metric.Observer
+}
+
+func (oi *observerInt64) Observe(value int64, opts ...metric.ObserveOption) {
+	oi.obs.ObserveInt64(oi.inst, value, opts...)
+}
+
 // Shutdown unregister all registered callbacks for async instruments.
 func (builder *TelemetryBuilder) Shutdown() {
 	builder.mu.Lock()
@@ -58,12 +153,60 @@ func NewTelemetryBuilder(settings component.TelemetrySettings, options ...Teleme
 	}
 	builder.meter = Meter(settings)
 	var err, errs error
+	builder.FileconsumerCheckpointFiles, err = builder.meter.Int64Gauge(
+		"otelcol_fileconsumer_checkpoint_files",
+		metric.WithDescription("Number of files whose offsets are stored in the checkpoint, including the archive [Development]"),
+		metric.WithUnit("{files}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.FileconsumerCorruptCompressedMembers, err = builder.meter.Int64Counter(
+		"otelcol_fileconsumer_corrupt_compressed_members",
+		metric.WithDescription("Number of corrupt members of compressed files that were skipped [Development]"),
+		metric.WithUnit("{members}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.FileconsumerDroppedLogRecords, err = builder.meter.Int64Counter(
+		"otelcol_fileconsumer_dropped_log_records",
+		metric.WithDescription("Number of log records dropped because they exceeded the max log size [Development]"),
+		metric.WithUnit("{records}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.FileconsumerFileLag, err = builder.meter.Int64ObservableGauge(
+		"otelcol_fileconsumer_file_lag",
+		metric.WithDescription("Number of bytes of a file that have not been read yet [Development]"),
+		metric.WithUnit("By"),
+	)
+	errs = errors.Join(errs, err)
+	builder.FileconsumerFileLastRead, err = builder.meter.Int64ObservableGauge(
+		"otelcol_fileconsumer_file_last_read",
+		metric.WithDescription("Time of the last read from a file, as seconds since the Unix epoch [Development]"),
+		metric.WithUnit("s"),
+	)
+	errs = errors.Join(errs, err)
+	builder.FileconsumerFileOffset, err = builder.meter.Int64ObservableGauge(
+		"otelcol_fileconsumer_file_offset",
+		metric.WithDescription("Offset up to which a file has been read [Development]"),
+		metric.WithUnit("By"),
+	)
+	errs = errors.Join(errs, err)
+	builder.FileconsumerFileSize, err = builder.meter.Int64ObservableGauge(
+		"otelcol_fileconsumer_file_size",
+		metric.WithDescription("Size of a file that is being read [Development]"),
+		metric.WithUnit("By"),
+	)
+	errs = errors.Join(errs, err)
 	builder.FileconsumerOpenFiles, err = builder.meter.Int64UpDownCounter(
 		"otelcol_fileconsumer_open_files",
 		metric.WithDescription("Number of open files [Development]"),
 		metric.WithUnit("1"),
 	)
 	errs = errors.Join(errs, err)
+	builder.FileconsumerPendingBytes, err = builder.meter.Int64ObservableGauge(
+		"otelcol_fileconsumer_pending_bytes",
+		metric.WithDescription("Number of bytes of the files read during the last poll that have not been read yet [Development]"),
+		metric.WithUnit("By"),
+	)
+	errs = errors.Join(errs, err)
 	builder.FileconsumerReadingFiles, err = builder.meter.Int64UpDownCounter(
 		"otelcol_fileconsumer_reading_files",
 		metric.WithDescription("Number of open files that are being read [Development]"),
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/compression"
+)
+
+// archive closes the file and moves it into the archive directory, compressing it if configured.
+// The file is left in place if it cannot be archived.
+func (r *Reader) archive() {
+	r.close()
+	if err := archiveFile(r.fileName, r.archiveDir, r.archiveCompression); err != nil {
+		r.set.Logger.Error("could not archive", zap.String("archive_to", r.archiveDir), zap.Error(err))
+	}
+}
+
+func archiveFile(path, dir, compressionType string) error {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return err
+	}
+	dst := archivePath(dir, filepath.Base(path), compression.Extension(compressionType))
+	if compressionType == "" {
+		if err := os.Rename(path, dst); err == nil {
+			return nil
+		}
+		// The archive directory may be on another device, in which case the file is copied.
+	}
+	if err := copyFile(path, dst, compressionType); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+// archivePath avoids overwriting a previously archived file of the same name
+// by inserting a timestamp before the extension.
+func archivePath(dir, name, ext string) string {
+	path := filepath.Join(dir, name+ext)
+	if _, err := os.Lstat(path); errors.Is(err, os.ErrNotExist) {
+		return path
+	}
+	return filepath.Join(dir, fmt.Sprintf("%s.%d%s", name, time.Now().UnixNano(), ext))
+}
+
+// copyFile writes the contents of src into the new file dst, which is removed if the copy fails.
+func copyFile(src, dst, compressionType string) (err error) {
+	in, err := os.Open(src) // #nosec - operator must read in files defined by user
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err = errors.Join(err, out.Close()); err != nil {
+			err = errors.Join(err, os.Remove(dst))
+		}
+	}()
+
+	if compressionType == "" {
+		_, err = io.Copy(out, in)
+		return err
+	}
+	w, err := compression.NewWriter(compressionType, out)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(w, in); err != nil {
+		return errors.Join(err, w.Close())
+	}
+	return w.Close()
+}
@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+
+import (
+	"slices"
+	"sync"
+)
+
+// LargeBufferPool keeps the buffers which were sized for tokens larger than the initial
+// buffer size, so that they are reused by the next reads rather than allocated again.
+// The buffers are released once none of them was used for a whole poll, so that the
+// memory taken by an occasional large token is not held on to.
+type LargeBufferPool struct {
+	mu   sync.Mutex
+	bufs []*[]byte
+	used bool
+}
+
+// get returns a buffer with a capacity of at least size bytes.
+func (p *LargeBufferPool) get(size int) *[]byte {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.used = true
+	for i, bufPtr := range p.bufs {
+		if cap(*bufPtr) >= size {
+			p.bufs = slices.Delete(p.bufs, i, i+1)
+			return bufPtr
+		}
+	}
+	buf := make([]byte, 0, size)
+	return &buf
+}
+
+// put keeps the buffer for reuse, unless its capacity exceeds maxSize.
+func (p *LargeBufferPool) put(bufPtr *[]byte, maxSize int) {
+	if cap(*bufPtr) > maxSize {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.bufs = append(p.bufs, bufPtr)
+}
+
+// ReleaseIdle releases the buffers if none of them was used since the previous call.
+func (p *LargeBufferPool) ReleaseIdle() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.used {
+		p.bufs = nil
+	}
+	p.used = false
+}
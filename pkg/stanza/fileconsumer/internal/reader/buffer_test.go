@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLargeBufferPool(t *testing.T) {
+	var p LargeBufferPool
+
+	bufPtr := p.get(100)
+	assert.GreaterOrEqual(t, cap(*bufPtr), 100)
+	p.put(bufPtr, 1000)
+
+	// A buffer which is large enough is reused
+	assert.Same(t, bufPtr, p.get(50))
+	p.put(bufPtr, 1000)
+	assert.NotSame(t, bufPtr, p.get(200))
+
+	// Buffers larger than the max size are not kept
+	large := p.get(2000)
+	p.put(large, 1000)
+	assert.NotSame(t, large, p.get(2000))
+}
+
+func TestLargeBufferPoolReleaseIdle(t *testing.T) {
+	var p LargeBufferPool
+
+	bufPtr := p.get(100)
+	p.put(bufPtr, 1000)
+
+	// The buffer is kept after a poll in which it was used
+	p.ReleaseIdle()
+	assert.Len(t, p.bufs, 1)
+
+	// and released after a poll in which it was not
+	p.ReleaseIdle()
+	assert.Empty(t, p.bufs)
+}
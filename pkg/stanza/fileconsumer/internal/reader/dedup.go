@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"sync"
+
+	"github.com/cespare/xxhash/v2"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
+)
+
+// Deduplicator remembers the tokens which were emitted recently, so that a token which is
+// read again from a copy of a file is emitted only once. A token is identified by the start
+// of the fingerprint of its file, its offset and its content. It is safe for concurrent use.
+type Deduplicator struct {
+	mu   sync.Mutex
+	seen map[uint64]struct{}
+	// keys holds the remembered keys in the order they were added, and next is the
+	// index of the oldest one, which is forgotten first once all of them are in use.
+	keys []uint64
+	next int
+}
+
+// NewDeduplicator creates a Deduplicator which remembers up to maxTokens tokens.
+func NewDeduplicator(maxTokens int) *Deduplicator {
+	return &Deduplicator{
+		seen: make(map[uint64]struct{}, maxTokens),
+		keys: make([]uint64, 0, maxTokens),
+	}
+}
+
+// add remembers the key, and returns false if it was already remembered.
+func (d *Deduplicator) add(key uint64) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, ok := d.seen[key]; ok {
+		return false
+	}
+	if len(d.keys) < cap(d.keys) {
+		d.keys = append(d.keys, key)
+	} else {
+		delete(d.seen, d.keys[d.next])
+		d.keys[d.next] = key
+		d.next = (d.next + 1) % len(d.keys)
+	}
+	d.seen[key] = struct{}{}
+	return true
+}
+
+// forget forgets the keys, e.g. of tokens which are read again.
+func (d *Deduplicator) forget(keys []uint64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, key := range keys {
+		delete(d.seen, key)
+	}
+}
+
+// dedupPrefix returns the key of the start of the fingerprint, which a file shares with its
+// copies, or false if the tokens of the file are not deduplicated.
+func (r *Reader) dedupPrefix() (string, bool) {
+	if r.dedup == nil || r.ArchiveFormat != "" || r.Fingerprint.Len() == 0 {
+		return "", false
+	}
+	return r.Fingerprint.HashPrefixKey(min(r.Fingerprint.Len(), fingerprint.MinSize))
+}
+
+// emitNew emits the tokens which were not emitted before, in runs of consecutive tokens.
+func (r *Reader) emitNew(ctx context.Context, tokens [][]byte, attributes map[string]any, lastRecordNumber int64, offsets []int64) error {
+	prefix, ok := r.dedupPrefix()
+	if !ok {
+		return r.emitBatch(ctx, tokens, attributes, lastRecordNumber, offsets)
+	}
+
+	var errs []error
+	start := 0
+	keys := make([]uint64, len(tokens))
+	var buf [8]byte
+	for i, token := range tokens {
+		h := xxhash.New()
+		_, _ = h.WriteString(prefix)
+		binary.LittleEndian.PutUint64(buf[:], uint64(offsets[i]))
+		_, _ = h.Write(buf[:])
+		_, _ = h.Write(token)
+		keys[i] = h.Sum64()
+		if r.dedup.add(keys[i]) {
+			continue
+		}
+		if start < i {
+			err := r.emitBatch(ctx, tokens[start:i], attributes, lastRecordNumber-int64(len(tokens)-i), offsets[start:])
+			if errors.Is(err, errRefused) {
+				// The refused tokens are read again, so they must not be dropped then
+				r.dedup.forget(keys[start:i])
+				return err
+			}
+			errs = append(errs, err)
+		}
+		start = i + 1
+	}
+	if start < len(tokens) {
+		err := r.emitBatch(ctx, tokens[start:], attributes, lastRecordNumber, offsets[start:])
+		if errors.Is(err, errRefused) {
+			r.dedup.forget(keys[start:])
+			return err
+		}
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
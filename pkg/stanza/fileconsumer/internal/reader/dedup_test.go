@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/internal/filetest"
+)
+
+func TestDeduplicatorAdd(t *testing.T) {
+	d := NewDeduplicator(2)
+	assert.True(t, d.add(1))
+	assert.True(t, d.add(2))
+	assert.False(t, d.add(1))
+
+	// The oldest key is forgotten once the limit is reached
+	assert.True(t, d.add(3))
+	assert.True(t, d.add(1))
+	assert.False(t, d.add(3))
+}
+
+func TestDedupCopies(t *testing.T) {
+	tempDir := t.TempDir()
+	content := "testlog1\ntestlog2\n"
+	original := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, original, content)
+	// The copy diverges from the original after its first lines
+	duplicate := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, duplicate, content+"testlog3\n")
+
+	var batches [][]string
+	var recordNums []int64
+	f := newTestFactory(t, func(_ context.Context, tokens [][]byte, _ map[string]any, lastRecordNumber int64, _ []int64) error {
+		batch := make([]string, 0, len(tokens))
+		for _, token := range tokens {
+			batch = append(batch, string(token))
+		}
+		batches = append(batches, batch)
+		recordNums = append(recordNums, lastRecordNumber)
+		return nil
+	})
+	f.Deduplicator = NewDeduplicator(100)
+
+	for _, file := range []*os.File{original, duplicate} {
+		fp, err := f.NewFingerprint(file)
+		require.NoError(t, err)
+		r, err := f.NewReader(file, fp)
+		require.NoError(t, err)
+		r.ReadToEnd(t.Context())
+		r.Close()
+	}
+
+	// Only the lines which were not read from the original are emitted from the copy
+	assert.Equal(t, [][]string{{"testlog1", "testlog2"}, {"testlog3"}}, batches)
+	assert.Equal(t, []int64{2, 3}, recordNums)
+}
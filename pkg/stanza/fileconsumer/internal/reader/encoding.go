@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+	"golang.org/x/text/encoding"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/header"
+)
+
+// The names of the encodings which can be detected
+const (
+	EncodingUTF8    = "utf-8"
+	EncodingUTF16LE = "utf-16le"
+	EncodingUTF16BE = "utf-16be"
+)
+
+// encodingSniffSize is the number of bytes at the start of a file which are used to detect its encoding.
+const encodingSniffSize = 512
+
+var byteOrderMarks = []struct {
+	bom      []byte
+	encoding string
+}{
+	{bom: []byte{0xEF, 0xBB, 0xBF}, encoding: EncodingUTF8},
+	{bom: []byte{0xFF, 0xFE}, encoding: EncodingUTF16LE},
+	{bom: []byte{0xFE, 0xFF}, encoding: EncodingUTF16BE},
+}
+
+// EncodingConfig holds the settings of a Factory which depend on the encoding of a file.
+type EncodingConfig struct {
+	Encoding     encoding.Encoding
+	SplitFunc    bufio.SplitFunc
+	HeaderConfig *header.Config
+}
+
+// DetectEncoding returns the name of the encoding of data from the start of a file, and
+// the length of its byte order mark. It returns "" if the encoding cannot be detected.
+func DetectEncoding(data []byte) (string, int) {
+	for _, m := range byteOrderMarks {
+		if bytes.HasPrefix(data, m.bom) {
+			return m.encoding, len(m.bom)
+		}
+	}
+
+	// Text in UTF-16 which is mostly ASCII has a zero byte in every other position
+	var evenZeros, oddZeros int
+	for i, b := range data {
+		if b == 0 {
+			if i%2 == 0 {
+				evenZeros++
+			} else {
+				oddZeros++
+			}
+		}
+	}
+	pairs := len(data) / 2
+	switch {
+	case pairs == 0:
+	case evenZeros == 0 && oddZeros > pairs/2:
+		return EncodingUTF16LE, 0
+	case oddZeros == 0 && evenZeros > pairs/2:
+		return EncodingUTF16BE, 0
+	case evenZeros+oddZeros == 0 && !isASCII(data) && validUTF8Prefix(data):
+		// Text in legacy encodings is rarely valid UTF-8 unless it is ASCII
+		return EncodingUTF8, 0
+	}
+	return "", 0
+}
+
+// detectEncoding returns the name of the encoding of file, or the fallback encoding
+// if it cannot be detected, and the length of its byte order mark.
+func (f *Factory) detectEncoding(file *os.File) (string, int) {
+	data := make([]byte, encodingSniffSize)
+	n, err := file.ReadAt(data, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		f.Logger.Debug("Failed to read the start of the file to detect its encoding", zap.Error(err))
+	}
+	if name, bomLen := DetectEncoding(data[:n]); name != "" {
+		return name, bomLen
+	}
+	return f.FallbackEncoding, 0
+}
+
+func isASCII(data []byte) bool {
+	for _, b := range data {
+		if b >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// validUTF8Prefix returns whether data is valid UTF-8, allowing for it to end in the
+// middle of a character.
+func validUTF8Prefix(data []byte) bool {
+	if utf8.Valid(data) {
+		return true
+	}
+	// Only the last character may be incomplete
+	for i := len(data) - 1; i >= 0 && i > len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			return !utf8.FullRune(data[i:]) && utf8.Valid(data[:i])
+		}
+	}
+	return false
+}
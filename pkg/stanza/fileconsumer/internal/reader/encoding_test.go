@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/unicode"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/internal/filetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/split"
+)
+
+func TestDetectEncoding(t *testing.T) {
+	utf16le, err := unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM).NewEncoder().String("testlog1\n")
+	require.NoError(t, err)
+	utf16be, err := unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM).NewEncoder().String("testlog1\n")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		data     []byte
+		expected string
+		bomLen   int
+	}{
+		{name: "Empty", data: nil, expected: ""},
+		{name: "ASCII", data: []byte("testlog1\n"), expected: ""},
+		{name: "UTF8BOM", data: []byte("\xEF\xBB\xBFtestlog1\n"), expected: EncodingUTF8, bomLen: 3},
+		{name: "UTF16LEBOM", data: []byte("\xFF\xFE" + utf16le), expected: EncodingUTF16LE, bomLen: 2},
+		{name: "UTF16BEBOM", data: []byte("\xFE\xFF" + utf16be), expected: EncodingUTF16BE, bomLen: 2},
+		{name: "UTF16LE", data: []byte(utf16le), expected: EncodingUTF16LE},
+		{name: "UTF16BE", data: []byte(utf16be), expected: EncodingUTF16BE},
+		{name: "UTF8", data: []byte("café\n"), expected: EncodingUTF8},
+		{name: "UTF8CutCharacter", data: []byte("café")[:4], expected: EncodingUTF8},
+		{name: "Latin1", data: []byte("caf\xE9\n"), expected: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			name, bomLen := DetectEncoding(tc.data)
+			assert.Equal(t, tc.expected, name)
+			assert.Equal(t, tc.bomLen, bomLen)
+		})
+	}
+}
+
+func TestDetectedEncodings(t *testing.T) {
+	f, sink := testFactory(t)
+	f.FallbackEncoding = EncodingUTF8
+	f.DetectedEncodings = map[string]EncodingConfig{}
+	for name, enc := range map[string]encoding.Encoding{
+		EncodingUTF16LE: unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM),
+		EncodingUTF16BE: unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM),
+	} {
+		splitFunc, err := split.Config{}.Func(enc, false, defaultMaxLogSize)
+		require.NoError(t, err)
+		f.DetectedEncodings[name] = EncodingConfig{Encoding: enc, SplitFunc: splitFunc}
+	}
+
+	testCases := []struct {
+		name     string
+		encoding encoding.Encoding
+		bom      string
+		expected string
+	}{
+		{name: "UTF16LE", encoding: unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM), bom: "\xFF\xFE", expected: EncodingUTF16LE},
+		{name: "UTF16BE", encoding: unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM), expected: EncodingUTF16BE},
+		{name: "Fallback", encoding: unicode.UTF8, expected: EncodingUTF8},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			content, err := tc.encoding.NewEncoder().String("testlog1\ntestlog2\n")
+			require.NoError(t, err)
+			temp := filetest.OpenTemp(t, t.TempDir())
+			filetest.WriteString(t, temp, tc.bom+content)
+
+			fp, err := f.NewFingerprint(temp)
+			require.NoError(t, err)
+			r, err := f.NewReader(temp, fp)
+			require.NoError(t, err)
+			defer r.Close()
+			assert.Equal(t, tc.expected, r.Encoding)
+
+			r.ReadToEnd(t.Context())
+			sink.ExpectTokens(t, []byte("testlog1"), []byte("testlog2"))
+		})
+	}
+}
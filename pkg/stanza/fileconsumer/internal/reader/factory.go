@@ -21,6 +21,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/compression"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/header"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/flush"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/tokenlen"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/trim"
@@ -36,27 +37,61 @@ type Factory struct {
 	component.TelemetrySettings
 	HeaderConfig            *header.Config
 	FromBeginning           bool
+	TimestampSeeker         *TimestampSeeker
 	FingerprintSize         int
+	HashFingerprints        bool
 	BufPool                 sync.Pool
 	InitialBufferSize       int
+	LargeBufPool            LargeBufferPool
+	MaxBufferSize           int
 	MaxLogSize              int
+	MaxBatchBytes           int
 	TruncateOnMaxLogSize    bool
+	DropOnMaxLogSize        bool
 	Encoding                encoding.Encoding
 	SplitFunc               bufio.SplitFunc
+	DetectedEncodings       map[string]EncodingConfig
+	FallbackEncoding        string
 	TrimFunc                trim.Func
+	NormalizeFunc           trim.Func
 	FlushTimeout            time.Duration
 	EmitFunc                emit.Callback
+	TelemetryBuilder        *metadata.TelemetryBuilder
 	Attributes              attrs.Resolver
 	DeleteAtEOF             bool
+	ArchiveDir              string
+	ArchiveCompression      string
 	IncludeFileRecordNumber bool
 	IncludeFileRecordOffset bool
 	Compression             string
 	AcquireFSLock           bool
+	FSLockExclusive         bool
+	FSLockTimeout           time.Duration
+	FSLockReadOnFailure     bool
 	FileCacheAdvise         bool
+	ReadArchives            bool
+	MaxFileBytesPerSecond   int
+	RateLimiter             *RateLimiter
+	Deduplicator            *Deduplicator
+	Sequencer               *Sequencer
+	RereadRefused           bool
 }
 
 func (f *Factory) NewFingerprint(file *os.File) (*fingerprint.Fingerprint, error) {
-	return fingerprint.NewFromFile(file, f.FingerprintSize, f.Compression != "", f.Logger)
+	return newFingerprint(file, f.FingerprintSize, f.Compression != "", f.HashFingerprints, f.Logger)
+}
+
+func newFingerprint(file *os.File, size int, decompressData, hashed bool, logger *zap.Logger) (*fingerprint.Fingerprint, error) {
+	fp, err := fingerprint.NewFromFile(file, size, decompressData, logger)
+	if err != nil || !hashed {
+		return fp, err
+	}
+	return fp.Hashed(), nil
+}
+
+// FileType returns the file type of file according to the compression setting.
+func (f *Factory) FileType(file *os.File) string {
+	return compression.FileType(file, f.Compression, f.Logger)
 }
 
 func (f *Factory) NewReader(file *os.File, fp *fingerprint.Fingerprint) (*Reader, error) {
@@ -64,11 +99,7 @@ func (f *Factory) NewReader(file *os.File, fp *fingerprint.Fingerprint) (*Reader
 	if err != nil {
 		return nil, err
 	}
-	var filetype string
-
-	if f.Compression != "" && compression.IsGzipFile(file, f.Logger) {
-		filetype = gzipExtension
-	}
+	filetype := f.FileType(file)
 
 	m := &Metadata{
 		Fingerprint:    fp,
@@ -79,94 +110,150 @@ func (f *Factory) NewReader(file *os.File, fp *fingerprint.Fingerprint) (*Reader
 		},
 		FileType: filetype,
 	}
+	if f.ReadArchives {
+		m.ArchiveFormat = ArchiveFormat(file.Name())
+	}
 	return f.NewReaderFromMetadata(file, m)
 }
 
 func (f *Factory) NewReaderFromMetadata(file *os.File, m *Metadata) (r *Reader, err error) {
 	r = &Reader{
-		Metadata:          m,
-		set:               f.TelemetrySettings,
-		file:              file,
-		fileName:          file.Name(),
-		fingerprintSize:   f.FingerprintSize,
-		bufPool:           &f.BufPool,
-		initialBufferSize: f.InitialBufferSize,
-		maxLogSize:        f.MaxLogSize,
-		decoder:           f.Encoding.NewDecoder(),
-		deleteAtEOF:       f.DeleteAtEOF,
-		compression:       f.Compression,
-		acquireFSLock:     f.AcquireFSLock,
-		fileCacheAdvise:   f.FileCacheAdvise,
-		maxBatchSize:      DefaultMaxBatchSize,
-		emitFunc:          f.EmitFunc,
+		Metadata:            m,
+		set:                 f.TelemetrySettings,
+		file:                file,
+		fileName:            file.Name(),
+		fingerprintSize:     f.FingerprintSize,
+		hashFingerprints:    f.HashFingerprints,
+		bufPool:             &f.BufPool,
+		initialBufferSize:   f.InitialBufferSize,
+		largeBufPool:        &f.LargeBufPool,
+		maxBufferSize:       f.MaxBufferSize,
+		maxLogSize:          f.MaxLogSize,
+		deleteAtEOF:         f.DeleteAtEOF,
+		archiveDir:          f.ArchiveDir,
+		archiveCompression:  f.ArchiveCompression,
+		compression:         f.Compression,
+		acquireFSLock:       f.AcquireFSLock,
+		fsLockExclusive:     f.FSLockExclusive,
+		fsLockTimeout:       f.FSLockTimeout,
+		fsLockReadOnFailure: f.FSLockReadOnFailure,
+		fileCacheAdvise:     f.FileCacheAdvise,
+		maxBatchSize:        DefaultMaxBatchSize,
+		maxBatchBytes:       f.MaxBatchBytes,
+		normalizeFunc:       f.NormalizeFunc,
+		holdPartialToken:    f.FlushTimeout > 0 && !f.DeleteAtEOF && f.ArchiveDir == "",
+		emitFunc:            f.EmitFunc,
+		telemetryBuilder:    f.TelemetryBuilder,
+		dedup:               f.Deduplicator,
+		sequencer:           f.Sequencer,
+		rereadRefused:       f.RereadRefused,
 	}
 	r.set.Logger = r.set.Logger.With(zap.String("path", r.fileName))
+	if id := FileID(file); id != "" {
+		m.FileID = id
+	}
+	m.Path = r.fileName
+	if f.Sequencer != nil {
+		if m.SequenceKey == "" {
+			m.SequenceKey = r.fileName
+		}
+		// The metadata may have been archived since the sequencer was restored
+		f.Sequencer.Restore([]*Metadata{m})
+	}
+	if f.MaxFileBytesPerSecond > 0 {
+		if m.limiter == nil {
+			m.limiter = NewRateLimiter(f.MaxFileBytesPerSecond)
+		}
+		r.limiters = append(r.limiters, m.limiter)
+	}
+	if f.RateLimiter != nil {
+		r.limiters = append(r.limiters, f.RateLimiter)
+	}
+
+	enc, splitFunc, headerConfig := f.Encoding, f.SplitFunc, f.HeaderConfig
+	var bomLen int
+	if f.DetectedEncodings != nil {
+		// The encoding is detected only once, as more data may change the outcome
+		if m.Encoding == "" {
+			m.Encoding, bomLen = f.detectEncoding(file)
+		}
+		if ec, ok := f.DetectedEncodings[m.Encoding]; ok {
+			enc, splitFunc, headerConfig = ec.Encoding, ec.SplitFunc, ec.HeaderConfig
+		}
+	}
+	r.decoder = enc.NewDecoder()
 
 	// Re-detect file type when compression is enabled.
-	// This handles the case where a file was compressed (e.g. test.log → test.log.gz):
-	// fingerprint matching succeeds because the decompressed content of the .gz matches the original
+	// This handles the case where a file was compressed (e.g. test.log → test.log.gz or test.log.zst):
+	// fingerprint matching succeeds because the decompressed content matches the original
 	// plaintext fingerprint, but the file format has changed. Reusing the old FileType and old
-	// plaintext Offset with a gzip-compressed file causes ReadToEnd to seek to the wrong position
+	// plaintext Offset with a compressed file causes ReadToEnd to seek to the wrong position
 	// and read raw compressed bytes as plaintext, producing corrupted log entries.
 	if f.Compression != "" {
-		var newFileType string
-		if compression.IsGzipFile(file, f.Logger) {
-			newFileType = gzipExtension
-		}
+		newFileType := f.FileType(file)
 		if newFileType != m.FileType {
 			r.set.Logger.Debug("File format changed",
 				zap.String("old_file_type", m.FileType),
 				zap.String("new_file_type", newFileType),
 				zap.Int64("old_offset", m.Offset),
 			)
-			// Plaintext → gzip compression: the old offset represents the number of
-			// decompressed bytes already consumed. Decompress the .gz
+			// Plaintext → compressed: the old offset represents the number of
+			// decompressed bytes already consumed. Decompress the file
 			// from byte 0 and skip that many decompressed bytes so we only emit
 			// new lines.
-			if m.FileType == "" && newFileType == gzipExtension {
+			if m.FileType == "" && newFileType != "" {
 				r.decompressedBytesToSkip = m.Offset
 			}
 			// Zero the persisted offset so that if ReadToEnd is skipped (e.g. due to
 			// context cancellation) and Close() is called immediately, the saved
 			// metadata carries Offset=0 rather than the stale plaintext value.
 			m.Offset = 0
+			m.MemberOffset = 0
 			m.FileType = newFileType
+			m.PartialToken = nil
 		}
 	}
 
 	if r.Fingerprint.Len() > r.fingerprintSize {
 		// User has reconfigured fingerprint_size
-		shorter, rereadErr := fingerprint.NewFromFile(file, r.fingerprintSize, r.compression != "", r.set.Logger)
+		shorter, rereadErr := r.newFingerprint()
 		if rereadErr != nil {
 			return nil, fmt.Errorf("reread fingerprint: %w", rereadErr)
 		}
-		if !r.Fingerprint.StartsWith(shorter) {
+		// The prefixes of hashed fingerprints loaded from a checkpoint are unknown,
+		// but the file already matched the whole fingerprint.
+		if r.Fingerprint.HasBytes() && !r.Fingerprint.StartsWith(shorter) {
 			return nil, errors.New("file truncated")
 		}
 		m.Fingerprint = shorter
+	} else if f.HashFingerprints {
+		// User has switched to hashed fingerprints
+		m.Fingerprint = m.Fingerprint.Hashed()
 	}
 
 	if !f.FromBeginning {
-		var info os.FileInfo
-		if info, err = r.file.Stat(); err != nil {
-			return nil, fmt.Errorf("stat: %w", err)
+		if f.TimestampSeeker != nil {
+			if r.Offset, err = f.TimestampSeeker.Seek(r.file); err != nil {
+				return nil, fmt.Errorf("seek timestamp: %w", err)
+			}
+		} else {
+			var info os.FileInfo
+			if info, err = r.file.Stat(); err != nil {
+				return nil, fmt.Errorf("stat: %w", err)
+			}
+			r.Offset = info.Size()
 		}
-		r.Offset = info.Size()
 	}
-
-	tokenLenFunc := m.TokenLenState.Func(f.SplitFunc)
-	flushFunc := m.FlushState.Func(tokenLenFunc, f.FlushTimeout)
-	var lengthLimitedFunc bufio.SplitFunc
-	if f.TruncateOnMaxLogSize {
-		lengthLimitedFunc = trim.ToLengthWithTruncate(flushFunc, f.MaxLogSize, &m.TruncateSkipping)
-	} else {
-		lengthLimitedFunc = trim.ToLength(flushFunc, f.MaxLogSize)
+	if r.Offset < int64(bomLen) {
+		// Skip the byte order mark
+		r.Offset = int64(bomLen)
 	}
-	r.contentSplitFunc = trim.WithFunc(lengthLimitedFunc, f.TrimFunc)
 
-	if f.HeaderConfig != nil && !m.HeaderFinalized {
-		r.headerSplitFunc = f.HeaderConfig.SplitFunc
-		r.headerReader, err = header.NewReader(f.TelemetrySettings, *f.HeaderConfig)
+	r.contentSplitFunc = f.contentSplitFunc(r, splitFunc)
+
+	if headerConfig != nil && !m.HeaderFinalized {
+		r.headerSplitFunc = headerConfig.SplitFunc
+		r.headerReader, err = header.NewReader(f.TelemetrySettings, *headerConfig)
 		if err != nil {
 			return nil, err
 		}
@@ -185,3 +272,21 @@ func (f *Factory) NewReaderFromMetadata(file *os.File, m *Metadata) (r *Reader,
 
 	return r, nil
 }
+
+// contentSplitFunc wraps splitFunc to track the state of the reader and to apply the limits of its tokens.
+func (f *Factory) contentSplitFunc(r *Reader, splitFunc bufio.SplitFunc) bufio.SplitFunc {
+	tokenLenFunc := r.TokenLenState.Func(splitFunc)
+	flushFunc := r.FlushState.Func(tokenLenFunc, f.FlushTimeout)
+	var lengthLimitedFunc bufio.SplitFunc
+	switch {
+	case f.TruncateOnMaxLogSize:
+		lengthLimitedFunc = trim.ToLengthWithTruncate(flushFunc, f.MaxLogSize, &r.TruncateSkipping)
+	case f.DropOnMaxLogSize:
+		lengthLimitedFunc = trim.ToLengthWithDrop(flushFunc, f.MaxLogSize, &r.TruncateSkipping, func() {
+			r.droppedToken = true
+		})
+	default:
+		lengthLimitedFunc = trim.ToLengthWithContinuation(flushFunc, f.MaxLogSize, &r.SplitContinuing)
+	}
+	return trim.WithFunc(lengthLimitedFunc, f.TrimFunc)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/emittest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/scanner"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/internal/filetest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/split"
@@ -52,19 +53,25 @@ func testFactory(t *testing.T, opts ...testFactoryOpt) (*Factory, *emittest.Sink
 	splitFunc, err := cfg.splitCfg.Func(cfg.encoding, false, cfg.maxLogSize)
 	require.NoError(t, err)
 
+	set := componenttest.NewNopTelemetrySettings()
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set)
+	require.NoError(t, err)
+
 	sink := emittest.NewSink(emittest.WithCallBuffer(cfg.sinkChanSize))
 	return &Factory{
-		TelemetrySettings:    componenttest.NewNopTelemetrySettings(),
+		TelemetrySettings:    set,
 		FromBeginning:        cfg.fromBeginning,
 		FingerprintSize:      cfg.fingerprintSize,
 		InitialBufferSize:    cfg.initialBufferSize,
 		MaxLogSize:           cfg.maxLogSize,
 		TruncateOnMaxLogSize: cfg.truncateOnMaxLogSize,
+		DropOnMaxLogSize:     cfg.dropOnMaxLogSize,
 		Encoding:             cfg.encoding,
 		SplitFunc:            splitFunc,
 		TrimFunc:             cfg.trimFunc,
 		FlushTimeout:         cfg.flushPeriod,
 		EmitFunc:             sink.Callback,
+		TelemetryBuilder:     telemetryBuilder,
 		Attributes:           cfg.attributes,
 		Compression:          cfg.compression,
 	}, sink
@@ -78,6 +85,7 @@ type testFactoryCfg struct {
 	initialBufferSize    int
 	maxLogSize           int
 	truncateOnMaxLogSize bool
+	dropOnMaxLogSize     bool
 	encoding             encoding.Encoding
 	splitCfg             split.Config
 	trimFunc             trim.Func
@@ -117,6 +125,12 @@ func withTruncateOnMaxLogSize(truncate bool) testFactoryOpt {
 	}
 }
 
+func withDropOnMaxLogSize(drop bool) testFactoryOpt {
+	return func(c *testFactoryCfg) {
+		c.dropOnMaxLogSize = drop
+	}
+}
+
 func withFlushPeriod(flushPeriod time.Duration) testFactoryOpt {
 	return func(c *testFactoryCfg) {
 		c.flushPeriod = flushPeriod
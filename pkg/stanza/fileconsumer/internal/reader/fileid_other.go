@@ -0,0 +1,14 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !windows
+
+package reader // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+
+import "os"
+
+// FileID returns "" on non-windows platforms, where renamed files are followed through
+// the handles which are kept open between polls.
+func FileID(*os.File) string {
+	return ""
+}
@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
+)
+
+// Formats of the archives whose members are read.
+const (
+	ArchiveFormatTar     = "tar"
+	ArchiveFormatTarGzip = "tar.gz"
+	ArchiveFormatZip     = "zip"
+)
+
+// errStopReading stops walking the members of an archive.
+var errStopReading = errors.New("stop reading")
+
+// ArchiveFormat returns the format of the archive with the given file name,
+// or "" if it is not an archive.
+func ArchiveFormat(name string) string {
+	name = strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(name, ".tar"):
+		return ArchiveFormatTar
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		return ArchiveFormatTarGzip
+	case strings.HasSuffix(name, ".zip"):
+		return ArchiveFormatZip
+	}
+	return ""
+}
+
+// readArchiveMembers reads the regular files in the archive, in order. The offset of each
+// member is tracked, so that an archive which is still being written is read incrementally.
+// The offset of the reader is set to the size of the archive once all of it has been read.
+func (r *Reader) readArchiveMembers(ctx context.Context) {
+	info, err := r.file.Stat()
+	if err != nil {
+		r.set.Logger.Error("failed to stat", zap.Error(err))
+		return
+	}
+	size := info.Size()
+	if size <= r.Offset {
+		return
+	}
+
+	if r.ArchiveMembers == nil {
+		r.ArchiveMembers = make(map[string]int64)
+	}
+	fileAttributes, offset := r.FileAttributes, r.Offset
+	defer func() {
+		r.FileAttributes = fileAttributes
+	}()
+
+	err = walkArchive(r.ArchiveFormat, io.NewSectionReader(r.file, 0, size), size, func(name string, memberSize int64, content io.Reader) error {
+		memberOffset := r.ArchiveMembers[name]
+		if memberOffset >= memberSize {
+			return nil
+		}
+		if _, err := io.CopyN(io.Discard, content, memberOffset); err != nil {
+			return err
+		}
+		r.reader = content
+		r.Offset = memberOffset
+		r.FileAttributes = maps.Clone(fileAttributes)
+		r.FileAttributes[attrs.LogFileArchiveMember] = name
+		eof := r.readContents(ctx)
+		r.ArchiveMembers[name] = r.Offset
+		if !eof {
+			return errStopReading
+		}
+		return nil
+	})
+	if err != nil {
+		if !errors.Is(err, errStopReading) {
+			// The archive may not have been written completely yet
+			r.set.Logger.Debug("failed to read archive", zap.Error(err))
+		}
+		r.Offset = offset
+		return
+	}
+	r.Offset = size
+	r.atEOF()
+}
+
+// walkArchive calls fn with the name, size and content of each regular file in the archive.
+func walkArchive(format string, archive io.ReaderAt, size int64, fn func(name string, size int64, content io.Reader) error) error {
+	switch format {
+	case ArchiveFormatTar:
+		return walkTar(io.NewSectionReader(archive, 0, size), fn)
+	case ArchiveFormatTarGzip:
+		gzipReader, err := gzip.NewReader(io.NewSectionReader(archive, 0, size))
+		if err != nil {
+			return err
+		}
+		defer gzipReader.Close()
+		return walkTar(gzipReader, fn)
+	case ArchiveFormatZip:
+		return walkZip(archive, size, fn)
+	}
+	return fmt.Errorf("unsupported archive format %q", format)
+}
+
+func walkTar(archive io.Reader, fn func(name string, size int64, content io.Reader) error) error {
+	tarReader := tar.NewReader(archive)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if err := fn(header.Name, header.Size, tarReader); err != nil {
+			return err
+		}
+	}
+}
+
+func walkZip(archive io.ReaderAt, size int64, fn func(name string, size int64, content io.Reader) error) error {
+	zipReader, err := zip.NewReader(archive, size)
+	if err != nil {
+		return err
+	}
+	for _, file := range zipReader.File {
+		if !file.Mode().IsRegular() {
+			continue
+		}
+		content, err := file.Open()
+		if err != nil {
+			return err
+		}
+		err = fn(file.Name, int64(file.UncompressedSize64), content)
+		content.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
+)
+
+type archiveMember struct {
+	name    string
+	content string
+}
+
+func writeTar(t *testing.T, members ...archiveMember) []byte {
+	var buf bytes.Buffer
+	writer := tar.NewWriter(&buf)
+	for _, m := range members {
+		require.NoError(t, writer.WriteHeader(&tar.Header{Name: m.name, Mode: 0o600, Size: int64(len(m.content))}))
+		_, err := writer.Write([]byte(m.content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func writeTarGzip(t *testing.T, members ...archiveMember) []byte {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	_, err := writer.Write(writeTar(t, members...))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func writeZip(t *testing.T, members ...archiveMember) []byte {
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for _, m := range members {
+		w, err := writer.Create(m.name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(m.content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func TestArchiveFormat(t *testing.T) {
+	assert.Equal(t, ArchiveFormatTar, ArchiveFormat("logs.tar"))
+	assert.Equal(t, ArchiveFormatTarGzip, ArchiveFormat("logs.tar.gz"))
+	assert.Equal(t, ArchiveFormatTarGzip, ArchiveFormat("LOGS.TGZ"))
+	assert.Equal(t, ArchiveFormatZip, ArchiveFormat("logs.zip"))
+	assert.Empty(t, ArchiveFormat("logs.log"))
+	assert.Empty(t, ArchiveFormat("logs.gz"))
+}
+
+func TestReadArchiveMembers(t *testing.T) {
+	members := []archiveMember{
+		{name: "a.log", content: "testlog1\ntestlog2\n"},
+		{name: "dir/b.log", content: "testlog3\nunterminated"},
+	}
+
+	testCases := []struct {
+		name    string
+		file    string
+		archive []byte
+	}{
+		{name: "Tar", file: "logs.tar", archive: writeTar(t, members...)},
+		{name: "TarGzip", file: "logs.tar.gz", archive: writeTarGzip(t, members...)},
+		{name: "Zip", file: "logs.zip", archive: writeZip(t, members...)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f, sink := testFactory(t)
+			f.ReadArchives = true
+
+			path := filepath.Join(t.TempDir(), tc.file)
+			require.NoError(t, os.WriteFile(path, tc.archive, 0o600))
+			file, err := os.Open(path)
+			require.NoError(t, err)
+
+			fp, err := f.NewFingerprint(file)
+			require.NoError(t, err)
+			r, err := f.NewReader(file, fp)
+			require.NoError(t, err)
+			defer r.Close()
+			assert.Equal(t, ArchiveFormat(tc.file), r.ArchiveFormat)
+
+			r.ReadToEnd(t.Context())
+			for _, expected := range []struct {
+				token  string
+				member string
+			}{
+				{token: "testlog1", member: "a.log"},
+				{token: "testlog2", member: "a.log"},
+				{token: "testlog3", member: "dir/b.log"},
+				{token: "unterminated", member: "dir/b.log"},
+			} {
+				token, attributes := sink.NextCall(t)
+				assert.Equal(t, expected.token, string(token))
+				assert.Equal(t, expected.member, attributes[attrs.LogFileArchiveMember])
+				assert.Equal(t, tc.file, attributes[attrs.LogFileName])
+			}
+			sink.ExpectNoCalls(t)
+
+			assert.Equal(t, int64(len(tc.archive)), r.Offset)
+			assert.Equal(t, map[string]int64{"a.log": 18, "dir/b.log": 21}, r.ArchiveMembers)
+			_, ok := r.FileAttributes[attrs.LogFileArchiveMember]
+			assert.False(t, ok)
+
+			// The archive is not read again
+			r.ReadToEnd(t.Context())
+			sink.ExpectNoCalls(t)
+		})
+	}
+}
+
+func TestReadArchiveMembersIncrementally(t *testing.T) {
+	f, sink := testFactory(t)
+	f.ReadArchives = true
+
+	// The first member is complete, while the second one is cut short in its second line
+	archive := writeTar(t,
+		archiveMember{name: "a.log", content: "testlog1\n"},
+		archiveMember{name: "b.log", content: "testlog2\ntestlog3\n"},
+	)
+	path := filepath.Join(t.TempDir(), "logs.tar")
+	require.NoError(t, os.WriteFile(path, archive[:512+512+512+12], 0o600))
+	file, err := os.Open(path)
+	require.NoError(t, err)
+
+	fp, err := f.NewFingerprint(file)
+	require.NoError(t, err)
+	r, err := f.NewReader(file, fp)
+	require.NoError(t, err)
+
+	r.ReadToEnd(t.Context())
+	sink.ExpectTokens(t, []byte("testlog1"), []byte("testlog2"))
+	sink.ExpectNoCalls(t)
+	require.Zero(t, r.Offset)
+
+	// Simulate a restart, where the metadata is persisted and loaded again
+	encoded, err := json.Marshal(r.Close())
+	require.NoError(t, err)
+	m := new(Metadata)
+	require.NoError(t, json.Unmarshal(encoded, m))
+	require.Equal(t, map[string]int64{"a.log": 9, "b.log": 9}, m.ArchiveMembers)
+
+	require.NoError(t, os.WriteFile(path, archive, 0o600))
+	file, err = os.Open(path)
+	require.NoError(t, err)
+	r, err = f.NewReaderFromMetadata(file, m)
+	require.NoError(t, err)
+	defer r.Close()
+
+	r.ReadToEnd(t.Context())
+	sink.ExpectToken(t, []byte("testlog3"))
+	sink.ExpectNoCalls(t)
+	assert.Equal(t, int64(len(archive)), r.Offset)
+}
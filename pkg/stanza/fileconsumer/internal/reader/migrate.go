@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+
+import (
+	"os"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
+)
+
+// MigrateFingerprint adapts the fingerprint of metadata loaded from a checkpoint to the
+// fingerprint size, which may have been reconfigured since the checkpoint was saved. Otherwise,
+// a fingerprint longer than the size never matches the file again, so the file is read again
+// from the start. A longer fingerprint is cut to the size, and a shorter one is extended with
+// the bytes of the file, as long as the file at the path of the metadata still starts with the
+// fingerprint. The file is also read when the bytes of a longer fingerprint are not known, as
+// for hashed fingerprints. It returns whether the fingerprint was changed.
+func (f *Factory) MigrateFingerprint(m *Metadata) bool {
+	length := m.Fingerprint.Len()
+	if length == 0 || length == f.FingerprintSize {
+		return false
+	}
+	hashed := f.HashFingerprints || m.Fingerprint.IsHashed()
+	if length > f.FingerprintSize && m.Fingerprint.HasBytes() {
+		m.Fingerprint = prefixFingerprint(m.Fingerprint.Bytes()[:f.FingerprintSize], hashed)
+		return true
+	}
+	if m.Path == "" {
+		return false
+	}
+
+	file, err := os.Open(m.Path) // #nosec - operator must read in files defined by user
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+	fp, err := newFingerprint(file, max(length, f.FingerprintSize), f.Compression != "", false, f.Logger)
+	if err != nil {
+		f.Logger.Debug("Failed to compute the fingerprint again", zap.String("path", m.Path), zap.Error(err))
+		return false
+	}
+	if !fp.StartsWith(m.Fingerprint) {
+		// The file was replaced or truncated since the fingerprint was computed
+		return false
+	}
+	first := fp.Bytes()
+	if len(first) == length && length < f.FingerprintSize {
+		// The file has not grown since
+		return false
+	}
+	m.Fingerprint = prefixFingerprint(first[:min(len(first), f.FingerprintSize)], hashed)
+	return true
+}
+
+func prefixFingerprint(first []byte, hashed bool) *fingerprint.Fingerprint {
+	if hashed {
+		return fingerprint.NewHashed(first)
+	}
+	return fingerprint.New(first)
+}
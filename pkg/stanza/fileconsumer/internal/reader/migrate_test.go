@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader
+
+import (
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
+)
+
+func TestMigrateFingerprint(t *testing.T) {
+	content := []byte("0123456789abcdefghijklmnopqrstuvwxyz\n")
+	plainPath := filepath.Join(t.TempDir(), "test.log")
+	require.NoError(t, os.WriteFile(plainPath, content, 0o600))
+	gzipPath := filepath.Join(t.TempDir(), "test.log.gz")
+	gzipFile, err := os.Create(gzipPath)
+	require.NoError(t, err)
+	gzWriter := gzip.NewWriter(gzipFile)
+	_, err = gzWriter.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, gzWriter.Close())
+	require.NoError(t, gzipFile.Close())
+
+	testCases := []struct {
+		name            string
+		fingerprint     *fingerprint.Fingerprint
+		path            string
+		fingerprintSize int
+		hashed          bool
+		compression     string
+		expectMigrated  bool
+		expect          *fingerprint.Fingerprint
+	}{
+		{
+			name:            "same size",
+			fingerprint:     fingerprint.New(content[:20]),
+			path:            plainPath,
+			fingerprintSize: 20,
+			expect:          fingerprint.New(content[:20]),
+		},
+		{
+			name:            "shrink",
+			fingerprint:     fingerprint.New(content[:30]),
+			fingerprintSize: 20,
+			expectMigrated:  true,
+			expect:          fingerprint.New(content[:20]),
+		},
+		{
+			name:            "shrink to hashed",
+			fingerprint:     fingerprint.New(content[:30]),
+			fingerprintSize: 20,
+			hashed:          true,
+			expectMigrated:  true,
+			expect:          fingerprint.NewHashed(content[:20]),
+		},
+		{
+			name:            "shrink hashed",
+			fingerprint:     fingerprint.NewFromHash(fingerprint.NewHashed(content[:30]).Hash(), 30),
+			path:            plainPath,
+			fingerprintSize: 20,
+			hashed:          true,
+			expectMigrated:  true,
+			expect:          fingerprint.NewHashed(content[:20]),
+		},
+		{
+			name:            "shrink hashed without path",
+			fingerprint:     fingerprint.NewFromHash(fingerprint.NewHashed(content[:30]).Hash(), 30),
+			fingerprintSize: 20,
+			hashed:          true,
+			expect:          fingerprint.NewFromHash(fingerprint.NewHashed(content[:30]).Hash(), 30),
+		},
+		{
+			name:            "grow",
+			fingerprint:     fingerprint.New(content[:20]),
+			path:            plainPath,
+			fingerprintSize: 30,
+			expectMigrated:  true,
+			expect:          fingerprint.New(content[:30]),
+		},
+		{
+			name:            "grow beyond file",
+			fingerprint:     fingerprint.New(content[:20]),
+			path:            plainPath,
+			fingerprintSize: 1000,
+			expectMigrated:  true,
+			expect:          fingerprint.New(content),
+		},
+		{
+			name:            "grow replaced file",
+			fingerprint:     fingerprint.New([]byte("another file content")),
+			path:            plainPath,
+			fingerprintSize: 30,
+			expect:          fingerprint.New([]byte("another file content")),
+		},
+		{
+			name:            "grow deleted file",
+			fingerprint:     fingerprint.New(content[:20]),
+			path:            filepath.Join(t.TempDir(), "deleted.log"),
+			fingerprintSize: 30,
+			expect:          fingerprint.New(content[:20]),
+		},
+		{
+			name:            "grow compressed",
+			fingerprint:     fingerprint.New(content[:20]),
+			path:            gzipPath,
+			fingerprintSize: 30,
+			compression:     "gzip",
+			expectMigrated:  true,
+			expect:          fingerprint.New(content[:30]),
+		},
+		{
+			name:            "shrink hashed compressed",
+			fingerprint:     fingerprint.NewFromHash(fingerprint.NewHashed(content[:30]).Hash(), 30),
+			path:            gzipPath,
+			fingerprintSize: 20,
+			hashed:          true,
+			compression:     "gzip",
+			expectMigrated:  true,
+			expect:          fingerprint.NewHashed(content[:20]),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f, _ := testFactory(t, withFingerprintSize(tc.fingerprintSize), withCompression(tc.compression))
+			f.HashFingerprints = tc.hashed
+			md := &Metadata{Fingerprint: tc.fingerprint, Path: tc.path}
+			assert.Equal(t, tc.expectMigrated, f.MigrateFingerprint(md))
+			assert.Equal(t, tc.expect.Len(), md.Fingerprint.Len())
+			assert.Equal(t, tc.expect.IsHashed(), md.Fingerprint.IsHashed())
+			assert.Equal(t, tc.expect.Key(), md.Fingerprint.Key())
+		})
+	}
+}
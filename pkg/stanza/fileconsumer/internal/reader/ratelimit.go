@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// RateLimiter limits the number of bytes read per second. It allows up to one
+// second worth of bytes to be read at once, and is safe for concurrent use.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter creates a RateLimiter which allows bytesPerSecond bytes to be read per second.
+func NewRateLimiter(bytesPerSecond int) *RateLimiter {
+	return &RateLimiter{
+		rate:   float64(bytesPerSecond),
+		tokens: float64(bytesPerSecond),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes up to n bytes from the limiter. It returns the number of bytes taken,
+// and how long to wait before they may be read.
+func (l *RateLimiter) reserve(n int) (int, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = min(l.rate, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+
+	n = max(1, min(n, int(l.rate)))
+	l.tokens -= float64(n)
+	if l.tokens >= 0 {
+		return n, 0
+	}
+	return n, time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// release returns n bytes which were reserved but not read.
+func (l *RateLimiter) release(n int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens = min(l.rate, l.tokens+float64(n))
+}
+
+// rateLimitedReader reads from a reader no faster than its limiters allow.
+type rateLimitedReader struct {
+	ctx      context.Context
+	reader   io.Reader
+	limiters []*RateLimiter
+}
+
+func (r *rateLimitedReader) Read(dst []byte) (int, error) {
+	if len(dst) == 0 {
+		return r.reader.Read(dst)
+	}
+	n, wait := len(dst), time.Duration(0)
+	reserved := make([]int, len(r.limiters))
+	for i, l := range r.limiters {
+		var d time.Duration
+		reserved[i], d = l.reserve(n)
+		n, wait = min(n, reserved[i]), max(wait, d)
+	}
+	release := func(read int) {
+		for i, l := range r.limiters {
+			if reserved[i] > read {
+				l.release(reserved[i] - read)
+			}
+		}
+	}
+
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-r.ctx.Done():
+			release(0)
+			return 0, r.ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	read, err := r.reader.Read(dst[:n])
+	release(read)
+	return read, err
+}
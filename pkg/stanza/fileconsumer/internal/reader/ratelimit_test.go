@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/internal/filetest"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	l := NewRateLimiter(100)
+
+	// Up to one second worth of bytes is available at once
+	n, wait := l.reserve(150)
+	assert.Equal(t, 100, n)
+	assert.Zero(t, wait)
+
+	n, wait = l.reserve(50)
+	assert.Equal(t, 50, n)
+	assert.InDelta(t, 500*time.Millisecond, wait, float64(50*time.Millisecond))
+
+	// Bytes which were not read are available again
+	l.release(150)
+	n, wait = l.reserve(10)
+	assert.Equal(t, 10, n)
+	assert.Zero(t, wait)
+}
+
+func TestRateLimitedReader(t *testing.T) {
+	content := strings.Repeat("a", 150)
+	reader := &rateLimitedReader{
+		ctx:      t.Context(),
+		reader:   strings.NewReader(content),
+		limiters: []*RateLimiter{NewRateLimiter(1000), NewRateLimiter(100)},
+	}
+
+	start := time.Now()
+	var buf bytes.Buffer
+	_, err := io.Copy(&buf, reader)
+	require.NoError(t, err)
+	assert.Equal(t, content, buf.String())
+	assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+}
+
+func TestRateLimitedReaderCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	reader := &rateLimitedReader{
+		ctx:      ctx,
+		reader:   strings.NewReader(strings.Repeat("a", 150)),
+		limiters: []*RateLimiter{NewRateLimiter(100)},
+	}
+
+	n, err := reader.Read(make([]byte, 150))
+	assert.Equal(t, 100, n)
+	require.NoError(t, err)
+
+	_, err = reader.Read(make([]byte, 150))
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestReadToEndRateLimited(t *testing.T) {
+	f, sink := testFactory(t)
+	f.MaxFileBytesPerSecond = 100
+	f.RateLimiter = NewRateLimiter(1000)
+
+	temp := filetest.OpenTemp(t, t.TempDir())
+	filetest.WriteString(t, temp, strings.Repeat("testlog1\n", 20))
+
+	fp, err := f.NewFingerprint(temp)
+	require.NoError(t, err)
+	r, err := f.NewReader(temp, fp)
+	require.NoError(t, err)
+	defer r.Close()
+
+	start := time.Now()
+	r.ReadToEnd(t.Context())
+	assert.GreaterOrEqual(t, time.Since(start), 700*time.Millisecond)
+	for range 20 {
+		sink.ExpectToken(t, []byte("testlog1"))
+	}
+	assert.Equal(t, int64(180), r.Offset)
+}
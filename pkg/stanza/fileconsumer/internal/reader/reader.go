@@ -5,27 +5,35 @@ package reader // import "github.com/open-telemetry/opentelemetry-collector-cont
 
 import (
 	"bufio"
-	"compress/gzip"
+	"bytes"
 	"context"
 	"errors"
 	"io"
+	"maps"
 	"os"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.uber.org/zap"
 	"golang.org/x/text/encoding"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/textutils"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/emit"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/compression"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/header"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/scanner"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/flush"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/tokenlen"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/trim"
 )
 
-const gzipExtension = ".gz"
+// lockRetryInterval is the interval at which a lock held by another process is retried.
+const lockRetryInterval = 10 * time.Millisecond
 
 type Metadata struct {
 	Fingerprint      *fingerprint.Fingerprint
@@ -37,6 +45,35 @@ type Metadata struct {
 	TokenLenState    tokenlen.State
 	FileType         string
 	TruncateSkipping bool
+	SplitContinuing  bool
+	// PartialToken holds the data at the end of a compressed file which does not
+	// form a complete token yet, so that it can be completed by data appended later.
+	PartialToken []byte
+	// Encoding is the name of the encoding detected for the file, if encodings are detected.
+	Encoding string
+	// MemberOffset is the number of decompressed bytes which were read from the member of a
+	// compressed stream at Offset, when the stream is read one member at a time.
+	MemberOffset int64
+	// ArchiveFormat is the format of the archive whose members are read, if the file is one.
+	ArchiveFormat string
+	// ArchiveMembers holds the offset read up to in each member of the archive.
+	ArchiveMembers map[string]int64
+	// LastSeen is the time at which the file was last read, and is used to expire its offset.
+	LastSeen time.Time
+	// Generation is the number of times the file was found to be truncated.
+	Generation int64
+	// FileID identifies the file across renames, where the platform provides such an ID.
+	FileID string
+	// Path is the path at which the file was last opened. It is used to compute the
+	// fingerprint of the file again when the fingerprint size is reconfigured.
+	Path string
+	// SequenceKey is the path of the logical file whose tokens are numbered together, and
+	// Sequence is the number of the last token emitted from it, if tokens are numbered.
+	SequenceKey string
+	Sequence    int64
+	// limiter limits the rate at which the file is read. It is kept with the metadata,
+	// as a reader is created for the file on each poll.
+	limiter *RateLimiter
 }
 
 // Reader manages a single file
@@ -47,8 +84,11 @@ type Reader struct {
 	file                   *os.File
 	reader                 io.Reader
 	fingerprintSize        int
+	hashFingerprints       bool
 	bufPool                *sync.Pool
 	initialBufferSize      int
+	largeBufPool           *LargeBufferPool
+	maxBufferSize          int
 	maxLogSize             int
 	headerSplitFunc        bufio.SplitFunc
 	contentSplitFunc       bufio.SplitFunc
@@ -56,60 +96,139 @@ type Reader struct {
 	headerReader           *header.Reader
 	emitFunc               emit.Callback
 	deleteAtEOF            bool
+	archiveDir             string
+	archiveCompression     string
 	needsUpdateFingerprint bool
 	compression            string
 	acquireFSLock          bool
+	fsLockExclusive        bool
+	fsLockTimeout          time.Duration
+	fsLockReadOnFailure    bool
 	fileCacheAdvise        bool
 	maxBatchSize           int
+	maxBatchBytes          int
+	normalizeFunc          trim.Func
+	holdPartialToken       bool
+	limiters               []*RateLimiter
+	telemetryBuilder       *metadata.TelemetryBuilder
+	// stream is set for named pipes and character devices, which are read without seeking.
+	stream bool
+	// sequencer numbers the tokens of the logical file, or is nil if tokens are not numbered.
+	sequencer *Sequencer
+	// dedup drops the tokens which were already emitted from a copy of the file, or is nil if disabled.
+	dedup *Deduplicator
+	// droppedToken is set when the last token exceeded the max log size and was dropped.
+	droppedToken bool
 	// decompressedBytesToSkip tracks the number of bytes in a decompressed stream
 	// that have already been consumed. When a plaintext file is compressed,
 	// the gzip file must be decompressed from byte 0, and this value is used to skip
 	// past previously processed content so only new lines are emitted.
 	decompressedBytesToSkip int64
+	// quantum is the number of bytes read before yielding to other files, or 0 to read to the end.
+	quantum int64
+	// yielded is set when the file was left to be read further after reading its quantum.
+	yielded bool
+	// memberReader is the decompressing reader, if it reads the compressed stream one member at a time.
+	memberReader compression.MemberReader
+	// reachedEOF is set once the file was read to its end.
+	reachedEOF bool
+	// rereadRefused stops reading at the tokens which the consumer refused, so that they are
+	// read again, and refused is set when it did so during the last read.
+	rereadRefused bool
+	refused       bool
+}
+
+// ReadQuantum reads the file like ReadToEnd, but yields at the end of a batch once quantum bytes
+// were read, and returns whether the file was left to be read further. Compressed files and archives
+// are always read to the end, as they can only be resumed at the end of the data which was read.
+func (r *Reader) ReadQuantum(ctx context.Context, quantum int64) bool {
+	r.quantum, r.yielded = quantum, false
+	defer func() {
+		r.quantum = 0
+	}()
+	r.ReadToEnd(ctx)
+	return r.yielded
 }
 
 // ReadToEnd will read until the end of the file
 func (r *Reader) ReadToEnd(ctx context.Context) {
+	r.refused = false
 	if r.acquireFSLock {
-		if !r.tryLockFile() {
+		if r.lockFile(ctx) {
+			defer r.unlockFile()
+		} else if !r.fsLockReadOnFailure {
 			return
 		}
-		defer r.unlockFile()
 	}
 
+	if r.ArchiveFormat != "" {
+		r.readArchiveMembers(ctx)
+		if r.needsUpdateFingerprint {
+			r.updateFingerprint()
+		}
+		return
+	}
+
+	// An explicit compression setting applies to every file, while "auto"
+	// relies on the file type detected from the file header.
+	fileType := r.FileType
 	switch r.compression {
-	case "gzip":
-		currentEOF, err := r.createGzipReader()
+	case compression.Gzip:
+		fileType = compression.GzipExtension
+	case compression.Zstd:
+		fileType = compression.ZstdExtension
+	case compression.Bzip2:
+		fileType = compression.Bzip2Extension
+	case compression.Xz:
+		fileType = compression.XzExtension
+	case compression.Auto:
+		// use the detected file type
+	default:
+		fileType = ""
+	}
+
+	if fileType != "" {
+		offset, memberOffset, recordNum, skip, partialToken := r.Offset, r.MemberOffset, r.RecordNum, r.decompressedBytesToSkip, r.PartialToken
+		compressedStart, currentEOF, err := r.createDecompressingReader(fileType)
 		if err != nil {
 			return
 		}
+		defer r.closeDecompressingReader()
 		// Offset tracking in an uncompressed file is based on the length of emitted tokens, but in this case
-		// we need to set the offset to the end of the file.
+		// we need to set the offset to the end of the file, or to the start of the member which was not
+		// read completely.
 		defer func() {
-			r.Offset = currentEOF
-		}()
-	case "auto":
-		if r.FileType == gzipExtension {
-			currentEOF, err := r.createGzipReader()
-			if err != nil {
+			if r.refused {
+				// The position of the refused tokens in the compressed stream is unknown, so all of the data is read again
+				r.Offset, r.MemberOffset, r.RecordNum, r.decompressedBytesToSkip, r.PartialToken = offset, memberOffset, recordNum, skip, partialToken
 				return
 			}
-			// Offset tracking in an uncompressed file is based on the length of emitted tokens, but in this case
-			// we need to set the offset to the end of the file.
-			defer func() {
-				r.Offset = currentEOF
-			}()
-		} else {
-			r.reader = r.file
-		}
-	default:
+			r.Offset, r.MemberOffset = currentEOF, 0
+			if r.memberReader == nil {
+				return
+			}
+			offset, read := r.memberReader.Resume()
+			r.Offset, r.MemberOffset = compressedStart+offset, read
+			if corrupt := r.memberReader.CorruptMembers(); corrupt > 0 {
+				r.set.Logger.Warn("Skipped corrupt members of compressed file", zap.Int("members", corrupt))
+				r.telemetryBuilder.FileconsumerCorruptCompressedMembers.Add(ctx, int64(corrupt))
+			}
+		}()
+	} else {
 		r.reader = r.file
 	}
 
-	if r.fileCacheAdvise && r.FileType != gzipExtension {
+	if r.fileCacheAdvise && r.FileType == "" {
 		r.fadviseFile()
 	}
 
+	if r.stream {
+		if r.readContents(ctx) {
+			r.set.Logger.Debug("stream closed by its writer")
+		}
+		return
+	}
+
 	if _, err := r.file.Seek(r.Offset, 0); err != nil {
 		r.set.Logger.Error("failed to seek", zap.Error(err))
 		return
@@ -127,55 +246,143 @@ func (r *Reader) ReadToEnd(ctx context.Context) {
 		}
 	}
 
-	r.readContents(ctx)
+	if r.readContents(ctx) {
+		r.atEOF()
+	}
+}
+
+// ReadCopyToEnd reads the rest of the file from copyFile, a copy of it made before it was
+// truncated, e.g. by copytruncate rotation. The copy is neither deleted nor archived.
+func (r *Reader) ReadCopyToEnd(ctx context.Context, copyFile *os.File) {
+	file, deleteAtEOF, archiveDir := r.file, r.deleteAtEOF, r.archiveDir
+	r.file, r.deleteAtEOF, r.archiveDir = copyFile, false, ""
+	defer func() {
+		r.file, r.deleteAtEOF, r.archiveDir = file, deleteAtEOF, archiveDir
+	}()
+	r.ReadToEnd(ctx)
 }
 
-// createGzipReader creates gzip reader and returns the file offset
-func (r *Reader) createGzipReader() (int64, error) {
-	// We need to create a gzip reader each time ReadToEnd is called because the underlying
+// atEOF deletes or archives the file, if configured, once it has been read to the end.
+func (r *Reader) atEOF() {
+	r.reachedEOF = true
+	if r.deleteAtEOF {
+		r.delete()
+	} else if r.archiveDir != "" {
+		r.archive()
+	}
+}
+
+// lockFile acquires the filesystem lock of the file, retrying while it is held by
+// another process until the lock timeout expires.
+func (r *Reader) lockFile(ctx context.Context) bool {
+	deadline := time.Now().Add(r.fsLockTimeout)
+	for {
+		locked, err := r.tryLockFile()
+		if err != nil {
+			r.set.Logger.Error("Failed to lock", zap.Error(err))
+			return false
+		}
+		if locked {
+			return true
+		}
+		if !time.Now().Before(deadline) {
+			r.set.Logger.Debug("File is locked by another process")
+			return false
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(lockRetryInterval):
+		}
+	}
+}
+
+// createDecompressingReader creates a reader that decompresses the file according to fileType
+// and returns the offsets at which it starts and ends
+func (r *Reader) createDecompressingReader(fileType string) (int64, int64, error) {
+	// We need to create a decompressing reader each time ReadToEnd is called because the underlying
 	// SectionReader can only read a fixed window (from previous offset to EOF).
+	r.memberReader = nil
 	info, err := r.file.Stat()
 	if err != nil {
 		r.set.Logger.Error("failed to stat", zap.Error(err))
-		return 0, err
+		return 0, 0, err
 	}
 	currentEOF := info.Size()
 
 	// Determine starting position of compressed file. When a plaintext file has been
-	// compressed, the entire .gz file is a new byte stream and must be
+	// compressed, the entire compressed file is a new byte stream and must be
 	// decompressed from byte 0. decompressedBytesToSkip holds the number of bytes
 	// already-consumed in the uncompressed stream to discard.
 	compressedStart := r.Offset
 	if r.decompressedBytesToSkip > 0 {
 		compressedStart = 0
 	}
+	if compressedStart >= currentEOF {
+		if len(r.PartialToken) > 0 {
+			// Nothing was appended since the last read, but the partial token
+			// may have to be flushed.
+			r.reader = bytes.NewReader(r.takePartialToken())
+			return currentEOF, currentEOF, nil
+		}
+		// Nothing was appended since the last read. Not every decompressor
+		// accepts an empty stream, so don't create one.
+		return 0, 0, io.EOF
+	}
 
-	// use a gzip Reader with an underlying SectionReader to pick up at the last
-	// offset of a gzip compressed file
-	gzipReader, err := gzip.NewReader(io.NewSectionReader(r.file, compressedStart, currentEOF-compressedStart))
+	// use a decompressing Reader with an underlying SectionReader to pick up at the last
+	// offset of a compressed file
+	decompressingReader, err := compression.NewReader(fileType, io.NewSectionReader(r.file, compressedStart, currentEOF-compressedStart))
 	if err != nil {
 		if !errors.Is(err, io.EOF) {
-			r.set.Logger.Error("failed to create gzip reader", zap.Error(err))
+			r.set.Logger.Error("failed to create decompressing reader", zap.String("file_type", fileType), zap.Error(err))
 		}
-		return 0, err
+		return 0, 0, err
 	}
 
 	// Skip past already-consumed decompressed bytes so only new lines are processed.
-	if r.decompressedBytesToSkip > 0 {
-		if _, err := io.CopyN(io.Discard, gzipReader, r.decompressedBytesToSkip); err != nil {
-			r.set.Logger.Error("failed to skip already-consumed decompressed bytes", zap.Error(err))
-			return 0, err
+	if skip := r.decompressedBytesToSkip + r.MemberOffset; skip > 0 {
+		if _, err := io.CopyN(io.Discard, decompressingReader, skip); err != nil {
+			// Nothing was appended to a member which was not read completely
+			if !errors.Is(err, io.EOF) || r.decompressedBytesToSkip > 0 {
+				r.set.Logger.Error("failed to skip already-consumed decompressed bytes", zap.Error(err))
+			}
+			decompressingReader.Close()
+			return 0, 0, err
 		}
 		r.decompressedBytesToSkip = 0
 	}
-	r.reader = gzipReader
-	return currentEOF, nil
+	r.memberReader, _ = decompressingReader.(compression.MemberReader)
+	r.reader = decompressingReader
+	if len(r.PartialToken) > 0 {
+		// Continue the partial token with the newly appended data
+		r.reader = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(r.takePartialToken()), decompressingReader), decompressingReader}
+	}
+	return compressedStart, currentEOF, nil
+}
+
+func (r *Reader) takePartialToken() []byte {
+	partialToken := r.PartialToken
+	r.PartialToken = nil
+	return partialToken
+}
+
+// closeDecompressingReader releases the resources of the decompressing reader.
+func (r *Reader) closeDecompressingReader() {
+	if closer, ok := r.reader.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			r.set.Logger.Debug("failed to close decompressing reader", zap.Error(err))
+		}
+	}
 }
 
 func (r *Reader) readHeader(ctx context.Context) (doneReadingFile bool) {
 	bufPtr := r.getBufPtrFromPool()
 	defer r.bufPool.Put(bufPtr)
-	s := scanner.New(r, r.maxLogSize, *bufPtr, r.Offset, r.headerSplitFunc, r.FileType == gzipExtension)
+	s := scanner.New(r, r.maxLogSize, *bufPtr, r.Offset, r.headerSplitFunc, r.FileType != "")
 
 	// Read the tokens from the file until no more header tokens are found or the end of file is reached.
 	for {
@@ -191,9 +398,7 @@ func (r *Reader) readHeader(ctx context.Context) (doneReadingFile bool) {
 				r.set.Logger.Error("failed during header scan", zap.Error(err))
 			} else {
 				r.set.Logger.Debug("end of file reached", zap.Bool("delete_at_eof", r.deleteAtEOF))
-				if r.deleteAtEOF {
-					r.delete()
-				}
+				r.atEOF()
 			}
 			// Either end of file was reached, or file cannot be scanned.
 			return true
@@ -234,7 +439,8 @@ func (r *Reader) readHeader(ctx context.Context) (doneReadingFile bool) {
 	return false
 }
 
-func (r *Reader) readContents(ctx context.Context) {
+// readContents reads the tokens of the file, and returns whether the end of the file was reached.
+func (r *Reader) readContents(ctx context.Context) bool {
 	var buf []byte
 	if r.TokenLenState.MinimumLength <= r.initialBufferSize {
 		bufPtr := r.getBufPtrFromPool()
@@ -243,40 +449,97 @@ func (r *Reader) readContents(ctx context.Context) {
 	} else {
 		// If we previously saw a potential token larger than the default buffer,
 		// size the buffer to be at least one byte larger so we can see if there's more data.
-		// Usually, expect this to be a rare event so that we don't bother pooling this special buffer size.
-		buf = make([]byte, 0, r.TokenLenState.MinimumLength+1)
+		// These buffers are only kept for reuse up to the max buffer size.
+		bufPtr := r.largeBufPool.get(r.TokenLenState.MinimumLength + 1)
+		buf = *bufPtr
+		defer r.largeBufPool.put(bufPtr, r.maxBufferSize)
+	}
+	var reader io.Reader = r
+	if len(r.limiters) > 0 {
+		reader = &rateLimitedReader{ctx: ctx, reader: r, limiters: r.limiters}
 	}
-	s := scanner.New(r, r.maxLogSize, buf, r.Offset, r.contentSplitFunc, r.FileType == gzipExtension)
+	// The members of archives are not appended to, so their last token is flushed like in compressed files,
+	// and so is the last token of a stream, whose writer has closed it at EOF
+	s := scanner.New(reader, r.maxLogSize, buf, r.Offset, r.contentSplitFunc, r.FileType != "" || r.ArchiveFormat != "" || r.stream)
 
 	tokenBodies := make([][]byte, r.maxBatchSize)
 	tokenOffsets := make([]int64, r.maxBatchSize+1)
 
 	numTokensBatched := 0
+	batchBytes := 0
+	start := r.Offset
 	tokenOffsets[0] = r.Offset
 	// Iterate over the contents of the file.
 	for {
 		select {
 		case <-ctx.Done():
-			return
+			return false
 		default:
 		}
 
+		continuation := r.SplitContinuing
 		ok := s.Scan()
 		if !ok {
-			if err := s.Error(); err != nil {
+			err := s.Error()
+			// A rate limited read is interrupted when the context is canceled
+			if err != nil && ctx.Err() == nil {
 				r.set.Logger.Error("failed during scan", zap.Error(err))
-			} else if r.deleteAtEOF {
-				r.delete()
 			}
 
 			if numTokensBatched > 0 {
-				err := r.emitFunc(ctx, tokenBodies[:numTokensBatched], r.FileAttributes, r.RecordNum, tokenOffsets)
-				if err != nil {
+				if err := r.emit(ctx, tokenBodies[:numTokensBatched], r.FileAttributes, r.RecordNum, tokenOffsets); err != nil {
+					if errors.Is(err, errRefused) {
+						return r.refuse(tokenOffsets[0], numTokensBatched)
+					}
 					r.set.Logger.Error("failed to emit token", zap.Error(err))
 				}
 				r.Offset = s.Pos()
 			}
-			return
+			return err == nil
+		}
+
+		if r.droppedToken {
+			// The token exceeded the max log size and was replaced by an empty one
+			r.droppedToken = false
+			r.telemetryBuilder.FileconsumerDroppedLogRecords.Add(ctx, 1)
+			tokenOffsets[numTokensBatched] = s.Pos()
+			if numTokensBatched == 0 {
+				r.Offset = s.Pos()
+			}
+			continue
+		}
+
+		if r.ArchiveFormat != "" && s.Partial() && s.Error() != nil {
+			// The member is cut short, as the archive is still being written. Its last
+			// token is read again once the rest of the member is available.
+			if numTokensBatched > 0 {
+				if err := r.emit(ctx, tokenBodies[:numTokensBatched], r.FileAttributes, r.RecordNum, tokenOffsets); err != nil {
+					if errors.Is(err, errRefused) {
+						return r.refuse(tokenOffsets[0], numTokensBatched)
+					}
+					r.set.Logger.Error("failed to emit token", zap.Error(err))
+				}
+				r.Offset = max(r.Offset, tokenOffsets[numTokensBatched])
+			}
+			return false
+		}
+
+		if r.holdPartialToken && r.ArchiveFormat == "" && s.Partial() {
+			// The rest of the token may still be appended to the file. Hold on to the
+			// data until then, or until it is flushed, rather than splitting the token.
+			r.PartialToken = append([]byte(nil), s.Bytes()...)
+			// The token is the last of the data. Scanning on would reset the flush state,
+			// as if the data was gone, so that the token would never be flushed.
+			if numTokensBatched > 0 {
+				if err := r.emit(ctx, tokenBodies[:numTokensBatched], r.FileAttributes, r.RecordNum, tokenOffsets); err != nil {
+					if errors.Is(err, errRefused) {
+						return r.refuse(tokenOffsets[0], numTokensBatched)
+					}
+					r.set.Logger.Error("failed to emit token", zap.Error(err))
+				}
+				r.Offset = s.Pos()
+			}
+			return true
 		}
 
 		var err error
@@ -287,19 +550,108 @@ func (r *Reader) readContents(ctx context.Context) {
 			r.Offset = s.Pos() // move past the bad token or we may be stuck
 			continue
 		}
+		if r.normalizeFunc != nil {
+			tokenBodies[numTokensBatched] = r.normalizeFunc(tokenBodies[numTokensBatched])
+		}
+		if r.maxBatchBytes > 0 && numTokensBatched > 0 && batchBytes+len(tokenBodies[numTokensBatched]) > r.maxBatchBytes {
+			// Emit the pending tokens first, so that the batch does not exceed its size
+			if err = r.emit(ctx, tokenBodies[:numTokensBatched], r.FileAttributes, r.RecordNum, tokenOffsets); err != nil {
+				if errors.Is(err, errRefused) {
+					return r.refuse(tokenOffsets[0], numTokensBatched)
+				}
+				r.set.Logger.Error("failed to emit token", zap.Error(err))
+			}
+			tokenBodies[0] = tokenBodies[numTokensBatched]
+			tokenOffsets[0], tokenOffsets[1] = tokenOffsets[numTokensBatched], tokenOffsets[numTokensBatched+1]
+			r.Offset = tokenOffsets[0]
+			numTokensBatched, batchBytes = 0, 0
+		}
+		batchBytes += len(tokenBodies[numTokensBatched])
 		numTokensBatched++
 
 		r.RecordNum++
+		if continuation {
+			// The continuation of a split token is emitted on its own, so that it can be flagged
+			if numTokensBatched > 1 {
+				if err = r.emit(ctx, tokenBodies[:numTokensBatched-1], r.FileAttributes, r.RecordNum-1, tokenOffsets); err != nil {
+					if errors.Is(err, errRefused) {
+						return r.refuse(tokenOffsets[0], numTokensBatched)
+					}
+					r.set.Logger.Error("failed to emit token", zap.Error(err))
+				}
+			}
+			attributes := make(map[string]any, len(r.FileAttributes)+1)
+			maps.Copy(attributes, r.FileAttributes)
+			attributes[attrs.LogFileRecordContinuation] = true
+			if err = r.emit(ctx, tokenBodies[numTokensBatched-1:numTokensBatched], attributes, r.RecordNum, tokenOffsets[numTokensBatched-1:]); err != nil {
+				if errors.Is(err, errRefused) {
+					return r.refuse(tokenOffsets[numTokensBatched-1], 1)
+				}
+				r.set.Logger.Error("failed to emit token", zap.Error(err))
+			}
+			numTokensBatched, batchBytes = 0, 0
+			r.Offset, tokenOffsets[0] = s.Pos(), s.Pos()
+			continue
+		}
 		if r.maxBatchSize > 0 && numTokensBatched >= r.maxBatchSize {
-			if err = r.emitFunc(ctx, tokenBodies[:numTokensBatched], r.FileAttributes, r.RecordNum, tokenOffsets); err != nil {
+			if err = r.emit(ctx, tokenBodies[:numTokensBatched], r.FileAttributes, r.RecordNum, tokenOffsets); err != nil {
+				if errors.Is(err, errRefused) {
+					return r.refuse(tokenOffsets[0], numTokensBatched)
+				}
 				r.set.Logger.Error("failed to emit token", zap.Error(err))
 			}
-			numTokensBatched = 0
+			numTokensBatched, batchBytes = 0, 0
 			r.Offset, tokenOffsets[0] = s.Pos(), s.Pos()
+			if r.quantum > 0 && r.FileType == "" && r.ArchiveFormat == "" && r.Offset-start >= r.quantum {
+				r.yielded = true
+				return false
+			}
 		}
 	}
 }
 
+// emit emits the tokens, except for those which were already emitted from a copy of the file.
+func (r *Reader) emit(ctx context.Context, tokens [][]byte, attributes map[string]any, lastRecordNumber int64, offsets []int64) error {
+	if r.dedup != nil {
+		return r.emitNew(ctx, tokens, attributes, lastRecordNumber, offsets)
+	}
+	return r.emitBatch(ctx, tokens, attributes, lastRecordNumber, offsets)
+}
+
+// emitBatch emits the tokens along with a snapshot of the read they come from.
+func (r *Reader) emitBatch(ctx context.Context, tokens [][]byte, attributes map[string]any, lastRecordNumber int64, offsets []int64) error {
+	snapshot := emit.Snapshot{
+		StartOffset:       offsets[0],
+		EndOffset:         offsets[len(tokens)],
+		FirstRecordNumber: lastRecordNumber - int64(len(tokens)) + 1,
+		LastRecordNumber:  lastRecordNumber,
+		Generation:        r.Generation,
+	}
+	if r.sequencer != nil {
+		r.Sequence = r.sequencer.next(r.SequenceKey, len(tokens))
+		snapshot.FirstSequence, snapshot.LastSequence = r.Sequence-int64(len(tokens))+1, r.Sequence
+	}
+	ctx = emit.NewContext(ctx, snapshot)
+	if !r.rereadRefused {
+		return r.emitFunc(ctx, tokens, attributes, lastRecordNumber, offsets)
+	}
+	refused := new(atomic.Bool)
+	err := r.emitFunc(context.WithValue(ctx, refusalKey{}, refused), tokens, attributes, lastRecordNumber, offsets)
+	if refused.Load() {
+		return errors.Join(err, errRefused)
+	}
+	return err
+}
+
+// refuse stops reading at offset, the start of the tokens which the consumer refused, so
+// that they are read again. It returns false, as the file was not read to its end.
+func (r *Reader) refuse(offset int64, numTokens int) bool {
+	r.Offset = offset
+	r.RecordNum -= int64(numTokens)
+	r.refused = true
+	return false
+}
+
 // Delete will close and delete the file
 func (r *Reader) delete() {
 	r.close()
@@ -311,6 +663,7 @@ func (r *Reader) delete() {
 // Close will close the file and return the metadata
 func (r *Reader) Close() *Metadata {
 	r.close()
+	r.LastSeen = time.Now()
 	m := r.Metadata
 	r.Metadata = nil
 	return m
@@ -344,6 +697,11 @@ func (r *Reader) Read(dst []byte) (n int, err error) {
 	return n, err
 }
 
+// ReachedEOF reports whether the file was read to its end.
+func (r *Reader) ReachedEOF() bool {
+	return r.reachedEOF
+}
+
 func (r *Reader) NameEquals(other *Reader) bool {
 	return r.fileName == other.fileName
 }
@@ -353,7 +711,7 @@ func (r *Reader) Validate() bool {
 	if r.file == nil {
 		return false
 	}
-	refreshedFingerprint, err := fingerprint.NewFromFile(r.file, r.fingerprintSize, r.compression != "", r.set.Logger)
+	refreshedFingerprint, err := r.newFingerprint()
 	if err != nil {
 		return false
 	}
@@ -368,12 +726,24 @@ func (m Metadata) GetFingerprint() *fingerprint.Fingerprint {
 	return m.Fingerprint
 }
 
+// ExtendFingerprint extends a fingerprint which is shorter than the fingerprint size with the
+// bytes written to the file since it was computed, so that files which start with the same bytes
+// are told apart as soon as they differ. It returns whether the fingerprint was extended.
+func (r *Reader) ExtendFingerprint() bool {
+	if r.Fingerprint.Len() >= r.fingerprintSize {
+		return false
+	}
+	length := r.Fingerprint.Len()
+	r.updateFingerprint()
+	return r.Fingerprint.Len() > length
+}
+
 func (r *Reader) updateFingerprint() {
 	r.needsUpdateFingerprint = false
 	if r.file == nil {
 		return
 	}
-	refreshedFingerprint, err := fingerprint.NewFromFile(r.file, r.fingerprintSize, r.compression != "", r.set.Logger)
+	refreshedFingerprint, err := r.newFingerprint()
 	if err != nil {
 		return
 	}
@@ -383,6 +753,10 @@ func (r *Reader) updateFingerprint() {
 	r.Fingerprint = refreshedFingerprint
 }
 
+func (r *Reader) newFingerprint() (*fingerprint.Fingerprint, error) {
+	return newFingerprint(r.file, r.fingerprintSize, r.compression != "", r.hashFingerprints, r.set.Logger)
+}
+
 func (r *Reader) getBufPtrFromPool() *[]byte {
 	bufP := r.bufPool.Get()
 	if bufP == nil {
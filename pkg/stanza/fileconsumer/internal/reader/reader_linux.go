@@ -12,15 +12,19 @@ import (
 	"golang.org/x/sys/unix"
 )
 
-func (r *Reader) tryLockFile() bool {
-	if err := unix.Flock(int(r.file.Fd()), unix.LOCK_SH|unix.LOCK_NB); err != nil {
-		if !errors.Is(err, unix.EWOULDBLOCK) {
-			r.set.Logger.Error("Failed to lock", zap.Error(err))
+func (r *Reader) tryLockFile() (bool, error) {
+	how := unix.LOCK_SH
+	if r.fsLockExclusive {
+		how = unix.LOCK_EX
+	}
+	if err := unix.Flock(int(r.file.Fd()), how|unix.LOCK_NB); err != nil {
+		if errors.Is(err, unix.EWOULDBLOCK) {
+			return false, nil
 		}
-		return false
+		return false, err
 	}
 
-	return true
+	return true, nil
 }
 
 func (r *Reader) unlockFile() {
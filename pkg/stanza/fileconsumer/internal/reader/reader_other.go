@@ -5,8 +5,8 @@
 
 package reader // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
 
-func (*Reader) tryLockFile() bool {
-	return true
+func (*Reader) tryLockFile() (bool, error) {
+	return true, nil
 }
 
 func (*Reader) unlockFile() {
@@ -53,6 +53,82 @@ func TestFileReader_FingerprintUpdated(t *testing.T) {
 // - Updates as a file is read
 // - Stops updating when the max fingerprint size is reached
 // - Stops exactly at max fingerprint size, regardless of content
+func TestReadQuantum(t *testing.T) {
+	f, sink := testFactory(t, withSinkChanSize(250))
+
+	temp := filetest.OpenTemp(t, t.TempDir())
+	var content strings.Builder
+	for i := range 250 {
+		fmt.Fprintf(&content, "testlog%03d\n", i)
+	}
+	filetest.WriteString(t, temp, content.String())
+
+	fp, err := f.NewFingerprint(temp)
+	require.NoError(t, err)
+	r, err := f.NewReader(temp, fp)
+	require.NoError(t, err)
+	defer r.Close()
+
+	// Each quantum is read up to the end of a batch
+	assert.True(t, r.ReadQuantum(t.Context(), 1))
+	assert.Len(t, sink.NextTokens(t, DefaultMaxBatchSize), DefaultMaxBatchSize)
+	sink.ExpectNoCalls(t)
+	assert.Equal(t, int64(100*len("testlog000\n")), r.Offset)
+
+	assert.True(t, r.ReadQuantum(t.Context(), 1))
+	assert.Len(t, sink.NextTokens(t, DefaultMaxBatchSize), DefaultMaxBatchSize)
+
+	assert.False(t, r.ReadQuantum(t.Context(), 1))
+	assert.Len(t, sink.NextTokens(t, 50), 50)
+	sink.ExpectNoCalls(t)
+	assert.Equal(t, int64(content.Len()), r.Offset)
+}
+
+func TestEmitSnapshot(t *testing.T) {
+	f, sink := testFactory(t, withSinkChanSize(150))
+
+	temp := filetest.OpenTemp(t, t.TempDir())
+	var content strings.Builder
+	for i := range 150 {
+		fmt.Fprintf(&content, "testlog%03d\n", i)
+	}
+	filetest.WriteString(t, temp, content.String())
+
+	fp, err := f.NewFingerprint(temp)
+	require.NoError(t, err)
+	r, err := f.NewReader(temp, fp)
+	require.NoError(t, err)
+	defer r.Close()
+	r.Generation = 2
+
+	r.ReadToEnd(t.Context())
+
+	// Each token carries the snapshot of the batch it was emitted in
+	lineLen := int64(len("testlog000\n"))
+	for i := range 150 {
+		token, snapshot := sink.NextSnapshot(t)
+		require.Equal(t, fmt.Appendf(nil, "testlog%03d", i), token)
+		if i < DefaultMaxBatchSize {
+			require.Equal(t, emit.Snapshot{
+				StartOffset:       0,
+				EndOffset:         100 * lineLen,
+				FirstRecordNumber: 1,
+				LastRecordNumber:  100,
+				Generation:        2,
+			}, snapshot)
+		} else {
+			require.Equal(t, emit.Snapshot{
+				StartOffset:       100 * lineLen,
+				EndOffset:         150 * lineLen,
+				FirstRecordNumber: 101,
+				LastRecordNumber:  150,
+				Generation:        2,
+			}, snapshot)
+		}
+	}
+	sink.ExpectNoCalls(t)
+}
+
 func TestFingerprintGrowsAndStops(t *testing.T) {
 	t.Parallel()
 
@@ -106,6 +182,35 @@ func TestFingerprintGrowsAndStops(t *testing.T) {
 	}
 }
 
+func TestExtendFingerprint(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "header\n")
+
+	f, _ := testFactory(t, withFingerprintSize(16))
+	fp, err := f.NewFingerprint(temp)
+	require.NoError(t, err)
+	reader, err := f.NewReader(filetest.OpenFile(t, temp.Name()), fp)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	// The file has not grown
+	require.False(t, reader.ExtendFingerprint())
+	require.Equal(t, fingerprint.New([]byte("header\n")), reader.Fingerprint)
+
+	// The fingerprint is extended without the new content being read
+	filetest.WriteString(t, temp, "first line\n")
+	require.True(t, reader.ExtendFingerprint())
+	require.Equal(t, fingerprint.New([]byte("header\nfirst lin")), reader.Fingerprint)
+
+	// The fingerprint has reached its size
+	filetest.WriteString(t, temp, "second line\n")
+	require.False(t, reader.ExtendFingerprint())
+	require.Equal(t, fingerprint.New([]byte("header\nfirst lin")), reader.Fingerprint)
+}
+
 // This is same test like TestFingerprintGrowsAndStops, but with additional check for fingerprint size check
 // Test that a fingerprint:
 // - Starts empty
@@ -363,6 +468,81 @@ func TestFileCacheAdvise(t *testing.T) {
 	sink.ExpectNoCalls(t)
 }
 
+func TestMaxBatchBytes(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	temp := filetest.OpenTemp(t, tempDir)
+	content := "aaaa\nbb\ncccccc\ndddddddddddd\ne\n"
+	filetest.WriteString(t, temp, content)
+
+	var batches [][]string
+	var recordNums []int64
+	f := newTestFactory(t, func(_ context.Context, tokens [][]byte, _ map[string]any, lastRecordNumber int64, _ []int64) error {
+		batch := make([]string, 0, len(tokens))
+		for _, token := range tokens {
+			batch = append(batch, string(token))
+		}
+		batches = append(batches, batch)
+		recordNums = append(recordNums, lastRecordNumber)
+		return nil
+	})
+	f.MaxBatchBytes = 8
+	fp, err := f.NewFingerprint(temp)
+	require.NoError(t, err)
+	r, err := f.NewReader(temp, fp)
+	require.NoError(t, err)
+	defer r.Close()
+
+	r.ReadToEnd(t.Context())
+
+	// A token larger than the batch size is emitted on its own
+	assert.Equal(t, [][]string{{"aaaa", "bb"}, {"cccccc"}, {"dddddddddddd"}, {"e"}}, batches)
+	assert.Equal(t, []int64{2, 3, 4, 5}, recordNums)
+	assert.Equal(t, int64(len(content)), r.Offset)
+}
+
+func TestRereadRefused(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	temp := filetest.OpenTemp(t, tempDir)
+	content := "testlog1\ntestlog2\n"
+	filetest.WriteString(t, temp, content)
+
+	var emitted []string
+	var refuse atomic.Bool
+	f := newTestFactory(t, func(ctx context.Context, tokens [][]byte, _ map[string]any, _ int64, _ []int64) error {
+		for _, token := range tokens {
+			emitted = append(emitted, string(token))
+		}
+		if refuse.Load() {
+			MarkRefused(ctx)
+		}
+		return nil
+	})
+	f.RereadRefused = true
+	fp, err := f.NewFingerprint(temp)
+	require.NoError(t, err)
+	r, err := f.NewReader(temp, fp)
+	require.NoError(t, err)
+	defer r.Close()
+
+	// The refused tokens are not read past
+	refuse.Store(true)
+	r.ReadToEnd(t.Context())
+	assert.Equal(t, []string{"testlog1", "testlog2"}, emitted)
+	assert.Zero(t, r.Offset)
+	assert.Zero(t, r.RecordNum)
+
+	// and are read again once they are accepted
+	refuse.Store(false)
+	r.ReadToEnd(t.Context())
+	assert.Equal(t, []string{"testlog1", "testlog2", "testlog1", "testlog2"}, emitted)
+	assert.Equal(t, int64(len(content)), r.Offset)
+	assert.Equal(t, int64(2), r.RecordNum)
+}
+
 func BenchmarkFileRead(b *testing.B) {
 	tempDir := b.TempDir()
 
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build unix && !aix && !solaris
+
+package reader
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/internal/filetest"
+)
+
+func TestFSLock(t *testing.T) {
+	testCases := []struct {
+		name          string
+		heldLock      int
+		exclusive     bool
+		timeout       time.Duration
+		readOnFailure bool
+		releaseAfter  time.Duration
+		expectRead    bool
+	}{
+		{name: "SharedWithShared", heldLock: unix.LOCK_SH, expectRead: true},
+		{name: "SharedWithExclusive", heldLock: unix.LOCK_EX, expectRead: false},
+		{name: "ExclusiveWithShared", heldLock: unix.LOCK_SH, exclusive: true, expectRead: false},
+		{name: "ReadOnFailure", heldLock: unix.LOCK_EX, readOnFailure: true, expectRead: true},
+		{name: "ReleasedWithinTimeout", heldLock: unix.LOCK_EX, timeout: 5 * time.Second, releaseAfter: 50 * time.Millisecond, expectRead: true},
+		{name: "NotReleasedWithinTimeout", heldLock: unix.LOCK_EX, timeout: 50 * time.Millisecond, expectRead: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			temp := filetest.OpenTemp(t, t.TempDir())
+			filetest.WriteString(t, temp, "testlog1\n")
+
+			// Locks are held per open file, so a separate file conflicts like another process would
+			other, err := os.Open(temp.Name())
+			require.NoError(t, err)
+			defer other.Close()
+			require.NoError(t, unix.Flock(int(other.Fd()), tc.heldLock|unix.LOCK_NB))
+			if tc.releaseAfter > 0 {
+				time.AfterFunc(tc.releaseAfter, func() {
+					_ = unix.Flock(int(other.Fd()), unix.LOCK_UN)
+				})
+			}
+
+			f, sink := testFactory(t)
+			f.AcquireFSLock = true
+			f.FSLockExclusive = tc.exclusive
+			f.FSLockTimeout = tc.timeout
+			f.FSLockReadOnFailure = tc.readOnFailure
+			fp, err := f.NewFingerprint(temp)
+			require.NoError(t, err)
+			r, err := f.NewReader(temp, fp)
+			require.NoError(t, err)
+			defer r.Close()
+
+			r.ReadToEnd(t.Context())
+			if tc.expectRead {
+				sink.ExpectToken(t, []byte("testlog1"))
+			} else {
+				sink.ExpectNoCalls(t)
+			}
+		})
+	}
+}
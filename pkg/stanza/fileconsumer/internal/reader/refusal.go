@@ -0,0 +1,23 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+)
+
+// errRefused is returned when emitting tokens which the consumer refused.
+var errRefused = errors.New("tokens refused by the consumer")
+
+type refusalKey struct{}
+
+// MarkRefused records that the consumer refused the tokens emitted with ctx, so that the
+// reader which emitted them reads them again, if it reads refused tokens again.
+func MarkRefused(ctx context.Context) {
+	if refused, ok := ctx.Value(refusalKey{}).(*atomic.Bool); ok {
+		refused.Store(true)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+
+import "sync"
+
+// Sequencer numbers the tokens of each logical file, which is identified by the path at which
+// the file was first read. The numbers continue across rotations of the file, as the file which
+// replaces a rotated one is read at the same path, and across restarts, as the last number of
+// each file is kept in its metadata. It is safe for concurrent use.
+type Sequencer struct {
+	mu   sync.Mutex
+	last map[string]int64
+}
+
+// NewSequencer creates a Sequencer which numbers the tokens of each logical file from 1.
+func NewSequencer() *Sequencer {
+	return &Sequencer{last: make(map[string]int64)}
+}
+
+// Restore makes the numbers of the logical file of each metadata continue from its last number.
+func (s *Sequencer) Restore(mds []*Metadata) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, md := range mds {
+		s.restore(md)
+	}
+}
+
+func (s *Sequencer) restore(md *Metadata) {
+	if md.SequenceKey != "" && md.Sequence > s.last[md.SequenceKey] {
+		s.last[md.SequenceKey] = md.Sequence
+	}
+}
+
+// Retain forgets the logical files which none of the metadata belongs to.
+func (s *Sequencer) Retain(mds []*Metadata) {
+	keys := make(map[string]struct{}, len(mds))
+	for _, md := range mds {
+		keys[md.SequenceKey] = struct{}{}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.last {
+		if _, ok := keys[key]; !ok {
+			delete(s.last, key)
+		}
+	}
+}
+
+// next takes the next n numbers of the logical file, and returns the last of them.
+func (s *Sequencer) next(key string, n int) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.last[key] += int64(n)
+	return s.last[key]
+}
@@ -0,0 +1,27 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSequencer(t *testing.T) {
+	s := NewSequencer()
+	assert.Equal(t, int64(3), s.next("a.log", 3))
+	assert.Equal(t, int64(5), s.next("a.log", 2))
+	assert.Equal(t, int64(1), s.next("b.log", 1))
+
+	// The numbers continue from the highest one restored
+	s.Restore([]*Metadata{{SequenceKey: "a.log", Sequence: 4}, {SequenceKey: "b.log", Sequence: 10}})
+	assert.Equal(t, int64(6), s.next("a.log", 1))
+	assert.Equal(t, int64(11), s.next("b.log", 1))
+
+	// The logical files without metadata are forgotten
+	s.Retain([]*Metadata{{SequenceKey: "a.log", Sequence: 6}})
+	assert.Equal(t, int64(7), s.next("a.log", 1))
+	assert.Equal(t, int64(1), s.next("b.log", 1))
+}
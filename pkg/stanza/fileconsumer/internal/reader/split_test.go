@@ -4,18 +4,30 @@
 package reader
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 	"go.uber.org/zap/zaptest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/textutils"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/header"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/metadatatest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/internal/filetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/internal/stanzatime"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/regex"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/split"
@@ -54,6 +66,119 @@ func TestPersistFlusher(t *testing.T) {
 	sink.ExpectToken(t, []byte("log without newline"))
 }
 
+func TestPersistPartialToken(t *testing.T) {
+	f, sink := testFactory(t, withCompression("gzip"))
+
+	temp := filetest.OpenTemp(t, t.TempDir())
+	appendGzip := func(content string) {
+		file, err := os.OpenFile(temp.Name(), os.O_APPEND|os.O_WRONLY, 0)
+		require.NoError(t, err)
+		defer file.Close()
+		writer := gzip.NewWriter(file)
+		_, err = writer.Write([]byte(content))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+	}
+	appendGzip("log with newline\nlog with")
+
+	clock := stanzatime.NewAlwaysIncreasingClock()
+	stanzatime.Now = clock.Now
+	stanzatime.Since = clock.Since
+	defer func() {
+		stanzatime.Now = time.Now
+		stanzatime.Since = time.Since
+	}()
+
+	fp, err := f.NewFingerprint(temp)
+	require.NoError(t, err)
+	r, err := f.NewReader(temp, fp)
+	require.NoError(t, err)
+
+	// The unterminated data at the end of the compressed stream is not emitted yet
+	r.ReadToEnd(t.Context())
+	sink.ExpectToken(t, []byte("log with newline"))
+	sink.ExpectNoCalls(t)
+
+	// Simulate a restart, where the metadata is persisted and loaded again
+	encoded, err := json.Marshal(r.Close())
+	require.NoError(t, err)
+	m := new(Metadata)
+	require.NoError(t, json.Unmarshal(encoded, m))
+	require.Equal(t, []byte("log with"), m.PartialToken)
+
+	file, err := os.Open(temp.Name())
+	require.NoError(t, err)
+	r, err = f.NewReaderFromMetadata(file, m)
+	require.NoError(t, err)
+	defer r.Close()
+
+	// The partial token is completed by the data appended to the file
+	appendGzip(" more\nunterminated")
+	r.ReadToEnd(t.Context())
+	sink.ExpectToken(t, []byte("log with more"))
+
+	r.ReadToEnd(t.Context())
+	sink.ExpectNoCalls(t)
+
+	// The partial token is flushed when nothing is appended within the flush period
+	clock.Advance(2 * defaultFlushPeriod)
+	r.ReadToEnd(t.Context())
+	sink.ExpectToken(t, []byte("unterminated"))
+	assert.Nil(t, r.PartialToken)
+}
+
+func TestGzipMembers(t *testing.T) {
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(tel.NewTelemetrySettings())
+	require.NoError(t, err)
+
+	f, sink := testFactory(t, withCompression("gzip"))
+	f.TelemetryBuilder = telemetryBuilder
+
+	gzipMember := func(content string) string {
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		_, err := writer.Write([]byte(content))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+		return buf.String()
+	}
+	first, second, third := gzipMember("testlog1\n"), gzipMember("testlog2\n"), gzipMember("testlog3\n")
+
+	// The second member is cut short after its header
+	temp := filetest.OpenTemp(t, t.TempDir())
+	appendData := func(content string) {
+		file, err := os.OpenFile(temp.Name(), os.O_APPEND|os.O_WRONLY, 0)
+		require.NoError(t, err)
+		defer file.Close()
+		_, err = file.WriteString(content)
+		require.NoError(t, err)
+	}
+	appendData(first + second[:10])
+
+	fp, err := f.NewFingerprint(temp)
+	require.NoError(t, err)
+	r, err := f.NewReader(temp, fp)
+	require.NoError(t, err)
+	defer r.Close()
+
+	r.ReadToEnd(t.Context())
+	sink.ExpectToken(t, []byte("testlog1"))
+	sink.ExpectNoCalls(t)
+	assert.Equal(t, int64(len(first)), r.Offset)
+
+	// The second member is read once the rest of it is written, and a corrupt member is skipped
+	appendData(second[10:] + "garbage" + third)
+	r.ReadToEnd(t.Context())
+	sink.ExpectTokens(t, []byte("testlog2"), []byte("testlog3"))
+	sink.ExpectNoCalls(t)
+	assert.Equal(t, int64(len(first+second+"garbage"+third)), r.Offset)
+	metadatatest.AssertEqualFileconsumerCorruptCompressedMembers(t, tel,
+		[]metricdata.DataPoint[int64]{{Value: 1}},
+		metricdatatest.IgnoreTimestamp())
+}
+
 func TestTokenization(t *testing.T) {
 	testCases := []struct {
 		testName    string
@@ -218,6 +343,66 @@ func TestTokenizationTooLongWithTruncate(t *testing.T) {
 	}
 }
 
+func TestTokenizationTooLongWithContinuation(t *testing.T) {
+	fileContent := []byte("aaaaaaaaaaaaaaaaaaaaaa\naaa\n")
+
+	f, sink := testFactory(t, withMaxLogSize(10))
+
+	temp := filetest.OpenTemp(t, t.TempDir())
+	_, err := temp.Write(fileContent)
+	require.NoError(t, err)
+
+	fp, err := f.NewFingerprint(temp)
+	require.NoError(t, err)
+
+	r, err := f.NewReader(temp, fp)
+	require.NoError(t, err)
+
+	r.ReadToEnd(t.Context())
+
+	fileName := filepath.Base(temp.Name())
+	continuationAttrs := map[string]any{
+		attrs.LogFileName:               fileName,
+		attrs.LogFileRecordContinuation: true,
+	}
+	sink.ExpectCall(t, []byte("aaaaaaaaaa"), map[string]any{attrs.LogFileName: fileName})
+	sink.ExpectCall(t, []byte("aaaaaaaaaa"), continuationAttrs)
+	sink.ExpectCall(t, []byte("aa"), continuationAttrs)
+	sink.ExpectCall(t, []byte("aaa"), map[string]any{attrs.LogFileName: fileName})
+}
+
+func TestTokenizationTooLongWithDrop(t *testing.T) {
+	fileContent := []byte("aaaaaaaaaaaaaaaaaaaaaa\naaa\nbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb\nccc\n")
+
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(tel.NewTelemetrySettings())
+	require.NoError(t, err)
+
+	f, sink := testFactory(t, withMaxLogSize(10), withDropOnMaxLogSize(true))
+	f.TelemetryBuilder = telemetryBuilder
+
+	temp := filetest.OpenTemp(t, t.TempDir())
+	_, err = temp.Write(fileContent)
+	require.NoError(t, err)
+
+	fp, err := f.NewFingerprint(temp)
+	require.NoError(t, err)
+
+	r, err := f.NewReader(temp, fp)
+	require.NoError(t, err)
+
+	r.ReadToEnd(t.Context())
+
+	sink.ExpectToken(t, []byte("aaa"))
+	sink.ExpectToken(t, []byte("ccc"))
+	sink.ExpectNoCalls(t)
+	assert.Equal(t, int64(len(fileContent)), r.Offset)
+	metadatatest.AssertEqualFileconsumerDroppedLogRecords(t, tel,
+		[]metricdata.DataPoint[int64]{{Value: 2}},
+		metricdatatest.IgnoreTimestamp())
+}
+
 // TestTokenizationMultilineWithTruncate tests that multiline patterns work correctly
 // with truncate mode. This is a regression test for the issue where the truncate
 // function incorrectly assumed newlines mark entry boundaries.
@@ -305,7 +490,7 @@ func TestHeaderFingerprintIncluded(t *testing.T) {
 
 	set := componenttest.NewNopTelemetrySettings()
 	set.Logger = zaptest.NewLogger(t)
-	h, err := header.NewConfig(set, "^#", []operator.Config{{Builder: regexConf}}, enc)
+	h, err := header.NewConfig(set, "^#", []operator.Config{{Builder: regexConf}}, nil, enc)
 	require.NoError(t, err)
 	f.HeaderConfig = h
 
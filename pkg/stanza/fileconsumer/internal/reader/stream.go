@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+
+import (
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/flush"
+)
+
+// IsStream reports whether the file mode is that of a named pipe or a character device.
+func IsStream(mode os.FileMode) bool {
+	return mode&(os.ModeNamedPipe|os.ModeCharDevice) != 0
+}
+
+// NewStreamReader creates a reader for a named pipe or a character device. These cannot be seeked,
+// so their content is read as it is written, without a fingerprint or an offset to resume from,
+// and their encoding and compression are not detected. The last token is flushed once the writer
+// closes the stream.
+func (f *Factory) NewStreamReader(file *os.File) (*Reader, error) {
+	attributes, err := f.Attributes.Resolve(file)
+	if err != nil {
+		return nil, err
+	}
+	m := &Metadata{
+		Fingerprint:    fingerprint.New(nil),
+		FileAttributes: attributes,
+		FlushState: flush.State{
+			LastDataChange: time.Now(),
+		},
+	}
+	r := &Reader{
+		Metadata:          m,
+		set:               f.TelemetrySettings,
+		file:              file,
+		fileName:          file.Name(),
+		bufPool:           &f.BufPool,
+		initialBufferSize: f.InitialBufferSize,
+		largeBufPool:      &f.LargeBufPool,
+		maxBufferSize:     f.MaxBufferSize,
+		maxLogSize:        f.MaxLogSize,
+		// A read waits until the stream is written again, so its tokens are emitted as they are read
+		maxBatchSize:     1,
+		maxBatchBytes:    f.MaxBatchBytes,
+		normalizeFunc:    f.NormalizeFunc,
+		emitFunc:         f.EmitFunc,
+		telemetryBuilder: f.TelemetryBuilder,
+		stream:           true,
+	}
+	r.set.Logger = r.set.Logger.With(zap.String("path", r.fileName))
+	if f.MaxFileBytesPerSecond > 0 {
+		m.limiter = NewRateLimiter(f.MaxFileBytesPerSecond)
+		r.limiters = append(r.limiters, m.limiter)
+	}
+	if f.RateLimiter != nil {
+		r.limiters = append(r.limiters, f.RateLimiter)
+	}
+	r.decoder = f.Encoding.NewDecoder()
+	r.contentSplitFunc = f.contentSplitFunc(r, f.SplitFunc)
+	return r, nil
+}
@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"regexp"
+	"time"
+)
+
+// timestampScanSize is the size of the range of a file below which the binary
+// search for the cutoff gives way to a scan of the lines.
+const timestampScanSize = 64 * 1024
+
+// TimestampSeeker finds the offset of the first line of a file with a timestamp
+// which is not before a cutoff. The timestamps of the lines are expected to be in order.
+type TimestampSeeker struct {
+	Cutoff time.Time
+	// Regex must have a subexpression named "timestamp" which captures the timestamp of a line.
+	Regex *regexp.Regexp
+	Parse func(string) (time.Time, error)
+}
+
+// Seek returns the offset of the first line of file with a timestamp which is not before
+// the cutoff, or the size of the file if there is no such line. Lines without a timestamp,
+// such as the continuation lines of multiline records, are skipped over.
+func (s *TimestampSeeker) Seek(file *os.File) (int64, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+	size := info.Size()
+
+	// lo is always the start of a line which is before the cutoff or of the file,
+	// so the line being looked for starts at or after it.
+	lo, hi := int64(0), size
+	for hi-lo > timestampScanSize {
+		mid := lo + (hi-lo)/2
+		start, ts, found, err := s.next(file, mid, hi)
+		if err != nil {
+			return 0, err
+		}
+		if found && ts.Before(s.Cutoff) {
+			lo = start
+		} else {
+			hi = mid
+		}
+	}
+
+	for {
+		start, ts, found, err := s.next(file, lo, size)
+		if err != nil {
+			return 0, err
+		}
+		if !found {
+			return size, nil
+		}
+		if !ts.Before(s.Cutoff) {
+			return start, nil
+		}
+		lo = start + 1
+	}
+}
+
+// next returns the start and timestamp of the first line with a timestamp which starts
+// in the range [from, to) of the file. A line which is cut by from is not considered.
+func (s *TimestampSeeker) next(file *os.File, from, to int64) (start int64, ts time.Time, found bool, err error) {
+	start = from
+	if from > 0 {
+		// Begin at the previous byte to find out whether from is the start of a line
+		start = from - 1
+	}
+	r := bufio.NewReader(io.NewSectionReader(file, start, 1<<62))
+	if from > 0 {
+		skipped, err := r.ReadBytes('\n')
+		if err != nil {
+			return 0, time.Time{}, false, ignoreEOF(err)
+		}
+		start += int64(len(skipped))
+	}
+
+	for start < to {
+		line, err := r.ReadBytes('\n')
+		if len(line) > 0 {
+			if ts, ok := s.timestamp(line); ok {
+				return start, ts, true, nil
+			}
+		}
+		if err != nil {
+			return 0, time.Time{}, false, ignoreEOF(err)
+		}
+		start += int64(len(line))
+	}
+	return 0, time.Time{}, false, nil
+}
+
+func (s *TimestampSeeker) timestamp(line []byte) (time.Time, bool) {
+	match := s.Regex.FindSubmatch(line)
+	if match == nil {
+		return time.Time{}, false
+	}
+	ts, err := s.Parse(string(match[s.Regex.SubexpIndex("timestamp")]))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return ts, true
+}
+
+func ignoreEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reader
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimestampSeeker(t *testing.T) {
+	base := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	// Each record takes a minute and has a continuation line without a timestamp
+	var content strings.Builder
+	var offsets []int64
+	const records = 5000
+	for i := range records {
+		offsets = append(offsets, int64(content.Len()))
+		fmt.Fprintf(&content, "%s record %d\n\tcontinued\n", base.Add(time.Duration(i)*time.Minute).Format(time.RFC3339), i)
+	}
+	size := int64(content.Len())
+	path := filepath.Join(t.TempDir(), "test.log")
+	require.NoError(t, os.WriteFile(path, []byte(content.String()), 0o600))
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	testCases := []struct {
+		name     string
+		cutoff   time.Time
+		expected int64
+	}{
+		{name: "BeforeFirst", cutoff: base.Add(-time.Hour), expected: 0},
+		{name: "First", cutoff: base, expected: 0},
+		{name: "Exact", cutoff: base.Add(1234 * time.Minute), expected: offsets[1234]},
+		{name: "Between", cutoff: base.Add(4321*time.Minute + time.Second), expected: offsets[4322]},
+		{name: "Last", cutoff: base.Add((records - 1) * time.Minute), expected: offsets[records-1]},
+		{name: "AfterLast", cutoff: base.Add(records * time.Minute), expected: size},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			seeker := &TimestampSeeker{
+				Cutoff: tc.cutoff,
+				Regex:  regexp.MustCompile(`^(?P<timestamp>\S+) record`),
+				Parse: func(value string) (time.Time, error) {
+					return time.Parse(time.RFC3339, value)
+				},
+			}
+			offset, err := seeker.Seek(file)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, offset)
+		})
+	}
+}
@@ -16,7 +16,8 @@ const DefaultBufferSize = 16 * 1024
 
 // Scanner is a scanner that maintains position
 type Scanner struct {
-	pos int64
+	pos     int64
+	partial bool
 	*bufio.Scanner
 }
 
@@ -36,7 +37,8 @@ func New(r io.Reader, maxLogSize int, buf []byte, startOffset int64, splitFunc b
 			advance, token, err = splitFunc(data, atEOF)
 			// flush data if there are no more tokens but there is still data left
 			// this is because gzip reader reads the entire compressed stream in one go
-			if advance == 0 && token == nil && atEOF && len(data) > 0 {
+			s.partial = advance == 0 && token == nil && atEOF && len(data) > 0
+			if s.partial {
 				advance = len(data)
 				token = data
 			}
@@ -54,6 +56,12 @@ func (s *Scanner) Pos() int64 {
 	return s.pos
 }
 
+// Partial returns whether the current token was flushed at the end of a compressed
+// stream without the split func considering it to be complete
+func (s *Scanner) Partial() bool {
+	return s.partial
+}
+
 func (s *Scanner) Error() error {
 	err := s.Err()
 	if errors.Is(err, bufio.ErrTooLong) {
@@ -148,6 +148,7 @@ func TestScannerGzip(t *testing.T) {
 		name     string
 		reader   io.Reader
 		expected [][]byte
+		partial  []bool
 		wantPos  int64
 	}{
 		{
@@ -157,6 +158,7 @@ func TestScannerGzip(t *testing.T) {
 			expected: [][]byte{
 				[]byte("last-line-without-delimiter"),
 			},
+			partial: []bool{true},
 		},
 		{
 			name:    "does not merge tokens (normal reader)",
@@ -166,6 +168,7 @@ func TestScannerGzip(t *testing.T) {
 				[]byte("a"),
 				[]byte("b"),
 			},
+			partial: []bool{false, false},
 		},
 		{
 			name:    "does not merge tokens (atEOF with delimiters)",
@@ -175,6 +178,7 @@ func TestScannerGzip(t *testing.T) {
 				[]byte("a"),
 				[]byte("b"),
 			},
+			partial: []bool{false, false},
 		},
 	}
 
@@ -189,13 +193,16 @@ func TestScannerGzip(t *testing.T) {
 			)
 
 			var got [][]byte
+			var partial []bool
 			for s.Scan() {
 				assert.NoError(t, s.Error())
 				got = append(got, append([]byte(nil), s.Bytes()...))
+				partial = append(partial, s.Partial())
 			}
 			assert.NoError(t, s.Error())
 
 			assert.Equal(t, tc.expected, got)
+			assert.Equal(t, tc.partial, partial)
 			assert.Equal(t, tc.wantPos, s.Pos())
 		})
 	}
@@ -30,16 +30,24 @@ type Tracker interface {
 	GetCurrentFile(fp *fingerprint.Fingerprint) *reader.Reader
 	GetOpenFile(fp *fingerprint.Fingerprint) *reader.Reader
 	GetClosedFile(fp *fingerprint.Fingerprint) *reader.Metadata
+	// GetClosedFileFunc returns the metadata of a closed file which fp continues and which satisfies keep.
+	GetClosedFileFunc(fp *fingerprint.Fingerprint, keep func(*reader.Metadata) bool) *reader.Metadata
 	GetMetadata() []*reader.Metadata
 	LoadMetadata(metadata []*reader.Metadata)
 	CurrentPollFiles() []*reader.Reader
 	PreviousPollFiles() []*reader.Reader
+	// ExtendFingerprints extends the fingerprints of the open files of the previous poll
+	// which are shorter than the fingerprint size, as far as their files have grown.
+	ExtendFingerprints()
+	LostFiles() []*reader.Reader
 	ClosePreviousFiles() int
 	EndPoll(context.Context)
 	EndConsume() int
 	TotalReaders() int
 	AddUnmatched(*os.File, *fingerprint.Fingerprint)
 	LookupArchive(context.Context) ([]*os.File, []*fingerprint.Fingerprint, []*reader.Metadata)
+	// CheckpointedFiles returns the number of files whose offsets are checkpointed, including the archive.
+	CheckpointedFiles() int
 }
 
 // fileTracker tracks known offsets for files that are being consumed by the manager.
@@ -52,13 +60,19 @@ type fileTracker struct {
 	previousPollFiles *fileset.Fileset[*reader.Reader]
 	knownFiles        []*fileset.Fileset[*reader.Metadata]
 
+	// lostFileGracePolls is the number of polls for which a file that is no longer
+	// matched is kept open before it is considered lost.
+	lostFileGracePolls int
+	// missedPolls counts the polls which missed each file of the previous poll.
+	missedPolls map[*reader.Reader]int
+
 	unmatchedFiles []*os.File
 	unmatchedFps   []*fingerprint.Fingerprint
 
 	archive archive.Archive
 }
 
-func NewFileTracker(ctx context.Context, set component.TelemetrySettings, maxBatchFiles, pollsToArchive int, persister operator.Persister) Tracker {
+func NewFileTracker(ctx context.Context, set component.TelemetrySettings, maxBatchFiles, pollsToArchive, lostFileGracePolls int, retention archive.Retention, persister operator.Persister) Tracker {
 	knownFiles := make([]*fileset.Fileset[*reader.Metadata], 3)
 	for i := range knownFiles {
 		knownFiles[i] = fileset.New[*reader.Metadata](maxBatchFiles)
@@ -66,12 +80,14 @@ func NewFileTracker(ctx context.Context, set component.TelemetrySettings, maxBat
 	set.Logger = set.Logger.With(zap.String("tracker", "fileTracker"))
 
 	t := &fileTracker{
-		set:               set,
-		maxBatchFiles:     maxBatchFiles,
-		currentPollFiles:  fileset.New[*reader.Reader](maxBatchFiles),
-		previousPollFiles: fileset.New[*reader.Reader](maxBatchFiles),
-		knownFiles:        knownFiles,
-		archive:           archive.New(ctx, set.Logger.Named("archive"), pollsToArchive, persister),
+		set:                set,
+		maxBatchFiles:      maxBatchFiles,
+		currentPollFiles:   fileset.New[*reader.Reader](maxBatchFiles),
+		previousPollFiles:  fileset.New[*reader.Reader](maxBatchFiles),
+		knownFiles:         knownFiles,
+		lostFileGracePolls: lostFileGracePolls,
+		missedPolls:        make(map[*reader.Reader]int),
+		archive:            archive.New(ctx, set.Logger.Named("archive"), pollsToArchive, retention, persister),
 	}
 	return t
 }
@@ -90,7 +106,12 @@ func (t *fileTracker) GetCurrentFile(fp *fingerprint.Fingerprint) *reader.Reader
 }
 
 func (t *fileTracker) GetOpenFile(fp *fingerprint.Fingerprint) *reader.Reader {
-	return t.previousPollFiles.MatchStartsWith(fp)
+	r := t.previousPollFiles.MatchStartsWith(fp)
+	if r != nil {
+		// The file was found again within its grace period
+		delete(t.missedPolls, r)
+	}
+	return r
 }
 
 func (t *fileTracker) GetClosedFile(fp *fingerprint.Fingerprint) *reader.Metadata {
@@ -102,6 +123,15 @@ func (t *fileTracker) GetClosedFile(fp *fingerprint.Fingerprint) *reader.Metadat
 	return nil
 }
 
+func (t *fileTracker) GetClosedFileFunc(fp *fingerprint.Fingerprint, keep func(*reader.Metadata) bool) *reader.Metadata {
+	for i := 0; i < len(t.knownFiles); i++ {
+		if oldMetadata := t.knownFiles[i].MatchFunc(fp, keep); oldMetadata != nil {
+			return oldMetadata
+		}
+	}
+	return nil
+}
+
 func (t *fileTracker) AddUnmatched(file *os.File, fp *fingerprint.Fingerprint) {
 	// exclude duplicate fingerprints
 	if slices.ContainsFunc(t.unmatchedFps, fp.Equal) {
@@ -143,12 +173,40 @@ func (t *fileTracker) PreviousPollFiles() []*reader.Reader {
 	return t.previousPollFiles.Get()
 }
 
+func (t *fileTracker) ExtendFingerprints() {
+	var extended bool
+	for _, r := range t.previousPollFiles.Get() {
+		if r.ExtendFingerprint() {
+			extended = true
+		}
+	}
+	if extended {
+		t.previousPollFiles.Reindex()
+	}
+}
+
+// LostFiles returns the files of the previous poll which were not matched by the
+// current poll, and whose grace period has expired.
+func (t *fileTracker) LostFiles() []*reader.Reader {
+	if t.lostFileGracePolls == 0 {
+		return t.previousPollFiles.Get()
+	}
+	var lost []*reader.Reader
+	for _, r := range t.previousPollFiles.Get() {
+		if t.missedPolls[r] >= t.lostFileGracePolls {
+			lost = append(lost, r)
+		}
+	}
+	return lost
+}
+
 func (t *fileTracker) ClosePreviousFiles() (filesClosed int) {
 	// t.previousPollFiles -> t.knownFiles[0]
 	for r, _ := t.previousPollFiles.Pop(); r != nil; r, _ = t.previousPollFiles.Pop() {
 		t.knownFiles[0].Add(r.Close())
 		filesClosed++
 	}
+	clear(t.missedPolls)
 	return filesClosed
 }
 
@@ -164,6 +222,10 @@ func (t *fileTracker) EndPoll(ctx context.Context) {
 	t.knownFiles[0] = oldest
 }
 
+func (t *fileTracker) CheckpointedFiles() int {
+	return t.TotalReaders() + t.archive.Len()
+}
+
 func (t *fileTracker) TotalReaders() int {
 	total := t.previousPollFiles.Len()
 	for i := 0; i < len(t.knownFiles); i++ {
@@ -223,18 +285,28 @@ func (*noStateTracker) GetOpenFile(*fingerprint.Fingerprint) *reader.Reader { re
 
 func (*noStateTracker) GetClosedFile(*fingerprint.Fingerprint) *reader.Metadata { return nil }
 
+func (*noStateTracker) GetClosedFileFunc(*fingerprint.Fingerprint, func(*reader.Metadata) bool) *reader.Metadata {
+	return nil
+}
+
 func (*noStateTracker) GetMetadata() []*reader.Metadata { return nil }
 
 func (*noStateTracker) LoadMetadata([]*reader.Metadata) {}
 
 func (*noStateTracker) PreviousPollFiles() []*reader.Reader { return nil }
 
+func (*noStateTracker) ExtendFingerprints() {}
+
+func (*noStateTracker) LostFiles() []*reader.Reader { return nil }
+
 func (*noStateTracker) ClosePreviousFiles() int { return 0 }
 
 func (*noStateTracker) EndPoll(context.Context) {}
 
 func (*noStateTracker) TotalReaders() int { return 0 }
 
+func (*noStateTracker) CheckpointedFiles() int { return 0 }
+
 func (t *noStateTracker) AddUnmatched(file *os.File, fp *fingerprint.Fingerprint) {
 	t.unmatchedFiles = append(t.unmatchedFiles, file)
 	t.unmatchedFps = append(t.unmatchedFps, fp)
@@ -14,7 +14,7 @@ import (
 // and read "lost" files, which have been moved out of the matching pattern.
 func (t *fileTracker) EndConsume() (filesClosed int) {
 	spare := t.previousPollFiles
-	filesClosed = t.ClosePreviousFiles()
+	filesClosed = t.closeLostFiles()
 
 	// t.currentPollFiles -> t.previousPollFiles
 	t.previousPollFiles = t.currentPollFiles
@@ -30,3 +30,19 @@ func (t *fileTracker) EndConsume() (filesClosed int) {
 	t.unmatchedFps = t.unmatchedFps[:0]
 	return filesClosed
 }
+
+// closeLostFiles closes the files of the previous poll whose grace period has expired,
+// and carries the others over into the current poll.
+func (t *fileTracker) closeLostFiles() (filesClosed int) {
+	for r, _ := t.previousPollFiles.Pop(); r != nil; r, _ = t.previousPollFiles.Pop() {
+		if missed := t.missedPolls[r]; missed < t.lostFileGracePolls {
+			t.missedPolls[r] = missed + 1
+			t.currentPollFiles.Add(r)
+			continue
+		}
+		delete(t.missedPolls, r)
+		t.knownFiles[0].Add(r.Close())
+		filesClosed++
+	}
+	return filesClosed
+}
@@ -4,11 +4,13 @@
 package tracker
 
 import (
+	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/archive"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/fingerprint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
@@ -18,7 +20,7 @@ func TestFileTrackerReusesFilesetsBetweenPolls(t *testing.T) {
 	set := componenttest.NewNopTelemetrySettings()
 	persister := testutil.NewUnscopedMockPersister()
 
-	tracker := NewFileTracker(t.Context(), set, 10, 0, persister)
+	tracker := NewFileTracker(t.Context(), set, 10, 0, 0, archive.Retention{}, persister)
 	ft := tracker.(*fileTracker)
 
 	firstCurrent := ft.currentPollFiles
@@ -43,7 +45,7 @@ func TestFileTrackerReusesUnmatchedSlices(t *testing.T) {
 	set := componenttest.NewNopTelemetrySettings()
 	persister := testutil.NewUnscopedMockPersister()
 
-	tracker := NewFileTracker(t.Context(), set, 10, 0, persister)
+	tracker := NewFileTracker(t.Context(), set, 10, 0, 0, archive.Retention{}, persister)
 	ft := tracker.(*fileTracker)
 
 	ft.AddUnmatched(nil, fingerprint.New([]byte("fp1")))
@@ -64,7 +66,7 @@ func TestFileTrackerReusesKnownFilesAcrossPolls(t *testing.T) {
 	set := componenttest.NewNopTelemetrySettings()
 	persister := testutil.NewUnscopedMockPersister()
 
-	tracker := NewFileTracker(t.Context(), set, 10, 0, persister)
+	tracker := NewFileTracker(t.Context(), set, 10, 0, 0, archive.Retention{}, persister)
 	ft := tracker.(*fileTracker)
 
 	first := ft.knownFiles[0]
@@ -80,6 +82,41 @@ func TestFileTrackerReusesKnownFilesAcrossPolls(t *testing.T) {
 	require.Zero(t, ft.knownFiles[0].Len())
 }
 
+func TestFileTrackerLostFileGracePolls(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("files are closed after every poll on windows")
+	}
+	set := componenttest.NewNopTelemetrySettings()
+	persister := testutil.NewUnscopedMockPersister()
+
+	tracker := NewFileTracker(t.Context(), set, 10, 0, 2, archive.Retention{}, persister)
+
+	lost := newTestReader("lost")
+	// The metadata of the reader is handed over once it is closed
+	lostFingerprint := lost.Fingerprint
+	found := newTestReader("found")
+	tracker.Add(lost)
+	tracker.Add(found)
+	tracker.EndConsume()
+
+	// Both files are missed by the first poll, which is within the grace period
+	require.Empty(t, tracker.LostFiles())
+	require.Zero(t, tracker.EndConsume())
+
+	// One file is found again by the second poll
+	require.Same(t, found, tracker.GetOpenFile(found.Fingerprint))
+	tracker.Add(found)
+	require.Empty(t, tracker.LostFiles())
+	require.Zero(t, tracker.EndConsume())
+
+	// The grace period of the other file expires with the third poll
+	tracker.Add(tracker.GetOpenFile(found.Fingerprint))
+	require.Equal(t, []*reader.Reader{lost}, tracker.LostFiles())
+	require.Equal(t, 1, tracker.EndConsume())
+	require.Equal(t, []*reader.Reader{found}, tracker.PreviousPollFiles())
+	require.NotNil(t, tracker.GetClosedFile(lostFingerprint))
+}
+
 func TestNoStateTrackerReusesUnmatchedSlices(t *testing.T) {
 	set := componenttest.NewNopTelemetrySettings()
 
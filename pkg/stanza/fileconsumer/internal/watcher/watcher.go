@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package watcher // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/watcher"
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/fsnotify/fsnotify"
+	"go.uber.org/zap"
+)
+
+// Watcher subscribes to file system events (inotify, FSEvents/kqueue or
+// ReadDirectoryChangesW) in the directories that may contain matching files,
+// and signals when a matching file is created, written or renamed.
+//
+// Watches are not recursive, so directories are added as they are discovered.
+// Events only shorten the time until the next read, the poll loop remains
+// responsible for discovering and reading files.
+type Watcher struct {
+	logger   *zap.Logger
+	fsw      *fsnotify.Watcher
+	include  []string
+	bases    []string
+	mu       sync.Mutex
+	watching map[string]struct{}
+	triggers chan struct{}
+}
+
+// New creates a Watcher for the given include patterns, and watches the
+// static base directory of each pattern.
+func New(logger *zap.Logger, include []string) (*Watcher, error) {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	w := &Watcher{
+		logger:   logger,
+		fsw:      fsw,
+		include:  include,
+		watching: make(map[string]struct{}),
+		// A single pending trigger is enough, as one poll reads every file.
+		triggers: make(chan struct{}, 1),
+	}
+	for _, pattern := range include {
+		base, _ := doublestar.SplitPattern(filepath.ToSlash(pattern))
+		w.bases = append(w.bases, filepath.FromSlash(base))
+	}
+	w.WatchDirs(nil)
+	return w, nil
+}
+
+// Triggers returns a channel that receives a value when matching files changed.
+// Events received while a value is pending are coalesced.
+func (w *Watcher) Triggers() <-chan struct{} {
+	return w.triggers
+}
+
+// WatchDirs watches the directories of the given file paths, which is
+// required for patterns that match files in nested directories. Base
+// directories of the patterns that did not exist before are retried as well.
+func (w *Watcher) WatchDirs(paths []string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, base := range w.bases {
+		w.watch(base)
+	}
+	for _, path := range paths {
+		w.watch(filepath.Dir(path))
+	}
+}
+
+// Run processes events until ctx is done, then releases the watcher.
+func (w *Watcher) Run(ctx context.Context) {
+	defer func() {
+		if err := w.fsw.Close(); err != nil {
+			w.logger.Debug("Failed to close file system watcher", zap.Error(err))
+		}
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-w.fsw.Events:
+			if !ok {
+				return
+			}
+			w.handle(event)
+		case err, ok := <-w.fsw.Errors:
+			if !ok {
+				return
+			}
+			// Missed events are picked up by the next regular poll.
+			w.logger.Debug("File system watcher error", zap.Error(err))
+		}
+	}
+}
+
+func (w *Watcher) handle(event fsnotify.Event) {
+	if event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename) {
+		// Watches are dropped along with removed directories, so forget about
+		// them to watch the directories again if they are recreated.
+		w.mu.Lock()
+		delete(w.watching, event.Name)
+		w.mu.Unlock()
+	}
+	if !event.Has(fsnotify.Create) && !event.Has(fsnotify.Write) && !event.Has(fsnotify.Rename) {
+		return
+	}
+	if !w.matches(event.Name) {
+		return
+	}
+	select {
+	case w.triggers <- struct{}{}:
+	default:
+	}
+}
+
+func (w *Watcher) matches(path string) bool {
+	for _, pattern := range w.include {
+		if ok, _ := doublestar.PathMatch(pattern, path); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// watch must be called with mu held.
+func (w *Watcher) watch(dir string) {
+	if _, ok := w.watching[dir]; ok {
+		return
+	}
+	if err := w.fsw.Add(dir); err != nil {
+		// The directory may not exist yet, so try again after the next poll.
+		w.logger.Debug("Failed to watch directory", zap.String("path", dir), zap.Error(err))
+		return
+	}
+	w.watching[dir] = struct{}{}
+}
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package watcher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const waitTime = 2 * time.Second
+
+func startWatcher(t *testing.T, include ...string) *Watcher {
+	w, err := New(zap.NewNop(), include)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		w.Run(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	return w
+}
+
+func expectTrigger(t *testing.T, w *Watcher) {
+	select {
+	case <-w.Triggers():
+	case <-time.After(waitTime):
+		require.FailNow(t, "expected a trigger")
+	}
+}
+
+func expectNoTrigger(t *testing.T, w *Watcher) {
+	select {
+	case <-w.Triggers():
+		require.FailNow(t, "unexpected trigger")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestWatcherMatchingFile(t *testing.T) {
+	dir := t.TempDir()
+	w := startWatcher(t, filepath.Join(dir, "*.log"))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "other.txt"), []byte("ignored\n"), 0o600))
+	expectNoTrigger(t, w)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.log"), []byte("line\n"), 0o600))
+	expectTrigger(t, w)
+}
+
+func TestWatcherNestedDirectory(t *testing.T) {
+	dir := t.TempDir()
+	nested := filepath.Join(dir, "nested")
+	require.NoError(t, os.Mkdir(nested, 0o700))
+	path := filepath.Join(nested, "a.log")
+	require.NoError(t, os.WriteFile(path, []byte("line\n"), 0o600))
+
+	w := startWatcher(t, filepath.Join(dir, "*", "*.log"))
+
+	// The nested directory is only watched once it is known to contain files.
+	w.WatchDirs([]string{path})
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	defer f.Close()
+	_, err = f.WriteString("another line\n")
+	require.NoError(t, err)
+	expectTrigger(t, w)
+}
+
+func TestWatcherMissingBaseDirectory(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "missing")
+	w := startWatcher(t, filepath.Join(dir, "*.log"))
+
+	require.NoError(t, os.Mkdir(dir, 0o700))
+	w.WatchDirs(nil)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.log"), []byte("line\n"), 0o600))
+	expectTrigger(t, w)
+}
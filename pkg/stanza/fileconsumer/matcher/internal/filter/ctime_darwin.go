@@ -0,0 +1,21 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build darwin
+
+package filter // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/matcher/internal/filter"
+
+import (
+	"os"
+	"syscall"
+	"time"
+)
+
+// ChangeTime returns the time at which the inode of the file was last changed,
+// e.g. when the file was copied, even if its modification time was preserved.
+func ChangeTime(fi os.FileInfo) time.Time {
+	if stat, ok := fi.Sys().(*syscall.Stat_t); ok {
+		return time.Unix(stat.Ctimespec.Unix())
+	}
+	return fi.ModTime()
+}
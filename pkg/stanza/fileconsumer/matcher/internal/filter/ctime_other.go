@@ -0,0 +1,16 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !linux && !darwin && !windows
+
+package filter // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/matcher/internal/filter"
+
+import (
+	"os"
+	"time"
+)
+
+// ChangeTime returns the modification time of the file, as its change time is not available.
+func ChangeTime(fi os.FileInfo) time.Time {
+	return fi.ModTime()
+}
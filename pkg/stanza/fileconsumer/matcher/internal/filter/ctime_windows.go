@@ -0,0 +1,21 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+
+package filter // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/matcher/internal/filter"
+
+import (
+	"os"
+	"syscall"
+	"time"
+)
+
+// ChangeTime returns the creation time of the file, which is set when the file is
+// copied, even if its modification time was preserved.
+func ChangeTime(fi os.FileInfo) time.Time {
+	if data, ok := fi.Sys().(*syscall.Win32FileAttributeData); ok {
+		return time.Unix(0, data.CreationTime.Nanoseconds())
+	}
+	return fi.ModTime()
+}
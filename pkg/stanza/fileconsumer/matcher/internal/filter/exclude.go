@@ -12,13 +12,20 @@ import (
 )
 
 type excludeOlderThanOption struct {
-	age time.Duration
+	age      time.Duration
+	fileTime func(os.FileInfo) time.Time
+	keep     func(path string) bool
 }
 
 func (eot excludeOlderThanOption) apply(items []*item) ([]*item, error) {
 	filteredItems := make([]*item, 0, len(items))
 	var errs error
 	for _, item := range items {
+		if eot.keep != nil && eot.keep(item.value) {
+			filteredItems = append(filteredItems, item)
+			continue
+		}
+
 		fi, err := os.Stat(item.value)
 		if err != nil {
 			errs = multierr.Append(errs, err)
@@ -27,7 +34,7 @@ func (eot excludeOlderThanOption) apply(items []*item) ([]*item, error) {
 
 		// Keep (include) the file if its age (since last modification)
 		// is the same or less than the configured age.
-		fileAge := stanzatime.Since(fi.ModTime())
+		fileAge := stanzatime.Since(eot.fileTime(fi))
 		if fileAge <= eot.age {
 			filteredItems = append(filteredItems, item)
 		}
@@ -38,5 +45,19 @@ func (eot excludeOlderThanOption) apply(items []*item) ([]*item, error) {
 
 // ExcludeOlderThan excludes files whose modification time is older than the specified age.
 func ExcludeOlderThan(age time.Duration) Option {
-	return excludeOlderThanOption{age: age}
+	return ExcludeOlderThanFunc(age, os.FileInfo.ModTime, nil)
+}
+
+// ExcludeOlderThanFunc excludes files whose time, as returned by fileTime, is older than the
+// specified age. Files for which keep returns true are never excluded.
+func ExcludeOlderThanFunc(age time.Duration, fileTime func(os.FileInfo) time.Time, keep func(path string) bool) Option {
+	return excludeOlderThanOption{age: age, fileTime: fileTime, keep: keep}
+}
+
+// NewestTime returns the most recent of the modification time and the change time of a file.
+func NewestTime(fi os.FileInfo) time.Time {
+	if ctime := ChangeTime(fi); ctime.After(fi.ModTime()) {
+		return ctime
+	}
+	return fi.ModTime()
 }
@@ -120,3 +120,36 @@ func TestExcludeOlderThanFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestExcludeOlderThanFunc(t *testing.T) {
+	tmpDir := t.TempDir()
+	threeHoursAgo := time.Now().Add(-3 * time.Hour)
+	var items []*item
+	for _, file := range []string{"copied.log", "reading.log"} {
+		fullPath := filepath.Join(tmpDir, file)
+		f, err := os.Create(fullPath)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		// The modification time is preserved, like by copy tools, but the change time is not
+		require.NoError(t, os.Chtimes(fullPath, threeHoursAgo, threeHoursAgo))
+		it, err := newItem(fullPath, nil)
+		require.NoError(t, err)
+		items = append(items, it)
+	}
+	keep := func(path string) bool {
+		return filepath.Base(path) == "reading.log"
+	}
+
+	result, err := ExcludeOlderThanFunc(time.Hour, os.FileInfo.ModTime, keep).apply(items)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, "reading.log", filepath.Base(result[0].value))
+
+	result, err = ExcludeOlderThanFunc(time.Hour, ChangeTime, nil).apply(items)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+
+	result, err = ExcludeOlderThanFunc(time.Hour, NewestTime, nil).apply(items)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+}
@@ -6,6 +6,7 @@ package matcher // import "github.com/open-telemetry/opentelemetry-collector-con
 import (
 	"errors"
 	"fmt"
+	"os"
 	"regexp"
 	"time"
 
@@ -27,14 +28,25 @@ const (
 	defaultOrderingCriteriaTopN = 1
 )
 
+// Times of a file which its age is measured from.
+const (
+	FileTimeMtime  = "mtime"
+	FileTimeCtime  = "ctime"
+	FileTimeNewest = "newest"
+)
+
 type Criteria struct {
 	Include []string `mapstructure:"include,omitempty"`
 	Exclude []string `mapstructure:"exclude,omitempty"`
 
 	// ExcludeOlderThan allows excluding files whose modification time is older
 	// than the specified age.
-	ExcludeOlderThan time.Duration    `mapstructure:"exclude_older_than"`
-	OrderingCriteria OrderingCriteria `mapstructure:"ordering_criteria,omitempty"`
+	ExcludeOlderThan time.Duration `mapstructure:"exclude_older_than"`
+	// ExcludeOlderThanTime is the time of the file which its age is measured from:
+	// 'mtime' (default), 'ctime', which is the creation time on windows, or 'newest',
+	// the most recent of both.
+	ExcludeOlderThanTime string           `mapstructure:"exclude_older_than_time,omitempty"`
+	OrderingCriteria     OrderingCriteria `mapstructure:"ordering_criteria,omitempty"`
 }
 
 type OrderingCriteria struct {
@@ -71,8 +83,22 @@ func New(c Criteria) (*Matcher, error) {
 		exclude: c.Exclude,
 	}
 
+	if c.ExcludeOlderThanTime != "" && c.ExcludeOlderThan == 0 {
+		return nil, errors.New("'exclude_older_than_time' requires 'exclude_older_than' to be specified")
+	}
 	if c.ExcludeOlderThan != 0 {
-		m.filterOpts = append(m.filterOpts, filter.ExcludeOlderThan(c.ExcludeOlderThan))
+		var fileTime func(os.FileInfo) time.Time
+		switch c.ExcludeOlderThanTime {
+		case "", FileTimeMtime:
+			fileTime = os.FileInfo.ModTime
+		case FileTimeCtime:
+			fileTime = filter.ChangeTime
+		case FileTimeNewest:
+			fileTime = filter.NewestTime
+		default:
+			return nil, fmt.Errorf("invalid 'exclude_older_than_time' value '%s'", c.ExcludeOlderThanTime)
+		}
+		m.filterOpts = append(m.filterOpts, filter.ExcludeOlderThanFunc(c.ExcludeOlderThan, fileTime, m.keepFile))
 	}
 
 	if c.OrderingCriteria.GroupBy != "" {
@@ -161,6 +187,17 @@ type Matcher struct {
 	regex      *regexp.Regexp
 	filterOpts []filter.Option
 	groupBy    *regexp.Regexp
+	keep       func(path string) bool
+}
+
+// SetKeepFunc sets a function which reports the files that are not excluded by their
+// age, e.g. because they are still being read.
+func (m *Matcher) SetKeepFunc(keep func(path string) bool) {
+	m.keep = keep
+}
+
+func (m *Matcher) keepFile(path string) bool {
+	return m.keep != nil && m.keep(path)
 }
 
 // MatchFiles gets a list of paths given an array of glob patterns to include and exclude
@@ -238,6 +238,31 @@ func TestNew(t *testing.T) {
 				ExcludeOlderThan: 24 * time.Hour,
 			},
 		},
+		{
+			name: "ExcludeOlderThanCtime",
+			criteria: Criteria{
+				Include:              []string{"*.log"},
+				ExcludeOlderThan:     24 * time.Hour,
+				ExcludeOlderThanTime: FileTimeCtime,
+			},
+		},
+		{
+			name: "ExcludeOlderThanInvalidTime",
+			criteria: Criteria{
+				Include:              []string{"*.log"},
+				ExcludeOlderThan:     24 * time.Hour,
+				ExcludeOlderThanTime: "atime",
+			},
+			expectedErr: "invalid 'exclude_older_than_time' value 'atime'",
+		},
+		{
+			name: "ExcludeOlderThanTimeWithoutAge",
+			criteria: Criteria{
+				Include:              []string{"*.log"},
+				ExcludeOlderThanTime: FileTimeNewest,
+			},
+			expectedErr: "'exclude_older_than_time' requires 'exclude_older_than' to be specified",
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
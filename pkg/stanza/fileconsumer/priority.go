@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"time"
+)
+
+// PriorityConfig defines which files are read first when more files match than
+// are read concurrently.
+type PriorityConfig struct {
+	// Tiers are regular expressions matched against the paths of the files. The files
+	// matching an earlier tier are read first, and the files matching none of them last.
+	Tiers []string `mapstructure:"tiers,omitempty"`
+	// NewestFirst reads the most recently modified files of each tier first.
+	NewestFirst bool `mapstructure:"newest_first,omitempty"`
+}
+
+// priority orders the files matched by a poll.
+type priority struct {
+	tiers       []*regexp.Regexp
+	newestFirst bool
+}
+
+func (c PriorityConfig) build() (*priority, error) {
+	if len(c.Tiers) == 0 && !c.NewestFirst {
+		return nil, nil
+	}
+	p := &priority{newestFirst: c.NewestFirst}
+	for i, tier := range c.Tiers {
+		regex, err := regexp.Compile(tier)
+		if err != nil {
+			return nil, fmt.Errorf("'tiers[%d]': %w", i, err)
+		}
+		p.tiers = append(p.tiers, regex)
+	}
+	return p, nil
+}
+
+// sort orders paths by tier, and by modification time within each tier if the
+// newest files are read first. Files which are otherwise equal keep their order.
+func (p *priority) sort(paths []string) {
+	tiers := make(map[string]int, len(paths))
+	modTimes := make(map[string]time.Time, len(paths))
+	for _, path := range paths {
+		tiers[path] = len(p.tiers)
+		for i, regex := range p.tiers {
+			if regex.MatchString(path) {
+				tiers[path] = i
+				break
+			}
+		}
+		if p.newestFirst {
+			if info, err := os.Stat(path); err == nil {
+				modTimes[path] = info.ModTime()
+			}
+		}
+	}
+	slices.SortStableFunc(paths, func(a, b string) int {
+		if tiers[a] != tiers[b] {
+			return tiers[a] - tiers[b]
+		}
+		// Files which cannot be stat'ed have the zero time, and are read last
+		return modTimes[b].Compare(modTimes[a])
+	})
+}
@@ -5,6 +5,7 @@ package fileconsumer
 
 import (
 	"bytes"
+	"compress/gzip"
 	"fmt"
 	"io"
 	"os"
@@ -545,6 +546,45 @@ func TestOnTruncateReadWholeFile(t *testing.T) {
 	sink.ExpectTokens(t, log4)
 }
 
+// TestTruncateGeneration tests that the generation in the emit snapshot is incremented when a file
+// is truncated, so that the offsets of its new content can be told apart from the old ones.
+func TestTruncateGeneration(t *testing.T) {
+	t.Parallel()
+
+	identicalPrefix := string(bytes.Repeat([]byte("x"), 100))
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.OnTruncate = OnTruncateReadWholeFile
+	cfg.FingerprintSize = 100
+
+	operator, sink := testManager(t, cfg)
+
+	temp := filetest.OpenTemp(t, tempDir)
+	log1 := []byte(identicalPrefix + " - log line 1")
+	filetest.WriteString(t, temp, string(log1)+"\n")
+
+	operator.poll(t.Context())
+	token, snapshot := sink.NextSnapshot(t)
+	require.Equal(t, log1, token)
+	require.Equal(t, int64(0), snapshot.StartOffset)
+	require.Equal(t, int64(0), snapshot.Generation)
+
+	require.NoError(t, temp.Truncate(0))
+	_, err := temp.Seek(0, 0)
+	require.NoError(t, err)
+	log2 := []byte(identicalPrefix + " - log 2")
+	filetest.WriteString(t, temp, string(log2)+"\n")
+
+	// The new content is read from the same offset as the old one, in a new generation
+	operator.poll(t.Context())
+	token, snapshot = sink.NextSnapshot(t)
+	require.Equal(t, log2, token)
+	require.Equal(t, int64(0), snapshot.StartOffset)
+	require.Equal(t, int64(1), snapshot.Generation)
+}
+
 // TestOnTruncateReadNew tests that when on_truncate is set to "read_new",
 // the offset is set to the current file size when truncation is detected,
 // so only data written after that point is read.
@@ -594,6 +634,51 @@ func TestOnTruncateReadNew(t *testing.T) {
 	sink.ExpectTokens(t, log4)
 }
 
+// TestOnTruncateRereadWindow tests that the lines of a truncated file which were not read
+// yet are read from its copy before its offset is adjusted.
+func TestOnTruncateRereadWindow(t *testing.T) {
+	t.Parallel()
+
+	identicalPrefix := string(bytes.Repeat([]byte("x"), 100)) // 100 bytes of 'x'
+
+	tempDir := t.TempDir()
+	cfg := NewConfig()
+	cfg.Include = []string{filepath.Join(tempDir, "*.log")}
+	cfg.StartAt = "beginning"
+	cfg.OnTruncate = OnTruncateReadWholeFile
+	cfg.OnTruncateRereadWindow = time.Second
+	cfg.FingerprintSize = 100 // Match the prefix size
+
+	operator, sink := testManager(t, cfg)
+	operator.persister = testutil.NewUnscopedMockPersister()
+
+	temp, err := os.Create(filepath.Join(tempDir, "app.log"))
+	require.NoError(t, err)
+	defer temp.Close()
+	log1 := []byte(identicalPrefix + " - log line 1")
+	log2 := []byte(identicalPrefix + " - log line 2")
+	filetest.WriteString(t, temp, string(log1)+"\n")
+
+	operator.poll(t.Context())
+	sink.ExpectTokens(t, log1)
+
+	// The file is copied with a line which was not read yet, and truncated
+	filetest.WriteString(t, temp, string(log2)+"\n")
+	content, err := os.ReadFile(temp.Name())
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "app.log.1"), content, 0o600))
+	require.NoError(t, temp.Truncate(0))
+	_, err = temp.Seek(0, 0)
+	require.NoError(t, err)
+	log3 := []byte(identicalPrefix + " - 3")
+	filetest.WriteString(t, temp, string(log3)+"\n")
+
+	// The line is read from the copy before the whole file is read
+	operator.poll(t.Context())
+	sink.ExpectTokens(t, log2, log3)
+	sink.ExpectNoCalls(t)
+}
+
 // TestOnTruncateIgnore tests that when on_truncate is set to "ignore" (default),
 // the old offset is kept, meaning no data is read until the file grows past it
 func TestOnTruncateIgnore(t *testing.T) {
@@ -665,3 +750,58 @@ func TestOnTruncateIgnore(t *testing.T) {
 
 	require.NoError(t, op2.Stop())
 }
+
+// TestRotatedCompressedBackup tests that when a file is rotated and compressed while the
+// collector is not running, the remaining lines are read from the compressed backup before
+// the file replacing it, and that unknown backups are ignored.
+func TestRotatedCompressedBackup(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig()
+	cfg.Include = []string{filepath.Join(tempDir, "*.log")}
+	cfg.RotatedBackups = []string{filepath.Join(tempDir, "*.log.*.gz")}
+	cfg.Compression = "auto"
+	cfg.StartAt = "beginning"
+	persister := testutil.NewUnscopedMockPersister()
+
+	logPath := filepath.Join(tempDir, "app.log")
+	logFile := filetest.OpenFile(t, logPath)
+	filetest.WriteString(t, logFile, "before rotation 1\n")
+
+	operatorOne, sink1 := testManager(t, cfg)
+	operatorOne.persister = persister
+	operatorOne.poll(t.Context())
+	sink1.ExpectToken(t, []byte("before rotation 1"))
+	require.NoError(t, operatorOne.Stop())
+
+	// While stopped, the file is written to, then rotated and compressed immediately.
+	filetest.WriteString(t, logFile, "before rotation 2\n")
+	require.NoError(t, logFile.Close())
+	content, err := os.ReadFile(logPath)
+	require.NoError(t, err)
+	writeGzipFile(t, filepath.Join(tempDir, "app.log.1.gz"), content)
+	writeGzipFile(t, filepath.Join(tempDir, "app.log.2.gz"), []byte("unknown backup\n"))
+	require.NoError(t, os.Remove(logPath))
+	newLogFile := filetest.OpenFile(t, logPath)
+	filetest.WriteString(t, newLogFile, "after rotation\n")
+
+	operatorTwo, sink2 := testManager(t, cfg)
+	require.NoError(t, operatorTwo.Start(persister))
+	defer func() {
+		require.NoError(t, operatorTwo.Stop())
+	}()
+	sink2.ExpectToken(t, []byte("before rotation 2"))
+	sink2.ExpectToken(t, []byte("after rotation"))
+	sink2.ExpectNoCalls(t)
+}
+
+func writeGzipFile(t *testing.T, path string, content []byte) {
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	w := gzip.NewWriter(f)
+	_, err = w.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.NoError(t, f.Close())
+}
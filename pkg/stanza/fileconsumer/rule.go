@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/split"
+)
+
+// Predefined ways of splitting the files matched by a rule.
+const (
+	RuleSplitLine = "line"
+	RuleSplitJSON = "json"
+)
+
+// RuleConfig overrides how the files matched by the top-level criteria whose path
+// matches a pattern are decoded and split into tokens.
+type RuleConfig struct {
+	// Match is a glob pattern matched against the base name of the file, or against
+	// its full path if the pattern contains a path separator.
+	Match    string `mapstructure:"match"`
+	Encoding string `mapstructure:"encoding,omitempty"`
+	// Split is a predefined way of splitting the files: 'line' splits them on newlines,
+	// and 'json' on complete top-level JSON objects.
+	Split       string        `mapstructure:"split,omitempty"`
+	SplitConfig *split.Config `mapstructure:"multiline,omitempty"`
+}
+
+func (r RuleConfig) validate() error {
+	if r.Match == "" {
+		return errors.New("'match' must be specified")
+	}
+	if !doublestar.ValidatePathPattern(r.Match) {
+		return fmt.Errorf("invalid 'match' pattern '%s'", r.Match)
+	}
+	switch r.Split {
+	case "", RuleSplitLine, RuleSplitJSON:
+	default:
+		return fmt.Errorf("invalid 'split' value '%s'", r.Split)
+	}
+	if r.Split != "" && r.SplitConfig != nil {
+		return errors.New("'split' cannot be used with 'multiline'")
+	}
+	return nil
+}
+
+// groupConfig returns the settings of the rule as those of a group.
+func (r RuleConfig) groupConfig() GroupConfig {
+	g := GroupConfig{Encoding: r.Encoding, SplitConfig: r.SplitConfig}
+	switch r.Split {
+	case RuleSplitLine:
+		g.SplitConfig = &split.Config{}
+	case RuleSplitJSON:
+		g.SplitConfig = &split.Config{JSONObjects: true}
+	}
+	return g
+}
+
+// rule reads the files whose path matches its pattern with its own reader settings.
+type rule struct {
+	match         string
+	readerFactory *reader.Factory
+}
+
+func (r *rule) matches(path string) bool {
+	if !strings.ContainsRune(r.match, filepath.Separator) {
+		path = filepath.Base(path)
+	}
+	ok, _ := doublestar.PathMatch(r.match, path)
+	return ok
+}
+
+// ruleFactory returns the reader factory of the first rule which matches the path,
+// or the top-level reader factory if none does.
+func (m *Manager) ruleFactory(path string) *reader.Factory {
+	for _, r := range m.rules {
+		if r.matches(path) {
+			return r.readerFactory
+		}
+	}
+	return m.readerFactory
+}
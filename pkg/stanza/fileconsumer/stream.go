@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"context"
+	"os"
+	"slices"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/internal/reader"
+)
+
+// startStreams starts reading the named pipes and character devices among the paths, and returns
+// the other paths. Streams are read as they are written until their writer closes them, rather
+// than once per poll, and they are opened again by the next poll.
+func (m *Manager) startStreams(ctx context.Context, paths []string) []string {
+	return slices.DeleteFunc(paths, func(path string) bool {
+		info, err := os.Stat(path)
+		if err != nil || !reader.IsStream(info.Mode()) {
+			return false
+		}
+
+		m.streamsMu.Lock()
+		defer m.streamsMu.Unlock()
+		if _, ok := m.streams[path]; ok {
+			return true
+		}
+		file, err := openStream(path)
+		if err != nil {
+			m.set.Logger.Debug("Failed to open stream", zap.String("path", path), zap.Error(err))
+			return true
+		}
+		r, err := m.factoryFor(path).NewStreamReader(file)
+		if err != nil {
+			m.set.Logger.Error("Failed to create reader", zap.String("path", path), zap.Error(err))
+			_ = file.Close()
+			return true
+		}
+
+		m.streams[path] = struct{}{}
+		m.wg.Go(func() {
+			defer func() {
+				m.streamsMu.Lock()
+				delete(m.streams, path)
+				m.streamsMu.Unlock()
+			}()
+			m.readStream(ctx, r, file)
+		})
+		return true
+	})
+}
+
+func (m *Manager) readStream(ctx context.Context, r *reader.Reader, file *os.File) {
+	// A read which waits for the writer is interrupted by closing the stream
+	stop := context.AfterFunc(ctx, func() {
+		_ = file.Close()
+	})
+	defer stop()
+
+	m.telemetryBuilder.FileconsumerReadingFiles.Add(ctx, 1)
+	r.ReadToEnd(ctx)
+	m.telemetryBuilder.FileconsumerReadingFiles.Add(ctx, -1)
+	r.Close()
+}
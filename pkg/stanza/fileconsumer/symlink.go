@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileconsumer // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
+
+import (
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+)
+
+// resolveSymlinks applies the follow_symlinks setting to the matched paths.
+func (m *Manager) resolveSymlinks(paths []string) []string {
+	if m.followSymlinks != FollowSymlinksNever && m.followSymlinks != FollowSymlinksResolveOnce {
+		return paths
+	}
+
+	resolvedPaths := make([]string, 0, len(paths))
+	seen := make(map[string]struct{}, len(paths))
+	symlinkTargets := make(map[string]string)
+	for _, path := range paths {
+		info, err := os.Lstat(path)
+		if err != nil || info.Mode()&os.ModeSymlink == 0 {
+			if _, ok := seen[path]; !ok {
+				seen[path] = struct{}{}
+				resolvedPaths = append(resolvedPaths, path)
+			}
+			continue
+		}
+		if m.followSymlinks == FollowSymlinksNever {
+			m.set.Logger.Debug("Skipping symlink", zap.String("path", path))
+			continue
+		}
+
+		target, ok := m.symlinkTargets[path]
+		if ok {
+			if _, err := os.Stat(target); err != nil {
+				// The first target no longer exists, so the symlink is resolved again
+				ok = false
+			}
+		}
+		if !ok {
+			if target, err = filepath.EvalSymlinks(path); err != nil {
+				m.set.Logger.Debug("Failed to resolve symlink", zap.String("path", path), zap.Error(err))
+				continue
+			}
+		}
+		symlinkTargets[path] = target
+		if _, ok := seen[target]; ok {
+			continue
+		}
+		seen[target] = struct{}{}
+		resolvedPaths = append(resolvedPaths, target)
+		if f, ok := m.groupFactories[path]; ok {
+			// The target is read with the settings of the group which matched the symlink
+			if _, ok := m.groupFactories[target]; !ok {
+				m.groupFactories[target] = f
+			}
+		}
+	}
+	m.symlinkTargets = symlinkTargets
+	return resolvedPaths
+}
@@ -58,6 +58,7 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 		toBody:                  toBody,
 		includeFileRecordNumber: c.IncludeFileRecordNumber,
 		includeFileRecordOffset: c.IncludeFileRecordOffset,
+		includeFileSequence:     c.IncludeFileSequence,
 	}
 
 	input.fileConsumer, err = c.Config.Build(set, input.emitBatch)
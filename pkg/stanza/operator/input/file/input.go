@@ -13,6 +13,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/emit"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
 )
@@ -28,6 +29,7 @@ type Input struct {
 	toBody                  toBodyFunc
 	includeFileRecordNumber bool
 	includeFileRecordOffset bool
+	includeFileSequence     bool
 }
 
 // Start will start the file monitoring process
@@ -40,9 +42,23 @@ func (i *Input) Stop() error {
 	return i.fileConsumer.Stop()
 }
 
+// Files returns the files read during the last poll
+func (i *Input) Files() []fileconsumer.FileInfo {
+	return i.fileConsumer.Files()
+}
+
+// HandleBackpressure pauses reading while the consumer refuses data, if enabled
+func (i *Input) HandleBackpressure(ctx context.Context, refused bool) {
+	i.fileConsumer.HandleBackpressure(ctx, refused)
+}
+
 func (i *Input) emitBatch(ctx context.Context, tokens [][]byte, attributes map[string]any, lastRecordNumber int64, offsets []int64) error {
 	var errs error
-	entries, err := i.convertTokens(tokens, attributes, lastRecordNumber, offsets)
+	var firstSequence int64
+	if snapshot, ok := emit.FromContext(ctx); ok {
+		firstSequence = snapshot.FirstSequence
+	}
+	entries, err := i.convertTokens(tokens, attributes, lastRecordNumber, offsets, firstSequence)
 	if err != nil {
 		errs = multierr.Append(errs, fmt.Errorf("convert tokens: %w", err))
 	}
@@ -54,7 +70,7 @@ func (i *Input) emitBatch(ctx context.Context, tokens [][]byte, attributes map[s
 	return errs
 }
 
-func (i *Input) convertTokens(tokens [][]byte, attributes map[string]any, lastRecordNumber int64, offsets []int64) ([]*entry.Entry, error) {
+func (i *Input) convertTokens(tokens [][]byte, attributes map[string]any, lastRecordNumber int64, offsets []int64, firstSequence int64) ([]*entry.Entry, error) {
 	entries := make([]*entry.Entry, 0, len(tokens))
 	var errs error
 
@@ -70,6 +86,14 @@ func (i *Input) convertTokens(tokens [][]byte, attributes map[string]any, lastRe
 		}
 
 		for k, v := range attributes {
+			if resource, ok := v.(map[string]any); ok && k == attrs.LogFileResource {
+				for rk, rv := range resource {
+					if err = ent.Set(entry.NewResourceField(rk), rv); err != nil {
+						i.Logger().Error("set resource attribute", zap.Error(err))
+					}
+				}
+				continue
+			}
 			if err = ent.Set(entry.NewAttributeField(k), v); err != nil {
 				i.Logger().Error("set attribute", zap.Error(err))
 			}
@@ -87,6 +111,12 @@ func (i *Input) convertTokens(tokens [][]byte, attributes map[string]any, lastRe
 			}
 		}
 
+		if i.includeFileSequence && firstSequence > 0 {
+			if err = ent.Set(entry.NewAttributeField(attrs.LogFileSequence), firstSequence+int64(tokenIndex)); err != nil {
+				i.Logger().Error("set sequence attribute", zap.Error(err))
+			}
+		}
+
 		entries = append(entries, ent)
 	}
 	return entries, errs
@@ -4,6 +4,7 @@
 package file
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -12,9 +13,12 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/attrs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
 )
 
@@ -199,6 +203,56 @@ func TestAddFileRecordNumber(t *testing.T) {
 	require.Equal(t, int64(6), e.Attributes["log.file.record_number"])
 }
 
+// TestAddFileSequence tests that the `log.file.sequence` continues across the rotation
+// of the file and a restart when IncludeFileSequence is set to true
+func TestAddFileSequence(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Moving files which are being read is not supported on Windows")
+	}
+	t.Parallel()
+	persister := testutil.NewUnscopedMockPersister()
+	fileInput, logReceived, tempDir := newTestFileOperator(t, func(cfg *Config) {
+		cfg.IncludeFileSequence = true
+	})
+	path := filepath.Join(tempDir, "app.log")
+	require.NoError(t, os.WriteFile(path, []byte("testlog1\ntestlog2\n"), 0o600))
+
+	require.NoError(t, fileInput.Start(persister))
+	for i := 1; i <= 2; i++ {
+		e := waitForOne(t, logReceived)
+		require.Equal(t, fmt.Sprintf("testlog%d", i), e.Body)
+		require.Equal(t, int64(i), e.Attributes[attrs.LogFileSequence])
+	}
+
+	// The file which replaces the rotated one continues its sequence
+	require.NoError(t, os.Rename(path, path+".1"))
+	require.NoError(t, os.WriteFile(path, []byte("testlog3\n"), 0o600))
+	e := waitForOne(t, logReceived)
+	require.Equal(t, "testlog3", e.Body)
+	require.Equal(t, int64(3), e.Attributes[attrs.LogFileSequence])
+	require.NoError(t, fileInput.Stop())
+
+	// and so does the file after a restart
+	cfg := newDefaultConfig(tempDir)
+	cfg.IncludeFileSequence = true
+	op, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	fakeOutput := testutil.NewFakeOutput(t)
+	require.NoError(t, op.SetOutputs([]operator.Operator{fakeOutput}))
+	require.NoError(t, op.Start(persister))
+	defer func() {
+		require.NoError(t, op.Stop())
+	}()
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	defer file.Close()
+	writeString(t, file, "testlog4\n")
+	e = waitForOne(t, fakeOutput.Received)
+	require.Equal(t, "testlog4", e.Body)
+	require.Equal(t, int64(4), e.Attributes[attrs.LogFileSequence])
+}
+
 // ReadExistingLogs tests that, when starting from beginning, we
 // read all the lines that are already there
 func TestReadExistingLogs(t *testing.T) {
@@ -218,6 +272,31 @@ func TestReadExistingLogs(t *testing.T) {
 	waitForMessage(t, logReceived, "testlog2")
 }
 
+// TestHeaderResourceAttributes tests that the values captured from the header
+// are set as resource attributes rather than record attributes
+func TestHeaderResourceAttributes(t *testing.T) {
+	t.Parallel()
+	operator, logReceived, tempDir := newTestFileOperator(t, func(cfg *Config) {
+		cfg.Header = &fileconsumer.HeaderConfig{
+			Pattern:            "^#(?:Software: (?P<software>.*)|.*)",
+			ResourceAttributes: map[string]string{"software": "w3c.software"},
+		}
+	})
+
+	temp := openTemp(t, tempDir)
+	writeString(t, temp, "#Software: Microsoft Internet Information Services 10.0\n#Version: 1.0\ntestlog\n")
+
+	require.NoError(t, operator.Start(testutil.NewUnscopedMockPersister()))
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+
+	e := waitForOne(t, logReceived)
+	require.Equal(t, "testlog", e.Body)
+	require.Equal(t, map[string]any{"w3c.software": "Microsoft Internet Information Services 10.0"}, e.Resource)
+	require.NotContains(t, e.Attributes, attrs.LogFileResource)
+}
+
 // TestReadUsingNopEncoding tests when nop encoding is set, that the splitfunction returns all bytes unchanged.
 func TestReadUsingNopEncoding(t *testing.T) {
 	tcs := []struct {
@@ -43,3 +43,11 @@ type Operator interface {
 	// Logger returns the operator's logger
 	Logger() *zap.Logger
 }
+
+// BackpressureHandler is implemented by operators which react to the consumer of their
+// receiver refusing data, e.g. by pausing reading until it accepts data again.
+type BackpressureHandler interface {
+	// HandleBackpressure is called each time entries are passed on to the consumer, with the
+	// context they were passed on with and whether they were refused with a retryable error.
+	HandleBackpressure(ctx context.Context, refused bool)
+}
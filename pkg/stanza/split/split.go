@@ -35,6 +35,7 @@ type Config struct {
 	LineStartPattern string `mapstructure:"line_start_pattern"`
 	LineEndPattern   string `mapstructure:"line_end_pattern"`
 	OmitPattern      bool   `mapstructure:"omit_pattern"`
+	JSONObjects      bool   `mapstructure:"json_objects"`
 }
 
 // Func will return a bufio.SplitFunc based on the config
@@ -51,9 +52,22 @@ func (c Config) FuncWithLogger(enc encoding.Encoding, flushAtEOF bool, maxLogSiz
 		if c.LineStartPattern != "" {
 			return nil, errors.New("line_start_pattern should not be set when using nop encoding")
 		}
+		if c.JSONObjects {
+			return nil, errors.New("json_objects should not be set when using nop encoding")
+		}
 		return NoSplitFunc(maxLogSize), nil
 	}
 
+	if c.JSONObjects {
+		if c.LineEndPattern != "" || c.LineStartPattern != "" {
+			return nil, errors.New("json_objects cannot be set with line_start_pattern or line_end_pattern")
+		}
+		if !asciiCompatible(enc) {
+			return nil, errors.New("json_objects requires an ASCII compatible encoding")
+		}
+		return JSONObjectSplitFunc(flushAtEOF), nil
+	}
+
 	if c.LineEndPattern == "" && c.LineStartPattern == "" {
 		return NewlineSplitFunc(enc, flushAtEOF)
 	}
@@ -273,6 +287,80 @@ func NewlineSplitFunc(enc encoding.Encoding, flushAtEOF bool) (bufio.SplitFunc,
 	}, nil
 }
 
+// JSONObjectSplitFunc splits an incoming stream into tokens that each hold a complete
+// top-level JSON object, which may span multiple lines. Objects are delimited by counting
+// braces outside of strings. Whitespace between objects is skipped, and lines that do
+// not start an object are returned as tokens of their own so that no data is lost.
+func JSONObjectSplitFunc(flushAtEOF bool) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		start := 0
+		for start < len(data) && isJSONWhitespace(data[start]) {
+			start++
+		}
+		if start == len(data) {
+			// Request more data
+			return 0, nil, nil
+		}
+
+		if data[start] != '{' {
+			if i := bytes.IndexByte(data[start:], '\n'); i >= 0 {
+				return start + i + 1, bytes.TrimSuffix(data[start:start+i], []byte{'\r'}), nil
+			}
+			if atEOF && flushAtEOF {
+				return len(data), data[start:], nil
+			}
+			return 0, nil, nil
+		}
+
+		depth := 0
+		inString := false
+		escaped := false
+		for i := start; i < len(data); i++ {
+			c := data[i]
+			switch {
+			case escaped:
+				escaped = false
+			case inString:
+				switch c {
+				case '\\':
+					escaped = true
+				case '"':
+					inString = false
+				}
+			case c == '"':
+				inString = true
+			case c == '{':
+				depth++
+			case c == '}':
+				depth--
+				if depth == 0 {
+					return i + 1, data[start : i+1], nil
+				}
+			}
+		}
+
+		// Flush if no more data is expected
+		if atEOF && flushAtEOF {
+			return len(data), data[start:], nil
+		}
+
+		// Request more data
+		return 0, nil, nil
+	}
+}
+
+func isJSONWhitespace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+// asciiCompatible reports whether the encoding represents the characters which delimit
+// JSON objects as their single ASCII bytes, so that they can be found without decoding.
+func asciiCompatible(enc encoding.Encoding) bool {
+	delimiters := []byte("{}\"\\\n")
+	encoded, err := enc.NewEncoder().Bytes(delimiters)
+	return err == nil && bytes.Equal(encoded, delimiters)
+}
+
 // NoSplitFunc doesn't split any of the bytes, it reads in all of the bytes and returns it all at once. This is for when the encoding is nop
 func NoSplitFunc(maxLogSize int) bufio.SplitFunc {
 	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
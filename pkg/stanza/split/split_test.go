@@ -60,6 +60,35 @@ func TestConfigFunc(t *testing.T) {
 		assert.Equal(t, []byte("foo"), token)
 	})
 
+	t.Run("JSONObjects", func(t *testing.T) {
+		cfg := Config{JSONObjects: true}
+		f, err := cfg.Func(unicode.UTF8, false, maxLogSize)
+		assert.NoError(t, err)
+
+		advance, token, err := f([]byte("{\n  \"a\": 1\n}\n{\"b\": 2}\n"), false)
+		assert.NoError(t, err)
+		assert.Equal(t, 12, advance)
+		assert.Equal(t, []byte("{\n  \"a\": 1\n}"), token)
+	})
+
+	t.Run("JSONObjectsWithPattern", func(t *testing.T) {
+		cfg := Config{JSONObjects: true, LineStartPattern: "{"}
+		_, err := cfg.Func(unicode.UTF8, false, maxLogSize)
+		assert.EqualError(t, err, "json_objects cannot be set with line_start_pattern or line_end_pattern")
+	})
+
+	t.Run("JSONObjectsNopEncoding", func(t *testing.T) {
+		cfg := Config{JSONObjects: true}
+		_, err := cfg.Func(encoding.Nop, false, maxLogSize)
+		assert.EqualError(t, err, "json_objects should not be set when using nop encoding")
+	})
+
+	t.Run("JSONObjectsUTF16", func(t *testing.T) {
+		cfg := Config{JSONObjects: true}
+		_, err := cfg.Func(unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM), false, maxLogSize)
+		assert.EqualError(t, err, "json_objects requires an ASCII compatible encoding")
+	})
+
 	t.Run("InvalidStartRegex", func(t *testing.T) {
 		cfg := Config{LineStartPattern: "["}
 		_, err := cfg.Func(unicode.UTF8, false, maxLogSize)
@@ -1067,6 +1096,70 @@ func TestNoSplitFunc(t *testing.T) {
 	}
 }
 
+func TestJSONObjectSplitFunc(t *testing.T) {
+	testCases := []struct {
+		name       string
+		flushAtEOF bool
+		input      []byte
+		steps      []splittest.Step
+	}{
+		{
+			name:  "EmptyFile",
+			input: []byte(""),
+		},
+		{
+			name:  "OneObject",
+			input: []byte(`{"a":1}` + "\n"),
+			steps: []splittest.Step{
+				splittest.ExpectToken(`{"a":1}`),
+			},
+		},
+		{
+			name:  "PrettyPrinted",
+			input: []byte("{\n  \"a\": {\n    \"b\": [1, 2]\n  }\n}\n{\n  \"c\": 3\n}\n"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("{\n  \"a\": {\n    \"b\": [1, 2]\n  }\n}"),
+				splittest.ExpectAdvanceToken(len("\n{\n  \"c\": 3\n}"), "{\n  \"c\": 3\n}"),
+			},
+		},
+		{
+			name:  "BracesInStrings",
+			input: []byte(`{"msg":"} { \"}"}{"b":"\\"}`),
+			steps: []splittest.Step{
+				splittest.ExpectToken(`{"msg":"} { \"}"}`),
+				splittest.ExpectToken(`{"b":"\\"}`),
+			},
+		},
+		{
+			name:  "NonObjectLines",
+			input: []byte("starting up\r\n{\"a\":1}\n  not json\n"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(len("starting up\r\n"), "starting up"),
+				splittest.ExpectToken(`{"a":1}`),
+				splittest.ExpectAdvanceToken(len("\n  not json\n"), "not json"),
+			},
+		},
+		{
+			name:  "IncompleteObject",
+			input: []byte(`{"a":{"b":1}`),
+		},
+		{
+			name:       "IncompleteObjectFlush",
+			flushAtEOF: true,
+			input:      []byte(`{"a":{"b":1}`),
+			steps: []splittest.Step{
+				splittest.ExpectToken(`{"a":{"b":1}`),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		splitFunc, err := Config{JSONObjects: true}.Func(unicode.UTF8, tc.flushAtEOF, 0)
+		require.NoError(t, err)
+		t.Run(tc.name, splittest.New(splitFunc, tc.input, tc.steps...))
+	}
+}
+
 // Helper function to encode string to UTF-16LE bytes
 func utf16LEBytes(s string) []byte {
 	result := make([]byte, len(s)*2)
@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trim // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/trim"
+
+import (
+	"bytes"
+	"fmt"
+)
+
+const (
+	NormalizeStripANSI          = "strip_ansi"
+	NormalizeCollapseWhitespace = "collapse_whitespace"
+	NormalizeRemoveNUL          = "remove_nul"
+)
+
+// Chain returns a Func which applies the funcs in order. Nil funcs are skipped.
+func Chain(funcs ...Func) Func {
+	chained := make([]Func, 0, len(funcs))
+	for _, f := range funcs {
+		if f != nil {
+			chained = append(chained, f)
+		}
+	}
+	switch len(chained) {
+	case 0:
+		return Nop
+	case 1:
+		return chained[0]
+	}
+	return func(data []byte) []byte {
+		for _, f := range chained {
+			data = f(data)
+		}
+		return data
+	}
+}
+
+// Normalizer returns a Func which applies the named normalizations in order,
+// or nil if there are none.
+func Normalizer(names []string) (Func, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	funcs := make([]Func, 0, len(names))
+	for _, name := range names {
+		switch name {
+		case NormalizeStripANSI:
+			funcs = append(funcs, StripANSI)
+		case NormalizeCollapseWhitespace:
+			funcs = append(funcs, CollapseWhitespace)
+		case NormalizeRemoveNUL:
+			funcs = append(funcs, RemoveNUL)
+		default:
+			return nil, fmt.Errorf("invalid normalization '%s', must be one of '%s', '%s' or '%s'",
+				name, NormalizeStripANSI, NormalizeCollapseWhitespace, NormalizeRemoveNUL)
+		}
+	}
+	return Chain(funcs...), nil
+}
+
+// The normalizations do not modify the data they are given, as it may be the buffer of a
+// scanner. The data is copied only if it is changed.
+
+// StripANSI removes ANSI escape sequences, such as the color codes written to terminals.
+func StripANSI(data []byte) []byte {
+	i := bytes.IndexByte(data, 0x1b)
+	if i < 0 {
+		return data
+	}
+	out := append(make([]byte, 0, len(data)), data[:i]...)
+	for i < len(data) {
+		if data[i] != 0x1b {
+			out = append(out, data[i])
+			i++
+			continue
+		}
+		i = skipEscape(data, i)
+	}
+	return out
+}
+
+// skipEscape returns the index after the escape sequence which starts at data[i].
+func skipEscape(data []byte, i int) int {
+	i++
+	if i == len(data) {
+		return i
+	}
+	switch c := data[i]; {
+	case c == '[':
+		// Control sequence: parameter and intermediate bytes, then a final byte
+		for i++; i < len(data); i++ {
+			if data[i] >= 0x40 && data[i] <= 0x7e {
+				return i + 1
+			}
+			if data[i] < 0x20 || data[i] > 0x3f {
+				return i
+			}
+		}
+		return i
+	case c == ']':
+		// Operating system command: terminated by BEL or by ESC \
+		for i++; i < len(data); i++ {
+			if data[i] == 0x07 {
+				return i + 1
+			}
+			if data[i] == 0x1b && i+1 < len(data) && data[i+1] == '\\' {
+				return i + 2
+			}
+		}
+		return i
+	case c >= 0x40 && c <= 0x5f:
+		return i + 1
+	}
+	return i
+}
+
+// CollapseWhitespace replaces each run of whitespace with a single space.
+func CollapseWhitespace(data []byte) []byte {
+	i := 0
+	for ; i < len(data); i++ {
+		if isSpace(data[i]) && (data[i] != ' ' || i+1 < len(data) && isSpace(data[i+1])) {
+			break
+		}
+	}
+	if i == len(data) {
+		return data
+	}
+	out := append(make([]byte, 0, len(data)), data[:i]...)
+	for ; i < len(data); i++ {
+		if !isSpace(data[i]) {
+			out = append(out, data[i])
+		} else if i == 0 || !isSpace(data[i-1]) {
+			out = append(out, ' ')
+		}
+	}
+	return out
+}
+
+// RemoveNUL removes NUL bytes, which are left in files that are preallocated or truncated.
+func RemoveNUL(data []byte) []byte {
+	if bytes.IndexByte(data, 0) < 0 {
+		return data
+	}
+	out := make([]byte, 0, len(data))
+	for _, c := range data {
+		if c != 0 {
+			out = append(out, c)
+		}
+	}
+	return out
+}
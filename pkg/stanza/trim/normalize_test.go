@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	testCases := []struct {
+		name   string
+		fn     Func
+		input  string
+		expect string
+	}{
+		{"strip ansi none", StripANSI, "hello world", "hello world"},
+		{"strip ansi color", StripANSI, "\x1b[31merror\x1b[0m: failed", "error: failed"},
+		{"strip ansi cursor", StripANSI, "\x1b[2K\x1b[1;32mok", "ok"},
+		{"strip ansi title", StripANSI, "\x1b]0;title\x07log", "log"},
+		{"strip ansi title st", StripANSI, "\x1b]0;title\x1b\\log", "log"},
+		{"strip ansi two byte", StripANSI, "a\x1bMb", "ab"},
+		{"strip ansi trailing escape", StripANSI, "log\x1b", "log"},
+		{"collapse none", CollapseWhitespace, "hello world", "hello world"},
+		{"collapse spaces", CollapseWhitespace, "hello    world", "hello world"},
+		{"collapse tabs", CollapseWhitespace, "\thello\t \r\nworld  ", " hello world "},
+		{"collapse single tab", CollapseWhitespace, "hello\tworld", "hello world"},
+		{"remove nul none", RemoveNUL, "hello", "hello"},
+		{"remove nul", RemoveNUL, "\x00\x00hel\x00lo", "hello"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := []byte(tc.input)
+			assert.Equal(t, tc.expect, string(tc.fn(input)))
+			assert.Equal(t, tc.input, string(input), "the input must not be modified")
+		})
+	}
+}
+
+func TestNormalizer(t *testing.T) {
+	fn, err := Normalizer(nil)
+	require.NoError(t, err)
+	assert.Nil(t, fn)
+
+	fn, err = Normalizer([]string{NormalizeRemoveNUL, NormalizeStripANSI, NormalizeCollapseWhitespace})
+	require.NoError(t, err)
+	assert.Equal(t, "error: disk full", string(fn([]byte("\x1b[31merror\x1b[0m:\x00  disk \t full"))))
+
+	_, err = Normalizer([]string{"lowercase"})
+	require.ErrorContains(t, err, "invalid normalization 'lowercase'")
+}
+
+func TestChain(t *testing.T) {
+	assert.Equal(t, "hello", string(Chain()([]byte("hello"))))
+	assert.Equal(t, "hello", string(Chain(nil, Leading)([]byte("  hello"))))
+	assert.Equal(t, "hello", string(Chain(Leading, Trailing)([]byte("  hello  "))))
+}
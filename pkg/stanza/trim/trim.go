@@ -92,6 +92,51 @@ func ToLength(splitFunc bufio.SplitFunc, maxLength int) bufio.SplitFunc {
 // skipping the remainder of an oversized entry. This allows the state to be persisted
 // across multiple reader recreations (e.g., between poll cycles).
 func ToLengthWithTruncate(splitFunc bufio.SplitFunc, maxLength int, skipping *bool) bufio.SplitFunc {
+	return toLengthWithSkip(splitFunc, maxLength, skipping, func(token []byte) []byte {
+		return token[:maxLength]
+	})
+}
+
+// ToLengthWithDrop wraps a bufio.SplitFunc to drop tokens that exceed maxLength entirely.
+// It skips the content of oversized tokens in the same way as ToLengthWithTruncate, and
+// calls onDrop for each dropped token. An empty token is returned in place of a dropped
+// token, since a bufio.Scanner stops at EOF when no token is returned.
+func ToLengthWithDrop(splitFunc bufio.SplitFunc, maxLength int, skipping *bool, onDrop func()) bufio.SplitFunc {
+	return toLengthWithSkip(splitFunc, maxLength, skipping, func([]byte) []byte {
+		onDrop()
+		return []byte{}
+	})
+}
+
+// ToLengthWithContinuation wraps a bufio.SplitFunc to split tokens that exceed maxLength,
+// like ToLength. The continuing parameter is set when a token is split, and cleared when
+// a token is returned as is. Its value before a token is returned therefore tells whether
+// the token is the continuation of a split token. It is a pointer so that the state can be
+// persisted across reader recreations.
+func ToLengthWithContinuation(splitFunc bufio.SplitFunc, maxLength int, continuing *bool) bufio.SplitFunc {
+	if maxLength <= 0 {
+		return splitFunc
+	}
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		advance, token, err := splitFunc(data, atEOF)
+		if (advance == 0 && token == nil && err == nil) && len(data) >= maxLength {
+			*continuing = true
+			return maxLength, data[:maxLength], nil
+		}
+		if len(token) > maxLength {
+			*continuing = true
+			return maxLength, token[:maxLength], nil
+		}
+		if token != nil {
+			*continuing = false
+		}
+		return advance, token, err
+	}
+}
+
+// toLengthWithSkip wraps a bufio.SplitFunc to replace tokens that exceed maxLength with the
+// result of the oversized function, and to skip the remainder of their content.
+func toLengthWithSkip(splitFunc bufio.SplitFunc, maxLength int, skipping *bool, oversized Func) bufio.SplitFunc {
 	if maxLength <= 0 {
 		return splitFunc
 	}
@@ -148,7 +193,7 @@ func ToLengthWithTruncate(splitFunc bufio.SplitFunc, maxLength int, skipping *bo
 			if dataLen == maxLength || dataLen == lastDataLen || atEOF {
 				// Buffer is at max capacity or EOF, truncate and enter skip mode.
 				*skipping = true
-				return maxLength, data[:maxLength], nil
+				return maxLength, oversized(data[:maxLength]), nil
 			}
 
 			// Buffer is larger than maxLength but might still grow.
@@ -157,8 +202,8 @@ func ToLengthWithTruncate(splitFunc bufio.SplitFunc, maxLength int, skipping *bo
 		}
 		if len(token) > maxLength {
 			// A token was found but it is longer than the max length.
-			// Return truncated token but advance past the entire original token.
-			return advance, token[:maxLength], nil
+			// Replace the token but advance past the entire original token.
+			return advance, oversized(token), nil
 		}
 		return advance, token, err
 	}
@@ -557,3 +557,89 @@ func TestToLengthWithTruncate_MultilinePattern(t *testing.T) {
 		})
 	}
 }
+
+func TestToLengthWithDrop_Scanner(t *testing.T) {
+	testCases := []struct {
+		name           string
+		input          []byte
+		maxLength      int
+		expectedTokens []string
+		expectedDrops  int
+	}{
+		{
+			name:           "NoLongTokens",
+			input:          []byte("Short.\nTiny\n"),
+			maxLength:      10,
+			expectedTokens: []string{"Short.", "Tiny"},
+		},
+		{
+			name:           "MixedSizes",
+			input:          []byte("Short.\nVery long oversized line.\nTiny\n"),
+			maxLength:      10,
+			expectedTokens: []string{"Short.", "", "Tiny"},
+			expectedDrops:  1,
+		},
+		{
+			name:           "MultipleOversizedLines",
+			input:          []byte("First very long line here.\nSecond also very long line.\nShort.\n"),
+			maxLength:      10,
+			expectedTokens: []string{"", "", "Short."},
+			expectedDrops:  2,
+		},
+		{
+			name:           "OversizedLineAtEOF",
+			input:          []byte("This is a very long line without newline"),
+			maxLength:      10,
+			expectedTokens: []string{""},
+			expectedDrops:  1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			skipping := false
+			drops := 0
+			splitFunc := ToLengthWithDrop(bufio.ScanLines, tc.maxLength, &skipping, func() { drops++ })
+			scanner := bufio.NewScanner(bytes.NewReader(tc.input))
+			scanner.Split(splitFunc)
+
+			var tokens []string
+			for scanner.Scan() {
+				tokens = append(tokens, scanner.Text())
+			}
+
+			assert.NoError(t, scanner.Err())
+			assert.Equal(t, tc.expectedTokens, tokens)
+			assert.Equal(t, tc.expectedDrops, drops)
+		})
+	}
+}
+
+func TestToLengthWithContinuation_Scanner(t *testing.T) {
+	continuing := false
+	splitFunc := ToLengthWithContinuation(bufio.ScanLines, 10, &continuing)
+	scanner := bufio.NewScanner(bytes.NewReader([]byte("Short.\nThis is a very long line.\nTiny\n")))
+	scanner.Split(splitFunc)
+
+	type token struct {
+		text         string
+		continuation bool
+	}
+	var tokens []token
+	for {
+		continuation := continuing
+		if !scanner.Scan() {
+			break
+		}
+		tokens = append(tokens, token{scanner.Text(), continuation})
+	}
+
+	assert.NoError(t, scanner.Err())
+	assert.Equal(t, []token{
+		{"Short.", false},
+		{"This is a ", false},
+		{"very long ", true},
+		{"line.", true},
+		{"Tiny", false},
+	}, tokens)
+}
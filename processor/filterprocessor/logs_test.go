@@ -11,6 +11,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -20,6 +21,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	grpcmetadata "google.golang.org/grpc/metadata"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
@@ -1087,6 +1089,71 @@ func Test_ProcessLogs_InferredContext(t *testing.T) {
 	}
 }
 
+func Test_ProcessLogs_OTelColContext(t *testing.T) {
+	tests := []struct {
+		name             string
+		conditions       []string
+		filterEverything bool
+		want             func(ld plog.Logs)
+	}{
+		{
+			name:             "client metadata: drop everything",
+			conditions:       []string{`otelcol.client.metadata["x-tenant"][0] == "blocked-tenant"`},
+			filterEverything: true,
+		},
+		{
+			name:       "client metadata: keep everything",
+			conditions: []string{`otelcol.client.metadata["x-tenant"][0] == "other-tenant"`},
+			want:       func(_ plog.Logs) {},
+		},
+		{
+			name:             "grpc metadata: drop everything",
+			conditions:       []string{`otelcol.grpc.metadata["x-tenant"][0] == "blocked-tenant"`},
+			filterEverything: true,
+		},
+		{
+			name:       "grpc metadata: keep everything",
+			conditions: []string{`otelcol.grpc.metadata["x-tenant"][0] == "other-tenant"`},
+			want:       func(_ plog.Logs) {},
+		},
+		{
+			name:       "client metadata and log: drop by body",
+			conditions: []string{`otelcol.client.metadata["x-tenant"][0] == "blocked-tenant" and log.body == "operationA"`},
+			want: func(ld plog.Logs) {
+				rl := ld.ResourceLogs().At(0)
+				for i := 0; i < rl.ScopeLogs().Len(); i++ {
+					rl.ScopeLogs().At(i).LogRecords().RemoveIf(func(log plog.LogRecord) bool {
+						return log.Body().AsString() == "operationA"
+					})
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, _ := NewFactory().CreateDefaultConfig().(*Config)
+			cfg.LogConditions = []condition.ContextConditions{{Conditions: tt.conditions}}
+			processor, err := newFilterLogsProcessor(processortest.NewNopSettings(metadata.Type), cfg)
+			require.NoError(t, err)
+
+			ctx := client.NewContext(t.Context(), client.Info{
+				Metadata: client.NewMetadata(map[string][]string{"x-tenant": {"blocked-tenant"}}),
+			})
+			ctx = grpcmetadata.NewIncomingContext(ctx, grpcmetadata.Pairs("x-tenant", "blocked-tenant"))
+			got, err := processor.processLogs(ctx, constructLogs())
+
+			if tt.filterEverything {
+				assert.Equal(t, processorhelper.ErrSkipProcessingData, err)
+			} else {
+				assert.NoError(t, err)
+				exLd := constructLogs()
+				tt.want(exLd)
+				assert.Equal(t, exLd, got)
+			}
+		})
+	}
+}
+
 func Test_ProcessLogs_ErrorMode(t *testing.T) {
 	tests := []struct {
 		name string
@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package geoipprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/processor"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// Locator looks up the geographical location of IP addresses using the providers
+// of a Config. It exposes the lookup to OTTL through the GeoLocate converter, so
+// the enrichment can be done conditionally by components such as the transform
+// processor:
+//
+//	locator, err := geoipprocessor.NewLocator(ctx, set, cfg)
+//	...
+//	transformprocessor.NewFactoryWithOptions(
+//		transformprocessor.WithLogFunctions([]ottl.Factory[ottllog.TransformContext]{
+//			geoipprocessor.NewGeoLocateFactory[ottllog.TransformContext](locator),
+//		}),
+//	)
+//
+// The Locator must be closed once it is no longer used.
+type Locator struct {
+	geo *geoIPProcessor
+}
+
+// NewLocator creates the providers of the given configuration and returns a Locator using them.
+// Only the providers of the configuration are used, the other settings only apply to the processor.
+func NewLocator(ctx context.Context, set processor.Settings, cfg *Config) (*Locator, error) {
+	if len(cfg.Providers) == 0 {
+		return nil, errors.New("must specify at least one geo IP data provider when using the geoip processor")
+	}
+	providers, err := createGeoIPProviders(ctx, set, cfg, providerFactories)
+	if err != nil {
+		return nil, err
+	}
+	return &Locator{geo: newGeoIPProcessor(cfg, providers, set)}, nil
+}
+
+// Close releases the providers of the Locator.
+func (l *Locator) Close(ctx context.Context) error {
+	return l.geo.shutdown(ctx)
+}
+
+type GeoLocateArguments[K any] struct {
+	Target ottl.StringGetter[K]
+}
+
+// NewGeoLocateFactory returns the factory of the GeoLocate converter, which returns a map with the
+// geographical location attributes of the IP address it is given, e.g. `geo.city_name`. The map is
+// empty if no provider has information about the address.
+func NewGeoLocateFactory[K any](l *Locator) ottl.Factory[K] {
+	return ottl.NewFactory("GeoLocate", &GeoLocateArguments[K]{}, func(_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+		args, ok := oArgs.(*GeoLocateArguments[K])
+		if !ok {
+			return nil, errors.New("GeoLocateFactory args must be of type *GeoLocateArguments[K]")
+		}
+		return geoLocate(l, args.Target), nil
+	})
+}
+
+func geoLocate[K any](l *Locator, target ottl.StringGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		ip, err := parseIP(val)
+		if err != nil {
+			return nil, fmt.Errorf("invalid IP address %q: %w", val, err)
+		}
+		attributes, err := l.geo.geoLocation(ctx, ip)
+		if err != nil {
+			return nil, err
+		}
+		result := pcommon.NewMap()
+		putGeoAttributes(result, attributes)
+		return result, nil
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package geoipprocessor
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/processortest"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	conventions "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/convention"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider"
+)
+
+func newTestLocator(t *testing.T, location func(context.Context, netip.Addr) (attribute.Set, error)) *Locator {
+	const providerKey string = "mock"
+	providerFactories[providerKey] = &providerFactoryMock{
+		CreateDefaultConfigF: baseMockFactory.CreateDefaultConfigF,
+		CreateGeoIPProviderF: func(context.Context, processor.Settings, provider.Config) (provider.GeoIPProvider, error) {
+			return &providerMock{
+				LocationF: location,
+				CloseF: func(context.Context) error {
+					return nil
+				},
+			}, nil
+		},
+	}
+	t.Cleanup(func() {
+		delete(providerFactories, providerKey)
+	})
+
+	cfg := &Config{Providers: map[string]provider.Config{providerKey: &providerConfigMock{}}}
+	locator, err := NewLocator(t.Context(), processortest.NewNopSettings(metadata.Type), cfg)
+	require.NoError(t, err)
+	return locator
+}
+
+func TestNewLocatorNoProviders(t *testing.T) {
+	_, err := NewLocator(t.Context(), processortest.NewNopSettings(metadata.Type), &Config{})
+	assert.EqualError(t, err, "must specify at least one geo IP data provider when using the geoip processor")
+}
+
+func TestGeoLocate(t *testing.T) {
+	locator := newTestLocator(t, func(_ context.Context, ip netip.Addr) (attribute.Set, error) {
+		if ip.String() == "1.2.3.4" {
+			return attribute.NewSet(
+				attribute.String(conventions.AttributeGeoCityName, "Boxford"),
+				attribute.Float64(conventions.AttributeGeoLocationLat, 51.75),
+				attribute.Int(conventions.AttributeGeoPostalCode, 1),
+			), nil
+		}
+		if ip.String() == "10.0.0.1" {
+			return attribute.Set{}, provider.ErrNoMetadataFound
+		}
+		return attribute.Set{}, errors.New("lookup error")
+	})
+
+	tests := []struct {
+		name          string
+		ip            string
+		expected      map[string]any
+		expectedError string
+	}{
+		{
+			name: "location found",
+			ip:   "1.2.3.4",
+			expected: map[string]any{
+				conventions.AttributeGeoCityName:    "Boxford",
+				conventions.AttributeGeoLocationLat: 51.75,
+			},
+		},
+		{
+			name:     "no metadata found",
+			ip:       "10.0.0.1",
+			expected: map[string]any{},
+		},
+		{
+			name:          "provider error",
+			ip:            "2.2.2.2",
+			expectedError: "lookup error",
+		},
+		{
+			name:          "invalid IP",
+			ip:            "example.com",
+			expectedError: `invalid IP address "example.com"`,
+		},
+		{
+			name:          "unspecified IP",
+			ip:            "0.0.0.0",
+			expectedError: errUnspecifiedIP.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := NewGeoLocateFactory[any](locator).CreateFunction(ottl.FunctionContext{}, &GeoLocateArguments[any]{
+				Target: ottl.StandardStringGetter[any]{
+					Getter: func(context.Context, any) (any, error) {
+						return tt.ip, nil
+					},
+				},
+			})
+			require.NoError(t, err)
+
+			result, err := exprFunc(t.Context(), nil)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.IsType(t, pcommon.Map{}, result)
+			assert.Equal(t, tt.expected, result.(pcommon.Map).AsRaw())
+		})
+	}
+	assert.NoError(t, locator.Close(t.Context()))
+}
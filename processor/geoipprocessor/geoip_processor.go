@@ -113,6 +113,13 @@ func (g *geoIPProcessor) processAttributes(ctx context.Context, metadata pcommon
 		}
 	}
 
+	putGeoAttributes(metadata, attributes)
+
+	return nil
+}
+
+// putGeoAttributes adds the geolocation attributes to the given pcommon.Map.
+func putGeoAttributes(metadata pcommon.Map, attributes attribute.Set) {
 	for _, geoAttr := range attributes.ToSlice() {
 		switch geoAttr.Value.Type() {
 		case attribute.FLOAT64:
@@ -121,8 +128,6 @@ func (g *geoIPProcessor) processAttributes(ctx context.Context, metadata pcommon
 			metadata.PutStr(string(geoAttr.Key), geoAttr.Value.AsString())
 		}
 	}
-
-	return nil
 }
 
 func (g *geoIPProcessor) shutdown(ctx context.Context) error {
@@ -21,12 +21,18 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlprofile"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottltrace"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlwasm"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metadata"
 )
 
 var errFlatLogsGateDisabled = errors.New("'flatten_data' requires the 'transform.flatten.logs' feature gate to be enabled")
 
+// debugExplain makes the processor log, for each execution of the statements, which conditions
+// matched and which paths were read and written with their values.
+const debugExplain = "explain"
+
 // Config defines the configuration for the processor.
 type Config struct {
 	// ErrorMode determines how the processor reacts to errors that occur while processing a statement.
@@ -42,7 +48,23 @@ type Config struct {
 	ProfileStatements []common.ContextStatements `mapstructure:"profile_statements"`
 
 	FlattenData bool `mapstructure:"flatten_data"`
-	logger      *zap.Logger
+
+	// Debug enables a debugging facility. The only valid value is `explain`, which logs a trace
+	// of each execution of the statements. It is verbose and slows the processing down, so it is
+	// not meant to be used in production.
+	Debug string `mapstructure:"debug"`
+
+	// KeyProvider is the ID of the key provider extension providing the keys of the
+	// HMAC_SHA256, SaltedSHA256, Encrypt and Decrypt functions, which are only available
+	// when it is set.
+	KeyProvider *component.ID `mapstructure:"key_provider"`
+
+	// WasmPlugins are the WebAssembly modules providing additional functions, which are loaded
+	// when the processor starts. It requires the `processor.transform.enableWasmFunctions`
+	// feature gate to be enabled.
+	WasmPlugins []ottlwasm.PluginConfig `mapstructure:"wasm_plugins"`
+
+	logger *zap.Logger
 
 	dataPointFunctions map[string]ottl.Factory[*ottldatapoint.TransformContext]
 	exemplarFunctions  map[string]ottl.Factory[*ottlexemplar.TransformContext]
@@ -50,6 +72,7 @@ type Config struct {
 	metricFunctions    map[string]ottl.Factory[*ottlmetric.TransformContext]
 	spanEventFunctions map[string]ottl.Factory[*ottlspanevent.TransformContext]
 	spanFunctions      map[string]ottl.Factory[*ottlspan.TransformContext]
+	traceFunctions     map[string]ottl.Factory[*ottltrace.TransformContext]
 	profileFunctions   map[string]ottl.Factory[*ottlprofile.TransformContext]
 }
 
@@ -141,9 +164,16 @@ var _ component.Config = (*Config)(nil)
 
 func (c *Config) Validate() error {
 	var errors error
+	if len(c.WasmPlugins) > 0 && !metadata.ProcessorTransformEnableWasmFunctionsFeatureGate.IsEnabled() {
+		return errWasmPluginsGateDisabled
+	}
+	funcs, err := newHostFunctions(c)
+	if err != nil {
+		return err
+	}
 
 	if len(c.TraceStatements) > 0 {
-		pc, err := common.NewTraceParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, common.WithSpanParser(c.spanFunctions), common.WithSpanEventParser(c.spanEventFunctions))
+		pc, err := common.NewTraceParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, common.WithSpanParser(withHostFunctions(c.spanFunctions, funcs)), common.WithSpanEventParser(withHostFunctions(c.spanEventFunctions, funcs)), common.WithTraceParser(withHostFunctions(c.traceFunctions, funcs)))
 		if err != nil {
 			return err
 		}
@@ -156,7 +186,7 @@ func (c *Config) Validate() error {
 	}
 
 	if len(c.MetricStatements) > 0 {
-		pc, err := common.NewMetricParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, common.WithMetricParser(c.metricFunctions), common.WithDataPointParser(c.dataPointFunctions), common.WithExemplarParser(c.exemplarFunctions))
+		pc, err := common.NewMetricParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, common.WithMetricParser(withHostFunctions(c.metricFunctions, funcs)), common.WithDataPointParser(withHostFunctions(c.dataPointFunctions, funcs)), common.WithExemplarParser(withHostFunctions(c.exemplarFunctions, funcs)))
 		if err != nil {
 			return err
 		}
@@ -169,7 +199,7 @@ func (c *Config) Validate() error {
 	}
 
 	if len(c.LogStatements) > 0 {
-		pc, err := common.NewLogParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, common.WithLogParser(c.logFunctions))
+		pc, err := common.NewLogParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, common.WithLogParser(withHostFunctions(c.logFunctions, funcs)))
 		if err != nil {
 			return err
 		}
@@ -182,7 +212,7 @@ func (c *Config) Validate() error {
 	}
 
 	if len(c.ProfileStatements) > 0 {
-		pc, err := common.NewProfileParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, common.WithProfileParser(c.profileFunctions))
+		pc, err := common.NewProfileParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, common.WithProfileParser(withHostFunctions(c.profileFunctions, funcs)))
 		if err != nil {
 			return err
 		}
@@ -198,5 +228,9 @@ func (c *Config) Validate() error {
 		errors = multierr.Append(errors, errFlatLogsGateDisabled)
 	}
 
+	if c.Debug != "" && c.Debug != debugExplain {
+		errors = multierr.Append(errors, fmt.Errorf("invalid debug %q, must be empty or %q", c.Debug, debugExplain))
+	}
+
 	return errors
 }
@@ -23,6 +23,7 @@ import (
 func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
+	keyProviderID := component.MustNewIDWithName("keyprovider", "pii")
 	tests := []struct {
 		id       component.ID
 		expected component.Config
@@ -152,6 +153,42 @@ func TestLoadConfig(t *testing.T) {
 				ProfileStatements: []common.ContextStatements{},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "debug_explain"),
+			expected: &Config{
+				ErrorMode:        ottl.IgnoreError,
+				Debug:            debugExplain,
+				TraceStatements:  []common.ContextStatements{},
+				MetricStatements: []common.ContextStatements{},
+				LogStatements: []common.ContextStatements{
+					{
+						Statements: []string{`set(log.attributes["name"], "bear")`},
+					},
+				},
+				ProfileStatements: []common.ContextStatements{},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "key_provider"),
+			expected: &Config{
+				ErrorMode:        ottl.IgnoreError,
+				KeyProvider:      &keyProviderID,
+				TraceStatements:  []common.ContextStatements{},
+				MetricStatements: []common.ContextStatements{},
+				LogStatements: []common.ContextStatements{
+					{
+						Statements: []string{`set(log.attributes["email"], Encrypt(log.attributes["email"], "pii"))`},
+					},
+				},
+				ProfileStatements: []common.ContextStatements{},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "unknown_debug"),
+			errors: []error{
+				errors.New(`invalid debug "trace", must be empty or "explain"`),
+			},
+		},
 		{
 			id: component.NewIDWithName(metadata.Type, "bad_syntax_trace"),
 		},
@@ -5,7 +5,10 @@ package transformprocessor // import "github.com/open-telemetry/opentelemetry-co
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"os"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
@@ -21,9 +24,12 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlexemplar"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlotelcol"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlprofile"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottltrace"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/logs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metadata"
@@ -41,6 +47,7 @@ type transformProcessorFactory struct {
 	metricFunctions                     map[string]ottl.Factory[*ottlmetric.TransformContext]
 	spanEventFunctions                  map[string]ottl.Factory[*ottlspanevent.TransformContext]
 	spanFunctions                       map[string]ottl.Factory[*ottlspan.TransformContext]
+	traceFunctions                      map[string]ottl.Factory[*ottltrace.TransformContext]
 	profileFunctions                    map[string]ottl.Factory[*ottlprofile.TransformContext]
 	defaultDataPointFunctionsOverridden bool
 	defaultExemplarFunctionsOverridden  bool
@@ -48,6 +55,7 @@ type transformProcessorFactory struct {
 	defaultMetricFunctionsOverridden    bool
 	defaultSpanEventFunctionsOverridden bool
 	defaultSpanFunctionsOverridden      bool
+	defaultTraceFunctionsOverridden     bool
 	defaultProfileFunctionsOverridden   bool
 }
 
@@ -151,6 +159,18 @@ func WithSpanFunctionsNew(spanFunctions []ottl.Factory[*ottlspan.TransformContex
 	return WithSpanFunctions(spanFunctions)
 }
 
+// WithTraceFunctions will override the default OTTL trace context functions with the provided traceFunctions in the resulting processor.
+// Subsequent uses of WithTraceFunctions will merge the provided traceFunctions with the previously registered functions.
+func WithTraceFunctions(traceFunctions []ottl.Factory[*ottltrace.TransformContext]) FactoryOption {
+	return func(factory *transformProcessorFactory) {
+		if !factory.defaultTraceFunctionsOverridden {
+			factory.traceFunctions = map[string]ottl.Factory[*ottltrace.TransformContext]{}
+			factory.defaultTraceFunctionsOverridden = true
+		}
+		factory.traceFunctions = mergeFunctionsToMap(factory.traceFunctions, traceFunctions)
+	}
+}
+
 // WithProfileFunctions will override the default OTTL profile context functions with the provided profileFunctions in the resulting processor.
 // Subsequent uses of WithProfileFunctions will merge the provided profileFunctions with the previously registered functions.
 func WithProfileFunctions(profileFunctions []ottl.Factory[*ottlprofile.TransformContext]) FactoryOption {
@@ -181,6 +201,7 @@ func NewFactoryWithOptions(options ...FactoryOption) processor.Factory {
 		metricFunctions:    defaultMetricFunctionsMap(),
 		spanEventFunctions: defaultSpanEventFunctionsMap(),
 		spanFunctions:      defaultSpanFunctionsMap(),
+		traceFunctions:     defaultTraceFunctionsMap(),
 		profileFunctions:   defaultProfileFunctionsMap(),
 	}
 	for _, o := range options {
@@ -214,6 +235,7 @@ func (f *transformProcessorFactory) createDefaultConfig() component.Config {
 		metricFunctions:    f.metricFunctions,
 		spanEventFunctions: f.spanEventFunctions,
 		spanFunctions:      f.spanFunctions,
+		traceFunctions:     f.traceFunctions,
 		profileFunctions:   f.profileFunctions,
 	}
 }
@@ -228,17 +250,27 @@ func (f *transformProcessorFactory) createLogsProcessor(
 	if f.defaultLogFunctionsOverridden {
 		set.Logger.Debug("non-default OTTL log functions have been registered in the \"transform\" processor", zap.Bool("log", f.defaultLogFunctionsOverridden))
 	}
-	proc, err := logs.NewProcessor(oCfg.LogStatements, oCfg.ErrorMode, oCfg.FlattenData, set.TelemetrySettings, f.logFunctions)
+	funcs, err := newHostFunctions(oCfg)
 	if err != nil {
 		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
 	}
-	return processorhelper.NewLogs(
+	proc, err := logs.NewProcessor(oCfg.LogStatements, oCfg.ErrorMode, oCfg.FlattenData, oCfg.Debug == debugExplain, set.TelemetrySettings, withHostFunctions(f.logFunctions, funcs))
+	if err != nil {
+		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
+	}
+	p, err := processorhelper.NewLogs(
 		ctx,
 		set,
 		cfg,
-		nextConsumer,
-		proc.ProcessLogs,
-		processorhelper.WithCapabilities(processorCapabilities))
+		applyOutgoingMetadataLogs{nextConsumer},
+		withCollectorInfo(set, proc.ProcessLogs),
+		processorhelper.WithCapabilities(processorCapabilities),
+		processorhelper.WithStart(funcs.start),
+		processorhelper.WithShutdown(funcs.shutdown))
+	if err != nil {
+		return nil, err
+	}
+	return outgoingMetadataLogs{p}, nil
 }
 
 func (f *transformProcessorFactory) createTracesProcessor(
@@ -248,23 +280,35 @@ func (f *transformProcessorFactory) createTracesProcessor(
 	nextConsumer consumer.Traces,
 ) (processor.Traces, error) {
 	oCfg := cfg.(*Config)
-	if f.defaultSpanEventFunctionsOverridden || f.defaultSpanFunctionsOverridden {
+	if f.defaultSpanEventFunctionsOverridden || f.defaultSpanFunctionsOverridden || f.defaultTraceFunctionsOverridden {
 		set.Logger.Debug("non-default OTTL trace functions have been registered in the \"transform\" processor",
 			zap.Bool("span", f.defaultSpanFunctionsOverridden),
 			zap.Bool("spanevent", f.defaultSpanEventFunctionsOverridden),
+			zap.Bool("trace", f.defaultTraceFunctionsOverridden),
 		)
 	}
-	proc, err := traces.NewProcessor(oCfg.TraceStatements, oCfg.ErrorMode, set.TelemetrySettings, f.spanFunctions, f.spanEventFunctions)
+	funcs, err := newHostFunctions(oCfg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
+	}
+	proc, err := traces.NewProcessor(oCfg.TraceStatements, oCfg.ErrorMode, oCfg.Debug == debugExplain, set.TelemetrySettings,
+		withHostFunctions(f.spanFunctions, funcs), withHostFunctions(f.spanEventFunctions, funcs), withHostFunctions(f.traceFunctions, funcs))
 	if err != nil {
 		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
 	}
-	return processorhelper.NewTraces(
+	p, err := processorhelper.NewTraces(
 		ctx,
 		set,
 		cfg,
-		nextConsumer,
-		proc.ProcessTraces,
-		processorhelper.WithCapabilities(processorCapabilities))
+		applyOutgoingMetadataTraces{nextConsumer},
+		withCollectorInfo(set, proc.ProcessTraces),
+		processorhelper.WithCapabilities(processorCapabilities),
+		processorhelper.WithStart(funcs.start),
+		processorhelper.WithShutdown(funcs.shutdown))
+	if err != nil {
+		return nil, err
+	}
+	return outgoingMetadataTraces{p}, nil
 }
 
 func (f *transformProcessorFactory) createMetricsProcessor(
@@ -282,17 +326,28 @@ func (f *transformProcessorFactory) createMetricsProcessor(
 			zap.Bool("metric", f.defaultMetricFunctionsOverridden),
 		)
 	}
-	proc, err := metrics.NewProcessor(oCfg.MetricStatements, oCfg.ErrorMode, set.TelemetrySettings, f.metricFunctions, f.dataPointFunctions, f.exemplarFunctions)
+	funcs, err := newHostFunctions(oCfg)
 	if err != nil {
 		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
 	}
-	return processorhelper.NewMetrics(
+	proc, err := metrics.NewProcessor(oCfg.MetricStatements, oCfg.ErrorMode, oCfg.Debug == debugExplain, set.TelemetrySettings,
+		withHostFunctions(f.metricFunctions, funcs), withHostFunctions(f.dataPointFunctions, funcs), withHostFunctions(f.exemplarFunctions, funcs))
+	if err != nil {
+		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
+	}
+	p, err := processorhelper.NewMetrics(
 		ctx,
 		set,
 		cfg,
-		nextConsumer,
-		proc.ProcessMetrics,
-		processorhelper.WithCapabilities(processorCapabilities))
+		applyOutgoingMetadataMetrics{nextConsumer},
+		withCollectorInfo(set, proc.ProcessMetrics),
+		processorhelper.WithCapabilities(processorCapabilities),
+		processorhelper.WithStart(funcs.start),
+		processorhelper.WithShutdown(funcs.shutdown))
+	if err != nil {
+		return nil, err
+	}
+	return outgoingMetadataMetrics{p}, nil
 }
 
 func (f *transformProcessorFactory) createProfilesProcessor(
@@ -307,15 +362,77 @@ func (f *transformProcessorFactory) createProfilesProcessor(
 	if f.defaultProfileFunctionsOverridden {
 		set.Logger.Debug("non-default OTTL profile functions have been registered in the \"transform\" processor", zap.Bool("profile", f.defaultProfileFunctionsOverridden))
 	}
-	proc, err := profiles.NewProcessor(oCfg.ProfileStatements, oCfg.ErrorMode, set.TelemetrySettings, f.profileFunctions)
+	funcs, err := newHostFunctions(oCfg)
 	if err != nil {
 		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
 	}
-	return xprocessorhelper.NewProfiles(
+	proc, err := profiles.NewProcessor(oCfg.ProfileStatements, oCfg.ErrorMode, oCfg.Debug == debugExplain, set.TelemetrySettings, withHostFunctions(f.profileFunctions, funcs))
+	if err != nil {
+		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
+	}
+	p, err := xprocessorhelper.NewProfiles(
 		ctx,
 		set,
 		cfg,
-		nextConsumer,
-		proc.ProcessProfiles,
-		xprocessorhelper.WithCapabilities(processorCapabilities))
+		applyOutgoingMetadataProfiles{nextConsumer},
+		withCollectorInfo(set, proc.ProcessProfiles),
+		xprocessorhelper.WithCapabilities(processorCapabilities),
+		xprocessorhelper.WithStart(funcs.start),
+		xprocessorhelper.WithShutdown(funcs.shutdown))
+	if err != nil {
+		return nil, err
+	}
+	return outgoingMetadataProfiles{p}, nil
+}
+
+// hostFunctions are the functions of the processor bound to the collector when it starts:
+// the functions using the keys of the key provider extension, the Lookup function using
+// the lookup table extensions, and the functions of the WebAssembly plugins.
+type hostFunctions struct {
+	keys    *keyProvider
+	tables  *ottlfuncs.LookupTableStore
+	plugins wasmPlugins
+}
+
+func newHostFunctions(cfg *Config) (*hostFunctions, error) {
+	plugins, err := newWasmPlugins(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &hostFunctions{keys: newKeyProvider(cfg), tables: ottlfuncs.NewLookupTableStore(), plugins: plugins}, nil
+}
+
+func (h *hostFunctions) start(ctx context.Context, host component.Host) error {
+	if err := h.keys.start(ctx, host); err != nil {
+		return err
+	}
+	h.tables.SetHost(host)
+	return h.plugins.start(ctx, host)
+}
+
+func (h *hostFunctions) shutdown(ctx context.Context) error {
+	h.tables.SetHost(nil)
+	return errors.Join(h.plugins.shutdown(ctx), h.keys.shutdown(ctx))
+}
+
+func withHostFunctions[K any](functions map[string]ottl.Factory[K], h *hostFunctions) map[string]ottl.Factory[K] {
+	withLookup := maps.Clone(functions)
+	if _, ok := withLookup["Lookup"]; !ok {
+		withLookup["Lookup"] = ottlfuncs.NewLookupFactory[K](h.tables)
+	}
+	return withWasmFunctions(withKeyFunctions(withLookup, h.keys.keyStore()), h.plugins)
+}
+
+// withCollectorInfo returns a function processing the data with a context carrying the
+// information read by the `otelcol.collector` paths of the statements.
+func withCollectorInfo[T any](set processor.Settings, process func(context.Context, T) (T, error)) func(context.Context, T) (T, error) {
+	info := ottlotelcol.CollectorInfo{Version: set.BuildInfo.Version}
+	if hostname, err := os.Hostname(); err == nil {
+		info.Hostname = hostname
+	} else {
+		set.Logger.Debug("failed to get the hostname of the collector", zap.Error(err))
+	}
+	return func(ctx context.Context, data T) (T, error) {
+		return process(ottlotelcol.NewCollectorInfoContext(ctx, info), data)
+	}
 }
@@ -5,12 +5,15 @@ package transformprocessor
 
 import (
 	"context"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/confmap/xconfmap"
+	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -54,6 +57,9 @@ func assertConfigContainsDefaultFunctions(t *testing.T, config Config) {
 	for _, f := range DefaultSpanEventFunctions() {
 		assert.Contains(t, config.spanEventFunctions, f.Name(), "missing span event function %v", f.Name())
 	}
+	for _, f := range DefaultTraceFunctions() {
+		assert.Contains(t, config.traceFunctions, f.Name(), "missing trace function %v", f.Name())
+	}
 	for _, f := range DefaultProfileFunctions() {
 		assert.Contains(t, config.profileFunctions, f.Name(), "missing profile function %v", f.Name())
 	}
@@ -254,6 +260,87 @@ func TestFactoryCreateLogs(t *testing.T) {
 	assert.Equal(t, "pass", val.Str())
 }
 
+func TestFactoryCreateLogs_OTelColContext(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.LogStatements = []common.ContextStatements{
+		{
+			Statements: []string{
+				`set(resource.attributes["tenant"], otelcol.client.metadata["x-tenant"])`,
+				`set(log.attributes["collector.version"], otelcol.collector.version)`,
+				`set(log.attributes["collector.hostname"], otelcol.collector.hostname)`,
+			},
+		},
+	}
+	set := processortest.NewNopSettings(metadata.Type)
+	set.BuildInfo.Version = "1.2.3"
+	lp, err := factory.CreateLogs(t.Context(), set, cfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	log := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+
+	ctx := client.NewContext(t.Context(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{"x-tenant": {"tenant-a"}}),
+	})
+	require.NoError(t, lp.ConsumeLogs(ctx, ld))
+
+	tenant, ok := rl.Resource().Attributes().Get("tenant")
+	require.True(t, ok)
+	assert.Equal(t, []any{"tenant-a"}, tenant.Slice().AsRaw())
+	version, ok := log.Attributes().Get("collector.version")
+	require.True(t, ok)
+	assert.Equal(t, "1.2.3", version.Str())
+	hostname, err := os.Hostname()
+	require.NoError(t, err)
+	collectorHostname, ok := log.Attributes().Get("collector.hostname")
+	require.True(t, ok)
+	assert.Equal(t, hostname, collectorHostname.Str())
+}
+
+func TestFactoryCreateLogs_OutgoingMetadata(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.LogStatements = []common.ContextStatements{
+		{
+			Statements: []string{
+				`set(otelcol.client.metadata["x-tenant"], resource.attributes["tenant"])`,
+				`delete_key(otelcol.client.metadata, "x-debug")`,
+			},
+		},
+	}
+	var received client.Metadata
+	next, err := consumer.NewLogs(func(ctx context.Context, _ plog.Logs) error {
+		received = client.FromContext(ctx).Metadata
+		return nil
+	})
+	require.NoError(t, err)
+	lp, err := factory.CreateLogs(t.Context(), processortest.NewNopSettings(metadata.Type), cfg, next)
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("tenant", "tenant-b")
+	rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+
+	ctx := client.NewContext(t.Context(), client.Info{
+		Metadata: client.NewMetadata(map[string][]string{
+			"x-tenant": {"tenant-a"},
+			"x-debug":  {"true"},
+			"x-region": {"eu"},
+		}),
+	})
+	require.NoError(t, lp.ConsumeLogs(ctx, ld))
+
+	assert.Equal(t, []string{"tenant-b"}, received.Get("x-tenant"))
+	assert.Empty(t, received.Get("x-debug"))
+	assert.Equal(t, []string{"eu"}, received.Get("x-region"))
+	assert.Equal(t, []string{"tenant-a"}, client.FromContext(ctx).Metadata.Get("x-tenant"))
+}
+
 func TestFactoryCreateLogs_InvalidActions(t *testing.T) {
 	factory := NewFactory()
 	cfg := factory.CreateDefaultConfig()
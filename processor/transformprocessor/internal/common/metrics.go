@@ -304,6 +304,10 @@ func WithMetricErrorMode(errorMode ottl.ErrorMode) MetricParserCollectionOption
 	return MetricParserCollectionOption(ottl.WithParserCollectionErrorMode[MetricsConsumer](errorMode))
 }
 
+func WithMetricExplain(enabled bool) MetricParserCollectionOption {
+	return MetricParserCollectionOption(ottl.WithParserCollectionExplain[MetricsConsumer](enabled))
+}
+
 func NewMetricParserCollection(settings component.TelemetrySettings, options ...MetricParserCollectionOption) (*MetricParserCollection, error) {
 	pcOptions := []ottl.ParserCollectionOption[MetricsConsumer]{
 		withCommonContextParsers[MetricsConsumer](),
@@ -340,7 +344,7 @@ func convertMetricStatements(pc *ottl.ParserCollection[MetricsConsumer], stateme
 	if errGlobalBoolExpr != nil {
 		return nil, errGlobalBoolExpr
 	}
-	mStatements := ottlmetric.NewStatementSequence(parsedStatements, pc.Settings, ottlmetric.WithStatementSequenceErrorMode(errorMode))
+	mStatements := newStatementSequence(pc, parsedStatements, errorMode)
 	return metricStatements{mStatements, globalExpr}, nil
 }
 
@@ -361,7 +365,7 @@ func convertDataPointStatements(pc *ottl.ParserCollection[MetricsConsumer], stat
 	if errGlobalBoolExpr != nil {
 		return nil, errGlobalBoolExpr
 	}
-	dpStatements := ottldatapoint.NewStatementSequence(parsedStatements, pc.Settings, ottldatapoint.WithStatementSequenceErrorMode(errorMode))
+	dpStatements := newStatementSequence(pc, parsedStatements, errorMode)
 	return dataPointStatements{dpStatements, globalExpr}, nil
 }
 
@@ -382,7 +386,7 @@ func convertExemplarStatements(pc *ottl.ParserCollection[MetricsConsumer], state
 	if errGlobalBoolExpr != nil {
 		return nil, errGlobalBoolExpr
 	}
-	eStatements := ottlexemplar.NewStatementSequence(parsedStatements, pc.Settings, ottlexemplar.WithStatementSequenceErrorMode(errorMode))
+	eStatements := newStatementSequence(pc, parsedStatements, errorMode)
 	return exemplarStatements{eStatements, globalExpr}, nil
 }
 
@@ -11,6 +11,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/pprofile"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
@@ -216,6 +217,19 @@ type baseContext interface {
 	ProfilesConsumer
 }
 
+// newStatementSequence creates the StatementSequence of the parsed statements. When the ParserCollection
+// is configured to explain the statements, each execution of the sequence is explained in the logs.
+func newStatementSequence[K, R any](pc *ottl.ParserCollection[R], parsedStatements []*ottl.Statement[K], errorMode ottl.ErrorMode) ottl.StatementSequence[K] {
+	options := []ottl.StatementSequenceOption[K]{ottl.WithStatementSequenceErrorMode[K](errorMode)}
+	if pc.Explain {
+		logger := pc.Settings.Logger
+		options = append(options, ottl.WithStatementSequenceExplain[K](func(explanation ottl.Explanation) {
+			logger.Info("explained statements execution", zap.Object("explanation", explanation))
+		}))
+	}
+	return ottl.NewStatementSequence(parsedStatements, pc.Settings, options...)
+}
+
 func withCommonContextParsers[R any]() ottl.ParserCollectionOption[R] {
 	return func(pc *ottl.ParserCollection[R]) error {
 		rp, err := ottlresource.NewParser(ResourceFunctions(), pc.Settings, ottlresource.EnablePathContextNames())
@@ -262,7 +276,7 @@ func parseResourceContextStatements[R any](
 	if errGlobalBoolExpr != nil {
 		return *new(R), errGlobalBoolExpr
 	}
-	rStatements := ottlresource.NewStatementSequence(parsedStatements, pc.Settings, ottlresource.WithStatementSequenceErrorMode(errorMode))
+	rStatements := newStatementSequence(pc, parsedStatements, errorMode)
 	result := baseContext(resourceStatements{rStatements, globalExpr})
 	return result.(R), nil
 }
@@ -288,7 +302,7 @@ func parseScopeContextStatements[R any](
 	if errGlobalBoolExpr != nil {
 		return *new(R), errGlobalBoolExpr
 	}
-	sStatements := ottlscope.NewStatementSequence(parsedStatements, pc.Settings, ottlscope.WithStatementSequenceErrorMode(errorMode))
+	sStatements := newStatementSequence(pc, parsedStatements, errorMode)
 	result := baseContext(scopeStatements{sStatements, globalExpr})
 	return result.(R), nil
 }
@@ -307,3 +321,13 @@ func parseGlobalExpr[K, O any](
 	// By default, set the global expression to always true unless conditions are specified.
 	return expr.AlwaysTrue[K](), nil
 }
+
+// ErrorDetailsField returns a field logging where the OTTL statements failed, such as the index of
+// the statement and the position of its function which returned the error, if the error has them.
+func ErrorDetailsField(err error) zap.Field {
+	statementErrs := ottl.StatementErrors(err)
+	if len(statementErrs) == 0 {
+		return zap.Skip()
+	}
+	return zap.Objects("error_details", statementErrs)
+}
@@ -72,6 +72,10 @@ func WithProfileErrorMode(errorMode ottl.ErrorMode) ProfileParserCollectionOptio
 	return ProfileParserCollectionOption(ottl.WithParserCollectionErrorMode[ProfilesConsumer](errorMode))
 }
 
+func WithProfileExplain(enabled bool) ProfileParserCollectionOption {
+	return ProfileParserCollectionOption(ottl.WithParserCollectionExplain[ProfilesConsumer](enabled))
+}
+
 func NewProfileParserCollection(settings component.TelemetrySettings, options ...ProfileParserCollectionOption) (*ProfileParserCollection, error) {
 	pcOptions := []ottl.ParserCollectionOption[ProfilesConsumer]{
 		withCommonContextParsers[ProfilesConsumer](),
@@ -108,7 +112,7 @@ func convertProfileStatements(pc *ottl.ParserCollection[ProfilesConsumer], state
 	if errGlobalBoolExpr != nil {
 		return nil, errGlobalBoolExpr
 	}
-	lStatements := ottlprofile.NewStatementSequence(parsedStatements, pc.Settings, ottlprofile.WithStatementSequenceErrorMode(errorMode))
+	lStatements := newStatementSequence(pc, parsedStatements, errorMode)
 	return profileStatements{lStatements, globalExpr}, nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
@@ -14,6 +15,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottltrace"
 )
 
 type TracesConsumer interface {
@@ -97,6 +99,53 @@ func (s spanEventStatements) ConsumeTraces(ctx context.Context, td ptrace.Traces
 	return nil
 }
 
+type traceContextStatements struct {
+	ottl.StatementSequence[*ottltrace.TransformContext]
+	expr.BoolExpr[*ottltrace.TransformContext]
+}
+
+func (traceContextStatements) Context() ContextID {
+	return Trace
+}
+
+// ConsumeTraces groups the spans of the batch by trace ID, and executes the statements once per
+// trace, in the order in which the traces first appear in the batch.
+func (t traceContextStatements) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	var traceIDs []pcommon.TraceID
+	spansByTraceID := map[pcommon.TraceID][]ptrace.Span{}
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rspans := td.ResourceSpans().At(i)
+		for j := 0; j < rspans.ScopeSpans().Len(); j++ {
+			spans := rspans.ScopeSpans().At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				traceID := span.TraceID()
+				if _, ok := spansByTraceID[traceID]; !ok {
+					traceIDs = append(traceIDs, traceID)
+				}
+				spansByTraceID[traceID] = append(spansByTraceID[traceID], span)
+			}
+		}
+	}
+	for _, traceID := range traceIDs {
+		tCtx := ottltrace.NewTransformContextPtr(traceID, spansByTraceID[traceID])
+		condition, err := t.Eval(ctx, tCtx)
+		if err != nil {
+			tCtx.Close()
+			return err
+		}
+		if condition {
+			err = t.Execute(ctx, tCtx)
+			if err != nil {
+				tCtx.Close()
+				return err
+			}
+		}
+		tCtx.Close()
+	}
+	return nil
+}
+
 type TraceParserCollection ottl.ParserCollection[TracesConsumer]
 
 type TraceParserCollectionOption ottl.ParserCollectionOption[TracesConsumer]
@@ -121,10 +170,24 @@ func WithSpanEventParser(functions map[string]ottl.Factory[*ottlspanevent.Transf
 	}
 }
 
+func WithTraceParser(functions map[string]ottl.Factory[*ottltrace.TransformContext]) TraceParserCollectionOption {
+	return func(pc *ottl.ParserCollection[TracesConsumer]) error {
+		parser, err := ottltrace.NewParser(functions, pc.Settings, ottltrace.EnablePathContextNames())
+		if err != nil {
+			return err
+		}
+		return ottl.WithParserCollectionContext(ottltrace.ContextName, &parser, ottl.WithStatementConverter(convertTraceStatements))(pc)
+	}
+}
+
 func WithTraceErrorMode(errorMode ottl.ErrorMode) TraceParserCollectionOption {
 	return TraceParserCollectionOption(ottl.WithParserCollectionErrorMode[TracesConsumer](errorMode))
 }
 
+func WithTraceExplain(enabled bool) TraceParserCollectionOption {
+	return TraceParserCollectionOption(ottl.WithParserCollectionExplain[TracesConsumer](enabled))
+}
+
 func NewTraceParserCollection(settings component.TelemetrySettings, options ...TraceParserCollectionOption) (*TraceParserCollection, error) {
 	pcOptions := []ottl.ParserCollectionOption[TracesConsumer]{
 		withCommonContextParsers[TracesConsumer](),
@@ -161,7 +224,7 @@ func convertSpanStatements(pc *ottl.ParserCollection[TracesConsumer], statements
 	if errGlobalBoolExpr != nil {
 		return nil, errGlobalBoolExpr
 	}
-	sStatements := ottlspan.NewStatementSequence(parsedStatements, pc.Settings, ottlspan.WithStatementSequenceErrorMode(errorMode))
+	sStatements := newStatementSequence(pc, parsedStatements, errorMode)
 	return traceStatements{sStatements, globalExpr}, nil
 }
 
@@ -182,10 +245,31 @@ func convertSpanEventStatements(pc *ottl.ParserCollection[TracesConsumer], state
 	if errGlobalBoolExpr != nil {
 		return nil, errGlobalBoolExpr
 	}
-	seStatements := ottlspanevent.NewStatementSequence(parsedStatements, pc.Settings, ottlspanevent.WithStatementSequenceErrorMode(errorMode))
+	seStatements := newStatementSequence(pc, parsedStatements, errorMode)
 	return spanEventStatements{seStatements, globalExpr}, nil
 }
 
+func convertTraceStatements(pc *ottl.ParserCollection[TracesConsumer], statements ottl.StatementsGetter, parsedStatements []*ottl.Statement[*ottltrace.TransformContext]) (TracesConsumer, error) {
+	contextStatements, err := toContextStatements(statements)
+	if err != nil {
+		return nil, err
+	}
+	errorMode := pc.ErrorMode
+	if contextStatements.ErrorMode != "" {
+		errorMode = contextStatements.ErrorMode
+	}
+	var parserOptions []ottl.Option[*ottltrace.TransformContext]
+	if contextStatements.Context == "" {
+		parserOptions = append(parserOptions, ottltrace.EnablePathContextNames())
+	}
+	globalExpr, errGlobalBoolExpr := parseGlobalExpr(filterottl.NewBoolExprForTraceWithOptions, contextStatements.Conditions, errorMode, pc.Settings, filterottl.StandardTraceFuncs(), parserOptions)
+	if errGlobalBoolExpr != nil {
+		return nil, errGlobalBoolExpr
+	}
+	tStatements := newStatementSequence(pc, parsedStatements, errorMode)
+	return traceContextStatements{tStatements, globalExpr}, nil
+}
+
 func (tpc *TraceParserCollection) ParseContextStatements(contextStatements ContextStatements) (TracesConsumer, error) {
 	pc := ottl.ParserCollection[TracesConsumer](*tpc)
 	if contextStatements.Context != "" {
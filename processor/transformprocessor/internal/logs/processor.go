@@ -23,8 +23,8 @@ type Processor struct {
 	flatMode bool
 }
 
-func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.ErrorMode, flatMode bool, settings component.TelemetrySettings, logFunctions map[string]ottl.Factory[*ottllog.TransformContext]) (*Processor, error) {
-	pc, err := common.NewLogParserCollection(settings, common.WithLogParser(logFunctions), common.WithLogErrorMode(errorMode))
+func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.ErrorMode, flatMode, explain bool, settings component.TelemetrySettings, logFunctions map[string]ottl.Factory[*ottllog.TransformContext]) (*Processor, error) {
+	pc, err := common.NewLogParserCollection(settings, common.WithLogParser(logFunctions), common.WithLogErrorMode(errorMode), common.WithLogExplain(explain))
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +59,7 @@ func (p *Processor) ProcessLogs(ctx context.Context, ld plog.Logs) (plog.Logs, e
 	for _, c := range p.contexts {
 		err := c.ConsumeLogs(ctx, ld)
 		if err != nil {
-			p.logger.Error("failed processing logs", zap.Error(err))
+			p.logger.Error("failed processing logs", zap.Error(err), common.ErrorDetailsField(err))
 			return ld, err
 		}
 	}
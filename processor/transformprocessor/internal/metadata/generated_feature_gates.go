@@ -14,6 +14,14 @@ var ProcessorTransformDefaultErrorModeIgnoreFeatureGate = featuregate.GlobalRegi
 	featuregate.WithRegisterFromVersion("v0.150.0"),
 )
 
+var ProcessorTransformEnableWasmFunctionsFeatureGate = featuregate.GlobalRegistry().MustRegister(
+	"processor.transform.enableWasmFunctions",
+	featuregate.StageAlpha,
+	featuregate.WithRegisterDescription("Allows loading OTTL functions from the WebAssembly modules configured with wasm_plugins"),
+	featuregate.WithRegisterReferenceURL("https://github.com/paulojmdias/opentelemetry-collector-contrib/issues/2841"),
+	featuregate.WithRegisterFromVersion("v0.156.0"),
+)
+
 var TransformFlattenLogsFeatureGate = featuregate.GlobalRegistry().MustRegister(
 	"transform.flatten.logs",
 	featuregate.StageAlpha,
@@ -21,8 +21,8 @@ type Processor struct {
 	logger   *zap.Logger
 }
 
-func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.ErrorMode, settings component.TelemetrySettings, profileFunctions map[string]ottl.Factory[*ottlprofile.TransformContext]) (*Processor, error) {
-	pc, err := common.NewProfileParserCollection(settings, common.WithProfileParser(profileFunctions), common.WithProfileErrorMode(errorMode))
+func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.ErrorMode, explain bool, settings component.TelemetrySettings, profileFunctions map[string]ottl.Factory[*ottlprofile.TransformContext]) (*Processor, error) {
+	pc, err := common.NewProfileParserCollection(settings, common.WithProfileParser(profileFunctions), common.WithProfileErrorMode(errorMode), common.WithProfileExplain(explain))
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +51,7 @@ func (p *Processor) ProcessProfiles(ctx context.Context, ld pprofile.Profiles) (
 	for _, c := range p.contexts {
 		err := c.ConsumeProfiles(ctx, ld)
 		if err != nil {
-			p.logger.Error("failed processing profiles", zap.Error(err))
+			p.logger.Error("failed processing profiles", zap.Error(err), common.ErrorDetailsField(err))
 			return ld, err
 		}
 	}
@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor"
+
+import (
+	"context"
+	"maps"
+
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+// keyProvider binds the store of the functions using keys to the key provider extension
+// configured with `key_provider`, which is only available once the processor starts.
+type keyProvider struct {
+	id   component.ID
+	keys *ottlfuncs.KeyStore
+}
+
+func (k *keyProvider) start(_ context.Context, host component.Host) error {
+	if k == nil {
+		return nil
+	}
+	provider, err := ottlfuncs.GetKeyProvider(host, k.id)
+	if err != nil {
+		return err
+	}
+	k.keys.SetKeyProvider(provider)
+	return nil
+}
+
+func (k *keyProvider) shutdown(context.Context) error {
+	if k == nil {
+		return nil
+	}
+	k.keys.SetKeyProvider(nil)
+	return nil
+}
+
+// withKeyFunctions returns the functions with the HMAC_SHA256, SaltedSHA256, Encrypt and
+// Decrypt functions using the keys of the store added, when a key provider is configured.
+// The functions registered with the same names by the factory options are kept.
+func withKeyFunctions[K any](functions map[string]ottl.Factory[K], keys *ottlfuncs.KeyStore) map[string]ottl.Factory[K] {
+	if keys == nil {
+		return functions
+	}
+	withKeys := maps.Clone(functions)
+	for _, f := range []ottl.Factory[K]{
+		ottlfuncs.NewHMACSHA256Factory[K](keys),
+		ottlfuncs.NewSaltedSHA256Factory[K](keys),
+		ottlfuncs.NewEncryptFactory[K](keys),
+		ottlfuncs.NewDecryptFactory[K](keys),
+	} {
+		if _, ok := withKeys[f.Name()]; !ok {
+			withKeys[f.Name()] = f
+		}
+	}
+	return withKeys
+}
+
+// newKeyProvider returns the key provider of the processor, or nil if none is configured.
+func newKeyProvider(cfg *Config) *keyProvider {
+	if cfg.KeyProvider == nil {
+		return nil
+	}
+	return &keyProvider{id: *cfg.KeyProvider, keys: ottlfuncs.NewKeyStore()}
+}
+
+// keyStore returns the store of the functions using keys, or nil if no key provider is configured.
+func (k *keyProvider) keyStore() *ottlfuncs.KeyStore {
+	if k == nil {
+		return nil
+	}
+	return k.keys
+}
@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/processor/processortest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metadata"
+)
+
+type testKeyProvider struct {
+	component.StartFunc
+	component.ShutdownFunc
+	keys map[string][]byte
+}
+
+func (p *testKeyProvider) Key(_ context.Context, id string) ([]byte, bool, error) {
+	key, ok := p.keys[id]
+	return key, ok, nil
+}
+
+type extensionsHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h extensionsHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+func TestProcessLogsWithKeyProvider(t *testing.T) {
+	providerID := component.MustNewID("keys")
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.KeyProvider = &providerID
+	oCfg.LogStatements = []common.ContextStatements{
+		{
+			Context: "log",
+			Statements: []string{
+				`set(log.attributes["encrypted"], Encrypt(log.attributes["email"], "pii"))`,
+				`set(log.attributes["decrypted"], Decrypt(log.attributes["encrypted"], "pii"))`,
+			},
+		},
+	}
+	require.NoError(t, oCfg.Validate())
+
+	sink := new(consumertest.LogsSink)
+	p, err := factory.CreateLogs(t.Context(), processortest.NewNopSettings(metadata.Type), oCfg, sink)
+	require.NoError(t, err)
+	host := extensionsHost{
+		Host: componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{
+			providerID: &testKeyProvider{keys: map[string][]byte{"pii": []byte("0123456789abcdef0123456789abcdef")}},
+		},
+	}
+	require.NoError(t, p.Start(t.Context(), host))
+	t.Cleanup(func() { require.NoError(t, p.Shutdown(context.Background())) })
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Attributes().PutStr("email", "user@example.com")
+	require.NoError(t, p.ConsumeLogs(t.Context(), ld))
+
+	require.Len(t, sink.AllLogs(), 1)
+	attrs := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+	encrypted, ok := attrs.Get("encrypted")
+	require.True(t, ok)
+	assert.NotEmpty(t, encrypted.Str())
+	assert.NotEqual(t, "user@example.com", encrypted.Str())
+	decrypted, ok := attrs.Get("decrypted")
+	require.True(t, ok)
+	assert.Equal(t, "user@example.com", decrypted.Str())
+}
+
+func TestProcessLogsWithKeyProviderNotFound(t *testing.T) {
+	providerID := component.MustNewID("keys")
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.KeyProvider = &providerID
+
+	p, err := factory.CreateLogs(t.Context(), processortest.NewNopSettings(metadata.Type), oCfg, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.ErrorContains(t, p.Start(t.Context(), componenttest.NewNopHost()), `key provider extension "keys" not found`)
+}
+
+func TestKeyFunctionsRequireKeyProvider(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.LogStatements = []common.ContextStatements{
+		{
+			Context:    "log",
+			Statements: []string{`set(log.attributes["hash"], HMAC_SHA256(log.attributes["email"], "pii"))`},
+		},
+	}
+	assert.ErrorContains(t, oCfg.Validate(), `undefined function "HMAC_SHA256"`)
+}
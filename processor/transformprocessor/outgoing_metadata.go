@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/xconsumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/xprocessor"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlotelcol"
+)
+
+// The processors returned by processorhelper pass the context they receive to the next consumer,
+// so the statements can set `otelcol.client.metadata` when the processor is wrapped to receive a
+// context created by ottlotelcol.NewOutgoingMetadataContext, and the next consumer is wrapped to
+// receive the context returned by ottlotelcol.ApplyOutgoingMetadata.
+
+type outgoingMetadataLogs struct {
+	processor.Logs
+}
+
+func (p outgoingMetadataLogs) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	return p.Logs.ConsumeLogs(ottlotelcol.NewOutgoingMetadataContext(ctx), ld)
+}
+
+type applyOutgoingMetadataLogs struct {
+	consumer.Logs
+}
+
+func (c applyOutgoingMetadataLogs) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	return c.Logs.ConsumeLogs(ottlotelcol.ApplyOutgoingMetadata(ctx), ld)
+}
+
+type outgoingMetadataTraces struct {
+	processor.Traces
+}
+
+func (p outgoingMetadataTraces) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	return p.Traces.ConsumeTraces(ottlotelcol.NewOutgoingMetadataContext(ctx), td)
+}
+
+type applyOutgoingMetadataTraces struct {
+	consumer.Traces
+}
+
+func (c applyOutgoingMetadataTraces) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	return c.Traces.ConsumeTraces(ottlotelcol.ApplyOutgoingMetadata(ctx), td)
+}
+
+type outgoingMetadataMetrics struct {
+	processor.Metrics
+}
+
+func (p outgoingMetadataMetrics) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	return p.Metrics.ConsumeMetrics(ottlotelcol.NewOutgoingMetadataContext(ctx), md)
+}
+
+type applyOutgoingMetadataMetrics struct {
+	consumer.Metrics
+}
+
+func (c applyOutgoingMetadataMetrics) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	return c.Metrics.ConsumeMetrics(ottlotelcol.ApplyOutgoingMetadata(ctx), md)
+}
+
+type outgoingMetadataProfiles struct {
+	xprocessor.Profiles
+}
+
+func (p outgoingMetadataProfiles) ConsumeProfiles(ctx context.Context, pd pprofile.Profiles) error {
+	return p.Profiles.ConsumeProfiles(ottlotelcol.NewOutgoingMetadataContext(ctx), pd)
+}
+
+type applyOutgoingMetadataProfiles struct {
+	xconsumer.Profiles
+}
+
+func (c applyOutgoingMetadataProfiles) ConsumeProfiles(ctx context.Context, pd pprofile.Profiles) error {
+	return c.Profiles.ConsumeProfiles(ottlotelcol.ApplyOutgoingMetadata(ctx), pd)
+}
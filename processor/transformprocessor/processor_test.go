@@ -4,12 +4,17 @@
 package transformprocessor
 
 import (
+	"context"
 	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/featuregate"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/processor/processortest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
@@ -145,3 +150,57 @@ func BenchmarkLogsWithFlatten(b *testing.B) {
 		require.NoError(b, p.ConsumeLogs(b.Context(), input))
 	}
 }
+
+type testLookupTable map[string]any
+
+func (t testLookupTable) Lookup(_ context.Context, key string) (any, bool, error) {
+	val, ok := t[key]
+	return val, ok, nil
+}
+
+type testLookupTableExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+	testLookupTable
+}
+
+func TestProcessLogsWithLookupTable(t *testing.T) {
+	require.NoError(t, featuregate.GlobalRegistry().Set("ottl.functions.enableLookup", true))
+	t.Cleanup(func() {
+		_ = featuregate.GlobalRegistry().Set("ottl.functions.enableLookup", false)
+	})
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.LogStatements = []common.ContextStatements{
+		{
+			Context: "log",
+			Statements: []string{
+				`set(log.attributes["owner"], Lookup(log.attributes["service"], "lookuptable/owners"))`,
+			},
+		},
+	}
+	require.NoError(t, oCfg.Validate())
+
+	sink := new(consumertest.LogsSink)
+	p, err := factory.CreateLogs(t.Context(), processortest.NewNopSettings(metadata.Type), oCfg, sink)
+	require.NoError(t, err)
+	host := extensionsHost{
+		Host: componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{
+			component.MustNewIDWithName("lookuptable", "owners"): testLookupTableExtension{testLookupTable: testLookupTable{"checkout": "payments-team"}},
+		},
+	}
+	require.NoError(t, p.Start(t.Context(), host))
+	t.Cleanup(func() { require.NoError(t, p.Shutdown(context.Background())) })
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Attributes().PutStr("service", "checkout")
+	require.NoError(t, p.ConsumeLogs(t.Context(), ld))
+
+	require.Len(t, sink.AllLogs(), 1)
+	owner, ok := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get("owner")
+	require.True(t, ok)
+	assert.Equal(t, "payments-team", owner.Str())
+}
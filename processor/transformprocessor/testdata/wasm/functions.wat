@@ -0,0 +1,42 @@
+;; Source of functions.wasm, used by the tests. It can be rebuilt with:
+;;   wat2wasm --enable-bulk-memory functions.wat -o functions.wasm
+(module
+  (memory (export "memory") 1)
+  (global $heap (mut i32) (i32.const 1024))
+
+  ;; {"value":
+  (data (i32.const 16) "{\"value\":")
+  ;; {"error":"failed"}
+  (data (i32.const 32) "{\"error\":\"failed\"}")
+
+  ;; A bump allocator, memory is never reused.
+  (func $alloc (export "ottl_alloc") (param $size i32) (result i32)
+    global.get $heap
+    global.get $heap
+    local.get $size
+    i32.add
+    global.set $heap)
+
+  (func $free (export "ottl_free") (param $ptr i32) (param $size i32))
+
+  ;; Returns the array of arguments as the value.
+  (func $echo (export "echo") (param $ptr i32) (param $len i32) (result i64)
+    (local $out i32)
+    local.get $len
+    i32.const 10
+    i32.add
+    call $alloc
+    local.set $out
+    (memory.copy (local.get $out) (i32.const 16) (i32.const 9))
+    (memory.copy (i32.add (local.get $out) (i32.const 9)) (local.get $ptr) (local.get $len))
+    (i32.store8 (i32.add (i32.add (local.get $out) (i32.const 9)) (local.get $len)) (i32.const 125))
+    (i64.or
+      (i64.shl (i64.extend_i32_u (local.get $out)) (i64.const 32))
+      (i64.extend_i32_u (i32.add (local.get $len) (i32.const 10)))))
+
+  ;; Always returns an error.
+  (func $fail (export "fail") (param $ptr i32) (param $len i32) (result i64)
+    i64.const 137438953490) ;; 32 << 32 | 18
+
+  (func $bad_signature (export "bad_signature") (param $ptr i32) (result i32)
+    local.get $ptr))
@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor"
+
+import (
+	"context"
+	"errors"
+	"maps"
+
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlwasm"
+)
+
+var errWasmPluginsGateDisabled = errors.New("'wasm_plugins' requires the 'processor.transform.enableWasmFunctions' feature gate to be enabled")
+
+// wasmPlugins are the WebAssembly plugins configured with `wasm_plugins`, whose modules
+// are loaded when the processor starts.
+type wasmPlugins []*ottlwasm.Plugin
+
+func newWasmPlugins(cfg *Config) (wasmPlugins, error) {
+	plugins := make(wasmPlugins, 0, len(cfg.WasmPlugins))
+	for _, pluginCfg := range cfg.WasmPlugins {
+		plugin, err := ottlwasm.NewPlugin(pluginCfg)
+		if err != nil {
+			return nil, err
+		}
+		plugins = append(plugins, plugin)
+	}
+	return plugins, nil
+}
+
+func (p wasmPlugins) start(ctx context.Context, _ component.Host) error {
+	for _, plugin := range p {
+		if err := plugin.Load(ctx); err != nil {
+			return errors.Join(err, p.shutdown(ctx))
+		}
+	}
+	return nil
+}
+
+func (p wasmPlugins) shutdown(ctx context.Context) error {
+	var errs error
+	for _, plugin := range p {
+		errs = errors.Join(errs, plugin.Close(ctx))
+	}
+	return errs
+}
+
+// withWasmFunctions returns the functions with the functions of the plugins added. They replace
+// the functions registered with the same names.
+func withWasmFunctions[K any](functions map[string]ottl.Factory[K], plugins wasmPlugins) map[string]ottl.Factory[K] {
+	if len(plugins) == 0 {
+		return functions
+	}
+	withPlugins := maps.Clone(functions)
+	for _, plugin := range plugins {
+		for _, f := range ottlwasm.NewFactories[K](plugin) {
+			withPlugins[f.Name()] = f
+		}
+	}
+	return withPlugins
+}
@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transformprocessor
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/featuregate"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/processor/processortest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlwasm"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metadata"
+)
+
+func wasmPluginsConfig() *Config {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.WasmPlugins = []ottlwasm.PluginConfig{
+		{
+			Path:      filepath.Join("testdata", "wasm", "functions.wasm"),
+			Functions: []ottlwasm.FunctionConfig{{Name: "Echo", Export: "echo", Parameters: []string{"value"}}},
+		},
+	}
+	cfg.LogStatements = []common.ContextStatements{
+		{
+			Context:    "log",
+			Statements: []string{`set(log.attributes["echo"], Echo(log.attributes["name"]))`},
+		},
+	}
+	return cfg
+}
+
+func TestWasmPluginsRequireGate(t *testing.T) {
+	assert.Equal(t, errWasmPluginsGateDisabled, wasmPluginsConfig().Validate())
+}
+
+func TestProcessLogsWithWasmPlugins(t *testing.T) {
+	require.NoError(t, featuregate.GlobalRegistry().Set(metadata.ProcessorTransformEnableWasmFunctionsFeatureGate.ID(), true))
+	t.Cleanup(func() {
+		_ = featuregate.GlobalRegistry().Set(metadata.ProcessorTransformEnableWasmFunctionsFeatureGate.ID(), false)
+	})
+
+	cfg := wasmPluginsConfig()
+	cfg.ErrorMode = ottl.PropagateError
+	require.NoError(t, cfg.Validate())
+
+	sink := new(consumertest.LogsSink)
+	p, err := NewFactory().CreateLogs(t.Context(), processortest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, p.Start(t.Context(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, p.Shutdown(context.Background())) })
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Attributes().PutStr("name", "bear")
+
+	// A canceled request doesn't prevent the module from processing the next ones
+	canceled, cancel := context.WithCancel(t.Context())
+	cancel()
+	assert.Error(t, p.ConsumeLogs(canceled, ld))
+	require.NoError(t, p.ConsumeLogs(t.Context(), ld))
+
+	require.Len(t, sink.AllLogs(), 1)
+	echo, ok := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get("echo")
+	require.True(t, ok)
+	assert.Equal(t, []any{"bear"}, echo.Slice().AsRaw())
+}
+
+func TestWasmPluginsInvalidConfig(t *testing.T) {
+	require.NoError(t, featuregate.GlobalRegistry().Set(metadata.ProcessorTransformEnableWasmFunctionsFeatureGate.ID(), true))
+	t.Cleanup(func() {
+		_ = featuregate.GlobalRegistry().Set(metadata.ProcessorTransformEnableWasmFunctionsFeatureGate.ID(), false)
+	})
+
+	cfg := wasmPluginsConfig()
+	cfg.WasmPlugins[0].Path = ""
+	assert.ErrorContains(t, cfg.Validate(), "path must be specified")
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/consumerretry"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/adapter"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/file"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filelogreceiver/internal/metadata"
@@ -22,6 +23,21 @@ func NewFactory() receiver.Factory {
 	)
 }
 
+// TrackedFiles returns the files read during the last poll of a receiver created
+// by the factory of this package. It returns nil for any other receiver.
+func TrackedFiles(r receiver.Logs) []fileconsumer.FileInfo {
+	p, ok := r.(interface{ Operators() []operator.Operator })
+	if !ok {
+		return nil
+	}
+	for _, op := range p.Operators() {
+		if input, ok := op.(*file.Input); ok {
+			return input.Files()
+		}
+	}
+	return nil
+}
+
 // ReceiverType implements stanza.LogReceiverType
 // to create a file tailing receiver
 type ReceiverType struct{}
@@ -134,6 +134,29 @@ func TestReadStaticFile(t *testing.T) {
 	require.NoError(t, rcvr.Shutdown(t.Context()))
 }
 
+func TestTrackedFiles(t *testing.T) {
+	t.Parallel()
+
+	f := NewFactory()
+	sink := new(consumertest.LogsSink)
+	rcvr, err := f.CreateLogs(t.Context(), receivertest.NewNopSettings(metadata.Type), testdataConfigYaml(), sink)
+	require.NoError(t, err, "failed to create receiver")
+	require.NoError(t, rcvr.Start(t.Context(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, rcvr.Shutdown(t.Context()))
+	}()
+
+	require.Eventually(t, expectNLogs(sink, 3), 2*time.Second, 5*time.Millisecond)
+	// The file is reported once it has been read to the end
+	require.Eventually(t, func() bool {
+		return len(TrackedFiles(rcvr)) == 1
+	}, 2*time.Second, 5*time.Millisecond)
+	files := TrackedFiles(rcvr)
+	assert.Equal(t, "simple.log", filepath.Base(files[0].Path))
+	assert.Equal(t, int64(3), files[0].RecordNum)
+	assert.Equal(t, "simple.log", files[0].Attributes["log.file.name"])
+}
+
 func TestReadRotatingFiles(t *testing.T) {
 	tests := []rotationTest{
 		{
@@ -207,16 +207,19 @@ func testdataConfigYamlAsMap() *Config {
 				IncludeFileNameResolved: false,
 				IncludeFilePathResolved: false,
 			},
-			PollInterval:       200 * time.Millisecond,
-			Encoding:           "utf-8",
-			StartAt:            "end",
-			FingerprintSize:    1000,
-			InitialBufferSize:  16 * 1024,
-			MaxLogSize:         1024 * 1024,
-			MaxLogSizeBehavior: fileconsumer.MaxLogSizeBehaviorSplit,
-			MaxConcurrentFiles: 1024,
-			FlushPeriod:        500 * time.Millisecond,
-			OnTruncate:         "ignore",
+			PollInterval:        200 * time.Millisecond,
+			Encoding:            "utf-8",
+			StartAt:             "end",
+			FingerprintSize:     1000,
+			InitialBufferSize:   16 * 1024,
+			MaxLogSize:          1024 * 1024,
+			MaxLogSizeBehavior:  fileconsumer.MaxLogSizeBehaviorSplit,
+			MaxConcurrentFiles:  1024,
+			FlushPeriod:         500 * time.Millisecond,
+			OnTruncate:          "ignore",
+			FSLockMode:          fileconsumer.FSLockModeShared,
+			FSLockOnFailure:     fileconsumer.FSLockOnFailureSkip,
+			FingerprintStrategy: fileconsumer.FingerprintStrategyBytes,
 			Criteria: matcher.Criteria{
 				Include: []string{"/var/log/*.log"},
 				Exclude: []string{"/var/log/example.log"},
@@ -3,8 +3,11 @@ module github.com/open-telemetry/opentelemetry-collector-contrib/receiver/udplog
 go 1.25.0
 
 require (
+	github.com/fsnotify/fsnotify v1.10.1 // indirect
+	github.com/klauspost/compress v1.18.7 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza v0.155.0
 	github.com/stretchr/testify v1.11.1
+	github.com/ulikunitz/xz v0.5.15 // indirect
 	go.opentelemetry.io/collector/component v1.61.1-0.20260625204839-9782f9e8a3d6
 	go.opentelemetry.io/collector/confmap v1.61.1-0.20260625204839-9782f9e8a3d6
 	go.opentelemetry.io/collector/consumer v1.61.1-0.20260625204839-9782f9e8a3d6 // indirect